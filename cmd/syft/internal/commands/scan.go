@@ -50,6 +50,7 @@ const (
 	imageSchemeHelp  = `    {{.appName}} {{.command}} docker:yourrepo/yourimage:tag            explicitly use the Docker daemon
     {{.appName}} {{.command}} podman:yourrepo/yourimage:tag            explicitly use the Podman daemon
     {{.appName}} {{.command}} registry:yourrepo/yourimage:tag          pull image directly from a registry (no container runtime required)
+    {{.appName}} {{.command}} containers-storage:yourrepo/yourimage:tag read directly from the local containers-storage store (used by Podman)
     {{.appName}} {{.command}} docker-archive:path/to/yourimage.tar     use a tarball from disk for archives created from "docker save"
     {{.appName}} {{.command}} oci-archive:path/to/yourimage.tar        use a tarball from disk for OCI archives (from Skopeo or otherwise)
     {{.appName}} {{.command}} oci-dir:path/to/yourimage                read directly from a path on disk for OCI layout directories (from Skopeo or otherwise)
@@ -219,9 +220,12 @@ func getSource(ctx context.Context, opts *options.Catalog, userInput string, sou
 			Version: opts.Source.Version,
 		}).
 		WithExcludeConfig(source.ExcludeConfig{
-			Paths: opts.Exclusions,
+			Paths:           opts.Exclusions,
+			CaseInsensitive: opts.GlobCaseInsensitive,
+			SyftIgnore:      opts.SyftIgnore,
 		}).
 		WithBasePath(opts.Source.BasePath).
+		WithMaxArchiveEntries(opts.Package.MaxArchiveEntries).
 		WithSources(sources...).
 		WithDefaultImagePullSource(opts.Source.Image.DefaultPullSource)
 
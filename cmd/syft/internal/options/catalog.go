@@ -15,33 +15,38 @@ import (
 	"github.com/anchore/syft/syft/cataloging"
 	"github.com/anchore/syft/syft/cataloging/filecataloging"
 	"github.com/anchore/syft/syft/cataloging/pkgcataloging"
+	"github.com/anchore/syft/syft/file/cataloger/envregistry"
 	"github.com/anchore/syft/syft/file/cataloger/executable"
 	"github.com/anchore/syft/syft/file/cataloger/filecontent"
+	"github.com/anchore/syft/syft/file/cataloger/filemetadata"
 	"github.com/anchore/syft/syft/pkg/cataloger/binary"
 	"github.com/anchore/syft/syft/pkg/cataloger/golang"
 	"github.com/anchore/syft/syft/pkg/cataloger/java"
 	"github.com/anchore/syft/syft/pkg/cataloger/javascript"
 	"github.com/anchore/syft/syft/pkg/cataloger/kernel"
+	"github.com/anchore/syft/syft/pkg/cataloger/php"
 	"github.com/anchore/syft/syft/pkg/cataloger/python"
 	"github.com/anchore/syft/syft/source"
 )
 
 type Catalog struct {
 	// high-level cataloger configuration
-	Catalogers        []string            `yaml:"-" json:"catalogers" mapstructure:"catalogers"` // deprecated and not shown in yaml output
-	DefaultCatalogers []string            `yaml:"default-catalogers" json:"default-catalogers" mapstructure:"default-catalogers"`
-	SelectCatalogers  []string            `yaml:"select-catalogers" json:"select-catalogers" mapstructure:"select-catalogers"`
-	Package           packageConfig       `yaml:"package" json:"package" mapstructure:"package"`
-	File              fileConfig          `yaml:"file" json:"file" mapstructure:"file"`
-	Scope             string              `yaml:"scope" json:"scope" mapstructure:"scope"`
-	Parallelism       int                 `yaml:"parallelism" json:"parallelism" mapstructure:"parallelism"` // the number of catalog workers to run in parallel
-	Relationships     relationshipsConfig `yaml:"relationships" json:"relationships" mapstructure:"relationships"`
+	Catalogers         []string            `yaml:"-" json:"catalogers" mapstructure:"catalogers"` // deprecated and not shown in yaml output
+	DefaultCatalogers  []string            `yaml:"default-catalogers" json:"default-catalogers" mapstructure:"default-catalogers"`
+	SelectCatalogers   []string            `yaml:"select-catalogers" json:"select-catalogers" mapstructure:"select-catalogers"`
+	Package            packageConfig       `yaml:"package" json:"package" mapstructure:"package"`
+	File               fileConfig          `yaml:"file" json:"file" mapstructure:"file"`
+	Scope              string              `yaml:"scope" json:"scope" mapstructure:"scope"`
+	Parallelism        int                 `yaml:"parallelism" json:"parallelism" mapstructure:"parallelism"`                         // the number of catalog workers to run in parallel
+	ParallelCatalogers int                 `yaml:"parallel-catalogers" json:"parallel-catalogers" mapstructure:"parallel-catalogers"` // the number of package catalogers to run in parallel
+	Relationships      relationshipsConfig `yaml:"relationships" json:"relationships" mapstructure:"relationships"`
 
 	// ecosystem-specific cataloger configuration
 	Golang      golangConfig      `yaml:"golang" json:"golang" mapstructure:"golang"`
 	Java        javaConfig        `yaml:"java" json:"java" mapstructure:"java"`
 	JavaScript  javaScriptConfig  `yaml:"javascript" json:"javascript" mapstructure:"javascript"`
 	LinuxKernel linuxKernelConfig `yaml:"linux-kernel" json:"linux-kernel" mapstructure:"linux-kernel"`
+	PHP         phpConfig         `yaml:"php" json:"php" mapstructure:"php"`
 	Python      pythonConfig      `yaml:"python" json:"python" mapstructure:"python"`
 
 	// configuration for the source (the subject being analyzed)
@@ -50,6 +55,9 @@ type Catalog struct {
 	Platform   string         `yaml:"platform" json:"platform" mapstructure:"platform"`
 	Source     sourceConfig   `yaml:"source" json:"source" mapstructure:"source"`
 	Exclusions []string       `yaml:"exclude" json:"exclude" mapstructure:"exclude"`
+
+	GlobCaseInsensitive bool `yaml:"glob-case-insensitive" json:"glob-case-insensitive" mapstructure:"glob-case-insensitive"`
+	SyftIgnore          bool `yaml:"syftignore" json:"syftignore" mapstructure:"syftignore"`
 }
 
 var _ interface {
@@ -68,6 +76,7 @@ func DefaultCatalog() Catalog {
 		Relationships: defaultRelationshipsConfig(),
 		Source:        defaultSourceConfig(),
 		Parallelism:   1,
+		SyftIgnore:    true,
 	}
 }
 
@@ -75,6 +84,7 @@ func (cfg Catalog) ToSBOMConfig(id clio.Identification) *syft.CreateSBOMConfig {
 	return syft.DefaultCreateSBOMConfig().
 		WithTool(id.Name, id.Version).
 		WithParallelism(cfg.Parallelism).
+		WithParallelCatalogers(cfg.ParallelCatalogers).
 		WithRelationshipsConfig(cfg.ToRelationshipsConfig()).
 		WithSearchConfig(cfg.ToSearchConfig()).
 		WithPackagesConfig(cfg.ToPackagesConfig()).
@@ -93,12 +103,17 @@ func (cfg Catalog) ToSearchConfig() cataloging.SearchConfig {
 }
 
 func (cfg Catalog) ToRelationshipsConfig() cataloging.RelationshipsConfig {
+	types, err := cfg.Relationships.types()
+	if err != nil {
+		log.WithFields("error", err).Warn("unable to configure relationship types")
+	}
+
 	return cataloging.RelationshipsConfig{
 		PackageFileOwnership:        cfg.Relationships.PackageFileOwnership,
 		PackageFileOwnershipOverlap: cfg.Relationships.PackageFileOwnershipOverlap,
 		// note: this option was surfaced in the syft application configuration before this relationships section was added
 		ExcludeBinaryPackagesWithFileOwnershipOverlap: cfg.Package.ExcludeBinaryOverlapByOwnership,
-	}
+	}.WithRelationships(cfg.Relationships.Enabled, types...)
 }
 
 func (cfg Catalog) ToFilesConfig() filecataloging.Config {
@@ -114,10 +129,18 @@ func (cfg Catalog) ToFilesConfig() filecataloging.Config {
 			Globs:              cfg.File.Content.Globs,
 			SkipFilesAboveSize: cfg.File.Content.SkipFilesAboveSize,
 		},
+		Metadata: filemetadata.Config{
+			CaptureOwnership: cfg.File.Metadata.CaptureOwnership,
+		},
 		Executable: executable.Config{
 			MIMETypes: executable.DefaultConfig().MIMETypes,
 			Globs:     cfg.File.Executable.Globs,
 		},
+		EnvRegistry: envregistry.Config{
+			Enabled:            cfg.File.EnvRegistry.Enabled,
+			Globs:              cfg.File.EnvRegistry.Globs,
+			SkipFilesAboveSize: cfg.File.EnvRegistry.SkipFilesAboveSize,
+		},
 	}
 }
 
@@ -125,6 +148,8 @@ func (cfg Catalog) ToPackagesConfig() pkgcataloging.Config {
 	archiveSearch := cataloging.ArchiveSearchConfig{
 		IncludeIndexedArchives:   cfg.Package.SearchIndexedArchives,
 		IncludeUnindexedArchives: cfg.Package.SearchUnindexedArchives,
+		MaxArchiveEntries:        cfg.Package.MaxArchiveEntries,
+		ArchiveTypes:             cfg.Package.ArchiveTypes,
 	}
 	return pkgcataloging.Config{
 		Binary: binary.DefaultClassifierCatalogerConfig(),
@@ -146,6 +171,8 @@ func (cfg Catalog) ToPackagesConfig() pkgcataloging.Config {
 		LinuxKernel: kernel.LinuxKernelCatalogerConfig{
 			CatalogModules: cfg.LinuxKernel.CatalogModules,
 		},
+		PHP: php.DefaultCatalogerConfig().
+			WithIncludeDevDependencies(cfg.PHP.IncludeDevDependencies),
 		Python: python.CatalogerConfig{
 			GuessUnpinnedRequirements: cfg.Python.GuessUnpinnedRequirements,
 		},
@@ -153,6 +180,13 @@ func (cfg Catalog) ToPackagesConfig() pkgcataloging.Config {
 			WithUseNetwork(cfg.Java.UseNetwork).
 			WithMavenBaseURL(cfg.Java.MavenURL).
 			WithArchiveTraversal(archiveSearch, cfg.Java.MaxParentRecursiveDepth),
+		JavaNativeImage:                java.DefaultNativeImageCatalogerConfig(),
+		ExcludeBinaryOverlapByMetadata: cfg.Package.ExcludeBinaryOverlapByMetadata,
+		MergeEmbeddedSBOMPackages:      cfg.Package.MergeEmbeddedSBOMPackages,
+		FetchAttachedSBOMs:             cfg.Package.FetchAttachedSBOMs,
+		RegistryOptions:                cfg.Registry.ToOptions(),
+		StablePackageIDs:               cfg.Package.StablePackageIDs,
+		IncludeDevDependencies:         cfg.Package.IncludeDevDependencies,
 	}
 }
 
@@ -202,6 +236,9 @@ func (cfg *Catalog) AddFlags(flags clio.FlagSet) {
 
 func (cfg *Catalog) DescribeFields(descriptions fangs.FieldDescriptionSet) {
 	descriptions.Add(&cfg.Parallelism, "number of cataloger workers to run in parallel")
+	descriptions.Add(&cfg.GlobCaseInsensitive, "match exclusion globs, along with the files searched for by catalogers, regardless of case (e.g. when scanning a Windows filesystem)")
+	descriptions.Add(&cfg.SyftIgnore, "exclude the paths matching the patterns of the .syftignore files within the scanned directory (directory scans only)")
+	descriptions.Add(&cfg.ParallelCatalogers, "maximum number of package catalogers to run in parallel (bounded by parallelism), where 0 is no additional limit")
 }
 
 func (cfg *Catalog) PostLoad() error {
@@ -8,17 +8,20 @@ import (
 
 	intFile "github.com/anchore/syft/internal/file"
 	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/file/cataloger/envregistry"
 )
 
 type fileConfig struct {
-	Metadata   fileMetadata   `yaml:"metadata" json:"metadata" mapstructure:"metadata"`
-	Content    fileContent    `yaml:"content" json:"content" mapstructure:"content"`
-	Executable fileExecutable `yaml:"executable" json:"executable" mapstructure:"executable"`
+	Metadata    fileMetadata    `yaml:"metadata" json:"metadata" mapstructure:"metadata"`
+	Content     fileContent     `yaml:"content" json:"content" mapstructure:"content"`
+	Executable  fileExecutable  `yaml:"executable" json:"executable" mapstructure:"executable"`
+	EnvRegistry fileEnvRegistry `yaml:"env-registry" json:"env-registry" mapstructure:"env-registry"`
 }
 
 type fileMetadata struct {
-	Selection file.Selection `yaml:"selection" json:"selection" mapstructure:"selection"`
-	Digests   []string       `yaml:"digests" json:"digests" mapstructure:"digests"`
+	Selection        file.Selection `yaml:"selection" json:"selection" mapstructure:"selection"`
+	Digests          []string       `yaml:"digests" json:"digests" mapstructure:"digests"`
+	CaptureOwnership bool           `yaml:"capture-ownership" json:"capture-ownership" mapstructure:"capture-ownership"`
 }
 
 type fileContent struct {
@@ -30,11 +33,19 @@ type fileExecutable struct {
 	Globs []string `yaml:"globs" json:"globs" mapstructure:"globs"`
 }
 
+type fileEnvRegistry struct {
+	Enabled            bool     `yaml:"enabled" json:"enabled" mapstructure:"enabled"`
+	SkipFilesAboveSize int64    `yaml:"skip-files-above-size" json:"skip-files-above-size" mapstructure:"skip-files-above-size"`
+	Globs              []string `yaml:"globs" json:"globs" mapstructure:"globs"`
+}
+
 func defaultFileConfig() fileConfig {
+	envRegistry := envregistry.DefaultConfig()
 	return fileConfig{
 		Metadata: fileMetadata{
-			Selection: file.FilesOwnedByPackageSelection,
-			Digests:   []string{"sha1", "sha256"},
+			Selection:        file.FilesOwnedByPackageSelection,
+			Digests:          []string{"sha1", "sha256"},
+			CaptureOwnership: true,
 		},
 		Content: fileContent{
 			SkipFilesAboveSize: 250 * intFile.KB,
@@ -42,6 +53,11 @@ func defaultFileConfig() fileConfig {
 		Executable: fileExecutable{
 			Globs: nil,
 		},
+		EnvRegistry: fileEnvRegistry{
+			Enabled:            envRegistry.Enabled,
+			SkipFilesAboveSize: envRegistry.SkipFilesAboveSize,
+			Globs:              envRegistry.Globs,
+		},
 	}
 }
 
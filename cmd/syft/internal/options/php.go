@@ -0,0 +1,5 @@
+package options
+
+type phpConfig struct {
+	IncludeDevDependencies bool `json:"include-dev-dependencies" yaml:"include-dev-dependencies" mapstructure:"include-dev-dependencies"`
+}
@@ -3,9 +3,16 @@ package options
 import "github.com/anchore/syft/syft/cataloging"
 
 type packageConfig struct {
-	SearchUnindexedArchives         bool `yaml:"search-unindexed-archives" json:"search-unindexed-archives" mapstructure:"search-unindexed-archives"`
-	SearchIndexedArchives           bool `yaml:"search-indexed-archives" json:"search-indexed-archives" mapstructure:"search-indexed-archives"`
-	ExcludeBinaryOverlapByOwnership bool `yaml:"exclude-binary-overlap-by-ownership" json:"exclude-binary-overlap-by-ownership" mapstructure:"exclude-binary-overlap-by-ownership"` // exclude synthetic binary packages owned by os package files
+	SearchUnindexedArchives         bool     `yaml:"search-unindexed-archives" json:"search-unindexed-archives" mapstructure:"search-unindexed-archives"`
+	SearchIndexedArchives           bool     `yaml:"search-indexed-archives" json:"search-indexed-archives" mapstructure:"search-indexed-archives"`
+	ExcludeBinaryOverlapByOwnership bool     `yaml:"exclude-binary-overlap-by-ownership" json:"exclude-binary-overlap-by-ownership" mapstructure:"exclude-binary-overlap-by-ownership"` // exclude synthetic binary packages owned by os package files
+	ExcludeBinaryOverlapByMetadata  bool     `yaml:"exclude-binary-overlap-by-metadata" json:"exclude-binary-overlap-by-metadata" mapstructure:"exclude-binary-overlap-by-metadata"`    // exclude binary-derived packages also found in package metadata
+	MergeEmbeddedSBOMPackages       bool     `yaml:"merge-embedded-sbom-packages" json:"merge-embedded-sbom-packages" mapstructure:"merge-embedded-sbom-packages"`                      // merge packages from embedded SBOMs into the same discovered packages
+	FetchAttachedSBOMs              bool     `yaml:"fetch-attached-sboms" json:"fetch-attached-sboms" mapstructure:"fetch-attached-sboms"`                                              // fetch SBOMs attached to a scanned image within its registry
+	StablePackageIDs                bool     `yaml:"stable-package-ids" json:"stable-package-ids" mapstructure:"stable-package-ids"`                                                    // derive package IDs only from the identifying fields of each package
+	IncludeDevDependencies          bool     `yaml:"include-dev-dependencies" json:"include-dev-dependencies" mapstructure:"include-dev-dependencies"`                                  // include packages lockfiles classify as only required for development
+	MaxArchiveEntries               int      `yaml:"max-archive-entries" json:"max-archive-entries" mapstructure:"max-archive-entries"`                                                 // maximum number of entries processed within each archive (0 is no limit)
+	ArchiveTypes                    []string `yaml:"archive-types" json:"archive-types" mapstructure:"archive-types"`                                                                   // file extensions of the archives to search within (empty is all supported types)
 }
 
 func defaultPackageConfig() packageConfig {
@@ -14,5 +21,6 @@ func defaultPackageConfig() packageConfig {
 		SearchIndexedArchives:           c.IncludeIndexedArchives,
 		SearchUnindexedArchives:         c.IncludeUnindexedArchives,
 		ExcludeBinaryOverlapByOwnership: true,
+		IncludeDevDependencies:          true,
 	}
 }
@@ -1,22 +1,66 @@
 package options
 
-import "github.com/anchore/fangs"
+import (
+	"fmt"
+	"slices"
+	"strings"
 
-var _ fangs.FieldDescriber = (*relationshipsConfig)(nil)
+	"github.com/anchore/clio"
+	"github.com/anchore/fangs"
+
+	"github.com/anchore/syft/syft/artifact"
+)
+
+var (
+	_ fangs.FieldDescriber = (*relationshipsConfig)(nil)
+	_ clio.PostLoader      = (*relationshipsConfig)(nil)
+)
 
 type relationshipsConfig struct {
-	PackageFileOwnership        bool `mapstructure:"package-file-ownership" json:"package-file-ownership" yaml:"package-file-ownership"`
-	PackageFileOwnershipOverlap bool `mapstructure:"package-file-ownership-overlap" json:"package-file-ownership-overlap" yaml:"package-file-ownership-overlap"`
+	PackageFileOwnership        bool     `mapstructure:"package-file-ownership" json:"package-file-ownership" yaml:"package-file-ownership"`
+	PackageFileOwnershipOverlap bool     `mapstructure:"package-file-ownership-overlap" json:"package-file-ownership-overlap" yaml:"package-file-ownership-overlap"`
+	Enabled                     bool     `mapstructure:"enabled" json:"enabled" yaml:"enabled"`
+	Types                       []string `mapstructure:"types" json:"types" yaml:"types"`
 }
 
 func defaultRelationshipsConfig() relationshipsConfig {
 	return relationshipsConfig{
 		PackageFileOwnership:        true,
 		PackageFileOwnershipOverlap: true,
+		Enabled:                     true,
 	}
 }
 
 func (r *relationshipsConfig) DescribeFields(descriptions fangs.FieldDescriptionSet) {
 	descriptions.Add(&r.PackageFileOwnership, "include package-to-file relationships that indicate which files are owned by which packages.")
 	descriptions.Add(&r.PackageFileOwnershipOverlap, "include package-to-package relationships that indicate one package is owned by another due to files claimed to be owned by one package are also evidence of another package's existence.")
+	descriptions.Add(&r.Enabled, "include relationships in the SBOM at all (disabling relationships makes for faster and smaller SBOMs).")
+	descriptions.Add(&r.Types, "only include relationships of the given types in the SBOM (e.g. 'contains', 'dependency-of', 'evident-by'), all types are included when empty.")
+}
+
+func (r *relationshipsConfig) PostLoad() error {
+	_, err := r.types()
+	return err
+}
+
+// validRelationshipTypes are the relationship types that can be selected within the SBOM
+func validRelationshipTypes() []artifact.RelationshipType {
+	return append(artifact.AllRelationshipTypes(), artifact.EvidentByRelationship)
+}
+
+func (r relationshipsConfig) types() ([]artifact.RelationshipType, error) {
+	valid := validRelationshipTypes()
+	var types []artifact.RelationshipType
+	for _, t := range r.Types {
+		rt := artifact.RelationshipType(strings.TrimSpace(t))
+		if !slices.Contains(valid, rt) {
+			var names []string
+			for _, v := range valid {
+				names = append(names, string(v))
+			}
+			return nil, fmt.Errorf("invalid relationship type: %q (valid types: %s)", t, strings.Join(names, ", "))
+		}
+		types = append(types, rt)
+	}
+	return types, nil
 }
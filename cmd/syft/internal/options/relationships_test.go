@@ -0,0 +1,50 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/artifact"
+)
+
+func Test_relationshipsConfig_types(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     relationshipsConfig
+		want    []artifact.RelationshipType
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "no types",
+			cfg:  relationshipsConfig{},
+		},
+		{
+			name: "valid types",
+			cfg: relationshipsConfig{
+				Types: []string{"contains", " evident-by"},
+			},
+			want: []artifact.RelationshipType{artifact.ContainsRelationship, artifact.EvidentByRelationship},
+		},
+		{
+			name: "error on unknown type",
+			cfg: relationshipsConfig{
+				Types: []string{"contains", "containz"},
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, `invalid relationship type: "containz" (valid types: ownership-by-file-overlap, contains, dependency-of, described-by, declared-by, evident-by)`)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = assert.NoError
+			}
+			got, err := tt.cfg.types()
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+			tt.wantErr(t, tt.cfg.PostLoad())
+		})
+	}
+}
@@ -35,7 +35,7 @@ var imageOnlyTestCases = []testCase{
 		pkgType:     pkg.PythonPkg,
 		pkgLanguage: pkg.Python,
 		pkgInfo: map[string]string{
-			"Pygments":     "2.6.1",
+			"pygments":     "2.6.1",
 			"requests":     "2.22.0",
 			"somerequests": "3.22.0",
 			"someotherpkg": "3.19.0",
@@ -172,7 +172,7 @@ var dirOnlyTestCases = []testCase{
 			"passlib":            "1.7.2",
 			"mypy":               "v0.770",
 			// common to image and directory
-			"Pygments":     "2.6.1",
+			"pygments":     "2.6.1",
 			"requests":     "2.22.0",
 			"somerequests": "3.22.0",
 			"someotherpkg": "3.19.0",
@@ -199,6 +199,15 @@ var dirOnlyTestCases = []testCase{
 			"fltk":       "1.3.8",
 		},
 	},
+	{
+		name:        "find vcpkg packages",
+		pkgType:     pkg.VcpkgPkg,
+		pkgLanguage: pkg.CPP,
+		pkgInfo: map[string]string{
+			"fmt":  "10.1.1",
+			"zlib": "",
+		},
+	},
 	{
 		name:        "find rust crates",
 		pkgType:     pkg.RustPkg,
@@ -252,6 +261,7 @@ var dirOnlyTestCases = []testCase{
 			"Microsoft.Extensions.Logging.Abstractions":             "6.0.0",
 			"Microsoft.Extensions.Options":                          "6.0.0",
 			"Microsoft.Extensions.Primitives":                       "6.0.0",
+			"Microsoft.NETCore.App":                                 "6.0.0",
 			"Newtonsoft.Json":                                       "13.0.1",
 			"Serilog":                                               "2.10.0",
 			"Serilog.Sinks.Console":                                 "4.0.1",
@@ -376,6 +386,14 @@ var dirOnlyTestCases = []testCase{
 			"accept": "0.3.5",
 		},
 	},
+	{
+		name:        "find perl cpanfile.snapshot packages",
+		pkgType:     pkg.PerlCpanPkg,
+		pkgLanguage: pkg.Perl,
+		pkgInfo: map[string]string{
+			"Plack": "1.0047",
+		},
+	},
 	{
 		name:        "find swift package manager packages",
 		pkgType:     pkg.SwiftPkg,
@@ -388,6 +406,20 @@ var dirOnlyTestCases = []testCase{
 			"swift-numerics":         "1.0.2",
 		},
 	},
+	{
+		name:    "find bazel modules",
+		pkgType: pkg.BazelModulePkg,
+		pkgInfo: map[string]string{
+			"rules_go": "0.41.0",
+		},
+	},
+	{
+		name:    "find git submodules",
+		pkgType: pkg.GitSubmodulePkg,
+		pkgInfo: map[string]string{
+			"github.com/anchore/stereoscope": "",
+		},
+	},
 	{
 		name:    "find github action packages (from usage in workflow files and composite actions)",
 		pkgType: pkg.GithubActionPkg,
@@ -412,6 +444,41 @@ var commonTestCases = []testCase{
 			"pacman": "6.0.1-5",
 		},
 	},
+	{
+		name:    "find macos app bundles",
+		pkgType: pkg.MacOSAppPkg,
+		pkgInfo: map[string]string{
+			"com.example.App": "3.2.1",
+		},
+	},
+	{
+		name:    "find macos installer receipts",
+		pkgType: pkg.MacOSPkg,
+		pkgInfo: map[string]string{
+			"com.example.tool": "2.4.1",
+		},
+	},
+	{
+		name:    "find homebrew formulae",
+		pkgType: pkg.HomebrewPkg,
+		pkgInfo: map[string]string{
+			"wget": "1.21.4",
+		},
+	},
+	{
+		name:    "find windows installer databases",
+		pkgType: pkg.WindowsMSIPkg,
+		pkgInfo: map[string]string{
+			"Example Tool": "3.2.1",
+		},
+	},
+	{
+		name:    "find android apps",
+		pkgType: pkg.AndroidAppPkg,
+		pkgInfo: map[string]string{
+			"com.example.app": "1.2.3",
+		},
+	},
 	{
 		name:    "find rpmdb packages",
 		pkgType: pkg.RpmPkg,
@@ -452,6 +519,22 @@ var commonTestCases = []testCase{
 			"glibc": "2.34-210",
 		},
 	},
+	{
+		name:        "find vscode extensions",
+		pkgType:     pkg.VSCodeExtensionPkg,
+		pkgLanguage: pkg.JavaScript,
+		pkgInfo: map[string]string{
+			"vscode-yaml": "1.14.0",
+		},
+	},
+	{
+		name:    "find wasm component imports",
+		pkgType: pkg.WasmPkg,
+		pkgInfo: map[string]string{
+			"wasi:cli": "0.2.0",
+			"wasi:io":  "0.2.0",
+		},
+	},
 	{
 		name:        "find wordpress plugins",
 		pkgType:     pkg.WordpressPluginPkg,
@@ -78,6 +78,9 @@ func TestPkgCoverageImage(t *testing.T) {
 	definedPkgs.Remove(string(pkg.SwiftPkg))
 	definedPkgs.Remove(string(pkg.GithubActionPkg))
 	definedPkgs.Remove(string(pkg.GithubActionWorkflowPkg))
+	definedPkgs.Remove(string(pkg.GitSubmodulePkg))
+	definedPkgs.Remove(string(pkg.BazelModulePkg))
+	definedPkgs.Remove(string(pkg.ProtobufPkg)) // the proto file cataloger is opt-in only
 
 	var cases []testCase
 	cases = append(cases, commonTestCases...)
@@ -219,6 +222,8 @@ func TestPkgCoverageDirectory(t *testing.T) {
 	definedPkgs.Remove(string(pkg.Rpkg))
 	definedPkgs.Remove(string(pkg.UnknownPkg))
 
+	definedPkgs.Remove(string(pkg.ProtobufPkg)) // the proto file cataloger is opt-in only
+
 	// for directory scans we should not expect to see any of the following package types
 	definedPkgs.Remove(string(pkg.KbPkg))
 
@@ -345,7 +345,7 @@ func packageCatalogerExports(t *testing.T) map[string]exportTokenSet {
 	// remove exceptions
 	// these are known violations to the common convention that are allowed.
 	if vs, ok := exportsPerPackage["binary"]; ok {
-		vs.Remove("Classifier", "EvidenceMatcher", "FileContentsVersionMatcher", "DefaultClassifiers")
+		vs.Remove("Classifier", "EvidenceMatcher", "FileContentsVersionMatcher", "CaddyModulesMatcher", "DefaultClassifiers")
 	}
 
 	return exportsPerPackage
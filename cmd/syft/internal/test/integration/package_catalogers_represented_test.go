@@ -53,8 +53,17 @@ func TestAllPackageCatalogersReachableInTasks(t *testing.T) {
 	assert.Equal(t, len(taskTagsByName), constructorCount, "mismatch in number of cataloger constructors and task names")
 
 	for taskName, tags := range taskTagsByName {
-		if taskName == "sbom-cataloger" {
-			continue // this is a special case
+		if taskName == "sbom-cataloger" || taskName == "buildpack-sbom-cataloger" {
+			continue // these are special cases: SBOM contents are not evidence of installed packages
+		}
+		if taskName == "proto-file-cataloger" {
+			continue // this is opt-in only: proto packages describe an API surface rather than dependencies
+		}
+		if taskName == "javascript-source-map-cataloger" {
+			continue // this is opt-in only: source maps of installed packages reference already cataloged dependencies
+		}
+		if taskName == "install-directory-cataloger" {
+			continue // this is opt-in only: the packages are inferred from the directory structure
 		}
 		if !strset.New(tags...).HasAny(pkgcataloging.ImageTag, pkgcataloging.DirectoryTag) {
 			t.Errorf("task %q is missing 'directory' or 'image' a tag", taskName)
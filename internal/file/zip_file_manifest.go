@@ -16,10 +16,17 @@ type ZipFileManifest map[string]os.FileInfo
 
 // NewZipFileManifest creates and returns a new ZipFileManifest populated with path and metadata from the given zip archive path.
 func NewZipFileManifest(archivePath string) (ZipFileManifest, error) {
+	manifest, _, err := NewZipFileManifestWithLimit(archivePath, 0)
+	return manifest, err
+}
+
+// NewZipFileManifestWithLimit creates and returns a new ZipFileManifest populated with (at most) the first maxEntries
+// entries of the given zip archive path (0 means there is no limit), indicating if entries were left out.
+func NewZipFileManifestWithLimit(archivePath string, maxEntries int) (ZipFileManifest, bool, error) {
 	zipReader, err := OpenZip(archivePath)
 	manifest := make(ZipFileManifest)
 	if err != nil {
-		return manifest, fmt.Errorf("unable to open zip archive (%s): %w", archivePath, err)
+		return manifest, false, fmt.Errorf("unable to open zip archive (%s): %w", archivePath, err)
 	}
 	defer func() {
 		err = zipReader.Close()
@@ -28,10 +35,13 @@ func NewZipFileManifest(archivePath string) (ZipFileManifest, error) {
 		}
 	}()
 
-	for _, file := range zipReader.Reader.File {
+	for i, file := range zipReader.Reader.File {
+		if maxEntries > 0 && i >= maxEntries {
+			return manifest, true, nil
+		}
 		manifest.Add(file.Name, file.FileInfo())
 	}
-	return manifest, nil
+	return manifest, false, nil
 }
 
 // Add a new path and it's file metadata to the collection.
@@ -85,6 +85,43 @@ func TestNewZip64FileManifest(t *testing.T) {
 	}
 }
 
+func TestNewZipFileManifestWithLimit(t *testing.T) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sourceDirPath := path.Join(cwd, "test-fixtures", "zip-source")
+	err = ensureNestedZipExists(t, sourceDirPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archiveFilePath := setupZipFileTest(t, sourceDirPath, false)
+
+	actual, truncated, err := NewZipFileManifestWithLimit(archiveFilePath, 2)
+	if err != nil {
+		t.Fatalf("unable to extract from unzip archive: %+v", err)
+	}
+	if !truncated {
+		t.Errorf("expected the manifest to be truncated")
+	}
+	if len(actual) != 2 {
+		t.Errorf("mismatched manifest: %d != 2", len(actual))
+	}
+
+	actual, truncated, err = NewZipFileManifestWithLimit(archiveFilePath, len(expectedZipArchiveEntries))
+	if err != nil {
+		t.Fatalf("unable to extract from unzip archive: %+v", err)
+	}
+	if truncated {
+		t.Errorf("expected the manifest to not be truncated")
+	}
+	if len(expectedZipArchiveEntries) != len(actual) {
+		t.Errorf("mismatched manifest: %d != %d", len(actual), len(expectedZipArchiveEntries))
+	}
+}
+
 func TestZipFileManifest_GlobMatch(t *testing.T) {
 	cwd, err := os.Getwd()
 	if err != nil {
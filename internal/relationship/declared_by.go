@@ -0,0 +1,53 @@
+package relationship
+
+import (
+	"path"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// declaredBy returns relationships from the direct dependencies resolved by a package-lock.json to the package
+// described by the package.json within the same directory (the manifest that declared them). Transitive dependencies
+// are not related to the manifest, since they are declared by other resolved packages.
+func declaredBy(catalog *pkg.Collection) []artifact.Relationship {
+	manifests := make(map[string]pkg.Package)
+	for p := range catalog.Enumerate(pkg.NpmPkg) {
+		if _, ok := p.Metadata.(pkg.NpmPackage); !ok {
+			continue
+		}
+		for _, l := range p.Locations.ToSlice() {
+			if path.Base(l.RealPath) == "package.json" {
+				manifests[path.Dir(l.RealPath)] = p
+			}
+		}
+	}
+	if len(manifests) == 0 {
+		return nil
+	}
+
+	var edges []artifact.Relationship
+	for _, p := range catalog.Sorted(pkg.NpmPkg) {
+		metadata, ok := p.Metadata.(pkg.NpmPackageLockEntry)
+		if !ok || metadata.DependencyKind != pkg.DirectDependency {
+			continue
+		}
+		for _, l := range p.Locations.ToSlice() {
+			if path.Base(l.RealPath) != "package-lock.json" {
+				continue
+			}
+			manifest, ok := manifests[path.Dir(l.RealPath)]
+			if !ok {
+				continue
+			}
+			edges = append(edges, artifact.Relationship{
+				From: p,
+				To:   manifest,
+				Type: artifact.DeclaredByRelationship,
+			})
+			break
+		}
+	}
+
+	return edges
+}
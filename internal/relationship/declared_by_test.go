@@ -0,0 +1,65 @@
+package relationship
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestRelationshipsDeclaredBy(t *testing.T) {
+	newPackage := func(name, location string, metadata any) pkg.Package {
+		p := pkg.Package{
+			Name:      name,
+			Version:   "1.0.0",
+			Type:      pkg.NpmPkg,
+			Locations: file.NewLocationSet(file.NewLocation(location)),
+			Metadata:  metadata,
+		}
+		p.SetID()
+		return p
+	}
+
+	manifest := newPackage("app", "/app/package.json", pkg.NpmPackage{Name: "app"})
+	direct := newPackage("express", "/app/package-lock.json", pkg.NpmPackageLockEntry{DependencyKind: pkg.DirectDependency})
+	transitive := newPackage("debug", "/app/package-lock.json", pkg.NpmPackageLockEntry{DependencyKind: pkg.TransitiveDependency})
+	otherProject := newPackage("lodash", "/other/package-lock.json", pkg.NpmPackageLockEntry{DependencyKind: pkg.DirectDependency})
+	installed := newPackage("express", "/app/node_modules/express/package.json", pkg.NpmPackage{Name: "express"})
+
+	tests := []struct {
+		name string
+		pkgs []pkg.Package
+		want []artifact.Relationship
+	}{
+		{
+			name: "direct dependencies are declared by the manifest alongside the lock file",
+			pkgs: []pkg.Package{manifest, direct, transitive, otherProject, installed},
+			want: []artifact.Relationship{
+				{
+					From: direct,
+					To:   manifest,
+					Type: artifact.DeclaredByRelationship,
+				},
+			},
+		},
+		{
+			name: "no manifest",
+			pkgs: []pkg.Package{direct, transitive},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := declaredBy(pkg.NewCollection(tt.pkgs...))
+			require.Len(t, actual, len(tt.want))
+			for i := range actual {
+				assert.Equal(t, tt.want[i].From.ID(), actual[i].From.ID())
+				assert.Equal(t, tt.want[i].To.ID(), actual[i].To.ID())
+				assert.Equal(t, tt.want[i].Type, actual[i].Type)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package relationship
+
+import (
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// ExcludeBinariesByMetadataOverlap removes packages discovered from binaries (e.g. by reading go buildinfo or
+// cargo-auditable sections) when the same package was also discovered by a cataloger reading package metadata
+// (e.g. go.mod or Cargo.lock) of the same project, preferring the richer metadata-derived package. The binary is
+// considered part of the project when it is found within the directory tree of the metadata file. Binary packages
+// duplicated by the metadata of another project are kept, with the metadata file added as supporting evidence.
+func ExcludeBinariesByMetadataOverlap(accessor sbomsync.Accessor) {
+	accessor.WriteToSBOM(func(s *sbom.SBOM) {
+		duplicates, evidence := binariesWithMetadataOverlap(s.Artifacts.Packages)
+		for _, id := range duplicates {
+			s.Artifacts.Packages.Delete(id)
+			s.Relationships = RemoveRelationshipsByID(s.Relationships, id)
+		}
+		for id, locations := range evidence {
+			p := s.Artifacts.Packages.Package(id)
+			if p == nil {
+				continue
+			}
+			updated := *p
+			for _, l := range locations {
+				updated.Locations.Add(l.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+			}
+			// re-add the package so the collection indexes the new locations (the ID is unchanged)
+			s.Artifacts.Packages.Delete(id)
+			s.Artifacts.Packages.Add(updated)
+		}
+	})
+}
+
+type packageIdentity struct {
+	pkgType pkg.Type
+	name    string
+	version string
+}
+
+// binariesWithMetadataOverlap returns the IDs of all binary-derived packages that share the same type, name, and
+// version with a package that was discovered from the metadata of the same project (and so duplicate it), along with
+// the metadata locations of the binary-derived packages that are only matched by packages of other projects.
+func binariesWithMetadataOverlap(c *pkg.Collection) ([]artifact.ID, map[artifact.ID][]file.Location) {
+	fromMetadata := make(map[packageIdentity][]file.Location)
+	var fromBinaries []pkg.Package
+	for p := range c.Enumerate() {
+		if isBinaryDerived(p) {
+			fromBinaries = append(fromBinaries, p)
+			continue
+		}
+		key := packageIdentity{pkgType: p.Type, name: p.Name, version: p.Version}
+		fromMetadata[key] = append(fromMetadata[key], p.Locations.ToSlice()...)
+	}
+
+	var duplicates []artifact.ID
+	evidence := make(map[artifact.ID][]file.Location)
+	for _, p := range fromBinaries {
+		if p.Version == "" {
+			continue
+		}
+		metadataLocations := fromMetadata[packageIdentity{pkgType: p.Type, name: p.Name, version: p.Version}]
+		if len(metadataLocations) == 0 {
+			continue
+		}
+		if isWithinProjectOf(p, metadataLocations) {
+			duplicates = append(duplicates, p.ID())
+			continue
+		}
+		evidence[p.ID()] = metadataLocations
+	}
+	return duplicates, evidence
+}
+
+// isWithinProjectOf reports whether any location of the package is within the directory tree of any of the given
+// metadata files.
+func isWithinProjectOf(p pkg.Package, metadataLocations []file.Location) bool {
+	for _, m := range metadataLocations {
+		dir := strings.TrimSuffix(path.Dir(m.RealPath), "/") + "/"
+		for _, l := range p.Locations.ToSlice() {
+			if strings.HasPrefix(l.RealPath, dir) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isBinaryDerived(p pkg.Package) bool {
+	switch p.Metadata.(type) {
+	case pkg.GolangBinaryBuildinfoEntry, pkg.RustBinaryAuditEntry, pkg.DotnetPortableExecutableEntry:
+		return true
+	}
+	return false
+}
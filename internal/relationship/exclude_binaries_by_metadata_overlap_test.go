@@ -0,0 +1,79 @@
+package relationship
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestBinariesWithMetadataOverlap(t *testing.T) {
+	goModLocation := file.NewLocation("/app/go.mod")
+	cargoLockLocation := file.NewLocation("/app/Cargo.lock")
+	otherGoModLocation := file.NewLocation("/src/other/go.mod")
+
+	newPackage := func(name, version string, pkgType pkg.Type, metadata interface{}, location file.Location) pkg.Package {
+		p := pkg.Package{Name: name, Version: version, Type: pkgType, Metadata: metadata, Locations: file.NewLocationSet(location)}
+		p.SetID()
+		return p
+	}
+
+	fromGoMod := newPackage("github.com/pkg/errors", "v0.9.1", pkg.GoModulePkg, pkg.GolangModuleEntry{}, goModLocation)
+	fromOtherGoMod := newPackage("github.com/pkg/errors", "v0.9.1", pkg.GoModulePkg, pkg.GolangModuleEntry{}, otherGoModLocation)
+	fromBinary := newPackage("github.com/pkg/errors", "v0.9.1", pkg.GoModulePkg, pkg.GolangBinaryBuildinfoEntry{}, file.NewLocation("/app/bin/server"))
+	fromUnrelatedBinary := newPackage("github.com/pkg/errors", "v0.9.1", pkg.GoModulePkg, pkg.GolangBinaryBuildinfoEntry{}, file.NewLocation("/usr/bin/tool"))
+	otherVersion := newPackage("github.com/pkg/errors", "v0.8.0", pkg.GoModulePkg, pkg.GolangBinaryBuildinfoEntry{}, file.NewLocation("/app/bin/server"))
+	fromCargoLock := newPackage("serde", "1.0.188", pkg.RustPkg, pkg.RustCargoLockEntry{}, cargoLockLocation)
+	fromAudit := newPackage("serde", "1.0.188", pkg.RustPkg, pkg.RustBinaryAuditEntry{}, file.NewLocation("/app/target/release/app"))
+
+	tests := []struct {
+		name               string
+		packages           []pkg.Package
+		expectedDuplicates []artifact.ID
+		expectedEvidence   map[artifact.ID][]file.Location
+	}{
+		{
+			name:               "binary package duplicated by go.mod package of the same project",
+			packages:           []pkg.Package{fromGoMod, fromBinary},
+			expectedDuplicates: []artifact.ID{fromBinary.ID()},
+		},
+		{
+			name:               "binary package duplicated by Cargo.lock package of the same project",
+			packages:           []pkg.Package{fromCargoLock, fromAudit},
+			expectedDuplicates: []artifact.ID{fromAudit.ID()},
+		},
+		{
+			name:     "binary package outside of the project is kept with the metadata as evidence",
+			packages: []pkg.Package{fromOtherGoMod, fromUnrelatedBinary},
+			expectedEvidence: map[artifact.ID][]file.Location{
+				fromUnrelatedBinary.ID(): {otherGoModLocation},
+			},
+		},
+		{
+			name:     "different versions are kept",
+			packages: []pkg.Package{fromGoMod, otherVersion},
+		},
+		{
+			name:     "binary packages without metadata counterparts are kept",
+			packages: []pkg.Package{fromBinary, fromCargoLock},
+		},
+		{
+			name:     "metadata packages are never excluded",
+			packages: []pkg.Package{fromGoMod, fromCargoLock},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectedEvidence == nil {
+				test.expectedEvidence = map[artifact.ID][]file.Location{}
+			}
+			duplicates, evidence := binariesWithMetadataOverlap(pkg.NewCollection(test.packages...))
+			assert.ElementsMatch(t, test.expectedDuplicates, duplicates)
+			assert.Equal(t, test.expectedEvidence, evidence)
+		})
+	}
+}
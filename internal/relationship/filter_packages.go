@@ -0,0 +1,42 @@
+package relationship
+
+import (
+	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// FilterPackages removes all packages that do not satisfy the given filter, along with any relationships to them.
+func FilterPackages(accessor sbomsync.Accessor, keep func(pkg.Package) bool) {
+	accessor.WriteToSBOM(func(s *sbom.SBOM) {
+		s.Relationships = filterPackages(s.Artifacts.Packages, s.Relationships, keep)
+	})
+}
+
+func filterPackages(c *pkg.Collection, relationships []artifact.Relationship, keep func(pkg.Package) bool) []artifact.Relationship {
+	removed := make(map[artifact.ID]struct{})
+	for _, p := range c.Sorted() {
+		if keep(p) {
+			continue
+		}
+		c.Delete(p.ID())
+		removed[p.ID()] = struct{}{}
+	}
+
+	if len(removed) == 0 {
+		return relationships
+	}
+
+	var kept []artifact.Relationship
+	for _, r := range relationships {
+		if _, ok := removed[r.From.ID()]; ok {
+			continue
+		}
+		if _, ok := removed[r.To.ID()]; ok {
+			continue
+		}
+		kept = append(kept, r)
+	}
+	return kept
+}
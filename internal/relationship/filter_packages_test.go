@@ -0,0 +1,57 @@
+package relationship
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestFilterPackages(t *testing.T) {
+	apk := pkg.Package{Name: "musl", Version: "1.2.4-r2", Type: pkg.ApkPkg}
+	npm := pkg.Package{Name: "express", Version: "4.18.2", Type: pkg.NpmPkg, Language: pkg.JavaScript}
+	npmDependency := pkg.Package{Name: "body-parser", Version: "1.20.1", Type: pkg.NpmPkg, Language: pkg.JavaScript}
+	for _, p := range []*pkg.Package{&apk, &npm, &npmDependency} {
+		p.SetID()
+	}
+
+	packageJSON := file.Coordinates{RealPath: "/app/node_modules/express/package.json"}
+	musl := file.Coordinates{RealPath: "/lib/ld-musl-x86_64.so.1"}
+
+	c := pkg.NewCollection(apk, npm, npmDependency)
+	relationships := []artifact.Relationship{
+		{From: apk, To: musl, Type: artifact.ContainsRelationship},
+		{From: npm, To: packageJSON, Type: artifact.ContainsRelationship},
+		{From: npmDependency, To: npm, Type: artifact.DependencyOfRelationship},
+		{From: apk, To: npm, Type: artifact.OwnershipByFileOverlapRelationship},
+	}
+
+	actual := filterPackages(c, relationships, func(p pkg.Package) bool {
+		return p.Language == pkg.JavaScript
+	})
+
+	assert.Nil(t, c.Package(apk.ID()))
+	assert.NotNil(t, c.Package(npm.ID()))
+	assert.NotNil(t, c.Package(npmDependency.ID()))
+
+	assert.Equal(t, []artifact.Relationship{
+		{From: npm, To: packageJSON, Type: artifact.ContainsRelationship},
+		{From: npmDependency, To: npm, Type: artifact.DependencyOfRelationship},
+	}, actual)
+}
+
+func TestFilterPackages_keepAll(t *testing.T) {
+	p := pkg.Package{Name: "express", Version: "4.18.2", Type: pkg.NpmPkg}
+	p.SetID()
+	other := pkg.Package{Name: "body-parser", Version: "1.20.1", Type: pkg.NpmPkg}
+	other.SetID()
+
+	relationships := []artifact.Relationship{{From: p, To: other, Type: artifact.DependencyOfRelationship}}
+	c := pkg.NewCollection(p, other)
+
+	assert.Equal(t, relationships, filterPackages(c, relationships, func(pkg.Package) bool { return true }))
+	assert.Equal(t, 2, c.PackageCount())
+}
@@ -1,6 +1,8 @@
 package relationship
 
 import (
+	"slices"
+
 	"github.com/anchore/syft/internal/sbomsync"
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/cataloging"
@@ -11,7 +13,7 @@ func Finalize(builder sbomsync.Builder, cfg cataloging.RelationshipsConfig, src
 	accessor := builder.(sbomsync.Accessor)
 
 	// add relationships showing packages that are evident by a file which is owned by another package (package-to-package)
-	if cfg.PackageFileOwnershipOverlap {
+	if cfg.PackageFileOwnershipOverlap && (cfg.Includes(artifact.OwnershipByFileOverlapRelationship) || cfg.ExcludeBinaryPackagesWithFileOwnershipOverlap) {
 		byFileOwnershipOverlapWorker(accessor)
 	}
 
@@ -22,16 +24,38 @@ func Finalize(builder sbomsync.Builder, cfg cataloging.RelationshipsConfig, src
 	}
 
 	// add source "contains package" relationship (source-to-package)
-	var sourceRelationships []artifact.Relationship
-	accessor.ReadFromSBOM(func(s *sbom.SBOM) {
-		sourceRelationships = toSource(src, s.Artifacts.Packages)
-	})
-	builder.AddRelationships(sourceRelationships...)
+	if cfg.Includes(artifact.ContainsRelationship) {
+		var sourceRelationships []artifact.Relationship
+		accessor.ReadFromSBOM(func(s *sbom.SBOM) {
+			sourceRelationships = toSource(src, s.Artifacts.Packages)
+		})
+		builder.AddRelationships(sourceRelationships...)
+	}
 
 	// add evident-by relationships (package-to-file)
-	var evidentByRelationships []artifact.Relationship
-	accessor.ReadFromSBOM(func(s *sbom.SBOM) {
-		evidentByRelationships = evidentBy(s.Artifacts.Packages)
-	})
-	builder.AddRelationships(evidentByRelationships...)
+	if cfg.Includes(artifact.EvidentByRelationship) {
+		var evidentByRelationships []artifact.Relationship
+		accessor.ReadFromSBOM(func(s *sbom.SBOM) {
+			evidentByRelationships = evidentBy(s.Artifacts.Packages)
+		})
+		builder.AddRelationships(evidentByRelationships...)
+	}
+
+	// add declared-by relationships between lock file packages and their manifest (package-to-package)
+	if cfg.Includes(artifact.DeclaredByRelationship) {
+		var declaredByRelationships []artifact.Relationship
+		accessor.ReadFromSBOM(func(s *sbom.SBOM) {
+			declaredByRelationships = declaredBy(s.Artifacts.Packages)
+		})
+		builder.AddRelationships(declaredByRelationships...)
+	}
+
+	// drop the relationships that are not wanted, including those found by catalogers
+	if cfg.Disabled || len(cfg.Types) > 0 {
+		accessor.WriteToSBOM(func(s *sbom.SBOM) {
+			s.Relationships = slices.DeleteFunc(s.Relationships, func(r artifact.Relationship) bool {
+				return !cfg.Includes(r.Type)
+			})
+		})
+	}
 }
@@ -0,0 +1,84 @@
+package relationship
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+type identifiable string
+
+func (i identifiable) ID() artifact.ID {
+	return artifact.ID(i)
+}
+
+func TestFinalize_RelationshipTypes(t *testing.T) {
+	newPackage := func(name string) pkg.Package {
+		p := pkg.Package{
+			Name:    name,
+			Version: "1.0.0",
+			Type:    pkg.NpmPkg,
+			Locations: file.NewLocationSet(
+				file.NewLocation("/app/node_modules/"+name+"/package.json").WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			),
+		}
+		p.SetID()
+		return p
+	}
+	express := newPackage("express")
+	debug := newPackage("debug")
+
+	tests := []struct {
+		name string
+		cfg  cataloging.RelationshipsConfig
+		want map[artifact.RelationshipType]int
+	}{
+		{
+			name: "all relationships by default",
+			cfg:  cataloging.DefaultRelationshipsConfig(),
+			want: map[artifact.RelationshipType]int{
+				artifact.ContainsRelationship:     2,
+				artifact.EvidentByRelationship:    2,
+				artifact.DependencyOfRelationship: 1,
+			},
+		},
+		{
+			name: "relationships restricted to the given types",
+			cfg:  cataloging.DefaultRelationshipsConfig().WithRelationships(true, artifact.DependencyOfRelationship, artifact.EvidentByRelationship),
+			want: map[artifact.RelationshipType]int{
+				artifact.EvidentByRelationship:    2,
+				artifact.DependencyOfRelationship: 1,
+			},
+		},
+		{
+			name: "relationships disabled",
+			cfg:  cataloging.DefaultRelationshipsConfig().WithRelationships(false),
+			want: map[artifact.RelationshipType]int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := sbom.SBOM{
+				Artifacts: sbom.Artifacts{Packages: pkg.NewCollection(express, debug)},
+				Relationships: []artifact.Relationship{
+					{From: debug, To: express, Type: artifact.DependencyOfRelationship},
+				},
+			}
+
+			Finalize(sbomsync.NewBuilder(&s), tt.cfg, identifiable("source"))
+
+			got := make(map[artifact.RelationshipType]int)
+			for _, r := range s.Relationships {
+				got[r.Type]++
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package relationship
+
+import (
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// AttachedSBOMFoundBy is the cataloger name recorded on packages claimed by an SBOM attached to the scanned image that
+// were not discovered by any cataloger.
+const AttachedSBOMFoundBy = "attached-sbom"
+
+// MergeAttachedSBOMPackages reconciles the packages claimed by SBOMs attached to the scanned image (e.g. within the
+// registry) with the packages discovered by the catalogers. Packages match when they share the same type, name, and
+// version. Claimed packages that were discovered are left as discovered, while claimed packages that were not
+// discovered are added to the SBOM (found by "attached-sbom") without any locations, since there is no evidence of
+// them within the source.
+func MergeAttachedSBOMPackages(accessor sbomsync.Accessor, attached []*sbom.SBOM) {
+	accessor.WriteToSBOM(func(s *sbom.SBOM) {
+		mergeAttachedSBOMPackages(s.Artifacts.Packages, attached)
+	})
+}
+
+func mergeAttachedSBOMPackages(c *pkg.Collection, attached []*sbom.SBOM) {
+	discovered := make(map[packageIdentity]struct{})
+	for p := range c.Enumerate() {
+		discovered[packageIdentity{pkgType: p.Type, name: p.Name, version: p.Version}] = struct{}{}
+	}
+
+	claimed := make(map[packageIdentity]struct{})
+	var confirmed, added int
+	for _, s := range attached {
+		if s == nil || s.Artifacts.Packages == nil {
+			continue
+		}
+		for _, p := range s.Artifacts.Packages.Sorted() {
+			key := packageIdentity{pkgType: p.Type, name: p.Name, version: p.Version}
+			if _, ok := claimed[key]; ok {
+				// the same package may be claimed by several attached SBOMs (e.g. a cosign SBOM and attestation)
+				continue
+			}
+			claimed[key] = struct{}{}
+
+			if _, ok := discovered[key]; ok {
+				confirmed++
+				continue
+			}
+
+			p.FoundBy = AttachedSBOMFoundBy
+			p.Locations = file.NewLocationSet()
+			p.SetID()
+			c.Add(p)
+			added++
+		}
+	}
+
+	log.WithFields("confirmed", confirmed, "added", added, "unclaimed", len(discovered)-confirmed).
+		Debug("merged packages from attached SBOMs")
+}
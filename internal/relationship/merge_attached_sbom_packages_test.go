@@ -0,0 +1,73 @@
+package relationship
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+func TestMergeAttachedSBOMPackages(t *testing.T) {
+	discovered := pkg.Package{
+		Name:      "musl",
+		Version:   "1.2.4-r2",
+		Type:      pkg.ApkPkg,
+		FoundBy:   "apk-db-cataloger",
+		Locations: file.NewLocationSet(file.NewLocation("/lib/apk/db/installed")),
+	}
+	discoveredOnly := pkg.Package{
+		Name:      "busybox",
+		Version:   "1.36.1-r5",
+		Type:      pkg.ApkPkg,
+		FoundBy:   "apk-db-cataloger",
+		Locations: file.NewLocationSet(file.NewLocation("/lib/apk/db/installed")),
+	}
+	claimed := pkg.Package{
+		Name:      "musl",
+		Version:   "1.2.4-r2",
+		Type:      pkg.ApkPkg,
+		FoundBy:   "apk-db-cataloger",
+		Locations: file.NewLocationSet(file.NewLocation("/lib/apk/db/installed")),
+	}
+	claimedOnly := pkg.Package{
+		Name:      "app",
+		Version:   "2.1.0",
+		Type:      pkg.GoModulePkg,
+		FoundBy:   "go-module-binary-cataloger",
+		Locations: file.NewLocationSet(file.NewLocation("/usr/bin/app")),
+	}
+	for _, p := range []*pkg.Package{&discovered, &discoveredOnly, &claimed, &claimedOnly} {
+		p.SetID()
+	}
+
+	attached := func(pkgs ...pkg.Package) *sbom.SBOM {
+		return &sbom.SBOM{Artifacts: sbom.Artifacts{Packages: pkg.NewCollection(pkgs...)}}
+	}
+
+	c := pkg.NewCollection(discovered, discoveredOnly)
+	mergeAttachedSBOMPackages(c, []*sbom.SBOM{
+		attached(claimed, claimedOnly),
+		// the same package claimed by another attached SBOM is only added once
+		attached(claimedOnly),
+		nil,
+	})
+
+	var names []string
+	for _, p := range c.Sorted() {
+		names = append(names, p.Name)
+	}
+	assert.Equal(t, []string{"app", "busybox", "musl"}, names)
+
+	// discovered packages are left as discovered
+	assert.Equal(t, &discovered, c.Package(discovered.ID()))
+
+	added := c.PackagesByName("app")
+	if assert.Len(t, added, 1) {
+		assert.Equal(t, AttachedSBOMFoundBy, added[0].FoundBy)
+		assert.Empty(t, added[0].Locations.ToSlice())
+		assert.NotEqual(t, claimedOnly.ID(), added[0].ID())
+	}
+}
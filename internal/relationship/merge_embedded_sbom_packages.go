@@ -0,0 +1,123 @@
+package relationship
+
+import (
+	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// MergeEmbeddedSBOMPackages merges packages read from SBOMs found within the source (e.g. by the sbom-cataloger) with
+// the same packages discovered by the other catalogers. Packages match when they share the same type, name, and
+// version. For each match the embedded SBOM package is removed, the SBOM file is added to the locations of the
+// discovered package as supporting evidence, and all relationships (including "described-by" the SBOM file) are
+// moved over to the discovered package. Embedded SBOM packages without a match are kept as-is.
+func MergeEmbeddedSBOMPackages(accessor sbomsync.Accessor) {
+	accessor.WriteToSBOM(func(s *sbom.SBOM) {
+		s.Relationships = mergeEmbeddedSBOMPackages(s.Artifacts.Packages, s.Relationships)
+	})
+}
+
+func mergeEmbeddedSBOMPackages(c *pkg.Collection, relationships []artifact.Relationship) []artifact.Relationship {
+	sbomLocations := embeddedSBOMLocations(c, relationships)
+	if len(sbomLocations) == 0 {
+		return relationships
+	}
+
+	discovered := make(map[packageIdentity][]pkg.Package)
+	for p := range c.Enumerate() {
+		if _, ok := sbomLocations[p.ID()]; ok {
+			continue
+		}
+		key := packageIdentity{pkgType: p.Type, name: p.Name, version: p.Version}
+		discovered[key] = append(discovered[key], p)
+	}
+
+	// map each embedded SBOM package to the discovered packages it duplicates
+	replacements := make(map[artifact.ID][]pkg.Package)
+	for id, locations := range sbomLocations {
+		embedded := c.Package(id)
+		if embedded == nil || embedded.Version == "" {
+			continue
+		}
+		matches := discovered[packageIdentity{pkgType: embedded.Type, name: embedded.Name, version: embedded.Version}]
+		if len(matches) == 0 {
+			continue
+		}
+
+		for i, p := range matches {
+			for _, l := range locations {
+				p.Locations.Add(l.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+			}
+			matches[i] = p
+		}
+		replacements[id] = matches
+	}
+
+	for id := range replacements {
+		c.Delete(id)
+	}
+	for _, matches := range replacements {
+		for _, p := range matches {
+			// re-add the package so the collection indexes the new locations (the ID is unchanged)
+			c.Delete(p.ID())
+			c.Add(p)
+		}
+	}
+
+	return replaceRelationshipEndpoints(relationships, replacements)
+}
+
+// embeddedSBOMLocations returns the locations of the SBOM files that describe each package read from an SBOM, keyed
+// by package ID. Packages read from an SBOM are identified by the "described-by" relationship to the SBOM file.
+func embeddedSBOMLocations(c *pkg.Collection, relationships []artifact.Relationship) map[artifact.ID][]file.Location {
+	locations := make(map[artifact.ID][]file.Location)
+	for _, r := range relationships {
+		if r.Type != artifact.DescribedByRelationship {
+			continue
+		}
+		coordinates, ok := r.To.(file.Coordinates)
+		if !ok {
+			continue
+		}
+		p := c.Package(r.From.ID())
+		if p == nil {
+			continue
+		}
+		locations[p.ID()] = append(locations[p.ID()], file.NewLocationFromCoordinates(coordinates))
+	}
+	return locations
+}
+
+// replaceRelationshipEndpoints rewrites relationships to or from a replaced package so they refer to each of its
+// replacements instead, dropping any relationships that would relate a package to itself.
+func replaceRelationshipEndpoints(relationships []artifact.Relationship, replacements map[artifact.ID][]pkg.Package) []artifact.Relationship {
+	endpoints := func(id artifact.Identifiable) []artifact.Identifiable {
+		ps, ok := replacements[id.ID()]
+		if !ok {
+			return []artifact.Identifiable{id}
+		}
+		var ids []artifact.Identifiable
+		for _, p := range ps {
+			ids = append(ids, p)
+		}
+		return ids
+	}
+
+	var result []artifact.Relationship
+	for _, r := range relationships {
+		for _, from := range endpoints(r.From) {
+			for _, to := range endpoints(r.To) {
+				if from.ID() == to.ID() {
+					continue
+				}
+				updated := r
+				updated.From = from
+				updated.To = to
+				result = append(result, updated)
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,102 @@
+package relationship
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestMergeEmbeddedSBOMPackages(t *testing.T) {
+	sbomFile := file.Coordinates{RealPath: "/app/sbom.spdx.json"}
+
+	discovered := pkg.Package{
+		Name:      "express",
+		Version:   "4.18.2",
+		Type:      pkg.NpmPkg,
+		FoundBy:   "javascript-package-cataloger",
+		Locations: file.NewLocationSet(file.NewLocation("/app/node_modules/express/package.json")),
+		Metadata:  pkg.NpmPackage{Name: "express", Version: "4.18.2"},
+	}
+	embedded := pkg.Package{
+		Name:      "express",
+		Version:   "4.18.2",
+		Type:      pkg.NpmPkg,
+		FoundBy:   "sbom-cataloger",
+		Locations: file.NewLocationSet(file.NewLocationFromCoordinates(sbomFile)),
+	}
+	embeddedDependency := pkg.Package{
+		Name:      "body-parser",
+		Version:   "1.20.1",
+		Type:      pkg.NpmPkg,
+		FoundBy:   "sbom-cataloger",
+		Locations: file.NewLocationSet(file.NewLocationFromCoordinates(sbomFile)),
+	}
+	embeddedOtherVersion := pkg.Package{
+		Name:      "express",
+		Version:   "4.17.0",
+		Type:      pkg.NpmPkg,
+		FoundBy:   "sbom-cataloger",
+		Locations: file.NewLocationSet(file.NewLocationFromCoordinates(sbomFile)),
+	}
+	for _, p := range []*pkg.Package{&discovered, &embedded, &embeddedDependency, &embeddedOtherVersion} {
+		p.SetID()
+	}
+
+	describedBy := func(p pkg.Package) artifact.Relationship {
+		return artifact.Relationship{From: p, To: sbomFile, Type: artifact.DescribedByRelationship}
+	}
+
+	c := pkg.NewCollection(discovered, embedded, embeddedDependency, embeddedOtherVersion)
+	relationships := []artifact.Relationship{
+		describedBy(embedded),
+		describedBy(embeddedDependency),
+		describedBy(embeddedOtherVersion),
+		{From: embeddedDependency, To: embedded, Type: artifact.DependencyOfRelationship},
+	}
+
+	actual := mergeEmbeddedSBOMPackages(c, relationships)
+
+	// the embedded package is merged into the discovered package, packages without a match are kept
+	assert.Nil(t, c.Package(embedded.ID()))
+	assert.NotNil(t, c.Package(embeddedDependency.ID()))
+	assert.NotNil(t, c.Package(embeddedOtherVersion.ID()))
+
+	merged := c.Package(discovered.ID())
+	require.NotNil(t, merged)
+	assert.Equal(t, "javascript-package-cataloger", merged.FoundBy)
+	assert.Equal(t, discovered.Metadata, merged.Metadata)
+
+	annotations := make(map[string]string)
+	for _, l := range merged.Locations.ToSlice() {
+		annotations[l.RealPath] = l.Annotations[pkg.EvidenceAnnotationKey]
+	}
+	assert.Equal(t, map[string]string{
+		"/app/node_modules/express/package.json": "",
+		"/app/sbom.spdx.json":                    pkg.SupportingEvidenceAnnotation,
+	}, annotations)
+
+	assert.ElementsMatch(t, []artifact.Relationship{
+		describedBy(discovered),
+		describedBy(embeddedDependency),
+		describedBy(embeddedOtherVersion),
+		{From: embeddedDependency, To: discovered, Type: artifact.DependencyOfRelationship},
+	}, actual)
+}
+
+func TestMergeEmbeddedSBOMPackages_noEmbeddedSBOMs(t *testing.T) {
+	p := pkg.Package{Name: "express", Version: "4.18.2", Type: pkg.NpmPkg}
+	p.SetID()
+	other := pkg.Package{Name: "body-parser", Version: "1.20.1", Type: pkg.NpmPkg}
+	other.SetID()
+
+	relationships := []artifact.Relationship{{From: p, To: other, Type: artifact.DependencyOfRelationship}}
+	c := pkg.NewCollection(p, other)
+
+	assert.Equal(t, relationships, mergeEmbeddedSBOMPackages(c, relationships))
+	assert.Equal(t, 2, c.PackageCount())
+}
@@ -0,0 +1,123 @@
+package relationship
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// StablePackageIDs replaces the ID of every package with one derived only from the fields that identify the package
+// (see StablePackageID), so the same package has the same ID regardless of the order packages were cataloged in, the
+// other details recorded for the package, or the version of syft used. All relationships are updated to use the new
+// IDs. This must be run after all relationships to packages have been captured.
+//
+// Packages that share the same identity (e.g. the same npm package installed in two places) are told apart by their
+// location paths. If this is still not enough, the packages keep their original IDs.
+func StablePackageIDs(accessor sbomsync.Accessor) {
+	accessor.WriteToSBOM(func(s *sbom.SBOM) {
+		s.Relationships = stablePackageIDs(s.Artifacts.Packages, s.Relationships)
+	})
+}
+
+func stablePackageIDs(c *pkg.Collection, relationships []artifact.Relationship) []artifact.Relationship {
+	var pkgs []pkg.Package
+	for p := range c.Enumerate() {
+		pkgs = append(pkgs, p)
+	}
+
+	replacements := make(map[artifact.ID][]pkg.Package)
+	for _, group := range groupByStableID(pkgs, StablePackageID) {
+		if len(group) == 1 {
+			replacements[group[0].ID()] = withID(group[0], StablePackageID(group[0]))
+			continue
+		}
+		for _, located := range groupByStableID(group, stablePackageIDWithLocations) {
+			if len(located) > 1 {
+				for _, p := range located {
+					log.WithFields("package", p.String()).Debug("unable to assign a stable ID to an indistinguishable package")
+				}
+				continue
+			}
+			replacements[located[0].ID()] = withID(located[0], stablePackageIDWithLocations(located[0]))
+		}
+	}
+
+	// remove all packages first, as a new ID could be the original ID of another package
+	for id := range replacements {
+		c.Delete(id)
+	}
+	for _, ps := range replacements {
+		c.Add(ps...)
+	}
+
+	return replaceRelationshipEndpoints(relationships, replacements)
+}
+
+// StablePackageID returns the first 16 hex characters of the SHA-256 digest of the package type, name, version,
+// package URL, and the CPEs of the package (in the CPE 2.3 formatted string binding, sorted), each followed by a
+// newline. Only these fields are considered, so the ID is independent of the order of the CPEs, the locations and
+// metadata of the package, and the version of syft.
+func StablePackageID(p pkg.Package) artifact.ID {
+	return digestID(identityFields(p))
+}
+
+// stablePackageIDWithLocations returns a stable ID that additionally considers the (sorted) location paths of the
+// package, to distinguish packages with the same identity.
+func stablePackageIDWithLocations(p pkg.Package) artifact.ID {
+	var paths []string
+	for _, l := range p.Locations.ToSlice() {
+		paths = append(paths, l.RealPath)
+	}
+	sort.Strings(paths)
+	return digestID(append(identityFields(p), paths...))
+}
+
+func identityFields(p pkg.Package) []string {
+	var cpes []string
+	for _, c := range p.CPEs {
+		cpes = append(cpes, c.Attributes.BindToFmtString())
+	}
+	sort.Strings(cpes)
+	return append([]string{string(p.Type), p.Name, p.Version, p.PURL}, cpes...)
+}
+
+func digestID(fields []string) artifact.ID {
+	var sb strings.Builder
+	for _, f := range fields {
+		sb.WriteString(f)
+		sb.WriteString("\n")
+	}
+	return artifact.ID(fmt.Sprintf("%x", sha256.Sum256([]byte(sb.String())))[:16])
+}
+
+// groupByStableID groups packages by the given ID function, with groups sorted by ID.
+func groupByStableID(pkgs []pkg.Package, id func(pkg.Package) artifact.ID) [][]pkg.Package {
+	byID := make(map[artifact.ID][]pkg.Package)
+	var ids []artifact.ID
+	for _, p := range pkgs {
+		i := id(p)
+		if _, ok := byID[i]; !ok {
+			ids = append(ids, i)
+		}
+		byID[i] = append(byID[i], p)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	groups := make([][]pkg.Package, 0, len(ids))
+	for _, i := range ids {
+		groups = append(groups, byID[i])
+	}
+	return groups
+}
+
+func withID(p pkg.Package, id artifact.ID) []pkg.Package {
+	p.OverrideID(id)
+	return []pkg.Package{p}
+}
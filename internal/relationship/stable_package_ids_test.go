@@ -0,0 +1,129 @@
+package relationship
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/cpe"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestStablePackageID(t *testing.T) {
+	p := pkg.Package{
+		Name:    "express",
+		Version: "4.18.2",
+		Type:    pkg.NpmPkg,
+		PURL:    "pkg:npm/express@4.18.2",
+		CPEs: []cpe.CPE{
+			cpe.Must("cpe:2.3:a:expressjs:express:4.18.2:*:*:*:*:*:*:*", cpe.GeneratedSource),
+			cpe.Must("cpe:2.3:a:express:express:4.18.2:*:*:*:*:*:*:*", cpe.GeneratedSource),
+		},
+	}
+
+	// the documented derivation of the ID
+	input := "npm\nexpress\n4.18.2\npkg:npm/express@4.18.2\n" +
+		"cpe:2.3:a:express:express:4.18.2:*:*:*:*:*:*:*\n" +
+		"cpe:2.3:a:expressjs:express:4.18.2:*:*:*:*:*:*:*\n"
+	expected := artifact.ID(fmt.Sprintf("%x", sha256.Sum256([]byte(input)))[:16])
+	assert.Equal(t, expected, StablePackageID(p))
+
+	// fields that do not identify the package do not affect the ID
+	other := p
+	other.CPEs = []cpe.CPE{p.CPEs[1], p.CPEs[0]}
+	other.FoundBy = "sbom-cataloger"
+	other.Locations = file.NewLocationSet(file.NewLocation("/app/node_modules/express/package.json"))
+	other.Metadata = pkg.NpmPackage{Name: "express", Version: "4.18.2"}
+	assert.Equal(t, expected, StablePackageID(other))
+
+	// fields that identify the package do
+	other = p
+	other.Version = "4.18.3"
+	assert.NotEqual(t, expected, StablePackageID(other))
+}
+
+func TestStablePackageIDs(t *testing.T) {
+	newPackages := func() []pkg.Package {
+		express := pkg.Package{
+			Name:      "express",
+			Version:   "4.18.2",
+			Type:      pkg.NpmPkg,
+			PURL:      "pkg:npm/express@4.18.2",
+			Locations: file.NewLocationSet(file.NewLocation("/app/node_modules/express/package.json")),
+		}
+		bodyParser := pkg.Package{
+			Name:      "body-parser",
+			Version:   "1.20.1",
+			Type:      pkg.NpmPkg,
+			PURL:      "pkg:npm/body-parser@1.20.1",
+			Locations: file.NewLocationSet(file.NewLocation("/app/node_modules/body-parser/package.json")),
+		}
+		// the same package installed in two places
+		debugA := pkg.Package{
+			Name:      "debug",
+			Version:   "2.6.9",
+			Type:      pkg.NpmPkg,
+			PURL:      "pkg:npm/debug@2.6.9",
+			Locations: file.NewLocationSet(file.NewLocation("/app/node_modules/debug/package.json")),
+		}
+		debugB := debugA
+		debugB.Locations = file.NewLocationSet(file.NewLocation("/app/node_modules/express/node_modules/debug/package.json"))
+		pkgs := []pkg.Package{express, bodyParser, debugA, debugB}
+		for i := range pkgs {
+			pkgs[i].SetID()
+		}
+		return pkgs
+	}
+
+	// catalog the same packages in different orders and relate them
+	run := func(order []int) map[string]artifact.ID {
+		pkgs := newPackages()
+		c := pkg.NewCollection()
+		for _, i := range order {
+			c.Add(pkgs[i])
+		}
+		relationships := []artifact.Relationship{
+			{From: pkgs[1], To: pkgs[0], Type: artifact.DependencyOfRelationship},
+			{From: pkgs[3], To: pkgs[0], Type: artifact.DependencyOfRelationship},
+		}
+
+		actual := stablePackageIDs(c, relationships)
+		require.Equal(t, len(pkgs), c.PackageCount())
+
+		ids := make(map[string]artifact.ID)
+		for p := range c.Enumerate() {
+			ids[p.Name+" "+p.Locations.ToSlice()[0].RealPath] = p.ID()
+		}
+		for _, r := range actual {
+			ids[fmt.Sprintf("%s->%s", r.From.ID(), r.To.ID())] = ""
+		}
+		return ids
+	}
+
+	expected := run([]int{0, 1, 2, 3})
+	assert.Len(t, expected, 6)
+	for _, order := range [][]int{{3, 2, 1, 0}, {2, 0, 3, 1}, {1, 3, 0, 2}} {
+		assert.Equal(t, expected, run(order))
+	}
+
+	// packages with a single identity are not affected by their locations
+	express := newPackages()[0]
+	assert.Equal(t, StablePackageID(express), expected["express /app/node_modules/express/package.json"])
+
+	// packages sharing an identity are still told apart
+	assert.NotEqual(t,
+		expected["debug /app/node_modules/debug/package.json"],
+		expected["debug /app/node_modules/express/node_modules/debug/package.json"],
+	)
+
+	// relationships refer to the new IDs
+	assert.Contains(t, expected, fmt.Sprintf("%s->%s",
+		expected["body-parser /app/node_modules/body-parser/package.json"],
+		expected["express /app/node_modules/express/package.json"],
+	))
+}
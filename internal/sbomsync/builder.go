@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/diagnostic"
 	"github.com/anchore/syft/syft/linux"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/sbom"
@@ -27,6 +28,8 @@ type Builder interface {
 	// other
 
 	SetLinuxDistribution(linux.Release)
+
+	AddWarnings(...diagnostic.Warning)
 }
 
 // Accessor allows for low-level access to the SBOM
@@ -81,3 +84,10 @@ func (b sbomBuilder) SetLinuxDistribution(release linux.Release) {
 
 	b.sbom.Artifacts.LinuxDistribution = &release
 }
+
+func (b sbomBuilder) AddWarnings(warnings ...diagnostic.Warning) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.sbom.Warnings = append(b.sbom.Warnings, warnings...)
+}
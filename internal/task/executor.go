@@ -9,13 +9,15 @@ import (
 	"github.com/hashicorp/go-multierror"
 
 	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/syft/cataloging/pkgcataloging"
 	"github.com/anchore/syft/syft/event/monitor"
 	"github.com/anchore/syft/syft/file"
 )
 
 type Executor struct {
-	numWorkers int
-	tasks      chan Task
+	numWorkers         int
+	parallelCatalogers int
+	tasks              chan Task
 }
 
 func NewTaskExecutor(tasks []Task, numWorkers int) *Executor {
@@ -32,25 +34,44 @@ func NewTaskExecutor(tasks []Task, numWorkers int) *Executor {
 	return p
 }
 
+// WithParallelCatalogers bounds the number of package cataloger tasks that execute at once, separately from the number
+// of workers (which also execute other tasks, such as the file catalogers). A value less than 1 leaves package
+// cataloger tasks bounded only by the number of workers.
+func (p *Executor) WithParallelCatalogers(n int) *Executor {
+	p.parallelCatalogers = n
+	return p
+}
+
 func (p *Executor) Execute(ctx context.Context, resolver file.Resolver, s sbomsync.Builder, prog *monitor.CatalogerTaskProgress) error {
 	var errs error
 	wg := &sync.WaitGroup{}
+	catalogers, others := p.queues()
 	for i := 0; i < p.numWorkers; i++ {
+		// only some workers take package cataloger tasks, so workers are never left waiting for a package cataloger
+		// to complete while other tasks remain
+		queues := []chan Task{others}
+		if i < p.parallelCatalogers {
+			queues = []chan Task{catalogers, others}
+		}
+
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
 
-			for {
-				tsk, ok := <-p.tasks
-				if !ok {
-					return
-				}
+			for _, queue := range queues {
+				for tsk := range queue {
+					if ctx.Err() != nil {
+						// the caller canceled the scan, don't start any remaining tasks
+						prog.Increment()
+						continue
+					}
 
-				if err := runTaskSafely(ctx, tsk, resolver, s); err != nil {
-					errs = multierror.Append(errs, fmt.Errorf("failed to run task: %w", err))
-					prog.SetError(err)
+					if err := runTaskSafely(ctx, tsk, resolver, s); err != nil {
+						errs = multierror.Append(errs, fmt.Errorf("failed to run task: %w", err))
+						prog.SetError(err)
+					}
+					prog.Increment()
 				}
-				prog.Increment()
 			}
 		}()
 	}
@@ -60,6 +81,27 @@ func (p *Executor) Execute(ctx context.Context, resolver file.Resolver, s sbomsy
 	return errs
 }
 
+// queues returns the package cataloger tasks and all other tasks. When package catalogers are not bounded separately
+// from the workers, all tasks are returned as other tasks.
+func (p *Executor) queues() (chan Task, chan Task) {
+	if p.parallelCatalogers < 1 {
+		return nil, p.tasks
+	}
+
+	catalogers := make(chan Task, len(p.tasks))
+	others := make(chan Task, len(p.tasks))
+	for tsk := range p.tasks {
+		if s, ok := tsk.(Selector); ok && s.HasAllSelectors(pkgcataloging.PackageTag) {
+			catalogers <- tsk
+		} else {
+			others <- tsk
+		}
+	}
+	close(catalogers)
+	close(others)
+	return catalogers, others
+}
+
 func runTaskSafely(ctx context.Context, t Task, resolver file.Resolver, s sbomsync.Builder) (err error) {
 	// handle individual cataloger panics
 	defer func() {
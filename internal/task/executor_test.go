@@ -2,12 +2,17 @@ package task
 
 import (
 	"context"
+	"fmt"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/wagoodman/go-progress"
 
 	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/syft/cataloging/pkgcataloging"
 	"github.com/anchore/syft/syft/event/monitor"
 	"github.com/anchore/syft/syft/file"
 )
@@ -25,3 +30,56 @@ func Test_TaskExecutor_PanicHandling(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "executor_test.go")
 }
+
+func Test_TaskExecutor_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var ran bool
+	tsk := NewTask("some-cataloger", func(_ context.Context, _ file.Resolver, _ sbomsync.Builder) error {
+		ran = true
+		return nil
+	})
+	ex := NewTaskExecutor([]Task{tsk}, 1)
+
+	err := ex.Execute(ctx, nil, nil, &monitor.CatalogerTaskProgress{
+		Manual: progress.NewManual(-1),
+	})
+
+	require.NoError(t, err)
+	require.False(t, ran)
+}
+
+func Test_TaskExecutor_ParallelCatalogers(t *testing.T) {
+	var running, maxRunning int32
+	// package catalogers cannot complete until the file task has run, so the file task must not be held back by them
+	fileTaskDone := make(chan struct{})
+
+	var tsks []Task
+	for i := 0; i < 4; i++ {
+		tsks = append(tsks, NewTask(fmt.Sprintf("cataloger-%d", i), func(_ context.Context, _ file.Resolver, _ sbomsync.Builder) error {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			<-fileTaskDone
+			time.Sleep(10 * time.Millisecond)
+			return nil
+		}, pkgcataloging.PackageTag))
+	}
+	tsks = append(tsks, NewTask("file-task", func(_ context.Context, _ file.Resolver, _ sbomsync.Builder) error {
+		close(fileTaskDone)
+		return nil
+	}))
+
+	err := NewTaskExecutor(tsks, 4).WithParallelCatalogers(2).Execute(context.Background(), nil, nil, &monitor.CatalogerTaskProgress{
+		Manual: progress.NewManual(-1),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), maxRunning)
+}
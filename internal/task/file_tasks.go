@@ -8,6 +8,7 @@ import (
 	"github.com/anchore/syft/internal/sbomsync"
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/file/cataloger/envregistry"
 	"github.com/anchore/syft/syft/file/cataloger/executable"
 	"github.com/anchore/syft/syft/file/cataloger/filecontent"
 	"github.com/anchore/syft/syft/file/cataloger/filedigest"
@@ -46,12 +47,12 @@ func NewFileDigestCatalogerTask(selection file.Selection, hashers ...crypto.Hash
 	return NewTask("file-digest-cataloger", fn)
 }
 
-func NewFileMetadataCatalogerTask(selection file.Selection) Task {
+func NewFileMetadataCatalogerTask(selection file.Selection, cfg filemetadata.Config) Task {
 	if selection == file.NoFilesSelection {
 		return nil
 	}
 
-	metadataCataloger := filemetadata.NewCataloger()
+	metadataCataloger := filemetadata.NewCatalogerWithConfig(cfg)
 
 	fn := func(ctx context.Context, resolver file.Resolver, builder sbomsync.Builder) error {
 		accessor := builder.(sbomsync.Accessor)
@@ -126,6 +127,31 @@ func NewExecutableCatalogerTask(selection file.Selection, cfg executable.Config)
 	return NewTask("file-executable-cataloger", fn)
 }
 
+func NewEnvRegistryCatalogerTask(cfg envregistry.Config) Task {
+	if !cfg.Enabled || len(cfg.Globs) == 0 {
+		return nil
+	}
+
+	cat := envregistry.NewCataloger(cfg)
+
+	fn := func(ctx context.Context, resolver file.Resolver, builder sbomsync.Builder) error {
+		accessor := builder.(sbomsync.Accessor)
+
+		result, err := cat.Catalog(ctx, resolver)
+		if err != nil {
+			return err
+		}
+
+		accessor.WriteToSBOM(func(sbom *sbom.SBOM) {
+			sbom.Artifacts.FileRegistryReferences = result
+		})
+
+		return nil
+	}
+
+	return NewTask("file-env-registry-cataloger", fn)
+}
+
 // TODO: this should be replaced with a fix that allows passing a coordinate or location iterator to the cataloger
 // Today internal to both cataloger this functions differently: a slice of coordinates vs a channel of locations
 func coordinatesForSelection(selection file.Selection, accessor sbomsync.Accessor) ([]file.Coordinates, bool) {
@@ -2,9 +2,12 @@ package task
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"runtime/debug"
 	"sort"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/scylladb/go-set/strset"
@@ -15,10 +18,12 @@ import (
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/cataloging"
 	"github.com/anchore/syft/syft/cataloging/pkgcataloging"
+	"github.com/anchore/syft/syft/diagnostic"
 	"github.com/anchore/syft/syft/event/monitor"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/pkg/cataloger/common/cpe"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
 )
 
 type packageTaskFactory func(cfg CatalogingFactoryConfig) Task
@@ -30,6 +35,8 @@ type CatalogingFactoryConfig struct {
 	RelationshipsConfig  cataloging.RelationshipsConfig
 	DataGenerationConfig cataloging.DataGenerationConfig
 	PackagesConfig       pkgcataloging.Config
+	RecordUnknowns       bool
+	CatalogerTimeout     time.Duration
 }
 
 func DefaultCatalogingFactoryConfig() CatalogingFactoryConfig {
@@ -53,6 +60,18 @@ func newSimplePackageTaskFactory(catalogerFactory func() pkg.Cataloger, tags ...
 	}
 }
 
+// newConfigurablePackageTaskFactory creates a factory for a cataloger whose settings are given through
+// pkgcataloging.Config.WithCatalogerConfig (by the name of the cataloger), falling back to the given defaults.
+func newConfigurablePackageTaskFactory[T any](name string, defaults func() T, catalogerFactory func(T) pkg.Cataloger, tags ...string) packageTaskFactory {
+	return func(cfg CatalogingFactoryConfig) Task {
+		catalogerCfg, err := pkgcataloging.CatalogerConfig(cfg.PackagesConfig, name, defaults())
+		if err != nil {
+			log.WithFields("error", err).Warn("using the default config for cataloger")
+		}
+		return NewPackageTask(cfg, catalogerFactory(catalogerCfg), tags...)
+	}
+}
+
 func (f PackageTaskFactories) Tasks(cfg CatalogingFactoryConfig) ([]Task, error) {
 	var allTasks []Task
 	taskNames := strset.New()
@@ -80,10 +99,23 @@ func (f PackageTaskFactories) Tasks(cfg CatalogingFactoryConfig) ([]Task, error)
 	return allTasks, err
 }
 
+// unknownsCataloger is implemented by catalogers that are able to report the files they selected but were unable to
+// parse as packages of the UnknownPkg type.
+type unknownsCataloger interface {
+	WithUnknowns(record bool) *generic.Cataloger
+}
+
+// errCatalogerTimeout is returned when a cataloger does not complete within the configured timeout.
+var errCatalogerTimeout = errors.New("cataloger timed out")
+
 // NewPackageTask creates a Task function for a generic pkg.Cataloger, honoring the common configuration options.
 //
 //nolint:funlen
 func NewPackageTask(cfg CatalogingFactoryConfig, c pkg.Cataloger, tags ...string) Task {
+	if u, ok := c.(unknownsCataloger); ok && cfg.RecordUnknowns {
+		c = u.WithUnknowns(true)
+	}
+
 	fn := func(ctx context.Context, resolver file.Resolver, sbom sbomsync.Builder) error {
 		catalogerName := c.Name()
 		log.WithFields("name", catalogerName).Trace("starting package cataloger")
@@ -100,7 +132,22 @@ func NewPackageTask(cfg CatalogingFactoryConfig, c pkg.Cataloger, tags ...string
 
 		t := bus.StartCatalogerTask(info, -1, "")
 
-		pkgs, relationships, err := c.Catalog(ctx, resolver)
+		// the progress and warnings are specific to this call, since the cataloger may be invoked concurrently (e.g. by
+		// other tasks). The warnings of a call that timed out are dropped along with its results, since the abandoned
+		// call may still be adding to them.
+		progress := &generic.Progress{}
+		warnings := &diagnostic.Collection{}
+		catalogCtx := diagnostic.ContextWithCollection(generic.ContextWithProgress(ctx, progress), warnings)
+		pkgs, relationships, err := catalogWithTimeout(catalogCtx, c, resolver, cfg.CatalogerTimeout)
+		if errors.Is(err, errCatalogerTimeout) {
+			sbom.AddPackages(timedOutPackages(cfg, c, progress)...)
+			sbom.AddWarnings(diagnostic.Warning{
+				Cataloger: catalogerName,
+				Reason:    fmt.Sprintf("cataloger did not complete within %s", cfg.CatalogerTimeout),
+			})
+			t.SetCompleted()
+			return nil
+		}
 		if err != nil {
 			return fmt.Errorf("unable to catalog packages with %q: %w", c.Name(), err)
 		}
@@ -108,7 +155,8 @@ func NewPackageTask(cfg CatalogingFactoryConfig, c pkg.Cataloger, tags ...string
 		log.WithFields("cataloger", c.Name()).Debugf("discovered %d packages", len(pkgs))
 
 		for i, p := range pkgs {
-			if cfg.DataGenerationConfig.GenerateCPEs {
+			// there is nothing to derive CPEs from for files that could not be parsed
+			if cfg.DataGenerationConfig.GenerateCPEs && p.Type != pkg.UnknownPkg {
 				// generate CPEs (note: this is excluded from package ID, so is safe to mutate)
 				// we might have binary classified CPE already with the package so we want to append here
 				dictionaryCPEs, ok := cpe.DictionaryFind(p)
@@ -127,7 +175,7 @@ func NewPackageTask(cfg CatalogingFactoryConfig, c pkg.Cataloger, tags ...string
 				p.Language = pkg.LanguageFromPURL(p.PURL)
 			}
 
-			if cfg.RelationshipsConfig.PackageFileOwnership {
+			if cfg.RelationshipsConfig.PackageFileOwnership && cfg.RelationshipsConfig.Includes(artifact.ContainsRelationship) {
 				// create file-to-package relationships for files owned by the package
 				owningRelationships, err := packageFileOwnershipRelationships(p, resolver)
 				if err != nil {
@@ -142,6 +190,7 @@ func NewPackageTask(cfg CatalogingFactoryConfig, c pkg.Cataloger, tags ...string
 
 		sbom.AddPackages(pkgs...)
 		sbom.AddRelationships(relationships...)
+		sbom.AddWarnings(warnings.Warnings()...)
 		t.Add(int64(len(pkgs)))
 
 		t.SetCompleted()
@@ -154,6 +203,70 @@ func NewPackageTask(cfg CatalogingFactoryConfig, c pkg.Cataloger, tags ...string
 	return NewTask(c.Name(), fn, tags...)
 }
 
+// catalogWithTimeout runs the given cataloger, abandoning it when it does not complete within the timeout (if positive).
+// The cataloger is canceled through the context, however, catalogers that do not honor the context may keep running
+// in the background until they complete, in which case the results are discarded.
+func catalogWithTimeout(ctx context.Context, c pkg.Cataloger, resolver file.Resolver, timeout time.Duration) ([]pkg.Package, []artifact.Relationship, error) {
+	if timeout <= 0 {
+		return c.Catalog(ctx, resolver)
+	}
+
+	catalogCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type result struct {
+		pkgs          []pkg.Package
+		relationships []artifact.Relationship
+		err           error
+	}
+	done := make(chan result, 1)
+	go func() {
+		// the cataloger is no longer running within the task, so panics must be handled here
+		defer func() {
+			if e := recover(); e != nil {
+				done <- result{err: fmt.Errorf("%v at:\n%s", e, string(debug.Stack()))}
+			}
+		}()
+		pkgs, relationships, err := c.Catalog(catalogCtx, resolver)
+		done <- result{pkgs: pkgs, relationships: relationships, err: err}
+	}()
+
+	timedOut := func() bool {
+		// only the deadline of this cataloger is considered, not the cancellation of the scan
+		return ctx.Err() == nil && errors.Is(catalogCtx.Err(), context.DeadlineExceeded)
+	}
+
+	select {
+	case r := <-done:
+		if r.err != nil && timedOut() {
+			return nil, nil, errCatalogerTimeout
+		}
+		return r.pkgs, r.relationships, r.err
+	case <-catalogCtx.Done():
+		if timedOut() {
+			return nil, nil, errCatalogerTimeout
+		}
+		return nil, nil, catalogCtx.Err()
+	}
+}
+
+// timedOutPackages logs that the given cataloger timed out, returning the file it was stuck on (as recorded by the
+// progress of generic catalogers) as a package of the UnknownPkg type if unknowns are recorded.
+func timedOutPackages(cfg CatalogingFactoryConfig, c pkg.Cataloger, progress *generic.Progress) []pkg.Package {
+	fields := []any{"cataloger", c.Name(), "timeout", cfg.CatalogerTimeout}
+
+	var pkgs []pkg.Package
+	if location, ok := progress.InProgress(); ok {
+		fields = append(fields, "path", location.RealPath)
+		if cfg.RecordUnknowns {
+			pkgs = append(pkgs, generic.NewUnknownPackage(location, c.Name()))
+		}
+	}
+
+	log.WithFields(fields...).Warn("cataloger timed out, skipping its results")
+	return pkgs
+}
+
 func prettyName(s string) string {
 	if s == "" {
 		return ""
@@ -0,0 +1,207 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+func Test_NewPackageTask_RecordUnknowns(t *testing.T) {
+	parser := func(context.Context, file.Resolver, *generic.Environment, file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+		return nil, nil, fmt.Errorf("malformed package.json")
+	}
+	resolver := file.NewMockResolverForPaths("test-fixtures/package.json")
+
+	for _, record := range []bool{false, true} {
+		t.Run(fmt.Sprintf("record=%t", record), func(t *testing.T) {
+			cfg := DefaultCatalogingFactoryConfig()
+			cfg.RecordUnknowns = record
+			c := generic.NewCataloger("some-cataloger").WithParserByGlobs(parser, "**/package.json")
+
+			s := sbom.SBOM{Artifacts: sbom.Artifacts{Packages: pkg.NewCollection()}}
+			require.NoError(t, NewPackageTask(cfg, c).Execute(context.Background(), resolver, sbomsync.NewBuilder(&s)))
+
+			// the file that could not be parsed is reported as a warning either way
+			require.Len(t, s.Warnings, 1)
+			assert.Equal(t, "some-cataloger", s.Warnings[0].Cataloger)
+			require.NotNil(t, s.Warnings[0].Location)
+			assert.Equal(t, "test-fixtures/package.json", s.Warnings[0].Location.RealPath)
+			assert.Equal(t, "malformed package.json", s.Warnings[0].Reason)
+
+			pkgs := s.Artifacts.Packages.Sorted()
+			if !record {
+				assert.Empty(t, pkgs)
+				return
+			}
+			require.Len(t, pkgs, 1)
+			assert.Equal(t, "package.json", pkgs[0].Name)
+			assert.Equal(t, pkg.UnknownPkg, pkgs[0].Type)
+			assert.Equal(t, "some-cataloger", pkgs[0].FoundBy)
+			assert.Empty(t, pkgs[0].CPEs)
+		})
+	}
+}
+
+func Test_NewPackageTask_CatalogerTimeout(t *testing.T) {
+	resolver := file.NewMockResolverForPaths("test-fixtures/package.json", "test-fixtures/pathological.tar")
+
+	// the parser is deliberately slow for pathological.tar, either honoring the cancellation of the context or not
+	slowParser := func(honorContext bool, stop <-chan struct{}) generic.Parser {
+		return func(ctx context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+			if reader.RealPath != "test-fixtures/pathological.tar" {
+				p := pkg.Package{Name: "some-package", Version: "1.0.0"}
+				p.SetID()
+				return []pkg.Package{p}, nil, nil
+			}
+			if honorContext {
+				<-ctx.Done()
+				return nil, nil, ctx.Err()
+			}
+			<-stop
+			return nil, nil, nil
+		}
+	}
+
+	tests := []struct {
+		name           string
+		timeout        time.Duration
+		honorContext   bool
+		recordUnknowns bool
+		expected       []string
+	}{
+		{
+			name:           "cataloger honoring the context times out",
+			timeout:        10 * time.Millisecond,
+			honorContext:   true,
+			recordUnknowns: true,
+			expected:       []string{"pathological.tar"},
+		},
+		{
+			name:           "cataloger ignoring the context is abandoned",
+			timeout:        10 * time.Millisecond,
+			recordUnknowns: true,
+			expected:       []string{"pathological.tar"},
+		},
+		{
+			name:         "timed out file is not recorded without unknowns",
+			timeout:      10 * time.Millisecond,
+			honorContext: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stop := make(chan struct{})
+			t.Cleanup(func() { close(stop) })
+
+			cfg := DefaultCatalogingFactoryConfig()
+			cfg.CatalogerTimeout = test.timeout
+			cfg.RecordUnknowns = test.recordUnknowns
+			c := generic.NewCataloger("slow-cataloger").WithParserByGlobs(slowParser(test.honorContext, stop), "**/package.json", "**/pathological.tar")
+
+			s := sbom.SBOM{Artifacts: sbom.Artifacts{Packages: pkg.NewCollection()}}
+			require.NoError(t, NewPackageTask(cfg, c).Execute(context.Background(), resolver, sbomsync.NewBuilder(&s)))
+
+			var actual []string
+			for _, p := range s.Artifacts.Packages.Sorted() {
+				assert.Equal(t, pkg.UnknownPkg, p.Type)
+				actual = append(actual, p.Name)
+			}
+			assert.Equal(t, test.expected, actual)
+
+			require.Len(t, s.Warnings, 1)
+			assert.Equal(t, "slow-cataloger", s.Warnings[0].Cataloger)
+			assert.Contains(t, s.Warnings[0].Reason, "did not complete")
+		})
+	}
+}
+
+func Test_catalogWithTimeout(t *testing.T) {
+	resolver := file.NewMockResolverForPaths("test-fixtures/package.json")
+	parser := func(context.Context, file.Resolver, *generic.Environment, file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+		p := pkg.Package{Name: "some-package", Version: "1.0.0"}
+		p.SetID()
+		return []pkg.Package{p}, nil, nil
+	}
+	c := generic.NewCataloger("some-cataloger").WithParserByGlobs(parser, "**/package.json")
+
+	t.Run("completes within timeout", func(t *testing.T) {
+		pkgs, _, err := catalogWithTimeout(context.Background(), c, resolver, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, pkgs, 1)
+		assert.Equal(t, "some-package", pkgs[0].Name)
+	})
+
+	t.Run("canceled scan is not a timeout", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, _, err := catalogWithTimeout(ctx, c, resolver, time.Minute)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.NotErrorIs(t, err, errCatalogerTimeout)
+	})
+}
+
+func Test_newConfigurablePackageTaskFactory(t *testing.T) {
+	type someConfig struct {
+		Glob string
+	}
+	defaults := func() someConfig {
+		return someConfig{Glob: "**/default.json"}
+	}
+	resolver := file.NewMockResolverForPaths("test-fixtures/package.json")
+
+	tests := []struct {
+		name      string
+		cfg       any
+		wantFound bool
+	}{
+		{
+			name:      "defaults without a cataloger config",
+			wantFound: false,
+		},
+		{
+			name:      "cataloger config given",
+			cfg:       someConfig{Glob: "**/package.json"},
+			wantFound: true,
+		},
+		{
+			name:      "invalid cataloger config falls back to defaults",
+			cfg:       "**/package.json",
+			wantFound: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultCatalogingFactoryConfig()
+			if tt.cfg != nil {
+				cfg.PackagesConfig = cfg.PackagesConfig.WithCatalogerConfig("some-cataloger", tt.cfg)
+			}
+
+			factory := newConfigurablePackageTaskFactory("some-cataloger", defaults, func(c someConfig) pkg.Cataloger {
+				parser := func(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+					return []pkg.Package{{Name: "found", Locations: file.NewLocationSet(reader.Location)}}, nil, nil
+				}
+				return generic.NewCataloger("some-cataloger").WithParserByGlobs(parser, c.Glob)
+			})
+
+			s := sbom.SBOM{Artifacts: sbom.Artifacts{Packages: pkg.NewCollection()}}
+			require.NoError(t, factory(cfg).Execute(context.Background(), resolver, sbomsync.NewBuilder(&s)))
+
+			if tt.wantFound {
+				assert.Equal(t, 1, s.Artifacts.Packages.PackageCount())
+			} else {
+				assert.Zero(t, s.Artifacts.Packages.PackageCount())
+			}
+		})
+	}
+}
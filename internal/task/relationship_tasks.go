@@ -1,14 +1,21 @@
 package task
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 
+	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/internal/relationship"
 	"github.com/anchore/syft/internal/sbomsync"
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/cataloging/pkgcataloging"
 	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/sbom"
 	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/stereoscopesource"
 )
 
 var _ artifact.Identifiable = (*sourceIdentifierAdapter)(nil)
@@ -33,3 +40,100 @@ func NewRelationshipsTask(cfg cataloging.RelationshipsConfig, src source.Descrip
 
 	return NewTask("relationships-cataloger", fn)
 }
+
+// NewExcludeBinaryOverlapByMetadataTask returns a task that removes binary-derived packages that duplicate packages
+// discovered from the package metadata of the same project, or nil if this is not configured.
+func NewExcludeBinaryOverlapByMetadataTask(cfg pkgcataloging.Config) Task {
+	if !cfg.ExcludeBinaryOverlapByMetadata {
+		return nil
+	}
+
+	fn := func(_ context.Context, _ file.Resolver, builder sbomsync.Builder) error {
+		relationship.ExcludeBinariesByMetadataOverlap(builder.(sbomsync.Accessor))
+		return nil
+	}
+
+	return NewTask("exclude-binary-overlap-by-metadata", fn)
+}
+
+// NewMergeEmbeddedSBOMPackagesTask returns a task that merges packages read from embedded SBOMs into the same packages
+// discovered by other catalogers, or nil if this is not configured.
+func NewMergeEmbeddedSBOMPackagesTask(cfg pkgcataloging.Config) Task {
+	if !cfg.MergeEmbeddedSBOMPackages {
+		return nil
+	}
+
+	fn := func(_ context.Context, _ file.Resolver, builder sbomsync.Builder) error {
+		relationship.MergeEmbeddedSBOMPackages(builder.(sbomsync.Accessor))
+		return nil
+	}
+
+	return NewTask("merge-embedded-sbom-packages", fn)
+}
+
+// NewAttachedSBOMsTask returns a task that fetches the SBOMs attached to the scanned image within its registry and
+// merges the packages they claim with the discovered packages, or nil if this is not configured (or the source is not
+// an image).
+func NewAttachedSBOMsTask(cfg pkgcataloging.Config, src source.Description) Task {
+	if !cfg.FetchAttachedSBOMs {
+		return nil
+	}
+
+	metadata, ok := src.Metadata.(source.ImageMetadata)
+	if !ok {
+		return nil
+	}
+
+	fn := func(ctx context.Context, _ file.Resolver, builder sbomsync.Builder) error {
+		attached, err := stereoscopesource.AttachedSBOMs(ctx, metadata, cfg.RegistryOptions)
+		if err != nil {
+			return fmt.Errorf("unable to fetch attached SBOMs: %w", err)
+		}
+
+		var sboms []*sbom.SBOM
+		for _, a := range attached {
+			s, _, _, err := format.Decode(bytes.NewReader(a.Content))
+			if err != nil || s == nil {
+				log.WithFields("error", err, "artifact", a.Reference).Debug("unable to decode attached SBOM")
+				continue
+			}
+			log.WithFields("artifact", a.Reference, "packages", s.Artifacts.Packages.PackageCount()).Debug("found attached SBOM")
+			sboms = append(sboms, s)
+		}
+
+		relationship.MergeAttachedSBOMPackages(builder.(sbomsync.Accessor), sboms)
+		return nil
+	}
+
+	return NewTask("attached-sboms", fn)
+}
+
+// NewPackageFilterTask returns a task that removes the packages not satisfying the configured package filter, or nil if
+// this is not configured.
+func NewPackageFilterTask(cfg pkgcataloging.Config) Task {
+	if cfg.PackageFilter == nil {
+		return nil
+	}
+
+	fn := func(_ context.Context, _ file.Resolver, builder sbomsync.Builder) error {
+		relationship.FilterPackages(builder.(sbomsync.Accessor), cfg.PackageFilter)
+		return nil
+	}
+
+	return NewTask("package-filter", fn)
+}
+
+// NewStablePackageIDsTask returns a task that replaces the ID of each package with one derived from the identity of
+// the package, or nil if this is not configured.
+func NewStablePackageIDsTask(cfg pkgcataloging.Config) Task {
+	if !cfg.StablePackageIDs {
+		return nil
+	}
+
+	fn := func(_ context.Context, _ file.Resolver, builder sbomsync.Builder) error {
+		relationship.StablePackageIDs(builder.(sbomsync.Accessor))
+		return nil
+	}
+
+	return NewTask("stable-package-ids", fn)
+}
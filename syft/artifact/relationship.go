@@ -21,6 +21,13 @@ const (
 
 	// DescribedByRelationship is a proxy for the SPDX 2.2.2 DESCRIBED_BY relationship.
 	DescribedByRelationship RelationshipType = "described-by"
+
+	// DeclaredByRelationship is a package-to-package relationship indicating that a package resolved by a lock file
+	// (the parent) was declared as a dependency by a package described by a manifest (the child), for example, an
+	// entry of a package-lock.json resolving a dependency declared by the package.json alongside it. This does NOT
+	// map to an existing specific SPDX relationship. Instead, this should be mapped to OTHER and the comment field be
+	// updated to show DECLARED_BY.
+	DeclaredByRelationship RelationshipType = "declared-by"
 )
 
 func AllRelationshipTypes() []RelationshipType {
@@ -29,6 +36,7 @@ func AllRelationshipTypes() []RelationshipType {
 		ContainsRelationship,
 		DependencyOfRelationship,
 		DescribedByRelationship,
+		DeclaredByRelationship,
 	}
 }
 
@@ -1,8 +1,19 @@
 package cataloging
 
+import "strings"
+
 type ArchiveSearchConfig struct {
 	IncludeIndexedArchives   bool `yaml:"include-indexed-archives" json:"include-indexed-archives" mapstructure:"include-indexed-archives"`
 	IncludeUnindexedArchives bool `yaml:"include-unindexed-archives" json:"include-unindexed-archives" mapstructure:"include-unindexed-archives"`
+
+	// MaxArchiveEntries is the maximum number of entries processed within each archive, where the remaining entries
+	// are skipped with a warning (0 means there is no limit). This bounds the work done for archives crafted with
+	// millions of (tiny) entries.
+	MaxArchiveEntries int `yaml:"max-archive-entries" json:"max-archive-entries" mapstructure:"max-archive-entries"`
+
+	// ArchiveTypes restricts the archives that are searched to those with one of the given file extensions (e.g.
+	// ".jar"), where no extensions means all archive types supported by each cataloger are searched.
+	ArchiveTypes []string `yaml:"archive-types" json:"archive-types" mapstructure:"archive-types"`
 }
 
 func DefaultArchiveSearchConfig() ArchiveSearchConfig {
@@ -21,3 +32,42 @@ func (c ArchiveSearchConfig) WithIncludeUnindexedArchives(include bool) ArchiveS
 	c.IncludeUnindexedArchives = include
 	return c
 }
+
+func (c ArchiveSearchConfig) WithMaxArchiveEntries(n int) ArchiveSearchConfig {
+	c.MaxArchiveEntries = n
+	return c
+}
+
+// WithoutDefaultArchiveTypes restricts the archives that are searched to those with one of the given file extensions
+// (e.g. ".jar", ".war", ".ear"), instead of all archive types supported by each cataloger (which includes zip files
+// and tarballs that are not otherwise related to the ecosystem).
+func (c ArchiveSearchConfig) WithoutDefaultArchiveTypes(extensions ...string) ArchiveSearchConfig {
+	c.ArchiveTypes = extensions
+	return c
+}
+
+// ArchiveGlobs returns the given globs for archive files (e.g. "**/*.jar") that match one of the configured archive
+// types, or all the given globs when no archive types are configured.
+func (c ArchiveSearchConfig) ArchiveGlobs(globs ...string) []string {
+	if len(c.ArchiveTypes) == 0 {
+		return globs
+	}
+
+	var results []string
+	for _, glob := range globs {
+		for _, ext := range c.ArchiveTypes {
+			ext = strings.ToLower(strings.TrimSpace(ext))
+			if ext == "" {
+				continue
+			}
+			if !strings.HasPrefix(ext, ".") {
+				ext = "." + ext
+			}
+			if strings.HasSuffix(strings.ToLower(glob), "*"+ext) {
+				results = append(results, glob)
+				break
+			}
+		}
+	}
+	return results
+}
@@ -9,21 +9,26 @@ import (
 	intFile "github.com/anchore/syft/internal/file"
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/file/cataloger/envregistry"
 	"github.com/anchore/syft/syft/file/cataloger/executable"
 	"github.com/anchore/syft/syft/file/cataloger/filecontent"
+	"github.com/anchore/syft/syft/file/cataloger/filemetadata"
 )
 
 type Config struct {
-	Selection  file.Selection     `yaml:"selection" json:"selection" mapstructure:"selection"`
-	Hashers    []crypto.Hash      `yaml:"hashers" json:"hashers" mapstructure:"hashers"`
-	Content    filecontent.Config `yaml:"content" json:"content" mapstructure:"content"`
-	Executable executable.Config  `yaml:"executable" json:"executable" mapstructure:"executable"`
+	Selection   file.Selection      `yaml:"selection" json:"selection" mapstructure:"selection"`
+	Hashers     []crypto.Hash       `yaml:"hashers" json:"hashers" mapstructure:"hashers"`
+	Content     filecontent.Config  `yaml:"content" json:"content" mapstructure:"content"`
+	Metadata    filemetadata.Config `yaml:"metadata" json:"metadata" mapstructure:"metadata"`
+	Executable  executable.Config   `yaml:"executable" json:"executable" mapstructure:"executable"`
+	EnvRegistry envregistry.Config  `yaml:"env-registry" json:"env-registry" mapstructure:"env-registry"`
 }
 
 type configMarshaledForm struct {
-	Selection file.Selection     `yaml:"selection" json:"selection" mapstructure:"selection"`
-	Hashers   []string           `yaml:"hashers" json:"hashers" mapstructure:"hashers"`
-	Content   filecontent.Config `yaml:"content" json:"content" mapstructure:"content"`
+	Selection file.Selection      `yaml:"selection" json:"selection" mapstructure:"selection"`
+	Hashers   []string            `yaml:"hashers" json:"hashers" mapstructure:"hashers"`
+	Content   filecontent.Config  `yaml:"content" json:"content" mapstructure:"content"`
+	Metadata  filemetadata.Config `yaml:"metadata" json:"metadata" mapstructure:"metadata"`
 }
 
 func DefaultConfig() Config {
@@ -32,10 +37,12 @@ func DefaultConfig() Config {
 		log.WithFields("error", err).Warn("unable to create file hashers")
 	}
 	return Config{
-		Selection:  file.FilesOwnedByPackageSelection,
-		Hashers:    hashers,
-		Content:    filecontent.DefaultConfig(),
-		Executable: executable.DefaultConfig(),
+		Selection:   file.FilesOwnedByPackageSelection,
+		Hashers:     hashers,
+		Content:     filecontent.DefaultConfig(),
+		Metadata:    filemetadata.DefaultConfig(),
+		Executable:  executable.DefaultConfig(),
+		EnvRegistry: envregistry.DefaultConfig(),
 	}
 }
 
@@ -43,6 +50,7 @@ func (cfg Config) MarshalJSON() ([]byte, error) {
 	marshaled := configMarshaledForm{
 		Selection: cfg.Selection,
 		Hashers:   hashersToString(cfg.Hashers),
+		Metadata:  cfg.Metadata,
 	}
 	return json.Marshal(marshaled)
 }
@@ -67,6 +75,7 @@ func (cfg *Config) UnmarshalJSON(data []byte) error {
 	}
 	cfg.Selection = marshaled.Selection
 	cfg.Hashers = hashers
+	cfg.Metadata = marshaled.Metadata
 	return nil
 }
 
@@ -84,3 +93,17 @@ func (cfg Config) WithContentConfig(content filecontent.Config) Config {
 	cfg.Content = content
 	return cfg
 }
+
+// WithCaptureFileOwnership sets whether the owner (uid/gid) and permissions of each file are recorded by the
+// file metadata cataloger.
+func (cfg Config) WithCaptureFileOwnership(capture bool) Config {
+	cfg.Metadata.CaptureOwnership = capture
+	return cfg
+}
+
+// WithEnvRegistryConfig sets the configuration of the cataloger recording the package registries referenced by the
+// environment variables within env files, docker compose files, and kubernetes manifests.
+func (cfg Config) WithEnvRegistryConfig(envRegistry envregistry.Config) Config {
+	cfg.EnvRegistry = envRegistry
+	return cfg
+}
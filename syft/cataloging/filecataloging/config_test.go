@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/file/cataloger/filemetadata"
 )
 
 func TestConfig_MarshalJSON(t *testing.T) {
@@ -24,7 +25,7 @@ func TestConfig_MarshalJSON(t *testing.T) {
 				Selection: file.FilesOwnedByPackageSelection,
 				Hashers:   []crypto.Hash{crypto.SHA256},
 			},
-			want: []byte(`{"selection":"owned-by-package","hashers":["sha-256"],"content":{"globs":null,"skip-files-above-size":0}}`),
+			want: []byte(`{"selection":"owned-by-package","hashers":["sha-256"],"content":{"globs":null,"skip-files-above-size":0},"metadata":{"capture-ownership":false}}`),
 		},
 	}
 	for _, tt := range tests {
@@ -60,6 +61,17 @@ func TestConfig_UnmarshalJSON(t *testing.T) {
 				Hashers:   []crypto.Hash{crypto.SHA256},
 			},
 		},
+		{
+			name: "reads file metadata config",
+			data: []byte(`{"selection":"all","hashers":["sha-256"],"metadata":{"capture-ownership":true}}`),
+			want: Config{
+				Selection: file.AllFilesSelection,
+				Hashers:   []crypto.Hash{crypto.SHA256},
+				Metadata: filemetadata.Config{
+					CaptureOwnership: true,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
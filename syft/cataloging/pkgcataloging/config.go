@@ -1,30 +1,77 @@
 package pkgcataloging
 
 import (
+	"fmt"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/pkg/cataloger/binary"
 	"github.com/anchore/syft/syft/pkg/cataloger/golang"
 	"github.com/anchore/syft/syft/pkg/cataloger/java"
 	"github.com/anchore/syft/syft/pkg/cataloger/javascript"
 	"github.com/anchore/syft/syft/pkg/cataloger/kernel"
+	"github.com/anchore/syft/syft/pkg/cataloger/php"
 	"github.com/anchore/syft/syft/pkg/cataloger/python"
 )
 
 type Config struct {
-	Binary      binary.ClassifierCatalogerConfig  `yaml:"binary" json:"binary" mapstructure:"binary"`
-	Golang      golang.CatalogerConfig            `yaml:"golang" json:"golang" mapstructure:"golang"`
-	JavaArchive java.ArchiveCatalogerConfig       `yaml:"java-archive" json:"java-archive" mapstructure:"java-archive"`
-	JavaScript  javascript.CatalogerConfig        `yaml:"javascript" json:"javascript" mapstructure:"javascript"`
-	LinuxKernel kernel.LinuxKernelCatalogerConfig `yaml:"linux-kernel" json:"linux-kernel" mapstructure:"linux-kernel"`
-	Python      python.CatalogerConfig            `yaml:"python" json:"python" mapstructure:"python"`
+	Binary          binary.ClassifierCatalogerConfig  `yaml:"binary" json:"binary" mapstructure:"binary"`
+	Golang          golang.CatalogerConfig            `yaml:"golang" json:"golang" mapstructure:"golang"`
+	JavaArchive     java.ArchiveCatalogerConfig       `yaml:"java-archive" json:"java-archive" mapstructure:"java-archive"`
+	JavaNativeImage java.NativeImageCatalogerConfig   `yaml:"java-native-image" json:"java-native-image" mapstructure:"java-native-image"`
+	JavaScript      javascript.CatalogerConfig        `yaml:"javascript" json:"javascript" mapstructure:"javascript"`
+	LinuxKernel     kernel.LinuxKernelCatalogerConfig `yaml:"linux-kernel" json:"linux-kernel" mapstructure:"linux-kernel"`
+	PHP             php.CatalogerConfig               `yaml:"php" json:"php" mapstructure:"php"`
+	Python          python.CatalogerConfig            `yaml:"python" json:"python" mapstructure:"python"`
+
+	// ExcludeBinaryOverlapByMetadata will exclude packages discovered from binaries (e.g. by the
+	// go-module-binary-cataloger) when the same package (by type, name, and version) was also discovered from package
+	// metadata (e.g. a go.mod file) of the same project, that is, when the binary is found within the directory tree
+	// of the metadata file. Packages of binaries found elsewhere are kept, with the metadata file added as supporting
+	// evidence.
+	ExcludeBinaryOverlapByMetadata bool `yaml:"exclude-binary-overlap-by-metadata" json:"exclude-binary-overlap-by-metadata" mapstructure:"exclude-binary-overlap-by-metadata"`
+
+	// MergeEmbeddedSBOMPackages will merge packages read from SBOMs within the source (e.g. by the sbom-cataloger) into
+	// the same packages (by type, name, and version) discovered by other catalogers, instead of reporting both.
+	MergeEmbeddedSBOMPackages bool `yaml:"merge-embedded-sbom-packages" json:"merge-embedded-sbom-packages" mapstructure:"merge-embedded-sbom-packages"`
+
+	// FetchAttachedSBOMs will fetch any SBOM attached to a scanned image within its registry (as an OCI referrer or a
+	// cosign SBOM or attestation) and compare it with the discovered packages, where packages claimed by an attached
+	// SBOM that were not discovered are added (found by "attached-sbom").
+	FetchAttachedSBOMs bool `yaml:"fetch-attached-sboms" json:"fetch-attached-sboms" mapstructure:"fetch-attached-sboms"`
+
+	// RegistryOptions are used to authenticate with the registry when fetching attached SBOMs.
+	RegistryOptions *image.RegistryOptions `yaml:"-" json:"-" mapstructure:"-"`
+
+	// StablePackageIDs will replace the ID of each package with one derived only from the fields that identify the
+	// package (type, name, version, PURL, and CPEs), so the same package has the same ID across SBOMs and syft versions.
+	StablePackageIDs bool `yaml:"stable-package-ids" json:"stable-package-ids" mapstructure:"stable-package-ids"`
+
+	// IncludeDevDependencies will report packages that lockfiles classify as only required for development (currently
+	// for package-lock.json, poetry.lock, and Pipfile files, along with composer.lock files when the dev packages are
+	// included by the PHP config). When false, these packages are skipped.
+	IncludeDevDependencies bool `yaml:"include-dev-dependencies" json:"include-dev-dependencies" mapstructure:"include-dev-dependencies"`
+
+	// PackageFilter is applied to every cataloged package before the SBOM is assembled, where packages for which the
+	// filter returns false are removed along with any relationships to them. When nil all packages are kept.
+	PackageFilter func(pkg.Package) bool `yaml:"-" json:"-" mapstructure:"-"`
+
+	// Catalogers holds the settings for individual catalogers (by cataloger name) that do not have a dedicated field
+	// within this config, which are retrieved by each cataloger when constructed (see CatalogerConfig).
+	Catalogers map[string]any `yaml:"catalogers,omitempty" json:"catalogers,omitempty" mapstructure:"-"`
 }
 
 func DefaultConfig() Config {
 	return Config{
-		Binary:      binary.DefaultClassifierCatalogerConfig(),
-		Golang:      golang.DefaultCatalogerConfig(),
-		LinuxKernel: kernel.DefaultLinuxKernelCatalogerConfig(),
-		Python:      python.DefaultCatalogerConfig(),
-		JavaArchive: java.DefaultArchiveCatalogerConfig(),
+		Binary:          binary.DefaultClassifierCatalogerConfig(),
+		Golang:          golang.DefaultCatalogerConfig(),
+		LinuxKernel:     kernel.DefaultLinuxKernelCatalogerConfig(),
+		PHP:             php.DefaultCatalogerConfig(),
+		Python:          python.DefaultCatalogerConfig(),
+		JavaArchive:     java.DefaultArchiveCatalogerConfig(),
+		JavaNativeImage: java.DefaultNativeImageCatalogerConfig(),
+
+		IncludeDevDependencies: true,
 	}
 }
 
@@ -48,6 +95,11 @@ func (c Config) WithLinuxKernelConfig(cfg kernel.LinuxKernelCatalogerConfig) Con
 	return c
 }
 
+func (c Config) WithPHPConfig(cfg php.CatalogerConfig) Config {
+	c.PHP = cfg
+	return c
+}
+
 func (c Config) WithPythonConfig(cfg python.CatalogerConfig) Config {
 	c.Python = cfg
 	return c
@@ -57,3 +109,79 @@ func (c Config) WithJavaArchiveConfig(cfg java.ArchiveCatalogerConfig) Config {
 	c.JavaArchive = cfg
 	return c
 }
+
+func (c Config) WithJavaNativeImageConfig(cfg java.NativeImageCatalogerConfig) Config {
+	c.JavaNativeImage = cfg
+	return c
+}
+
+// WithCatalogerConfig sets the settings for the cataloger with the given name, replacing any previously given settings
+// for that cataloger. The settings must be of the type expected by the cataloger.
+func (c Config) WithCatalogerConfig(name string, cfg any) Config {
+	// copy the existing settings so configs derived from the same config do not share state
+	catalogers := make(map[string]any, len(c.Catalogers)+1)
+	for n, v := range c.Catalogers {
+		catalogers[n] = v
+	}
+	catalogers[name] = cfg
+	c.Catalogers = catalogers
+	return c
+}
+
+// CatalogerConfig returns the settings given for the cataloger with the given name, or the given defaults if no
+// settings were given. An error is returned if the given settings are not of the type expected by the cataloger.
+func CatalogerConfig[T any](c Config, name string, defaults T) (T, error) {
+	v, ok := c.Catalogers[name]
+	if !ok || v == nil {
+		return defaults, nil
+	}
+	switch cfg := v.(type) {
+	case T:
+		return cfg, nil
+	case *T:
+		if cfg != nil {
+			return *cfg, nil
+		}
+		return defaults, nil
+	}
+	return defaults, fmt.Errorf("invalid config for cataloger %q: expected %T but got %T", name, defaults, v)
+}
+
+func (c Config) WithExcludeBinaryOverlapByMetadata(exclude bool) Config {
+	c.ExcludeBinaryOverlapByMetadata = exclude
+	return c
+}
+
+func (c Config) WithMergeEmbeddedSBOMPackages(merge bool) Config {
+	c.MergeEmbeddedSBOMPackages = merge
+	return c
+}
+
+func (c Config) WithFetchAttachedSBOMs(fetch bool) Config {
+	c.FetchAttachedSBOMs = fetch
+	return c
+}
+
+// WithRegistryOptions sets the options used to access the registry of a scanned image when fetching attached SBOMs
+// (typically the same options used to pull the image).
+func (c Config) WithRegistryOptions(opts *image.RegistryOptions) Config {
+	c.RegistryOptions = opts
+	return c
+}
+
+func (c Config) WithStablePackageIDs(stable bool) Config {
+	c.StablePackageIDs = stable
+	return c
+}
+
+// WithPackageFilter sets a predicate that every cataloged package must satisfy to be kept in the SBOM, where any
+// relationships to the removed packages are removed too (e.g. to keep only the packages of a single language).
+func (c Config) WithPackageFilter(filter func(pkg.Package) bool) Config {
+	c.PackageFilter = filter
+	return c
+}
+
+func (c Config) WithIncludeDevDependencies(include bool) Config {
+	c.IncludeDevDependencies = include
+	return c
+}
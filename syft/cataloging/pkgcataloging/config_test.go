@@ -0,0 +1,69 @@
+package pkgcataloging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCatalogerConfig struct {
+	MaxSize int64
+}
+
+func TestConfig_WithCatalogerConfig(t *testing.T) {
+	base := DefaultConfig().WithCatalogerConfig("a-cataloger", testCatalogerConfig{MaxSize: 1})
+	derived := base.WithCatalogerConfig("b-cataloger", testCatalogerConfig{MaxSize: 2})
+
+	// deriving a config must not alter the config it was derived from
+	assert.Len(t, base.Catalogers, 1)
+	assert.Len(t, derived.Catalogers, 2)
+}
+
+func TestCatalogerConfig(t *testing.T) {
+	defaults := testCatalogerConfig{MaxSize: 100}
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		want    testCatalogerConfig
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "no config given",
+			cfg:  DefaultConfig(),
+			want: defaults,
+		},
+		{
+			name: "config given for another cataloger",
+			cfg:  DefaultConfig().WithCatalogerConfig("other-cataloger", testCatalogerConfig{MaxSize: 5}),
+			want: defaults,
+		},
+		{
+			name: "config given",
+			cfg:  DefaultConfig().WithCatalogerConfig("some-cataloger", testCatalogerConfig{MaxSize: 5}),
+			want: testCatalogerConfig{MaxSize: 5},
+		},
+		{
+			name: "config given by pointer",
+			cfg:  DefaultConfig().WithCatalogerConfig("some-cataloger", &testCatalogerConfig{MaxSize: 5}),
+			want: testCatalogerConfig{MaxSize: 5},
+		},
+		{
+			name:    "config of the wrong type",
+			cfg:     DefaultConfig().WithCatalogerConfig("some-cataloger", map[string]any{"MaxSize": 5}),
+			want:    defaults,
+			wantErr: require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			got, err := CatalogerConfig(tt.cfg, "some-cataloger", defaults)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
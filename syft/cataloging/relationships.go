@@ -1,5 +1,11 @@
 package cataloging
 
+import (
+	"slices"
+
+	"github.com/anchore/syft/syft/artifact"
+)
+
 type RelationshipsConfig struct {
 	// PackageFileOwnership will include package-to-file relationships that indicate which files are owned by which packages.
 	PackageFileOwnership bool `yaml:"package-file-ownership" json:"package-file-ownership" mapstructure:"package-file-ownership"`
@@ -13,6 +19,14 @@ type RelationshipsConfig struct {
 	// For example, if a binary package representing the /bin/python binary is discovered and there is a python RPM package installed which claims to
 	// orn /bin/python, then the binary package will be excluded from the catalog altogether if this configuration is set to true.
 	ExcludeBinaryPackagesWithFileOwnershipOverlap bool `yaml:"exclude-binary-packages-with-file-ownership-overlap" json:"exclude-binary-packages-with-file-ownership-overlap" mapstructure:"exclude-binary-packages-with-file-ownership-overlap"`
+
+	// Disabled will exclude all relationships from the SBOM, skipping the computation of relationships where possible (for faster and smaller SBOMs).
+	// Note that file ownership overlap is still computed when needed to exclude binary packages.
+	Disabled bool `yaml:"disabled" json:"disabled" mapstructure:"disabled"`
+
+	// Types restricts the relationships in the SBOM to the given types (all types are included when empty), skipping the computation of
+	// relationships of other types where possible.
+	Types []artifact.RelationshipType `yaml:"types" json:"types" mapstructure:"types"`
 }
 
 func DefaultRelationshipsConfig() RelationshipsConfig {
@@ -37,3 +51,19 @@ func (c RelationshipsConfig) WithExcludeBinaryPackagesWithFileOwnershipOverlap(e
 	c.ExcludeBinaryPackagesWithFileOwnershipOverlap = exclude
 	return c
 }
+
+// WithRelationships allows for disabling relationships altogether, or for restricting them to the given types (all types are included
+// when none are given).
+func (c RelationshipsConfig) WithRelationships(enabled bool, types ...artifact.RelationshipType) RelationshipsConfig {
+	c.Disabled = !enabled
+	c.Types = types
+	return c
+}
+
+// Includes indicates if relationships of the given type should be included in the SBOM.
+func (c RelationshipsConfig) Includes(t artifact.RelationshipType) bool {
+	if c.Disabled {
+		return false
+	}
+	return len(c.Types) == 0 || slices.Contains(c.Types, t)
+}
@@ -0,0 +1,39 @@
+package cataloging
+
+// SourceFingerprintConfig specifies how the fingerprint of the source (a reproducible identity of the contents that
+// were cataloged) is computed. The fingerprint of an image is the manifest digest, where the fingerprint of a directory
+// (or file) is a hash of the digests of all regular files within it, which is bounded by MaxFiles and MaxBytes.
+type SourceFingerprintConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled" mapstructure:"enabled"`
+
+	// MaxFiles is the maximum number of files hashed for a directory source, where no fingerprint is recorded when
+	// there are more files (0 means there is no limit).
+	MaxFiles int `yaml:"max-files" json:"max-files" mapstructure:"max-files"`
+
+	// MaxBytes is the maximum number of bytes hashed for a directory source, where no fingerprint is recorded when
+	// the files are larger in total (0 means there is no limit).
+	MaxBytes int64 `yaml:"max-bytes" json:"max-bytes" mapstructure:"max-bytes"`
+}
+
+func DefaultSourceFingerprintConfig() SourceFingerprintConfig {
+	return SourceFingerprintConfig{
+		Enabled:  false,
+		MaxFiles: 100000,
+		MaxBytes: 1 << 30, // 1 GiB
+	}
+}
+
+func (c SourceFingerprintConfig) WithEnabled(enabled bool) SourceFingerprintConfig {
+	c.Enabled = enabled
+	return c
+}
+
+func (c SourceFingerprintConfig) WithMaxFiles(n int) SourceFingerprintConfig {
+	c.MaxFiles = n
+	return c
+}
+
+func (c SourceFingerprintConfig) WithMaxBytes(n int64) SourceFingerprintConfig {
+	c.MaxBytes = n
+	return c
+}
@@ -0,0 +1,161 @@
+package syft
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+// CreateMultiPlatformSBOM creates a single software bill-of-materials covering every given source, typically one
+// source per platform of a multi-arch image (see GetSources). Each package records the platform it was found in (see pkg.Package.Platform). The source description of the first source is used for the
+// resulting SBOM. If the CreateSBOMConfig is nil, then default options will be used.
+func CreateMultiPlatformSBOM(ctx context.Context, srcs []source.Source, cfg *CreateSBOMConfig) (*sbom.SBOM, error) {
+	if len(srcs) == 0 {
+		return nil, fmt.Errorf("no sources provided")
+	}
+
+	// an individual platform may legitimately have no packages, so only the combined result is considered
+	failOnEmpty := cfg != nil && cfg.FailOnEmpty
+	if failOnEmpty {
+		platformCfg := *cfg
+		platformCfg.FailOnEmpty = false
+		cfg = &platformCfg
+	}
+
+	var sboms []sbom.SBOM
+	for _, src := range srcs {
+		s, err := CreateSBOM(ctx, src, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create SBOM for source=%q: %w", src.Describe().Name, err)
+		}
+		sboms = append(sboms, *s)
+	}
+
+	merged := sboms[0]
+	if len(sboms) > 1 {
+		merged = mergePlatformSBOMs(srcs[0], sboms)
+	}
+
+	if failOnEmpty && merged.Artifacts.Packages.PackageCount() == 0 {
+		return nil, fmt.Errorf("%w in any platform", ErrNoPackagesFound)
+	}
+
+	return &merged, nil
+}
+
+// mergePlatformSBOMs combines the per-platform SBOMs into the first SBOM. Packages are given the platform they were found
+// in, along with an ID specific to that platform (so the same package found in several platforms is kept for each
+// platform). Relationships are rewritten to reference these packages, and any relationship that references one of the
+// other (per-platform) sources is rewritten to reference the primary source.
+func mergePlatformSBOMs(primary artifact.Identifiable, sboms []sbom.SBOM) sbom.SBOM {
+	merged := sbom.SBOM{
+		Source:     sboms[0].Source,
+		Descriptor: sboms[0].Descriptor,
+		Artifacts: sbom.Artifacts{
+			Packages:               pkg.NewCollection(),
+			FileMetadata:           map[file.Coordinates]file.Metadata{},
+			FileDigests:            map[file.Coordinates][]file.Digest{},
+			FileContents:           map[file.Coordinates]string{},
+			FileLicenses:           map[file.Coordinates][]file.License{},
+			Executables:            map[file.Coordinates]file.Executable{},
+			FileRegistryReferences: map[file.Coordinates][]file.RegistryReference{},
+			LinuxDistribution:      sboms[0].Artifacts.LinuxDistribution,
+		},
+	}
+
+	for _, s := range sboms {
+		platform := platformFromSource(s.Source)
+
+		platformPkgs := map[artifact.ID]pkg.Package{}
+		if s.Artifacts.Packages != nil {
+			for _, p := range s.Artifacts.Packages.Sorted() {
+				if platform != "" {
+					id := p.ID()
+					p = withPlatform(p, platform)
+					platformPkgs[id] = p
+				}
+				merged.Artifacts.Packages.Add(p)
+			}
+		}
+
+		for _, r := range s.Relationships {
+			if p, ok := platformPkgs[idOf(r.From)]; ok {
+				r.From = p
+			}
+			if p, ok := platformPkgs[idOf(r.To)]; ok {
+				r.To = p
+			}
+			if r.From != nil && r.From.ID() == artifact.ID(s.Source.ID) {
+				r.From = primary
+			}
+			if r.To != nil && r.To.ID() == artifact.ID(s.Source.ID) {
+				r.To = primary
+			}
+			merged.Relationships = append(merged.Relationships, r)
+		}
+
+		for k, v := range s.Artifacts.FileMetadata {
+			merged.Artifacts.FileMetadata[k] = v
+		}
+		for k, v := range s.Artifacts.FileDigests {
+			merged.Artifacts.FileDigests[k] = v
+		}
+		for k, v := range s.Artifacts.FileContents {
+			merged.Artifacts.FileContents[k] = v
+		}
+		for k, v := range s.Artifacts.FileLicenses {
+			merged.Artifacts.FileLicenses[k] = v
+		}
+		for k, v := range s.Artifacts.Executables {
+			merged.Artifacts.Executables[k] = v
+		}
+		for k, v := range s.Artifacts.FileRegistryReferences {
+			merged.Artifacts.FileRegistryReferences[k] = v
+		}
+	}
+
+	return merged
+}
+
+// withPlatform returns the package found in the given platform, with an ID specific to that platform.
+func withPlatform(p pkg.Package, platform string) pkg.Package {
+	id, err := artifact.IDByHash(struct {
+		ID       artifact.ID
+		Platform string
+	}{p.ID(), platform})
+	if err != nil {
+		log.WithFields("package", p.String(), "error", err).Debug("unable to create platform-specific package ID")
+		id = p.ID()
+	}
+	p.Platform = platform
+	p.OverrideID(id)
+	return p
+}
+
+func idOf(i artifact.Identifiable) artifact.ID {
+	if i == nil {
+		return ""
+	}
+	return i.ID()
+}
+
+func platformFromSource(desc source.Description) string {
+	metadata, ok := desc.Metadata.(source.ImageMetadata)
+	if !ok {
+		return ""
+	}
+	var fields []string
+	for _, f := range []string{metadata.OS, metadata.Architecture, metadata.Variant} {
+		if f != "" {
+			fields = append(fields, f)
+		}
+	}
+	return strings.Join(fields, "/")
+}
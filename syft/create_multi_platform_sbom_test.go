@@ -0,0 +1,121 @@
+package syft
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+type identifiable string
+
+func (i identifiable) ID() artifact.ID {
+	return artifact.ID(i)
+}
+
+func Test_mergePlatformSBOMs(t *testing.T) {
+	newSBOM := func(srcID, arch, variant, layer string) sbom.SBOM {
+		p := pkg.Package{
+			Name:    "busybox",
+			Version: "1.36.1",
+			Type:    pkg.ApkPkg,
+			Locations: file.NewLocationSet(
+				file.NewLocationFromCoordinates(file.Coordinates{RealPath: "/lib/apk/db/installed", FileSystemID: layer}).
+					WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			),
+			Metadata: pkg.ApkDBEntry{Package: "busybox", Architecture: arch},
+		}
+		p.SetID()
+
+		return sbom.SBOM{
+			Source: source.Description{
+				ID:   srcID,
+				Name: "busybox",
+				Metadata: source.ImageMetadata{
+					OS:           "linux",
+					Architecture: arch,
+					Variant:      variant,
+				},
+			},
+			Artifacts: sbom.Artifacts{
+				Packages:     pkg.NewCollection(p),
+				FileMetadata: map[file.Coordinates]file.Metadata{{RealPath: "/bin/busybox", FileSystemID: layer}: {}},
+			},
+			Relationships: []artifact.Relationship{
+				{
+					From: identifiable(srcID),
+					To:   p,
+					Type: artifact.ContainsRelationship,
+				},
+			},
+		}
+	}
+
+	primary := identifiable("amd64-src")
+	got := mergePlatformSBOMs(primary, []sbom.SBOM{
+		newSBOM("amd64-src", "x86_64", "", "sha256:amd64-layer"),
+		newSBOM("arm64-src", "aarch64", "v8", "sha256:arm64-layer"),
+	})
+
+	assert.Equal(t, "amd64-src", got.Source.ID)
+	require.Equal(t, 2, got.Artifacts.Packages.PackageCount())
+	assert.Len(t, got.Artifacts.FileMetadata, 2)
+
+	var platforms []string
+	for _, p := range got.Artifacts.Packages.Sorted() {
+		locations := p.Locations.ToSlice()
+		require.Len(t, locations, 1)
+		assert.Equal(t, pkg.PrimaryEvidenceAnnotation, locations[0].Annotations[pkg.EvidenceAnnotationKey])
+		platforms = append(platforms, p.Platform)
+	}
+	assert.ElementsMatch(t, []string{"linux/x86_64", "linux/aarch64/v8"}, platforms)
+
+	require.Len(t, got.Relationships, 2)
+	for _, r := range got.Relationships {
+		assert.Equal(t, primary.ID(), r.From.ID())
+		// relationships reference the platform-specific packages
+		assert.NotNil(t, got.Artifacts.Packages.Package(r.To.ID()))
+	}
+
+	// the same package found in several platforms is kept for each platform
+	got = mergePlatformSBOMs(primary, []sbom.SBOM{
+		newSBOM("amd64-src", "noarch", "", "sha256:layer"),
+		newSBOM("arm64-src", "noarch", "v8", "sha256:layer"),
+	})
+	assert.Equal(t, 2, got.Artifacts.Packages.PackageCount())
+}
+
+func Test_platformFromSource(t *testing.T) {
+	tests := []struct {
+		name string
+		desc source.Description
+		want string
+	}{
+		{
+			name: "image with variant",
+			desc: source.Description{Metadata: source.ImageMetadata{OS: "linux", Architecture: "arm64", Variant: "v8"}},
+			want: "linux/arm64/v8",
+		},
+		{
+			name: "image without variant",
+			desc: source.Description{Metadata: source.ImageMetadata{OS: "linux", Architecture: "amd64"}},
+			want: "linux/amd64",
+		},
+		{
+			name: "non-image source",
+			desc: source.Description{Metadata: source.DirectoryMetadata{Path: "/somewhere"}},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, platformFromSource(tt.desc))
+		})
+	}
+}
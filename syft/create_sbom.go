@@ -2,6 +2,7 @@ package syft
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/wagoodman/go-progress"
 
 	"github.com/anchore/syft/internal/bus"
+	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/internal/sbomsync"
 	"github.com/anchore/syft/internal/task"
 	"github.com/anchore/syft/syft/artifact"
@@ -20,6 +22,9 @@ import (
 	"github.com/anchore/syft/syft/source"
 )
 
+// ErrNoPackagesFound is returned when no packages are cataloged and CreateSBOMConfig.FailOnEmpty is set.
+var ErrNoPackagesFound = errors.New("no packages found")
+
 // CreateSBOM creates a software bill-of-materials from the given source. If the CreateSBOMConfig is nil, then
 // default options will be used.
 func CreateSBOM(ctx context.Context, src source.Source, cfg *CreateSBOMConfig) (*sbom.SBOM, error) {
@@ -42,6 +47,14 @@ func CreateSBOM(ctx context.Context, src source.Source, cfg *CreateSBOMConfig) (
 		return nil, fmt.Errorf("unable to get file resolver: %w", err)
 	}
 
+	if cfg.SourceFingerprint.Enabled {
+		fingerprint, err := sourceFingerprint(ctx, cfg.SourceFingerprint, srcMetadata, resolver)
+		if err != nil {
+			log.WithFields("error", err).Warn("unable to compute source fingerprint")
+		}
+		srcMetadata.Fingerprint = fingerprint
+	}
+
 	s := sbom.SBOM{
 		Source: srcMetadata,
 		Descriptor: sbom.Descriptor{
@@ -67,16 +80,27 @@ func CreateSBOM(ctx context.Context, src source.Source, cfg *CreateSBOMConfig) (
 
 	builder := sbomsync.NewBuilder(&s)
 	for i := range taskGroups {
-		err := task.NewTaskExecutor(taskGroups[i], cfg.Parallelism).Execute(ctx, resolver, builder, catalogingProgress)
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("cataloging canceled: %w", err)
+		}
+		err := task.NewTaskExecutor(taskGroups[i], cfg.Parallelism).WithParallelCatalogers(cfg.ParallelCatalogers).Execute(ctx, resolver, builder, catalogingProgress)
 		if err != nil {
 			// TODO: tie this to the open progress monitors...
 			return nil, fmt.Errorf("failed to run tasks: %w", err)
 		}
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("cataloging canceled: %w", err)
+	}
+
 	packageCatalogingProgress.SetCompleted()
 	catalogingProgress.SetCompleted()
 
+	if cfg.FailOnEmpty && s.Artifacts.Packages.PackageCount() == 0 {
+		return nil, fmt.Errorf("%w in source=%q", ErrNoPackagesFound, srcMetadata.Name)
+	}
+
 	return &s, nil
 }
 
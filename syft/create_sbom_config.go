@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"runtime/debug"
 	"strings"
+	"time"
 
 	"github.com/anchore/syft/internal/task"
+	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/cataloging"
 	"github.com/anchore/syft/syft/cataloging/filecataloging"
 	"github.com/anchore/syft/syft/cataloging/pkgcataloging"
@@ -25,8 +27,28 @@ type CreateSBOMConfig struct {
 	Packages           pkgcataloging.Config
 	Files              filecataloging.Config
 	Parallelism        int
+	ParallelCatalogers int
 	CatalogerSelection pkgcataloging.SelectionRequest
 
+	// FailOnEmpty causes SBOM creation to return ErrNoPackagesFound when no packages are cataloged
+	FailOnEmpty bool
+
+	// RecordUnknowns causes files that were selected by a package cataloger but could not be parsed to be reported as
+	// packages of the UnknownPkg type
+	RecordUnknowns bool
+
+	// CatalogerTimeout bounds the time each package cataloger may take, after which the cataloger is abandoned and its
+	// results are skipped (no timeout when not positive)
+	CatalogerTimeout time.Duration
+
+	// SourceFingerprint specifies if (and how) a reproducible identity of the source is recorded on the source
+	// description of the SBOM
+	SourceFingerprint cataloging.SourceFingerprintConfig
+
+	// LinuxReleaseDetection causes the linux distribution release of the source to be identified (e.g. from
+	// /etc/os-release), which is enabled by default
+	LinuxReleaseDetection bool
+
 	// audit what tool is being used to generate the SBOM
 	ToolName          string
 	ToolVersion       string
@@ -38,13 +60,15 @@ type CreateSBOMConfig struct {
 
 func DefaultCreateSBOMConfig() *CreateSBOMConfig {
 	return &CreateSBOMConfig{
-		Search:               cataloging.DefaultSearchConfig(),
-		Relationships:        cataloging.DefaultRelationshipsConfig(),
-		DataGeneration:       cataloging.DefaultDataGenerationConfig(),
-		Packages:             pkgcataloging.DefaultConfig(),
-		Files:                filecataloging.DefaultConfig(),
-		Parallelism:          1,
-		packageTaskFactories: task.DefaultPackageTaskFactories(),
+		Search:                cataloging.DefaultSearchConfig(),
+		Relationships:         cataloging.DefaultRelationshipsConfig(),
+		DataGeneration:        cataloging.DefaultDataGenerationConfig(),
+		Packages:              pkgcataloging.DefaultConfig(),
+		Files:                 filecataloging.DefaultConfig(),
+		SourceFingerprint:     cataloging.DefaultSourceFingerprintConfig(),
+		LinuxReleaseDetection: true,
+		Parallelism:           1,
+		packageTaskFactories:  task.DefaultPackageTaskFactories(),
 
 		// library consumers are free to override the tool values to fit their needs, however, we have some sane defaults
 		// to ensure that SBOMs generated don't have missing tool metadata.
@@ -93,6 +117,65 @@ func (c *CreateSBOMConfig) WithParallelism(p int) *CreateSBOMConfig {
 	return c
 }
 
+// WithParallelCatalogers allows for bounding the number of package catalogers that can be executing at once, separately
+// from the number of concurrent cataloging tasks (see WithParallelism), which also includes the file catalogers. This
+// is useful for limiting the resources used by individually heavy catalogers (e.g. the binary cataloger). A value less
+// than 1 leaves package catalogers bounded only by the parallelism.
+func (c *CreateSBOMConfig) WithParallelCatalogers(n int) *CreateSBOMConfig {
+	if n < 0 {
+		n = 0
+	}
+	c.ParallelCatalogers = n
+	return c
+}
+
+// WithFailOnEmpty allows for treating a scan that finds no packages as an error (ErrNoPackagesFound) instead of
+// producing an empty SBOM. This is useful for catching misconfigurations, such as an incorrect path or a cataloger
+// selection that filters out everything.
+func (c *CreateSBOMConfig) WithFailOnEmpty(failOnEmpty bool) *CreateSBOMConfig {
+	c.FailOnEmpty = failOnEmpty
+	return c
+}
+
+// WithRecordUnknowns allows for reporting each file that matched a package cataloger but could not be read or parsed
+// (e.g. a malformed package.json) as a package of the UnknownPkg type, located at the file and found by the cataloger
+// that failed. By default such files are only logged, so the SBOM does not reflect that cataloging was incomplete.
+func (c *CreateSBOMConfig) WithRecordUnknowns(record bool) *CreateSBOMConfig {
+	c.RecordUnknowns = record
+	return c
+}
+
+// WithCatalogerTimeout allows for bounding the time each package cataloger may take, so that a cataloger stuck on a
+// pathological file (e.g. a deeply nested archive) cannot hang the scan. A cataloger exceeding the timeout is canceled
+// (through the context) and its results are skipped, where the file being parsed at the time is logged and, when
+// recording unknowns (see WithRecordUnknowns), reported as a package of the UnknownPkg type. A value less than or
+// equal to 0 disables the timeout.
+func (c *CreateSBOMConfig) WithCatalogerTimeout(d time.Duration) *CreateSBOMConfig {
+	if d < 0 {
+		d = 0
+	}
+	c.CatalogerTimeout = d
+	return c
+}
+
+// WithSourceFingerprint allows for recording a reproducible identity of the scanned source on the source description
+// of the SBOM (see source.Description.Fingerprint), which is useful for detecting that the same source was scanned
+// again. The fingerprint of an image is its manifest digest, where the fingerprint of a directory is a hash of the
+// digests of all files within it, which is skipped (with a warning) for trees larger than the configured limits.
+func (c *CreateSBOMConfig) WithSourceFingerprint(cfg cataloging.SourceFingerprintConfig) *CreateSBOMConfig {
+	c.SourceFingerprint = cfg
+	return c
+}
+
+// WithLinuxReleaseDetection allows for skipping the identification of the linux distribution release of the source
+// (which reads files such as /etc/os-release), which is useful for targeted scans (e.g. of a single language
+// ecosystem) where the distribution is not of interest. Note that catalogers which rely on the release (e.g. to
+// qualify package URLs with the distro) behave as if no release was found.
+func (c *CreateSBOMConfig) WithLinuxReleaseDetection(detect bool) *CreateSBOMConfig {
+	c.LinuxReleaseDetection = detect
+	return c
+}
+
 // WithSearchConfig allows for setting the specific search configuration for cataloging.
 func (c *CreateSBOMConfig) WithSearchConfig(cfg cataloging.SearchConfig) *CreateSBOMConfig {
 	c.Search = cfg
@@ -105,6 +188,13 @@ func (c *CreateSBOMConfig) WithRelationshipsConfig(cfg cataloging.RelationshipsC
 	return c
 }
 
+// WithRelationships allows for disabling relationships altogether, or for restricting them to the given types (all types
+// are included when none are given), which makes for faster and smaller SBOMs when relationships are not wanted.
+func (c *CreateSBOMConfig) WithRelationships(enabled bool, types ...artifact.RelationshipType) *CreateSBOMConfig {
+	c.Relationships = c.Relationships.WithRelationships(enabled, types...)
+	return c
+}
+
 // WithDataGenerationConfig allows for defining what data elements that cannot be discovered from the underlying
 // target being scanned that should be generated after package creation.
 func (c *CreateSBOMConfig) WithDataGenerationConfig(cfg cataloging.DataGenerationConfig) *CreateSBOMConfig {
@@ -179,18 +269,38 @@ func (c *CreateSBOMConfig) makeTaskGroups(src source.Description) ([][]task.Task
 		taskGroups = append(taskGroups, append(pkgTasks, fileTasks...))
 	}
 
+	// attached SBOMs are compared with the complete set of cataloged packages, but before post-processing so the
+	// packages added from attached SBOMs are processed too
+	if t := task.NewAttachedSBOMsTask(c.Packages, src); t != nil {
+		taskGroups = append(taskGroups, []task.Task{t})
+	}
+
+	// pruning duplicate packages must be done after all packages have been cataloged, but before relationships
+	// are finalized. Each post-processing task is run in a group of its own, since each depends on the packages left
+	// by the previous task (regardless of the configured parallelism).
+	for _, t := range c.packagePostProcessingTasks() {
+		taskGroups = append(taskGroups, []task.Task{t})
+	}
+
 	// all relationship work must be done after all nodes (files and packages) have been cataloged
 	if len(relationshipsTasks) > 0 {
 		taskGroups = append(taskGroups, relationshipsTasks)
 	}
 
+	// package IDs can only be replaced once all relationships to packages have been captured
+	if t := task.NewStablePackageIDsTask(c.Packages); t != nil {
+		taskGroups = append(taskGroups, []task.Task{t})
+	}
+
 	// identifying the environment (i.e. the linux release) must be done first as this is required for package cataloging
-	taskGroups = append(
-		[][]task.Task{
-			environmentTasks,
-		},
-		taskGroups...,
-	)
+	if len(environmentTasks) > 0 {
+		taskGroups = append(
+			[][]task.Task{
+				environmentTasks,
+			},
+			taskGroups...,
+		)
+	}
 
 	return taskGroups, &catalogerManifest{
 		Requested: selectionEvidence.Request,
@@ -205,7 +315,7 @@ func (c *CreateSBOMConfig) fileTasks() []task.Task {
 	if t := task.NewFileDigestCatalogerTask(c.Files.Selection, c.Files.Hashers...); t != nil {
 		tsks = append(tsks, t)
 	}
-	if t := task.NewFileMetadataCatalogerTask(c.Files.Selection); t != nil {
+	if t := task.NewFileMetadataCatalogerTask(c.Files.Selection, c.Files.Metadata); t != nil {
 		tsks = append(tsks, t)
 	}
 	if t := task.NewFileContentCatalogerTask(c.Files.Content); t != nil {
@@ -214,6 +324,9 @@ func (c *CreateSBOMConfig) fileTasks() []task.Task {
 	if t := task.NewExecutableCatalogerTask(c.Files.Selection, c.Files.Executable); t != nil {
 		tsks = append(tsks, t)
 	}
+	if t := task.NewEnvRegistryCatalogerTask(c.Files.EnvRegistry); t != nil {
+		tsks = append(tsks, t)
+	}
 
 	return tsks
 }
@@ -225,6 +338,8 @@ func (c *CreateSBOMConfig) packageTasks(src source.Description) ([]task.Task, *t
 		RelationshipsConfig:  c.Relationships,
 		DataGenerationConfig: c.DataGeneration,
 		PackagesConfig:       c.Packages,
+		RecordUnknowns:       c.RecordUnknowns,
+		CatalogerTimeout:     c.CatalogerTimeout,
 	}
 
 	persistentTasks, selectableTasks, err := c.allPackageTasks(cfg)
@@ -306,6 +421,23 @@ func (c *CreateSBOMConfig) userPackageTasks(cfg task.CatalogingFactoryConfig) ([
 	return persistentPackageTasks, selectablePackageTasks, nil
 }
 
+// packagePostProcessingTasks returns the set of tasks that should be run over the complete set of cataloged packages.
+func (c *CreateSBOMConfig) packagePostProcessingTasks() []task.Task {
+	var tsks []task.Task
+
+	if t := task.NewExcludeBinaryOverlapByMetadataTask(c.Packages); t != nil {
+		tsks = append(tsks, t)
+	}
+	if t := task.NewMergeEmbeddedSBOMPackagesTask(c.Packages); t != nil {
+		tsks = append(tsks, t)
+	}
+	// note: filtering is done last so the filter is applied to the final form of each package
+	if t := task.NewPackageFilterTask(c.Packages); t != nil {
+		tsks = append(tsks, t)
+	}
+	return tsks
+}
+
 // relationshipTasks returns the set of tasks that should be run to generate additional relationships as well as
 // prune existing relationships.
 func (c *CreateSBOMConfig) relationshipTasks(src source.Description) []task.Task {
@@ -321,6 +453,10 @@ func (c *CreateSBOMConfig) relationshipTasks(src source.Description) []task.Task
 // of where it is being scanned. Today this is used to identify the linux distribution release for container images
 // being scanned.
 func (c *CreateSBOMConfig) environmentTasks() []task.Task {
+	if !c.LinuxReleaseDetection {
+		return nil
+	}
+
 	var tsks []task.Task
 
 	if t := task.NewEnvironmentTask(); t != nil {
@@ -17,6 +17,7 @@ import (
 	"github.com/anchore/syft/syft/cataloging/filecataloging"
 	"github.com/anchore/syft/syft/cataloging/pkgcataloging"
 	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/file/cataloger/envregistry"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/source"
 )
@@ -117,6 +118,84 @@ func TestCreateSBOMConfig_makeTaskGroups(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "without linux release detection",
+			src:  dirSrc,
+			cfg:  DefaultCreateSBOMConfig().WithLinuxReleaseDetection(false),
+			wantTaskNames: [][]string{
+				pkgCatalogerNamesWithTagOrName(t, "directory"),
+				fileCatalogerNames(true, true, true),
+				relationshipCatalogerNames(),
+			},
+			wantManifest: &catalogerManifest{
+				Requested: pkgcataloging.SelectionRequest{
+					DefaultNamesOrTags: []string{"directory"},
+				},
+				Used: pkgCatalogerNamesWithTagOrName(t, "directory"),
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "package filter",
+			src:  dirSrc,
+			cfg: DefaultCreateSBOMConfig().WithPackagesConfig(
+				pkgcataloging.DefaultConfig().WithPackageFilter(func(p pkg.Package) bool {
+					return p.Type != pkg.BinaryPkg
+				}),
+			),
+			wantTaskNames: [][]string{
+				environmentCatalogerNames(),
+				pkgCatalogerNamesWithTagOrName(t, "directory"),
+				fileCatalogerNames(true, true, true),
+				{"package-filter"},
+				relationshipCatalogerNames(),
+			},
+			wantManifest: &catalogerManifest{
+				Requested: pkgcataloging.SelectionRequest{
+					DefaultNamesOrTags: []string{"directory"},
+				},
+				Used: pkgCatalogerNamesWithTagOrName(t, "directory"),
+			},
+			wantErr: require.NoError,
+		},
+		{
+			name: "fetch attached SBOMs for image source",
+			src:  imgSrc,
+			cfg:  DefaultCreateSBOMConfig().WithPackagesConfig(pkgcataloging.DefaultConfig().WithFetchAttachedSBOMs(true)),
+			wantTaskNames: [][]string{
+				environmentCatalogerNames(),
+				pkgCatalogerNamesWithTagOrName(t, "image"),
+				fileCatalogerNames(true, true, true),
+				{"attached-sboms"},
+				relationshipCatalogerNames(),
+			},
+			wantManifest: &catalogerManifest{
+				Requested: pkgcataloging.SelectionRequest{
+					DefaultNamesOrTags: []string{"image"},
+				},
+				Used: pkgCatalogerNamesWithTagOrName(t, "image"),
+			},
+			wantErr: require.NoError,
+		},
+		{
+			// attached SBOMs are only fetched for images
+			name: "fetch attached SBOMs for directory source",
+			src:  dirSrc,
+			cfg:  DefaultCreateSBOMConfig().WithPackagesConfig(pkgcataloging.DefaultConfig().WithFetchAttachedSBOMs(true)),
+			wantTaskNames: [][]string{
+				environmentCatalogerNames(),
+				pkgCatalogerNamesWithTagOrName(t, "directory"),
+				fileCatalogerNames(true, true, true),
+				relationshipCatalogerNames(),
+			},
+			wantManifest: &catalogerManifest{
+				Requested: pkgcataloging.SelectionRequest{
+					DefaultNamesOrTags: []string{"directory"},
+				},
+				Used: pkgCatalogerNamesWithTagOrName(t, "directory"),
+			},
+			wantErr: require.NoError,
+		},
 		{
 			// note, the file source acts like a directory scan
 			name: "default catalogers for file source",
@@ -136,6 +215,35 @@ func TestCreateSBOMConfig_makeTaskGroups(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			// post-processing tasks depend on each other, so are run one after the other
+			name: "multiple post-processing tasks",
+			src:  dirSrc,
+			cfg: DefaultCreateSBOMConfig().WithPackagesConfig(
+				pkgcataloging.DefaultConfig().
+					WithExcludeBinaryOverlapByMetadata(true).
+					WithMergeEmbeddedSBOMPackages(true).
+					WithPackageFilter(func(p pkg.Package) bool {
+						return p.Type != pkg.BinaryPkg
+					}),
+			),
+			wantTaskNames: [][]string{
+				environmentCatalogerNames(),
+				pkgCatalogerNamesWithTagOrName(t, "directory"),
+				fileCatalogerNames(true, true, true),
+				{"exclude-binary-overlap-by-metadata"},
+				{"merge-embedded-sbom-packages"},
+				{"package-filter"},
+				relationshipCatalogerNames(),
+			},
+			wantManifest: &catalogerManifest{
+				Requested: pkgcataloging.SelectionRequest{
+					DefaultNamesOrTags: []string{"directory"},
+				},
+				Used: pkgCatalogerNamesWithTagOrName(t, "directory"),
+			},
+			wantErr: require.NoError,
+		},
 		{
 			name: "no file digest cataloger",
 			src:  imgSrc,
@@ -154,6 +262,26 @@ func TestCreateSBOMConfig_makeTaskGroups(t *testing.T) {
 			},
 			wantErr: require.NoError,
 		},
+		{
+			name: "env registry file cataloger",
+			src:  imgSrc,
+			cfg: DefaultCreateSBOMConfig().WithFilesConfig(filecataloging.DefaultConfig().WithEnvRegistryConfig(
+				envregistry.Config{Enabled: true, Globs: []string{"**/.env"}},
+			)),
+			wantTaskNames: [][]string{
+				environmentCatalogerNames(),
+				pkgCatalogerNamesWithTagOrName(t, "image"),
+				{"file-digest-cataloger", "file-env-registry-cataloger", "file-executable-cataloger", "file-metadata-cataloger"},
+				relationshipCatalogerNames(),
+			},
+			wantManifest: &catalogerManifest{
+				Requested: pkgcataloging.SelectionRequest{
+					DefaultNamesOrTags: []string{"image"},
+				},
+				Used: pkgCatalogerNamesWithTagOrName(t, "image"),
+			},
+			wantErr: require.NoError,
+		},
 		{
 			name: "select no file catalogers",
 			src:  imgSrc,
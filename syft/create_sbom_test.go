@@ -0,0 +1,160 @@
+package syft
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/directorysource"
+)
+
+func TestCreateSBOM_FailOnEmpty(t *testing.T) {
+	withPackage := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(withPackage, "requirements.txt"), []byte("requests==2.31.0\n"), 0o600))
+
+	tests := []struct {
+		name        string
+		dir         string
+		failOnEmpty bool
+		wantErr     require.ErrorAssertionFunc
+		wantCount   int
+	}{
+		{
+			name:      "empty SBOM allowed by default",
+			dir:       t.TempDir(),
+			wantErr:   require.NoError,
+			wantCount: 0,
+		},
+		{
+			name:        "empty SBOM is an error",
+			dir:         t.TempDir(),
+			failOnEmpty: true,
+			wantErr: func(t require.TestingT, err error, _ ...interface{}) {
+				require.ErrorIs(t, err, ErrNoPackagesFound)
+			},
+		},
+		{
+			name:        "packages found",
+			dir:         withPackage,
+			failOnEmpty: true,
+			wantErr:     require.NoError,
+			wantCount:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src, err := directorysource.NewFromPath(tt.dir)
+			require.NoError(t, err)
+
+			cfg := DefaultCreateSBOMConfig().WithFailOnEmpty(tt.failOnEmpty)
+
+			s, err := CreateSBOM(context.Background(), src, cfg)
+			tt.wantErr(t, err)
+			if err != nil {
+				assert.Nil(t, s)
+				return
+			}
+			assert.Equal(t, tt.wantCount, s.Artifacts.Packages.PackageCount())
+		})
+	}
+}
+
+func TestCreateSBOM_SourceFingerprint(t *testing.T) {
+	writeTree := func(t *testing.T, files map[string]string) string {
+		dir := t.TempDir()
+		for name, contents := range files {
+			path := filepath.Join(dir, name)
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+			require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+		}
+		return dir
+	}
+
+	fingerprint := func(t *testing.T, dir string, cfg cataloging.SourceFingerprintConfig) string {
+		src, err := directorysource.NewFromPath(dir)
+		require.NoError(t, err)
+
+		s, err := CreateSBOM(context.Background(), src, DefaultCreateSBOMConfig().WithSourceFingerprint(cfg))
+		require.NoError(t, err)
+		return s.Source.Fingerprint
+	}
+
+	files := map[string]string{
+		"requirements.txt": "requests==2.31.0\n",
+		"src/main.py":      "import requests\n",
+	}
+	enabled := cataloging.DefaultSourceFingerprintConfig().WithEnabled(true)
+
+	expected := fingerprint(t, writeTree(t, files), enabled)
+	assert.Regexp(t, "^sha256:[0-9a-f]{64}$", expected)
+
+	t.Run("not recorded by default", func(t *testing.T) {
+		assert.Empty(t, fingerprint(t, writeTree(t, files), cataloging.DefaultSourceFingerprintConfig()))
+	})
+
+	t.Run("same contents have the same fingerprint", func(t *testing.T) {
+		assert.Equal(t, expected, fingerprint(t, writeTree(t, files), enabled))
+	})
+
+	t.Run("changed contents have a different fingerprint", func(t *testing.T) {
+		assert.NotEqual(t, expected, fingerprint(t, writeTree(t, map[string]string{
+			"requirements.txt": "requests==2.32.0\n",
+			"src/main.py":      "import requests\n",
+		}), enabled))
+	})
+
+	t.Run("moved files have a different fingerprint", func(t *testing.T) {
+		assert.NotEqual(t, expected, fingerprint(t, writeTree(t, map[string]string{
+			"requirements.txt": "requests==2.31.0\n",
+			"main.py":          "import requests\n",
+		}), enabled))
+	})
+
+	t.Run("not recorded beyond the file limit", func(t *testing.T) {
+		assert.Empty(t, fingerprint(t, writeTree(t, files), enabled.WithMaxFiles(1)))
+	})
+
+	t.Run("not recorded beyond the size limit", func(t *testing.T) {
+		assert.Empty(t, fingerprint(t, writeTree(t, files), enabled.WithMaxBytes(10)))
+	})
+}
+
+func Test_sourceFingerprint_image(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata source.ImageMetadata
+		expected string
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "manifest digest",
+			metadata: source.ImageMetadata{ID: "sha256:config", ManifestDigest: "sha256:manifest"},
+			expected: "sha256:manifest",
+		},
+		{
+			name:     "image ID without a manifest digest",
+			metadata: source.ImageMetadata{ID: "sha256:config"},
+			expected: "sha256:config",
+		},
+		{
+			name:    "no identity",
+			wantErr: require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			actual, err := sourceFingerprint(context.Background(), cataloging.DefaultSourceFingerprintConfig(), source.Description{Metadata: tt.metadata}, nil)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
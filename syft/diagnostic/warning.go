@@ -0,0 +1,102 @@
+/*
+Package diagnostic provides the structured warnings that catalogers accumulate while cataloging, which are reported
+within the SBOM so the quality of a scan can be assessed without inspecting the log output.
+*/
+package diagnostic
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+// Warning describes a problem encountered while cataloging which did not stop cataloging, such as a file that could
+// not be read or parsed (and so may be missing packages from the SBOM).
+type Warning struct {
+	// Cataloger is the name of the cataloger that encountered the problem.
+	Cataloger string
+
+	// Location is the file the problem relates to (if any).
+	Location *file.Coordinates
+
+	// Reason describes the problem.
+	Reason string
+}
+
+// Collection accumulates warnings, which is safe for concurrent use. The zero value is ready to use.
+type Collection struct {
+	lock     sync.Mutex
+	warnings []Warning
+}
+
+type collectionContextKey struct{}
+
+// ContextWithCollection returns a context that collects the warnings of a Catalog call within the given collection.
+// Since a cataloger may be invoked concurrently, warnings are collected per call through the context instead of being
+// held by the cataloger.
+func ContextWithCollection(ctx context.Context, c *Collection) context.Context {
+	return context.WithValue(ctx, collectionContextKey{}, c)
+}
+
+// CollectionFromContext returns the collection that warnings of the current Catalog call are added to, which is nil
+// (and drops all warnings) when the context does not collect warnings.
+func CollectionFromContext(ctx context.Context) *Collection {
+	c, _ := ctx.Value(collectionContextKey{}).(*Collection)
+	return c
+}
+
+// Add records a warning for the given location (which may be nil when the problem does not relate to a file).
+func (c *Collection) Add(cataloger string, location *file.Location, reason string) {
+	if c == nil {
+		return
+	}
+	w := Warning{
+		Cataloger: cataloger,
+		Reason:    reason,
+	}
+	if location != nil {
+		coordinates := location.Coordinates
+		w.Location = &coordinates
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.warnings = append(c.warnings, w)
+}
+
+// Warnings returns a copy of the warnings collected so far.
+func (c *Collection) Warnings() []Warning {
+	if c == nil {
+		return nil
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if len(c.warnings) == 0 {
+		return nil
+	}
+	return append([]Warning(nil), c.warnings...)
+}
+
+// Sort orders the given warnings by cataloger, location, and reason, so the warnings of an SBOM are ordered
+// consistently across scans.
+func Sort(warnings []Warning) {
+	sort.SliceStable(warnings, func(i, j int) bool {
+		a, b := warnings[i], warnings[j]
+		if a.Cataloger != b.Cataloger {
+			return a.Cataloger < b.Cataloger
+		}
+		if pa, pb := locationKey(a.Location), locationKey(b.Location); pa != pb {
+			return pa < pb
+		}
+		return a.Reason < b.Reason
+	})
+}
+
+func locationKey(c *file.Coordinates) string {
+	if c == nil {
+		return ""
+	}
+	return c.RealPath + "\x00" + c.FileSystemID
+}
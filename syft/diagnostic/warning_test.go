@@ -0,0 +1,73 @@
+package diagnostic
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+func TestCollection(t *testing.T) {
+	var c Collection
+	assert.Nil(t, c.Warnings())
+
+	location := file.NewLocation("/package-lock.json")
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.Add("some-cataloger", &location, "malformed file")
+		}()
+	}
+	wg.Wait()
+	c.Add("some-cataloger", nil, "not related to a file")
+
+	warnings := c.Warnings()
+	require.Len(t, warnings, 11)
+	require.NotNil(t, warnings[0].Location)
+	assert.Equal(t, "/package-lock.json", warnings[0].Location.RealPath)
+	assert.Nil(t, warnings[10].Location)
+
+	// the warnings returned are a copy
+	warnings[0].Reason = "changed"
+	assert.Equal(t, "malformed file", c.Warnings()[0].Reason)
+}
+
+func TestCollectionFromContext(t *testing.T) {
+	// warnings are dropped when the context does not collect warnings
+	ctx := context.Background()
+	assert.Nil(t, CollectionFromContext(ctx))
+	CollectionFromContext(ctx).Add("some-cataloger", nil, "dropped")
+	assert.Nil(t, CollectionFromContext(ctx).Warnings())
+
+	c := &Collection{}
+	CollectionFromContext(ContextWithCollection(ctx, c)).Add("some-cataloger", nil, "collected")
+	warnings := c.Warnings()
+	require.Len(t, warnings, 1)
+	assert.Equal(t, "collected", warnings[0].Reason)
+}
+
+func TestSort(t *testing.T) {
+	warnings := []Warning{
+		{Cataloger: "b", Location: &file.Coordinates{RealPath: "/a"}, Reason: "x"},
+		{Cataloger: "a", Location: &file.Coordinates{RealPath: "/b"}, Reason: "x"},
+		{Cataloger: "a", Location: &file.Coordinates{RealPath: "/a"}, Reason: "y"},
+		{Cataloger: "a", Location: &file.Coordinates{RealPath: "/a"}, Reason: "x"},
+		{Cataloger: "a", Reason: "z"},
+	}
+
+	Sort(warnings)
+
+	assert.Equal(t, []Warning{
+		{Cataloger: "a", Reason: "z"},
+		{Cataloger: "a", Location: &file.Coordinates{RealPath: "/a"}, Reason: "x"},
+		{Cataloger: "a", Location: &file.Coordinates{RealPath: "/a"}, Reason: "y"},
+		{Cataloger: "a", Location: &file.Coordinates{RealPath: "/b"}, Reason: "x"},
+		{Cataloger: "b", Location: &file.Coordinates{RealPath: "/a"}, Reason: "x"},
+	}, warnings)
+}
@@ -0,0 +1,135 @@
+package envregistry
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/bus"
+	intFile "github.com/anchore/syft/internal/file"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/event/monitor"
+	"github.com/anchore/syft/syft/file"
+)
+
+type Config struct {
+	// Enabled indicates whether the files matching the globs are searched for package registry references.
+	Enabled bool `yaml:"enabled" json:"enabled" mapstructure:"enabled"`
+
+	// Globs are the file patterns that must be matched for a file to be considered for cataloging. Files with a YAML
+	// extension are read as docker compose files or kubernetes manifests, while all other files are read as env files.
+	Globs []string `yaml:"globs" json:"globs" mapstructure:"globs"`
+
+	// SkipFilesAboveSize is the maximum file size (in bytes) to allow to be considered while cataloging. If the file is larger than this size it will be skipped.
+	SkipFilesAboveSize int64 `yaml:"skip-files-above-size" json:"skip-files-above-size" mapstructure:"skip-files-above-size"`
+}
+
+type Cataloger struct {
+	globs                     []string
+	skipFilesAboveSizeInBytes int64
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Enabled: false,
+		Globs: []string{
+			"**/.env",
+			"**/.env.*",
+			"**/*.env",
+			"**/*.yaml",
+			"**/*.yml",
+		},
+		SkipFilesAboveSize: 1 * intFile.MB,
+	}
+}
+
+func NewCataloger(cfg Config) *Cataloger {
+	return &Cataloger{
+		globs:                     cfg.Globs,
+		skipFilesAboveSizeInBytes: cfg.SkipFilesAboveSize,
+	}
+}
+
+func (i *Cataloger) Catalog(_ context.Context, resolver file.Resolver) (map[file.Coordinates][]file.RegistryReference, error) {
+	results := make(map[file.Coordinates][]file.RegistryReference)
+
+	locations, err := resolver.FilesByGlob(i.globs...)
+	if err != nil {
+		return nil, err
+	}
+
+	prog := catalogingProgress(int64(len(locations)))
+
+	for _, location := range locations {
+		prog.AtomicStage.Set(location.Path())
+
+		metadata, err := resolver.FileMetadataByLocation(location)
+		if err != nil {
+			prog.SetError(err)
+			return nil, err
+		}
+
+		if i.skipFilesAboveSizeInBytes > 0 && metadata.Size() > i.skipFilesAboveSizeInBytes {
+			continue
+		}
+
+		refs, err := i.catalogLocation(resolver, location)
+		if internal.IsErrPathPermission(err) {
+			log.Debugf("env registry cataloger skipping - %+v", err)
+			continue
+		}
+		if err != nil {
+			// files matching the globs are commonly not env files (e.g. arbitrary YAML), so this is not fatal
+			log.WithFields("error", err, "path", location.RealPath).Trace("unable to read environment variables")
+		}
+
+		prog.Increment()
+
+		if len(refs) > 0 {
+			results[location.Coordinates] = refs
+		}
+	}
+
+	log.Debugf("env registry cataloger found registry references in %d files", len(results))
+
+	prog.AtomicStage.Set(fmt.Sprintf("%s files", humanize.Comma(prog.Current())))
+	prog.SetCompleted()
+
+	return results, nil
+}
+
+func (i *Cataloger) catalogLocation(resolver file.Resolver, location file.Location) ([]file.RegistryReference, error) {
+	contentReader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return nil, err
+	}
+	defer internal.CloseAndLogError(contentReader, location.AccessPath)
+
+	var vars []variable
+	switch strings.ToLower(path.Ext(location.RealPath)) {
+	case ".yaml", ".yml":
+		vars, err = parseYAMLVariables(contentReader)
+	default:
+		vars, err = parseEnvFileVariables(contentReader)
+	}
+	if err != nil {
+		return nil, internal.ErrPath{Context: "env-registry-cataloger", Path: location.RealPath, Err: err}
+	}
+
+	return registryReferences(vars), nil
+}
+
+func catalogingProgress(locations int64) *monitor.CatalogerTaskProgress {
+	info := monitor.GenericTask{
+		Title: monitor.Title{
+			Default: "Registry references",
+		},
+		ParentID: monitor.TopLevelCatalogingTaskID,
+	}
+
+	return bus.StartCatalogerTask(info, locations, "")
+}
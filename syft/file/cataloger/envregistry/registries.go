@@ -0,0 +1,124 @@
+package envregistry
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// registryVariables are the well-known variables that configure the registry of a package manager.
+var registryVariables = map[string]pkg.Type{
+	"NPM_CONFIG_REGISTRY":      pkg.NpmPkg,
+	"YARN_REGISTRY":            pkg.NpmPkg,
+	"YARN_NPM_REGISTRY_SERVER": pkg.NpmPkg,
+	"PIP_INDEX_URL":            pkg.PythonPkg,
+	"PIP_EXTRA_INDEX_URL":      pkg.PythonPkg,
+	"UV_INDEX_URL":             pkg.PythonPkg,
+	"UV_EXTRA_INDEX_URL":       pkg.PythonPkg,
+	"UV_DEFAULT_INDEX":         pkg.PythonPkg,
+	"UV_INDEX":                 pkg.PythonPkg,
+	"GOPROXY":                  pkg.GoModulePkg,
+	"COMPOSER_REPOSITORY_URL":  pkg.PhpComposerPkg,
+	"GEM_SOURCE":               pkg.GemPkg,
+	"NUGET_SOURCE":             pkg.DotnetPkg,
+}
+
+// registryVariablePatterns are the patterns of variables that configure named registries of a package manager.
+var registryVariablePatterns = map[*regexp.Regexp]pkg.Type{
+	regexp.MustCompile(`^CARGO_REGISTRIES_[A-Z0-9_]+_INDEX$`):  pkg.RustPkg,
+	regexp.MustCompile(`^POETRY_REPOSITORIES_[A-Z0-9_]+_URL$`): pkg.PythonPkg,
+	regexp.MustCompile(`^UV_INDEX_[A-Z0-9_]+_URL$`):            pkg.PythonPkg,
+}
+
+// registryVariableSuffixes are the conventional suffixes of variables that reference a registry of an unknown kind.
+var registryVariableSuffixes = []string{"_REGISTRY", "_REGISTRY_URL", "_INDEX_URL", "_REPOSITORY_URL"}
+
+// registryEcosystem returns the package type of the registry referenced by the variable (empty when not known), and
+// whether the variable references a registry at all.
+func registryEcosystem(name string) (string, bool) {
+	name = strings.ToUpper(name)
+	if t, ok := registryVariables[name]; ok {
+		return string(t), true
+	}
+	for pattern, t := range registryVariablePatterns {
+		if pattern.MatchString(name) {
+			return string(t), true
+		}
+	}
+	for _, suffix := range registryVariableSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return "", true
+		}
+	}
+	return "", false
+}
+
+// registryReferences returns the registries referenced by the given variables, in the order they are configured.
+func registryReferences(vars []variable) []file.RegistryReference {
+	var refs []file.RegistryReference
+	seen := make(map[file.RegistryReference]struct{})
+	for _, v := range vars {
+		ecosystem, ok := registryEcosystem(v.name)
+		if !ok {
+			continue
+		}
+		for _, u := range registryURLs(v.value) {
+			ref := file.RegistryReference{
+				Variable:  v.name,
+				URL:       u,
+				Ecosystem: ecosystem,
+			}
+			if _, ok := seen[ref]; ok {
+				continue
+			}
+			seen[ref] = struct{}{}
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// registryURLs returns the registries within the value of a variable (which may be a list, e.g. GOPROXY or
+// PIP_EXTRA_INDEX_URL), without any credentials, query, or fragment. Values referencing other variables cannot be
+// resolved and are skipped.
+func registryURLs(value string) []string {
+	var urls []string
+	for _, field := range strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == '|' || r == ' ' || r == '\t'
+	}) {
+		if strings.Contains(field, "$") {
+			continue
+		}
+		switch field {
+		case "direct", "off":
+			// GOPROXY keywords for fetching from the origin of each module (not a registry)
+			continue
+		}
+
+		raw := field
+		if !strings.Contains(raw, "://") {
+			// e.g. "registry.example.com:5000/path"
+			raw = "//" + raw
+		}
+		u, err := url.Parse(raw)
+		if err != nil || !isRegistryHost(u.Hostname()) {
+			continue
+		}
+		u.User = nil
+		u.RawQuery = ""
+		u.ForceQuery = false
+		u.Fragment = ""
+		u.RawFragment = ""
+
+		urls = append(urls, strings.TrimPrefix(u.String(), "//"))
+	}
+	return urls
+}
+
+func isRegistryHost(host string) bool {
+	// a bare word is not a host (e.g. values that are not registries at all)
+	return strings.Contains(host, ".") || host == "localhost"
+}
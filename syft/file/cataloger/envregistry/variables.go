@@ -0,0 +1,154 @@
+package envregistry
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// variable is an environment variable configured by a file.
+type variable struct {
+	name  string
+	value string
+}
+
+// parseEnvFileVariables returns the variables within a dotenv style file, where each line is a KEY=VALUE assignment
+// (optionally prefixed with "export") and lines beginning with "#" are comments.
+func parseEnvFileVariables(reader io.Reader) ([]variable, error) {
+	var vars []variable
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimSpace(strings.TrimPrefix(line, "export "))
+
+		if v, ok := parseAssignment(line); ok {
+			vars = append(vars, v)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read env file: %w", err)
+	}
+	return vars, nil
+}
+
+// parseYAMLVariables returns the variables within the "environment" sections of docker compose services and the "env"
+// sections of kubernetes containers, from each document within the YAML file.
+func parseYAMLVariables(reader io.Reader) ([]variable, error) {
+	var vars []variable
+	decoder := yaml.NewDecoder(reader)
+	for {
+		var doc yaml.Node
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return vars, fmt.Errorf("unable to parse yaml: %w", err)
+		}
+		vars = append(vars, yamlVariables(&doc)...)
+	}
+	return vars, nil
+}
+
+func yamlVariables(node *yaml.Node) []variable {
+	var vars []variable
+	switch node.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, child := range node.Content {
+			vars = append(vars, yamlVariables(child)...)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			switch key.Value {
+			case "environment":
+				// docker compose: a mapping of names to values, or a sequence of NAME=VALUE assignments
+				vars = append(vars, composeVariables(value)...)
+			case "env":
+				// kubernetes: a sequence of mappings with a name and value (values from secrets are not resolvable)
+				vars = append(vars, kubernetesVariables(value)...)
+			default:
+				vars = append(vars, yamlVariables(value)...)
+			}
+		}
+	}
+	return vars
+}
+
+func composeVariables(node *yaml.Node) []variable {
+	var vars []variable
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if value.Kind != yaml.ScalarNode {
+				continue
+			}
+			vars = append(vars, variable{name: key.Value, value: value.Value})
+		}
+	case yaml.SequenceNode:
+		for _, child := range node.Content {
+			if child.Kind != yaml.ScalarNode {
+				continue
+			}
+			if v, ok := parseAssignment(child.Value); ok {
+				vars = append(vars, v)
+			}
+		}
+	}
+	return vars
+}
+
+func kubernetesVariables(node *yaml.Node) []variable {
+	if node.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	var vars []variable
+	for _, child := range node.Content {
+		if child.Kind != yaml.MappingNode {
+			continue
+		}
+		var v variable
+		for i := 0; i+1 < len(child.Content); i += 2 {
+			key, value := child.Content[i], child.Content[i+1]
+			if value.Kind != yaml.ScalarNode {
+				continue
+			}
+			switch key.Value {
+			case "name":
+				v.name = value.Value
+			case "value":
+				v.value = value.Value
+			}
+		}
+		if v.name != "" && v.value != "" {
+			vars = append(vars, v)
+		}
+	}
+	return vars
+}
+
+func parseAssignment(assignment string) (variable, bool) {
+	name, value, ok := strings.Cut(assignment, "=")
+	name = strings.TrimSpace(name)
+	if !ok || name == "" {
+		return variable{}, false
+	}
+
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		value = value[1 : len(value)-1]
+	} else if i := strings.Index(value, " #"); i >= 0 {
+		// unquoted values may be followed by a comment
+		value = strings.TrimSpace(value[:i])
+	}
+	return variable{name: name, value: value}, true
+}
@@ -2,6 +2,8 @@ package executable
 
 import (
 	"debug/elf"
+	"fmt"
+	"io"
 	"regexp"
 	"strings"
 
@@ -37,6 +39,17 @@ func findELFFeatures(data *file.Executable, reader unionreader.UnionReader) erro
 	return nil
 }
 
+// ELFSecurityFeatures reports the hardening of the given ELF binary (RELRO, stack canary, NX, PIE, and compiler-based
+// protections) from its program and section headers, dynamic tags, and symbols. This is the same inspection done by
+// the executable cataloger, so that binary catalogers can surface the hardening of the binaries they find.
+func ELFSecurityFeatures(reader io.ReaderAt) (*file.ELFSecurityFeatures, error) {
+	f, err := elf.NewFile(reader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read elf file: %w", err)
+	}
+	return findELFSecurityFeatures(f), nil
+}
+
 func findELFSecurityFeatures(f *elf.File) *file.ELFSecurityFeatures {
 	return &file.ELFSecurityFeatures{
 		SymbolTableStripped:           isElfSymbolTableStripped(f),
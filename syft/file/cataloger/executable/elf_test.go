@@ -4,6 +4,7 @@ import (
 	"debug/elf"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -224,3 +225,28 @@ func Test_elfHasExports(t *testing.T) {
 		})
 	}
 }
+
+func TestELFSecurityFeatures(t *testing.T) {
+	t.Run("elf binary", func(t *testing.T) {
+		// the test binary itself is an ELF file on the platforms this runs on
+		path, err := os.Executable()
+		require.NoError(t, err)
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+		ef, err := elf.NewFile(f)
+		if err != nil {
+			t.Skip("test binary is not an ELF file")
+		}
+
+		got, err := ELFSecurityFeatures(f)
+		require.NoError(t, err)
+		assert.Equal(t, findELFSecurityFeatures(ef), got)
+	})
+
+	t.Run("not an elf binary", func(t *testing.T) {
+		_, err := ELFSecurityFeatures(strings.NewReader("not an elf file"))
+		require.Error(t, err)
+	})
+}
@@ -3,6 +3,7 @@ package filemetadata
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/dustin/go-humanize"
 
@@ -12,11 +13,29 @@ import (
 	"github.com/anchore/syft/syft/file"
 )
 
+type Config struct {
+	// CaptureOwnership indicates if the owner (uid/gid) and permissions of each file are recorded
+	CaptureOwnership bool `json:"capture-ownership" yaml:"capture-ownership" mapstructure:"capture-ownership"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		CaptureOwnership: true,
+	}
+}
+
 type Cataloger struct {
+	config Config
 }
 
 func NewCataloger() *Cataloger {
-	return &Cataloger{}
+	return NewCatalogerWithConfig(DefaultConfig())
+}
+
+func NewCatalogerWithConfig(cfg Config) *Cataloger {
+	return &Cataloger{
+		config: cfg,
+	}
 }
 
 func (i *Cataloger) Catalog(ctx context.Context, resolver file.Resolver, coordinates ...file.Coordinates) (map[file.Coordinates]file.Metadata, error) {
@@ -63,6 +82,10 @@ func (i *Cataloger) Catalog(ctx context.Context, resolver file.Resolver, coordin
 
 		prog.Increment()
 
+		if !i.config.CaptureOwnership {
+			metadata = withoutOwnership(metadata)
+		}
+
 		results[location.Coordinates] = metadata
 	}
 
@@ -74,6 +97,30 @@ func (i *Cataloger) Catalog(ctx context.Context, resolver file.Resolver, coordin
 	return results, nil
 }
 
+// withoutOwnership returns the given metadata with the owner and permissions of the file removed, where the owner is
+// reported as unknown (-1) rather than as root.
+func withoutOwnership(metadata file.Metadata) file.Metadata {
+	metadata.UserID = -1
+	metadata.GroupID = -1
+	if metadata.FileInfo != nil {
+		metadata.FileInfo = ownerlessFileInfo{FileInfo: metadata.FileInfo}
+	}
+	return metadata
+}
+
+// ownerlessFileInfo hides the permissions of a file, as well as the underlying stat data (which includes the owner).
+type ownerlessFileInfo struct {
+	os.FileInfo
+}
+
+func (i ownerlessFileInfo) Mode() os.FileMode {
+	return i.FileInfo.Mode() &^ (os.ModePerm | os.ModeSetuid | os.ModeSetgid | os.ModeSticky)
+}
+
+func (i ownerlessFileInfo) Sys() any {
+	return nil
+}
+
 func catalogingProgress(locations int64) *monitor.CatalogerTaskProgress {
 	info := monitor.GenericTask{
 		Title: monitor.Title{
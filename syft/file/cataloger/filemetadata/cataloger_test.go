@@ -3,6 +3,7 @@ package filemetadata
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,6 +13,7 @@ import (
 	"github.com/anchore/stereoscope/pkg/imagetest"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/directorysource"
 	"github.com/anchore/syft/syft/source/stereoscopesource"
 )
 
@@ -217,3 +219,61 @@ func TestFileMetadataCataloger_GivenCoordinates(t *testing.T) {
 	}
 
 }
+
+func TestFileMetadataCataloger_CaptureOwnership(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "secret.txt"), []byte("secret"), 0600))
+	require.NoError(t, os.Chmod(filepath.Join(root, "secret.txt"), 0640))
+
+	src, err := directorysource.NewFromPath(root)
+	require.NoError(t, err)
+
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	locations, err := resolver.FilesByPath("/secret.txt")
+	require.NoError(t, err)
+	require.Len(t, locations, 1)
+	coordinates := locations[0].Coordinates
+
+	tests := []struct {
+		name     string
+		capture  bool
+		wantMode os.FileMode
+		wantUID  int
+		wantGID  int
+	}{
+		{
+			name:     "ownership captured",
+			capture:  true,
+			wantMode: 0640,
+			wantUID:  os.Getuid(),
+			wantGID:  os.Getgid(),
+		},
+		{
+			name:     "ownership not captured",
+			capture:  false,
+			wantMode: 0,
+			wantUID:  -1,
+			wantGID:  -1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewCatalogerWithConfig(Config{CaptureOwnership: test.capture})
+
+			actual, err := c.Catalog(context.Background(), resolver, coordinates)
+			require.NoError(t, err)
+			require.Contains(t, actual, coordinates)
+
+			m := actual[coordinates]
+			assert.Equal(t, test.wantMode, m.Mode().Perm())
+			assert.True(t, m.Mode().IsRegular())
+			assert.Equal(t, int64(6), m.Size())
+			assert.Equal(t, test.wantUID, m.UserID)
+			assert.Equal(t, test.wantGID, m.GroupID)
+			assert.Equal(t, stereoscopeFile.TypeRegular, m.Type)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package file
+
+// RegistryReference is a reference to a package registry (e.g. a private npm registry or python package index) within
+// the environment variables configured by a file, which indicates a dependency source that a build may use.
+type RegistryReference struct {
+	// Variable is the name of the environment variable referencing the registry (e.g. "NPM_CONFIG_REGISTRY")
+	Variable string `json:"variable"`
+
+	// URL is the registry referenced by the variable, without any credentials, query, or fragment
+	URL string `json:"url"`
+
+	// Ecosystem is the type of packages fetched from the registry (e.g. "npm" or "python"), when known from the variable
+	Ecosystem string `json:"ecosystem,omitempty"`
+}
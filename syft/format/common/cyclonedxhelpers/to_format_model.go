@@ -211,11 +211,41 @@ func toBomProperties(srcMetadata source.Description) *[]cyclonedx.Property {
 	metadata, ok := srcMetadata.Metadata.(source.ImageMetadata)
 	if ok {
 		props := helpers.EncodeProperties(metadata.Labels, "syft:image:labels")
+		props = append(props, helpers.EncodeProperties(metadata.Annotations, "syft:image:annotations")...)
 		return &props
 	}
 	return nil
 }
 
+// toImageProvenanceExternalReferences returns the source repository (as VCS, noting the revision) and the URL (as website)
+// declared by the provenance of an image.
+func toImageProvenanceExternalReferences(provenance *source.ImageProvenance) *[]cyclonedx.ExternalReference {
+	if provenance == nil {
+		return nil
+	}
+	var refs []cyclonedx.ExternalReference
+	if provenance.Source != "" {
+		ref := cyclonedx.ExternalReference{
+			URL:  provenance.Source,
+			Type: cyclonedx.ERTypeVCS,
+		}
+		if provenance.Revision != "" {
+			ref.Comment = fmt.Sprintf("revision: %s", provenance.Revision)
+		}
+		refs = append(refs, ref)
+	}
+	if provenance.URL != "" {
+		refs = append(refs, cyclonedx.ExternalReference{
+			URL:  provenance.URL,
+			Type: cyclonedx.ERTypeWebsite,
+		})
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+	return &refs
+}
+
 func toBomDescriptorComponent(srcMetadata source.Description) *cyclonedx.Component {
 	name := srcMetadata.Name
 	version := srcMetadata.Version
@@ -232,10 +262,11 @@ func toBomDescriptorComponent(srcMetadata source.Description) *cyclonedx.Compone
 			log.Warnf("unable to get fingerprint of source image metadata=%s: %+v", metadata.ID, err)
 		}
 		return &cyclonedx.Component{
-			BOMRef:  string(bomRef),
-			Type:    cyclonedx.ComponentTypeContainer,
-			Name:    name,
-			Version: version,
+			BOMRef:             string(bomRef),
+			Type:               cyclonedx.ComponentTypeContainer,
+			Name:               name,
+			Version:            version,
+			ExternalReferences: toImageProvenanceExternalReferences(metadata.Provenance),
 		}
 	case source.DirectoryMetadata:
 		if name == "" {
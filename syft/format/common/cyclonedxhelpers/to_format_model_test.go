@@ -217,6 +217,58 @@ func Test_toBomDescriptor(t *testing.T) {
 					},
 				}},
 		},
+		{
+			name: "with image provenance source metadata",
+			args: args{
+				name:    "test-image",
+				version: "1.0.0",
+				srcMetadata: source.Description{
+					Metadata: source.ImageMetadata{
+						Labels: map[string]string{
+							"org.opencontainers.image.source": "https://github.com/anchore/syft",
+						},
+						Annotations: map[string]string{
+							"org.opencontainers.image.revision": "abc123",
+						},
+						Provenance: &source.ImageProvenance{
+							Source:   "https://github.com/anchore/syft",
+							Revision: "abc123",
+						},
+					},
+				},
+			},
+			want: &cyclonedx.Metadata{
+				Tools: &cyclonedx.ToolsChoice{
+					Components: &[]cyclonedx.Component{
+						{
+							Type:    cyclonedx.ComponentTypeApplication,
+							Author:  "anchore",
+							Name:    "test-image",
+							Version: "1.0.0",
+						},
+					},
+				},
+				Component: &cyclonedx.Component{
+					Type: "container",
+					ExternalReferences: &[]cyclonedx.ExternalReference{
+						{
+							URL:     "https://github.com/anchore/syft",
+							Type:    cyclonedx.ERTypeVCS,
+							Comment: "revision: abc123",
+						},
+					},
+				},
+				Properties: &[]cyclonedx.Property{
+					{
+						Name:  "syft:image:labels:org.opencontainers.image.source",
+						Value: "https://github.com/anchore/syft",
+					},
+					{
+						Name:  "syft:image:annotations:org.opencontainers.image.revision",
+						Value: "abc123",
+					},
+				}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
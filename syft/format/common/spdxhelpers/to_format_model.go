@@ -582,6 +582,8 @@ func lookupRelationship(ty artifact.RelationshipType) (bool, helpers.Relationshi
 		return true, helpers.OtherRelationship, fmt.Sprintf("%s: indicates that the parent package claims ownership of a child package since the parent metadata indicates overlap with a location that a cataloger found the child package by", ty)
 	case artifact.EvidentByRelationship:
 		return true, helpers.OtherRelationship, fmt.Sprintf("%s: indicates the package's existence is evident by the given file", ty)
+	case artifact.DeclaredByRelationship:
+		return true, helpers.OtherRelationship, fmt.Sprintf("%s: indicates the package resolved by a lock file was declared as a dependency by the given manifest package", ty)
 	}
 	return false, "", ""
 }
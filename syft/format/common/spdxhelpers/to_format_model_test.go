@@ -461,6 +461,12 @@ func Test_lookupRelationship(t *testing.T) {
 			ty:      helpers.OtherRelationship,
 			comment: "evident-by: indicates the package's existence is evident by the given file",
 		},
+		{
+			input:   artifact.DeclaredByRelationship,
+			exists:  true,
+			ty:      helpers.OtherRelationship,
+			comment: "declared-by: indicates the package resolved by a lock file was declared as a dependency by the given manifest package",
+		},
 		{
 			input:  "made-up",
 			exists: false,
@@ -386,9 +386,13 @@ func collectDocRelationships(spdxIDMap map[string]any, doc *spdx.Document) (out
 				to = toPackage
 			case helpers.OtherRelationship:
 				// Encoding uses a specifically formatted comment...
-				if strings.Index(r.RelationshipComment, string(artifact.OwnershipByFileOverlapRelationship)) == 0 {
+				switch {
+				case strings.Index(r.RelationshipComment, string(artifact.OwnershipByFileOverlapRelationship)) == 0:
 					typ = artifact.OwnershipByFileOverlapRelationship
 					to = toPackage
+				case strings.Index(r.RelationshipComment, string(artifact.DeclaredByRelationship)) == 0:
+					typ = artifact.DeclaredByRelationship
+					to = toPackage
 				}
 			}
 		}
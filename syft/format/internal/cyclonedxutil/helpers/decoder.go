@@ -212,10 +212,13 @@ func extractComponents(meta *cyclonedx.Metadata) source.Description {
 
 	switch c.Type {
 	case cyclonedx.ComponentTypeContainer:
-		var labels map[string]string
+		var labels, annotations map[string]string
 
 		if meta.Properties != nil {
 			labels = decodeProperties(*meta.Properties, "syft:image:labels:")
+			if decoded := decodeProperties(*meta.Properties, "syft:image:annotations:"); len(decoded) > 0 {
+				annotations = decoded
+			}
 		}
 
 		return source.Description{
@@ -227,6 +230,8 @@ func extractComponents(meta *cyclonedx.Metadata) source.Description {
 				ID:             c.BOMRef,
 				ManifestDigest: c.Version,
 				Labels:         labels,
+				Annotations:    annotations,
+				Provenance:     source.NewImageProvenance(annotations, labels),
 			},
 		}
 	case cyclonedx.ComponentTypeFile:
@@ -47,12 +47,11 @@ func encodeExternalReferences(p pkg.Package) *[]cyclonedx.ExternalReference {
 				})
 			}
 		case pkg.RubyGemspec:
-			if metadata.Homepage != "" && isValidExternalRef(metadata.Homepage) {
-				refs = append(refs, cyclonedx.ExternalReference{
-					URL:  metadata.Homepage,
-					Type: cyclonedx.ERTypeWebsite,
-				})
-			}
+			refs = append(refs, encodeProjectURLs(metadata)...)
+		case pkg.PhpComposerLockEntry:
+			refs = append(refs, encodeProjectURLs(metadata)...)
+		case pkg.PhpComposerInstalledEntry:
+			refs = append(refs, encodeProjectURLs(metadata)...)
 		case pkg.JavaArchive:
 			if len(metadata.ArchiveDigests) > 0 {
 				for _, digest := range metadata.ArchiveDigests {
@@ -85,6 +84,25 @@ func encodeExternalReferences(p pkg.Package) *[]cyclonedx.ExternalReference {
 	return nil
 }
 
+// encodeProjectURLs returns the homepage (as website) and the source (as VCS) references of the project behind a
+// package, skipping either if not IRI-compliant.
+func encodeProjectURLs(metadata pkg.ProjectURLProvider) []cyclonedx.ExternalReference {
+	var refs []cyclonedx.ExternalReference
+	if homepage := metadata.HomepageURL(); homepage != "" && isValidExternalRef(homepage) {
+		refs = append(refs, cyclonedx.ExternalReference{
+			URL:  homepage,
+			Type: cyclonedx.ERTypeWebsite,
+		})
+	}
+	if source := metadata.SourceURL(); source != "" && isValidExternalRef(source) {
+		refs = append(refs, cyclonedx.ExternalReference{
+			URL:  source,
+			Type: cyclonedx.ERTypeVCS,
+		})
+	}
+	return refs
+}
+
 // supported algorithm in cycloneDX as of 1.4
 // "MD5", "SHA-1", "SHA-256", "SHA-384", "SHA-512",
 // "SHA3-256", "SHA3-384", "SHA3-512", "BLAKE2b-256", "BLAKE2b-384", "BLAKE2b-512", "BLAKE3"
@@ -114,6 +132,11 @@ func decodeExternalReferences(c *cyclonedx.Component, metadata interface{}) {
 		meta.Homepage = refURL(c, cyclonedx.ERTypeWebsite)
 	case *pkg.RubyGemspec:
 		meta.Homepage = refURL(c, cyclonedx.ERTypeWebsite)
+		meta.SourceCodeURI = refURL(c, cyclonedx.ERTypeVCS)
+	case *pkg.PhpComposerLockEntry:
+		decodePhpComposerProjectURLs(c, &meta.Homepage, &meta.Source.URL)
+	case *pkg.PhpComposerInstalledEntry:
+		decodePhpComposerProjectURLs(c, &meta.Homepage, &meta.Source.URL)
 	case *pkg.JavaArchive:
 		var digests []syftFile.Digest
 		if ref := findExternalRef(c, cyclonedx.ERTypeBuildMeta); ref != nil {
@@ -137,6 +160,17 @@ func decodeExternalReferences(c *cyclonedx.Component, metadata interface{}) {
 	}
 }
 
+// decodePhpComposerProjectURLs sets the homepage and source URL of a composer package from the external references,
+// which are only present if IRI-compliant, so the values decoded from the properties are otherwise kept.
+func decodePhpComposerProjectURLs(c *cyclonedx.Component, homepage, source *string) {
+	if u := refURL(c, cyclonedx.ERTypeWebsite); u != "" {
+		*homepage = u
+	}
+	if u := refURL(c, cyclonedx.ERTypeVCS); u != "" {
+		*source = u
+	}
+}
+
 func findExternalRef(c *cyclonedx.Component, typ cyclonedx.ExternalReferenceType) *cyclonedx.ExternalReference {
 	if c.ExternalReferences != nil {
 		for _, r := range *c.ExternalReferences {
@@ -100,6 +100,50 @@ func Test_encodeExternalReferences(t *testing.T) {
 				{URL: "http://a-place.gov", Type: cyclonedx.ERTypeWebsite},
 			},
 		},
+		{
+			name: "from gem with source code uri",
+			input: pkg.Package{
+				Metadata: pkg.RubyGemspec{
+					Homepage:      "http://a-place.gov",
+					SourceCodeURI: "https://github.com/a/place",
+				},
+			},
+			expected: &[]cyclonedx.ExternalReference{
+				{URL: "http://a-place.gov", Type: cyclonedx.ERTypeWebsite},
+				{URL: "https://github.com/a/place", Type: cyclonedx.ERTypeVCS},
+			},
+		},
+		{
+			name: "from composer lock",
+			input: pkg.Package{
+				Metadata: pkg.PhpComposerLockEntry{
+					Homepage: "http://a-place.gov",
+					Source: pkg.PhpComposerExternalReference{
+						Type: "git",
+						URL:  "https://github.com/a/place.git",
+					},
+				},
+			},
+			expected: &[]cyclonedx.ExternalReference{
+				{URL: "http://a-place.gov", Type: cyclonedx.ERTypeWebsite},
+				{URL: "https://github.com/a/place.git", Type: cyclonedx.ERTypeVCS},
+			},
+		},
+		{
+			name: "from composer installed with invalid source URL",
+			input: pkg.Package{
+				Metadata: pkg.PhpComposerInstalledEntry{
+					Homepage: "http://a-place.gov",
+					Source: pkg.PhpComposerExternalReference{
+						Type: "git",
+						URL:  "git@github.com:a/place.git",
+					},
+				},
+			},
+			expected: &[]cyclonedx.ExternalReference{
+				{URL: "http://a-place.gov", Type: cyclonedx.ERTypeWebsite},
+			},
+		},
 		{
 			name: "from python direct url",
 			input: pkg.Package{
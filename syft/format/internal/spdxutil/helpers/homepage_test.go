@@ -38,6 +38,24 @@ func Test_Homepage(t *testing.T) {
 			},
 			expected: "http://a-place.gov",
 		},
+		{
+			name: "from composer lock",
+			input: pkg.Package{
+				Metadata: pkg.PhpComposerLockEntry{
+					Homepage: "http://a-place.gov",
+				},
+			},
+			expected: "http://a-place.gov",
+		},
+		{
+			name: "from composer installed",
+			input: pkg.Package{
+				Metadata: pkg.PhpComposerInstalledEntry{
+					Homepage: "http://a-place.gov",
+				},
+			},
+			expected: "http://a-place.gov",
+		},
 		{
 			// note: since this is an optional field, no value is preferred over NONE or NOASSERTION
 			name: "empty",
@@ -11,6 +11,8 @@ import (
 
 func Test_OriginatorSupplier(t *testing.T) {
 	completionTester := packagemetadata.NewCompletionTester(t,
+		pkg.AndroidApkManifest{},
+		pkg.BazelModuleEntry{},
 		pkg.BinarySignature{},
 		pkg.CocoaPodfileLockEntry{},
 		pkg.ConanV1LockEntry{},
@@ -19,26 +21,45 @@ func Test_OriginatorSupplier(t *testing.T) {
 		pkg.ConaninfoEntry{},
 		pkg.DartPubspecLockEntry{},
 		pkg.DotnetDepsEntry{},
+		pkg.DotnetNativeAOTEntry{},
+		pkg.DotnetRuntimeConfigEntry{},
 		pkg.ELFBinaryPackageNoteJSONPayload{},
+		pkg.ELFStaticLibrary{},
 		pkg.ElixirMixLockEntry{},
 		pkg.ErlangRebarLockEntry{},
+		pkg.GitSubmoduleEntry{},
 		pkg.GolangBinaryBuildinfoEntry{},
 		pkg.GolangModuleEntry{},
 		pkg.HackageStackYamlLockEntry{},
 		pkg.HackageStackYamlEntry{},
+		pkg.HomebrewFormula{},
+		pkg.InstallDirectoryEntry{},
 		pkg.LinuxKernel{},
+		pkg.MacOSAppBundle{},
+		pkg.MacOSInstallerReceipt{},
 		pkg.MicrosoftKbPatch{},
+		pkg.NixFlakeLockEntry{},
 		pkg.NixStoreEntry{},
 		pkg.NpmPackageLockEntry{},
+		pkg.NpmSourceMapEntry{},
+		pkg.PerlCpanfileEntry{},
+		pkg.PerlCpanfileSnapshotEntry{},
 		pkg.PhpComposerInstalledEntry{},
+		pkg.PhpComposerPlatformEntry{},
 		pkg.PhpPeclEntry{},
 		pkg.PortageEntry{},
+		pkg.ProtobufFile{},
 		pkg.PythonPipfileLockEntry{},
 		pkg.PythonRequirementsEntry{},
 		pkg.PythonPoetryLockEntry{},
+		pkg.RRenvLockEntry{},
 		pkg.RustBinaryAuditEntry{},
 		pkg.RustCargoLockEntry{},
 		pkg.SwiftPackageManagerResolvedEntry{},
+		pkg.VcpkgManifestEntry{},
+		pkg.VSCodeExtensionEntry{},
+		pkg.WasmImportEntry{},
+		pkg.WindowsMSIProduct{},
 		pkg.YarnLockEntry{},
 	)
 	tests := []struct {
@@ -36,6 +36,8 @@ func SourceInfo(p pkg.Package) string {
 		answer = "acquired package info from rust cargo manifest"
 	case pkg.PhpComposerPkg:
 		answer = "acquired package info from PHP composer manifest"
+	case pkg.PerlCpanPkg:
+		answer = "acquired package info from cpanfile or cpanfile.snapshot file"
 	case pkg.PhpPeclPkg:
 		answer = "acquired package info from PHP Pecl manifest"
 	case pkg.CocoapodsPkg:
@@ -55,11 +57,33 @@ func SourceInfo(p pkg.Package) string {
 	case pkg.LinuxKernelModulePkg:
 		answer = "acquired package info from linux kernel module files"
 	case pkg.NixPkg:
-		answer = "acquired package info from nix store path"
+		answer = "acquired package info from nix store path or flake.lock file"
 	case pkg.Rpkg:
-		answer = "acquired package info from R-package DESCRIPTION file"
+		answer = "acquired package info from R-package DESCRIPTION or renv.lock file"
 	case pkg.SwiftPkg:
 		answer = "acquired package info from resolved Swift package manifest"
+	case pkg.VSCodeExtensionPkg:
+		answer = "acquired package info from installed VS Code extension manifest"
+	case pkg.WasmPkg:
+		answer = "acquired package info from WebAssembly component imports"
+	case pkg.AndroidAppPkg:
+		answer = "acquired package info from Android application manifest"
+	case pkg.MacOSAppPkg:
+		answer = "acquired package info from macOS application bundle Info.plist"
+	case pkg.MacOSPkg:
+		answer = "acquired package info from macOS installer receipt"
+	case pkg.HomebrewPkg:
+		answer = "acquired package info from homebrew install receipt"
+	case pkg.VcpkgPkg:
+		answer = "acquired package info from vcpkg manifest"
+	case pkg.WindowsMSIPkg:
+		answer = "acquired package info from Windows Installer database"
+	case pkg.ProtobufPkg:
+		answer = "acquired package info from .proto file"
+	case pkg.BazelModulePkg:
+		answer = "acquired package info from MODULE.bazel or MODULE.bazel.lock file"
+	case pkg.GitSubmodulePkg:
+		answer = "acquired package info from .gitmodules file and git index"
 	case pkg.GithubActionPkg, pkg.GithubActionWorkflowPkg:
 		answer = "acquired package info from GitHub Actions workflow file or composite action file"
 	case pkg.WordpressPluginPkg:
@@ -127,6 +127,14 @@ func Test_SourceInfo(t *testing.T) {
 				"from PHP composer manifest",
 			},
 		},
+		{
+			input: pkg.Package{
+				Type: pkg.PerlCpanPkg,
+			},
+			expected: []string{
+				"from cpanfile or cpanfile.snapshot file",
+			},
+		},
 		{
 			input: pkg.Package{
 				Type: pkg.PhpPeclPkg,
@@ -236,7 +244,7 @@ func Test_SourceInfo(t *testing.T) {
 				Type: pkg.NixPkg,
 			},
 			expected: []string{
-				"from nix store path",
+				"from nix store path or flake.lock file",
 			},
 		},
 		{
@@ -244,7 +252,7 @@ func Test_SourceInfo(t *testing.T) {
 				Type: pkg.Rpkg,
 			},
 			expected: []string{
-				"acquired package info from R-package DESCRIPTION file",
+				"acquired package info from R-package DESCRIPTION or renv.lock file",
 			},
 		},
 		{
@@ -255,6 +263,94 @@ func Test_SourceInfo(t *testing.T) {
 				"from resolved Swift package manifest",
 			},
 		},
+		{
+			input: pkg.Package{
+				Type: pkg.VSCodeExtensionPkg,
+			},
+			expected: []string{
+				"from installed VS Code extension manifest",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.WasmPkg,
+			},
+			expected: []string{
+				"from WebAssembly component imports",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.AndroidAppPkg,
+			},
+			expected: []string{
+				"from Android application manifest",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.MacOSAppPkg,
+			},
+			expected: []string{
+				"from macOS application bundle Info.plist",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.MacOSPkg,
+			},
+			expected: []string{
+				"from macOS installer receipt",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.HomebrewPkg,
+			},
+			expected: []string{
+				"from homebrew install receipt",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.VcpkgPkg,
+			},
+			expected: []string{
+				"from vcpkg manifest",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.WindowsMSIPkg,
+			},
+			expected: []string{
+				"from Windows Installer database",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.ProtobufPkg,
+			},
+			expected: []string{
+				"from .proto file",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.BazelModulePkg,
+			},
+			expected: []string{
+				"from MODULE.bazel or MODULE.bazel.lock file",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.GitSubmodulePkg,
+			},
+			expected: []string{
+				"from .gitmodules file and git index",
+			},
+		},
 		{
 			input: pkg.Package{
 				Type: pkg.GithubActionPkg,
@@ -237,6 +237,15 @@ func Test_encodeDecodeFileMetadata(t *testing.T) {
 					},
 				},
 			},
+			FileRegistryReferences: map[file.Coordinates][]file.RegistryReference{
+				c: {
+					{
+						Variable:  "PIP_INDEX_URL",
+						URL:       "https://pypi.example.com/simple",
+						Ecosystem: "python",
+					},
+				},
+			},
 			LinuxDistribution: &linux.Release{
 				PrettyName:       "some os",
 				Name:             "os",
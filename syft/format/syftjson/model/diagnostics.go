@@ -0,0 +1,14 @@
+package model
+
+import "github.com/anchore/syft/syft/file"
+
+// Diagnostics describes the problems encountered while cataloging which did not stop cataloging
+type Diagnostics struct {
+	Warnings []Warning `json:"warnings"`
+}
+
+type Warning struct {
+	Cataloger string            `json:"cataloger,omitempty"`
+	Location  *file.Coordinates `json:"location,omitempty"`
+	Reason    string            `json:"reason"`
+}
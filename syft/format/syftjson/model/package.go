@@ -34,6 +34,7 @@ type PackageBasicData struct {
 	Language  pkg.Language    `json:"language"`
 	CPEs      cpes            `json:"cpes"`
 	PURL      string          `json:"purl"`
+	Platform  string          `json:"platform,omitempty"`
 }
 
 type cpes []CPE
@@ -13,21 +13,23 @@ import (
 
 // Source object represents the thing that was cataloged
 type Source struct {
-	ID       string      `json:"id"`
-	Name     string      `json:"name"`
-	Version  string      `json:"version"`
-	Type     string      `json:"type"`
-	Metadata interface{} `json:"metadata"`
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Version     string      `json:"version"`
+	Fingerprint string      `json:"fingerprint,omitempty"`
+	Type        string      `json:"type"`
+	Metadata    interface{} `json:"metadata"`
 }
 
 // sourceUnpacker is used to unmarshal Source objects
 type sourceUnpacker struct {
-	ID       string          `json:"id,omitempty"`
-	Name     string          `json:"name"`
-	Version  string          `json:"version"`
-	Type     string          `json:"type"`
-	Metadata json.RawMessage `json:"metadata"`
-	Target   json.RawMessage `json:"target"` // pre-v9 schema support
+	ID          string          `json:"id,omitempty"`
+	Name        string          `json:"name"`
+	Version     string          `json:"version"`
+	Fingerprint string          `json:"fingerprint,omitempty"`
+	Type        string          `json:"type"`
+	Metadata    json.RawMessage `json:"metadata"`
+	Target      json.RawMessage `json:"target"` // pre-v9 schema support
 }
 
 // UnmarshalJSON populates a source object from JSON bytes.
@@ -40,6 +42,7 @@ func (s *Source) UnmarshalJSON(b []byte) error {
 
 	s.Name = unpacker.Name
 	s.Version = unpacker.Version
+	s.Fingerprint = unpacker.Fingerprint
 	s.Type = unpacker.Type
 	s.ID = unpacker.ID
 
@@ -9,6 +9,7 @@ import (
 	"github.com/anchore/syft/internal"
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/diagnostic"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/format/syftjson/model"
 	"github.com/anchore/syft/syft/internal/packagemetadata"
@@ -37,6 +38,7 @@ func ToFormatModel(s sbom.SBOM, cfg EncoderConfig) model.Document {
 		Artifacts:             toPackageModels(s.Artifacts.Packages, cfg),
 		ArtifactRelationships: toRelationshipModel(s.Relationships),
 		Files:                 toFile(s),
+		Diagnostics:           toDiagnosticsModel(s.Warnings),
 		Source:                toSourceModel(s.Source),
 		Distro:                toLinuxReleaser(s.Artifacts.LinuxDistribution),
 		Descriptor:            toDescriptor(s.Descriptor),
@@ -47,6 +49,24 @@ func ToFormatModel(s sbom.SBOM, cfg EncoderConfig) model.Document {
 	}
 }
 
+func toDiagnosticsModel(warnings []diagnostic.Warning) *model.Diagnostics {
+	if len(warnings) == 0 {
+		return nil
+	}
+	sorted := append([]diagnostic.Warning(nil), warnings...)
+	diagnostic.Sort(sorted)
+
+	result := make([]model.Warning, len(sorted))
+	for i, w := range sorted {
+		result[i] = model.Warning{
+			Cataloger: w.Cataloger,
+			Location:  w.Location,
+			Reason:    w.Reason,
+		}
+	}
+	return &model.Diagnostics{Warnings: result}
+}
+
 func toLinuxReleaser(d *linux.Release) model.LinuxRelease {
 	if d == nil {
 		return model.LinuxRelease{}
@@ -124,6 +144,11 @@ func toFile(s sbom.SBOM) []model.File {
 			executable = &exec
 		}
 
+		var registries []file.RegistryReference
+		if refs, exists := artifacts.FileRegistryReferences[coordinates]; exists {
+			registries = refs
+		}
+
 		results = append(results, model.File{
 			ID:         string(coordinates.ID()),
 			Location:   coordinates,
@@ -132,6 +157,7 @@ func toFile(s sbom.SBOM) []model.File {
 			Contents:   contents,
 			Licenses:   licenses,
 			Executable: executable,
+			Registries: registries,
 		})
 	}
 
@@ -263,6 +289,7 @@ func toPackageModel(p pkg.Package, cfg EncoderConfig) model.Package {
 			Language:  p.Language,
 			CPEs:      cpes,
 			PURL:      p.PURL,
+			Platform:  p.Platform,
 		},
 		PackageCustomData: model.PackageCustomData{
 			MetadataType: metadataType(p.Metadata, cfg.Legacy),
@@ -296,11 +323,12 @@ func toRelationshipModel(relationships []artifact.Relationship) []model.Relation
 // toSourceModel creates a new source object to be represented into JSON.
 func toSourceModel(src source.Description) model.Source {
 	m := model.Source{
-		ID:       src.ID,
-		Name:     src.Name,
-		Version:  src.Version,
-		Type:     sourcemetadata.JSONName(src.Metadata),
-		Metadata: src.Metadata,
+		ID:          src.ID,
+		Name:        src.Name,
+		Version:     src.Version,
+		Fingerprint: src.Fingerprint,
+		Type:        sourcemetadata.JSONName(src.Metadata),
+		Metadata:    src.Metadata,
 	}
 
 	if metadata, ok := src.Metadata.(source.ImageMetadata); ok {
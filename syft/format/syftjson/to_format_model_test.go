@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	stereoscopeFile "github.com/anchore/stereoscope/pkg/file"
+	"github.com/anchore/syft/syft/diagnostic"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/format/syftjson/model"
 	"github.com/anchore/syft/syft/internal/sourcemetadata"
@@ -351,3 +352,53 @@ func Test_toPackageModel_metadataType(t *testing.T) {
 		})
 	}
 }
+
+func Test_toDiagnosticsModel(t *testing.T) {
+	assert.Nil(t, toDiagnosticsModel(nil))
+
+	warnings := []diagnostic.Warning{
+		{
+			Cataloger: "python-package-cataloger",
+			Location:  &file.Coordinates{RealPath: "/requirements.txt"},
+			Reason:    "unable to parse line 3",
+		},
+		{
+			Cataloger: "javascript-lock-cataloger",
+			Location:  &file.Coordinates{RealPath: "/package-lock.json", FileSystemID: "sha256:abc"},
+			Reason:    "unexpected end of JSON input",
+		},
+		{
+			Cataloger: "graalvm-native-image-cataloger",
+			Reason:    "cataloger did not complete within 1m0s",
+		},
+	}
+
+	got := toDiagnosticsModel(warnings)
+	require.NotNil(t, got)
+
+	want := &model.Diagnostics{
+		Warnings: []model.Warning{
+			{
+				Cataloger: "graalvm-native-image-cataloger",
+				Reason:    "cataloger did not complete within 1m0s",
+			},
+			{
+				Cataloger: "javascript-lock-cataloger",
+				Location:  &file.Coordinates{RealPath: "/package-lock.json", FileSystemID: "sha256:abc"},
+				Reason:    "unexpected end of JSON input",
+			},
+			{
+				Cataloger: "python-package-cataloger",
+				Location:  &file.Coordinates{RealPath: "/requirements.txt"},
+				Reason:    "unable to parse line 3",
+			},
+		},
+	}
+	assert.Equal(t, want, got)
+
+	// the given warnings are not reordered
+	assert.Equal(t, "python-package-cataloger", warnings[0].Cataloger)
+
+	// and the warnings survive a round trip
+	assert.ElementsMatch(t, warnings, toSyftWarnings(got))
+}
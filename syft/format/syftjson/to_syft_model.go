@@ -15,6 +15,7 @@ import (
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/cpe"
+	"github.com/anchore/syft/syft/diagnostic"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/format/syftjson/model"
 	"github.com/anchore/syft/syft/linux"
@@ -32,20 +33,37 @@ func toSyftModel(doc model.Document) *sbom.SBOM {
 
 	return &sbom.SBOM{
 		Artifacts: sbom.Artifacts{
-			Packages:          catalog,
-			FileMetadata:      fileArtifacts.FileMetadata,
-			FileDigests:       fileArtifacts.FileDigests,
-			FileContents:      fileArtifacts.FileContents,
-			FileLicenses:      fileArtifacts.FileLicenses,
-			Executables:       fileArtifacts.Executables,
-			LinuxDistribution: toSyftLinuxRelease(doc.Distro),
+			Packages:               catalog,
+			FileMetadata:           fileArtifacts.FileMetadata,
+			FileDigests:            fileArtifacts.FileDigests,
+			FileContents:           fileArtifacts.FileContents,
+			FileLicenses:           fileArtifacts.FileLicenses,
+			Executables:            fileArtifacts.Executables,
+			FileRegistryReferences: fileArtifacts.FileRegistryReferences,
+			LinuxDistribution:      toSyftLinuxRelease(doc.Distro),
 		},
 		Source:        *toSyftSourceData(doc.Source),
 		Descriptor:    toSyftDescriptor(doc.Descriptor),
 		Relationships: warnConversionErrors(toSyftRelationships(&doc, catalog, doc.ArtifactRelationships, idAliases)),
+		Warnings:      toSyftWarnings(doc.Diagnostics),
 	}
 }
 
+func toSyftWarnings(d *model.Diagnostics) []diagnostic.Warning {
+	if d == nil || len(d.Warnings) == 0 {
+		return nil
+	}
+	warnings := make([]diagnostic.Warning, len(d.Warnings))
+	for i, w := range d.Warnings {
+		warnings[i] = diagnostic.Warning{
+			Cataloger: w.Cataloger,
+			Location:  w.Location,
+			Reason:    w.Reason,
+		}
+	}
+	return warnings
+}
+
 func warnConversionErrors[T any](converted []T, errors []error) []T {
 	errorMessages := deduplicateErrors(errors)
 	for _, msg := range errorMessages {
@@ -68,11 +86,12 @@ func deduplicateErrors(errors []error) []string {
 
 func toSyftFiles(files []model.File) sbom.Artifacts {
 	ret := sbom.Artifacts{
-		FileMetadata: make(map[file.Coordinates]file.Metadata),
-		FileDigests:  make(map[file.Coordinates][]file.Digest),
-		FileContents: make(map[file.Coordinates]string),
-		FileLicenses: make(map[file.Coordinates][]file.License),
-		Executables:  make(map[file.Coordinates]file.Executable),
+		FileMetadata:           make(map[file.Coordinates]file.Metadata),
+		FileDigests:            make(map[file.Coordinates][]file.Digest),
+		FileContents:           make(map[file.Coordinates]string),
+		FileLicenses:           make(map[file.Coordinates][]file.License),
+		Executables:            make(map[file.Coordinates]file.Executable),
+		FileRegistryReferences: make(map[file.Coordinates][]file.RegistryReference),
 	}
 
 	for _, f := range files {
@@ -130,6 +149,10 @@ func toSyftFiles(files []model.File) sbom.Artifacts {
 		if f.Executable != nil {
 			ret.Executables[coord] = *f.Executable
 		}
+
+		if len(f.Registries) > 0 {
+			ret.FileRegistryReferences[coord] = f.Registries
+		}
 	}
 
 	return ret
@@ -276,7 +299,7 @@ func toSyftRelationship(idMap map[string]interface{}, relationship model.Relatio
 	typ := artifact.RelationshipType(relationship.Type)
 
 	switch typ {
-	case artifact.OwnershipByFileOverlapRelationship, artifact.ContainsRelationship, artifact.DependencyOfRelationship, artifact.EvidentByRelationship:
+	case artifact.OwnershipByFileOverlapRelationship, artifact.ContainsRelationship, artifact.DependencyOfRelationship, artifact.EvidentByRelationship, artifact.DeclaredByRelationship:
 	default:
 		if !strings.Contains(string(typ), "dependency-of") {
 			return nil, fmt.Errorf("unknown relationship type: %s", string(typ))
@@ -303,10 +326,11 @@ func toSyftDescriptor(d model.Descriptor) sbom.Descriptor {
 
 func toSyftSourceData(s model.Source) *source.Description {
 	return &source.Description{
-		ID:       s.ID,
-		Name:     s.Name,
-		Version:  s.Version,
-		Metadata: s.Metadata,
+		ID:          s.ID,
+		Name:        s.Name,
+		Version:     s.Version,
+		Fingerprint: s.Fingerprint,
+		Metadata:    s.Metadata,
 	}
 }
 
@@ -340,6 +364,7 @@ func toSyftPackage(p model.Package, idAliases map[string]string) pkg.Package {
 		Type:      p.Type,
 		CPEs:      cpes,
 		PURL:      p.PURL,
+		Platform:  p.Platform,
 		Metadata:  p.Metadata,
 	}
 
@@ -231,9 +231,10 @@ func Test_toSyftFiles(t *testing.T) {
 			name:  "empty",
 			files: []model.File{},
 			want: sbom.Artifacts{
-				FileMetadata: map[file.Coordinates]file.Metadata{},
-				FileDigests:  map[file.Coordinates][]file.Digest{},
-				Executables:  map[file.Coordinates]file.Executable{},
+				FileMetadata:           map[file.Coordinates]file.Metadata{},
+				FileDigests:            map[file.Coordinates][]file.Digest{},
+				Executables:            map[file.Coordinates]file.Executable{},
+				FileRegistryReferences: map[file.Coordinates][]file.RegistryReference{},
 			},
 		},
 		{
@@ -262,7 +263,8 @@ func Test_toSyftFiles(t *testing.T) {
 						},
 					},
 				},
-				Executables: map[file.Coordinates]file.Executable{},
+				Executables:            map[file.Coordinates]file.Executable{},
+				FileRegistryReferences: map[file.Coordinates][]file.RegistryReference{},
 			},
 		},
 		{
@@ -300,6 +302,13 @@ func Test_toSyftFiles(t *testing.T) {
 							ClangFortifySource:            boolRef(true),
 						},
 					},
+					Registries: []file.RegistryReference{
+						{
+							Variable:  "NPM_CONFIG_REGISTRY",
+							URL:       "https://npm.example.com",
+							Ecosystem: "npm",
+						},
+					},
 				},
 			},
 			want: sbom.Artifacts{
@@ -342,6 +351,15 @@ func Test_toSyftFiles(t *testing.T) {
 						},
 					},
 				},
+				FileRegistryReferences: map[file.Coordinates][]file.RegistryReference{
+					coord: {
+						{
+							Variable:  "NPM_CONFIG_REGISTRY",
+							URL:       "https://npm.example.com",
+							Ecosystem: "npm",
+						},
+					},
+				},
 			},
 		},
 	}
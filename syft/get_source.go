@@ -7,7 +7,11 @@ import (
 	"os"
 	"strings"
 
+	"github.com/anchore/stereoscope"
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/stereoscopesource"
 )
 
 // GetSource uses all of Syft's known source providers to attempt to resolve the user input to a usable source.Source
@@ -54,6 +58,78 @@ func GetSource(ctx context.Context, userInput string, cfg *GetSourceConfig) (sou
 	return nil, sourceError(userInput, errs...)
 }
 
+// GetSources resolves the user input to one or more sources. When all platforms are requested (see
+// GetSourceConfig.WithAllPlatforms) and the user input is a registry reference (given with the "registry:" scheme, or
+// with the registry as the only requested source) to a multi-arch image index, a source is returned for each platform
+// in the index; otherwise this behaves like GetSource and a single source is returned.
+func GetSources(ctx context.Context, userInput string, cfg *GetSourceConfig) ([]source.Source, error) {
+	if cfg == nil {
+		cfg = DefaultGetSourceConfig()
+	}
+
+	platforms := indexPlatforms(ctx, userInput, cfg)
+	if len(platforms) == 0 {
+		src, err := GetSource(ctx, userInput, cfg)
+		if err != nil {
+			return nil, err
+		}
+		return []source.Source{src}, nil
+	}
+
+	var srcs []source.Source
+	for _, platform := range platforms {
+		platformCfg := *cfg
+		providerCfg := *cfg.SourceProviderConfig
+		platformCfg.SourceProviderConfig = providerCfg.WithPlatform(platform).WithAllPlatforms(false)
+
+		platformSrc, err := GetSource(ctx, userInput, &platformCfg)
+		if err != nil {
+			for _, s := range srcs {
+				_ = s.Close()
+			}
+			return nil, fmt.Errorf("unable to get source for platform=%q: %w", platform.String(), err)
+		}
+		srcs = append(srcs, platformSrc)
+	}
+
+	return srcs, nil
+}
+
+// indexPlatforms returns the platforms of the image index that the user input refers to, when all platforms are
+// requested and the user input is a registry reference. Nothing is returned otherwise (including when the reference
+// is to a single image manifest), in which case a single source should be resolved.
+func indexPlatforms(ctx context.Context, userInput string, cfg *GetSourceConfig) []*image.Platform {
+	if cfg.SourceProviderConfig == nil || !cfg.SourceProviderConfig.AllPlatforms {
+		return nil
+	}
+
+	reference, ok := registryReference(userInput, cfg)
+	if !ok {
+		log.WithFields("input", userInput).Debug("all platforms are only cataloged for registry sources, cataloging a single platform")
+		return nil
+	}
+
+	platforms, err := stereoscopesource.Platforms(ctx, reference, cfg.SourceProviderConfig.RegistryOptions)
+	if err != nil {
+		log.WithFields("input", userInput, "error", err).Debug("unable to fetch image index, cataloging a single platform")
+		return nil
+	}
+	return platforms
+}
+
+// registryReference returns the image reference of the user input when it can only be resolved by the registry
+// source: when given with the "registry:" scheme, or when the registry is the only requested source. Other inputs may
+// be resolved by other sources (e.g. an image of a container daemon), which have no image index.
+func registryReference(userInput string, cfg *GetSourceConfig) (string, bool) {
+	if reference, ok := strings.CutPrefix(userInput, stereoscope.RegistryTag+":"); ok {
+		return reference, true
+	}
+	if len(cfg.Sources) == 1 && cfg.Sources[0] == stereoscope.RegistryTag {
+		return userInput, true
+	}
+	return "", false
+}
+
 func sourceError(userInput string, errs ...error) error {
 	switch len(errs) {
 	case 0:
@@ -41,6 +41,16 @@ func (c *GetSourceConfig) WithExcludeConfig(excludeConfig source.ExcludeConfig)
 	return c
 }
 
+func (c *GetSourceConfig) WithGlobCaseInsensitive(caseInsensitive bool) *GetSourceConfig {
+	c.SourceProviderConfig = c.SourceProviderConfig.WithGlobCaseInsensitive(caseInsensitive)
+	return c
+}
+
+func (c *GetSourceConfig) WithSyftIgnore(syftIgnore bool) *GetSourceConfig {
+	c.SourceProviderConfig = c.SourceProviderConfig.WithSyftIgnore(syftIgnore)
+	return c
+}
+
 func (c *GetSourceConfig) WithDigestAlgorithms(algorithms ...crypto.Hash) *GetSourceConfig {
 	c.SourceProviderConfig = c.SourceProviderConfig.WithDigestAlgorithms(algorithms...)
 	return c
@@ -51,6 +61,16 @@ func (c *GetSourceConfig) WithBasePath(basePath string) *GetSourceConfig {
 	return c
 }
 
+func (c *GetSourceConfig) WithAllPlatforms(allPlatforms bool) *GetSourceConfig {
+	c.SourceProviderConfig = c.SourceProviderConfig.WithAllPlatforms(allPlatforms)
+	return c
+}
+
+func (c *GetSourceConfig) WithMaxArchiveEntries(n int) *GetSourceConfig {
+	c.SourceProviderConfig = c.SourceProviderConfig.WithMaxArchiveEntries(n)
+	return c
+}
+
 func (c *GetSourceConfig) WithSources(sources ...string) *GetSourceConfig {
 	c.Sources = sources
 	return c
@@ -0,0 +1,167 @@
+package fileresolver
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+var _ file.Resolver = (*caseInsensitive)(nil)
+
+// caseInsensitive decorates a resolver so that path and glob requests match the paths of the delegate resolver
+// regardless of case (e.g. for a Windows filesystem, where "Program Files/App.DLL" and "program files/app.dll" are
+// the same file)
+type caseInsensitive struct {
+	delegate  file.Resolver
+	indexOnce sync.Once
+	paths     []string
+}
+
+// NewCaseInsensitiveDecorator create a new resolver which wraps the provided delegate and matches path and glob
+// requests against the paths of the delegate regardless of case
+func NewCaseInsensitiveDecorator(delegate file.Resolver) file.Resolver {
+	return &caseInsensitive{
+		delegate: delegate,
+	}
+}
+
+func (r *caseInsensitive) FileContentsByLocation(location file.Location) (io.ReadCloser, error) {
+	return r.delegate.FileContentsByLocation(location)
+}
+
+func (r *caseInsensitive) FileMetadataByLocation(location file.Location) (file.Metadata, error) {
+	return r.delegate.FileMetadataByLocation(location)
+}
+
+func (r *caseInsensitive) HasPath(path string) bool {
+	return r.delegate.HasPath(path) || len(r.pathsEqualTo(path)) > 0
+}
+
+func (r *caseInsensitive) FilesByPath(paths ...string) ([]file.Location, error) {
+	locations, err := r.delegate.FilesByPath(paths...)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.withLocationsOf(locations, r.pathsEqualTo(paths...))
+}
+
+func (r *caseInsensitive) FilesByGlob(patterns ...string) ([]file.Location, error) {
+	locations, err := r.delegate.FilesByGlob(patterns...)
+	if err != nil {
+		return nil, err
+	}
+
+	lowerPatterns := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		lowerPatterns = append(lowerPatterns, strings.ToLower(pattern))
+	}
+
+	matches := r.pathsMatching(func(p string) bool {
+		for _, pattern := range lowerPatterns {
+			if matched, err := doublestar.Match(pattern, p); err == nil && matched {
+				return true
+			}
+		}
+		return false
+	})
+	return r.withLocationsOf(locations, matches)
+}
+
+func (r *caseInsensitive) FilesByMIMEType(types ...string) ([]file.Location, error) {
+	return r.delegate.FilesByMIMEType(types...)
+}
+
+func (r *caseInsensitive) RelativeFileByPath(location file.Location, path string) *file.Location {
+	if l := r.delegate.RelativeFileByPath(location, path); l != nil {
+		return l
+	}
+	for _, p := range r.pathsEqualTo(path) {
+		if l := r.delegate.RelativeFileByPath(location, p); l != nil {
+			return l
+		}
+	}
+	return nil
+}
+
+func (r *caseInsensitive) AllLocations(ctx context.Context) <-chan file.Location {
+	return r.delegate.AllLocations(ctx)
+}
+
+// pathsEqualTo returns the paths known by the delegate resolver that are equal to any of the given paths regardless of
+// case.
+func (r *caseInsensitive) pathsEqualTo(paths ...string) []string {
+	folded := strset.New()
+	for _, p := range paths {
+		folded.Add(foldPath(p))
+	}
+	return r.pathsMatching(func(p string) bool { return folded.Has(p) })
+}
+
+// pathsMatching returns the paths known by the delegate resolver whose folded form (see foldPath) the given function
+// matches.
+func (r *caseInsensitive) pathsMatching(matches func(string) bool) []string {
+	r.indexOnce.Do(func() {
+		paths := strset.New()
+		for l := range r.delegate.AllLocations(context.Background()) {
+			paths.Add(l.RealPath)
+			if l.AccessPath != "" {
+				paths.Add(l.AccessPath)
+			}
+		}
+		r.paths = paths.List()
+		sort.Strings(r.paths)
+	})
+
+	var result []string
+	for _, p := range r.paths {
+		if matches(foldPath(p)) {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// withLocationsOf adds the locations found by the delegate resolver for the given (exactly cased) paths to the given
+// locations, skipping any location that is already present.
+func (r *caseInsensitive) withLocationsOf(locations []file.Location, paths []string) ([]file.Location, error) {
+	if len(paths) == 0 {
+		return locations, nil
+	}
+
+	found, err := r.delegate.FilesByPath(paths...)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := strset.New()
+	for _, l := range locations {
+		seen.Add(locationKey(l))
+	}
+	for _, l := range found {
+		key := locationKey(l)
+		if seen.Has(key) {
+			continue
+		}
+		seen.Add(key)
+		locations = append(locations, l)
+	}
+	return locations, nil
+}
+
+func locationKey(l file.Location) string {
+	return l.Coordinates.String() + ":" + l.AccessPath
+}
+
+// foldPath returns the lowercased, absolute form of the given path, so that paths can be compared regardless of case
+// and of whether the resolver responds with paths relative to its root.
+func foldPath(p string) string {
+	return strings.ToLower("/" + strings.TrimPrefix(p, "/"))
+}
@@ -0,0 +1,83 @@
+package fileresolver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+func TestCaseInsensitiveResolver(t *testing.T) {
+	root := t.TempDir()
+	for _, p := range []string{
+		"Windows/System32/Kernel32.DLL",
+		"Program Files/App/App.exe",
+		"Program Files/App/readme.txt",
+	} {
+		p = filepath.Join(root, filepath.FromSlash(p))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte("contents"), 0o600))
+	}
+
+	delegate, err := NewFromDirectory(root, "")
+	require.NoError(t, err)
+	resolver := NewCaseInsensitiveDecorator(delegate)
+
+	realPaths := func(locations []file.Location) []string {
+		var paths []string
+		for _, l := range locations {
+			paths = append(paths, l.RealPath)
+		}
+		return paths
+	}
+
+	tests := []struct {
+		name     string
+		globs    []string
+		paths    []string
+		expected []string
+	}{
+		{
+			name:     "glob matches mixed-case paths",
+			globs:    []string{"**/windows/system32/*.dll"},
+			expected: []string{"Windows/System32/Kernel32.DLL"},
+		},
+		{
+			name:     "exactly cased glob is not duplicated",
+			globs:    []string{"**/App/*.exe", "**/app/*.EXE"},
+			expected: []string{"Program Files/App/App.exe"},
+		},
+		{
+			name:     "path matches regardless of case",
+			paths:    []string{"/program files/app/README.TXT"},
+			expected: []string{"Program Files/App/readme.txt"},
+		},
+		{
+			name:  "no match",
+			globs: []string{"**/*.so"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var locations []file.Location
+			if len(tt.globs) > 0 {
+				locations, err = resolver.FilesByGlob(tt.globs...)
+			} else {
+				locations, err = resolver.FilesByPath(tt.paths...)
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, realPaths(locations))
+		})
+	}
+
+	assert.True(t, resolver.HasPath("/WINDOWS/system32/kernel32.dll"))
+	assert.False(t, resolver.HasPath("/windows/system32/user32.dll"))
+
+	location := resolver.RelativeFileByPath(file.NewLocation("/Program Files/App/App.exe"), "/program files/app/readme.txt")
+	require.NotNil(t, location)
+	assert.Equal(t, "Program Files/App/readme.txt", location.RealPath)
+}
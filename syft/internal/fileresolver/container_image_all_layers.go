@@ -16,8 +16,9 @@ var _ file.Resolver = (*ContainerImageAllLayers)(nil)
 
 // ContainerImageAllLayers implements path and content access for the AllLayers source option for container image data sources.
 type ContainerImageAllLayers struct {
-	img    *image.Image
-	layers []int
+	img       *image.Image
+	layers    []int
+	mimeTypes *mimeTypeCache
 }
 
 // NewFromContainerImageAllLayers returns a new resolver from the perspective of all image layers for the given image.
@@ -31,8 +32,9 @@ func NewFromContainerImageAllLayers(img *image.Image) (*ContainerImageAllLayers,
 		layers = append(layers, idx)
 	}
 	return &ContainerImageAllLayers{
-		img:    img,
-		layers: layers,
+		img:       img,
+		layers:    layers,
+		mimeTypes: &mimeTypeCache{},
 	}, nil
 }
 
@@ -208,11 +210,15 @@ func (r *ContainerImageAllLayers) FileContentsByLocation(location file.Location)
 }
 
 func (r *ContainerImageAllLayers) FilesByMIMEType(types ...string) ([]file.Location, error) {
+	return r.mimeTypes.filesByMIMEType(types, r.filesByMIMEType)
+}
+
+func (r *ContainerImageAllLayers) filesByMIMEType(mimeType string) ([]file.Location, error) {
 	uniqueFileIDs := stereoscopeFile.NewFileReferenceSet()
 	uniqueLocations := make([]file.Location, 0)
 
 	for idx, layerIdx := range r.layers {
-		refs, err := r.img.Layers[layerIdx].SearchContext.SearchByMIMEType(types...)
+		refs, err := r.img.Layers[layerIdx].SearchContext.SearchByMIMEType(mimeType)
 		if err != nil {
 			return nil, err
 		}
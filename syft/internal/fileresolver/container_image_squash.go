@@ -15,7 +15,8 @@ var _ file.Resolver = (*ContainerImageSquash)(nil)
 
 // ContainerImageSquash implements path and content access for the Squashed source option for container image data sources.
 type ContainerImageSquash struct {
-	img *image.Image
+	img       *image.Image
+	mimeTypes *mimeTypeCache
 }
 
 // NewFromContainerImageSquash returns a new resolver from the perspective of the squashed representation for the given image.
@@ -25,7 +26,8 @@ func NewFromContainerImageSquash(img *image.Image) (*ContainerImageSquash, error
 	}
 
 	return &ContainerImageSquash{
-		img: img,
+		img:       img,
+		mimeTypes: &mimeTypeCache{},
 	}, nil
 }
 
@@ -190,7 +192,11 @@ func (r *ContainerImageSquash) AllLocations(ctx context.Context) <-chan file.Loc
 }
 
 func (r *ContainerImageSquash) FilesByMIMEType(types ...string) ([]file.Location, error) {
-	refs, err := r.img.SquashedSearchContext.SearchByMIMEType(types...)
+	return r.mimeTypes.filesByMIMEType(types, r.filesByMIMEType)
+}
+
+func (r *ContainerImageSquash) filesByMIMEType(mimeType string) ([]file.Location, error) {
+	refs, err := r.img.SquashedSearchContext.SearchByMIMEType(mimeType)
 	if err != nil {
 		return nil, err
 	}
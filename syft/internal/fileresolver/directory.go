@@ -26,6 +26,7 @@ type Directory struct {
 	index         filetree.IndexReader
 	searchContext filetree.Searcher
 	indexer       *directoryIndexer
+	mimeTypes     *mimeTypeCache
 }
 
 func NewFromDirectory(root string, base string, pathFilters ...PathIndexVisitor) (*Directory, error) {
@@ -47,11 +48,12 @@ func newFromDirectoryWithoutIndex(root string, base string, pathFilters ...PathI
 	cleanBase := chroot.Base()
 
 	return &Directory{
-		path:    cleanRoot,
-		chroot:  *chroot,
-		tree:    filetree.New(),
-		index:   filetree.NewIndex(),
-		indexer: newDirectoryIndexer(cleanRoot, cleanBase, pathFilters...),
+		path:      cleanRoot,
+		chroot:    *chroot,
+		tree:      filetree.New(),
+		index:     filetree.NewIndex(),
+		indexer:   newDirectoryIndexer(cleanRoot, cleanBase, pathFilters...),
+		mimeTypes: &mimeTypeCache{},
 	}, nil
 }
 
@@ -250,10 +252,14 @@ func (r *Directory) FileMetadataByLocation(location file.Location) (file.Metadat
 }
 
 func (r *Directory) FilesByMIMEType(types ...string) ([]file.Location, error) {
+	return r.mimeTypes.filesByMIMEType(types, r.filesByMIMEType)
+}
+
+func (r *Directory) filesByMIMEType(mimeType string) ([]file.Location, error) {
 	uniqueFileIDs := stereoscopeFile.NewFileReferenceSet()
 	uniqueLocations := make([]file.Location, 0)
 
-	refVias, err := r.searchContext.SearchByMIMEType(types...)
+	refVias, err := r.searchContext.SearchByMIMEType(mimeType)
 	if err != nil {
 		return nil, err
 	}
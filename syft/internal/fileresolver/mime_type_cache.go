@@ -0,0 +1,70 @@
+package fileresolver
+
+import (
+	"sync"
+
+	stereoscopeFile "github.com/anchore/stereoscope/pkg/file"
+	"github.com/anchore/syft/syft/file"
+)
+
+// mimeTypeCache memoizes the locations of the files of each MIME type for the lifetime of a resolver. Several
+// catalogers search for the same MIME types (e.g. every binary cataloger searches for executables), and while the
+// MIME type of each file is only detected once when indexing, resolving the search results to locations is otherwise
+// repeated by each cataloger. A nil cache does not cache anything.
+type mimeTypeCache struct {
+	lock      sync.RWMutex
+	locations map[string][]file.Location
+}
+
+// filesByMIMEType returns the locations of the files of any of the given MIME types, where the files of each MIME
+// type not yet cached are found with the given search function.
+func (c *mimeTypeCache) filesByMIMEType(types []string, search func(mimeType string) ([]file.Location, error)) ([]file.Location, error) {
+	uniqueFileIDs := stereoscopeFile.NewFileReferenceSet()
+	uniqueLocations := make([]file.Location, 0)
+
+	for _, mimeType := range types {
+		if c == nil {
+			locations, err := search(mimeType)
+			if err != nil {
+				return nil, err
+			}
+			uniqueLocations = appendUniqueLocations(uniqueLocations, uniqueFileIDs, locations)
+			continue
+		}
+
+		c.lock.RLock()
+		locations, ok := c.locations[mimeType]
+		c.lock.RUnlock()
+
+		if !ok {
+			var err error
+			locations, err = search(mimeType)
+			if err != nil {
+				return nil, err
+			}
+
+			c.lock.Lock()
+			if c.locations == nil {
+				c.locations = make(map[string][]file.Location)
+			}
+			c.locations[mimeType] = locations
+			c.lock.Unlock()
+		}
+
+		// the cached locations are shared, so are only ever copied into the results
+		uniqueLocations = appendUniqueLocations(uniqueLocations, uniqueFileIDs, locations)
+	}
+
+	return uniqueLocations, nil
+}
+
+func appendUniqueLocations(results []file.Location, seen stereoscopeFile.ReferenceSet, locations []file.Location) []file.Location {
+	for _, location := range locations {
+		if seen.Contains(location.Reference()) {
+			continue
+		}
+		seen.Add(location.Reference())
+		results = append(results, location)
+	}
+	return results
+}
@@ -0,0 +1,64 @@
+package fileresolver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	stereoscopeFile "github.com/anchore/stereoscope/pkg/file"
+	"github.com/anchore/syft/syft/file"
+)
+
+func Test_mimeTypeCache_filesByMIMEType(t *testing.T) {
+	exe := file.NewLocationFromDirectory("/bin/ls", *stereoscopeFile.NewFileReference("/bin/ls"))
+	lib := file.NewLocationFromDirectory("/lib/libc.so", *stereoscopeFile.NewFileReference("/lib/libc.so"))
+
+	results := map[string][]file.Location{
+		"application/x-executable": {exe},
+		"application/x-sharedlib":  {lib},
+		// the same file may be found for more than one type, but is only returned once
+		"application/x-mach-binary": {exe},
+	}
+
+	searches := make(map[string]int)
+	search := func(mimeType string) ([]file.Location, error) {
+		searches[mimeType]++
+		return results[mimeType], nil
+	}
+
+	c := &mimeTypeCache{}
+
+	got, err := c.filesByMIMEType([]string{"application/x-executable", "application/x-mach-binary"}, search)
+	require.NoError(t, err)
+	assert.Equal(t, []file.Location{exe}, got)
+
+	got, err = c.filesByMIMEType([]string{"application/x-sharedlib", "application/x-executable"}, search)
+	require.NoError(t, err)
+	assert.Equal(t, []file.Location{lib, exe}, got)
+
+	assert.Equal(t, map[string]int{
+		"application/x-executable":  1,
+		"application/x-mach-binary": 1,
+		"application/x-sharedlib":   1,
+	}, searches)
+}
+
+func Test_mimeTypeCache_filesByMIMEType_searchError(t *testing.T) {
+	searches := 0
+	search := func(string) ([]file.Location, error) {
+		searches++
+		return nil, errors.New("failed")
+	}
+
+	c := &mimeTypeCache{}
+
+	_, err := c.filesByMIMEType([]string{"application/x-executable"}, search)
+	require.Error(t, err)
+
+	// failed searches are not cached
+	_, err = c.filesByMIMEType([]string{"application/x-executable"}, search)
+	require.Error(t, err)
+	assert.Equal(t, 2, searches)
+}
@@ -8,7 +8,9 @@ import "github.com/anchore/syft/syft/pkg"
 func AllTypes() []any {
 	return []any{
 		pkg.AlpmDBEntry{},
+		pkg.AndroidApkManifest{},
 		pkg.ApkDBEntry{},
+		pkg.BazelModuleEntry{},
 		pkg.BinarySignature{},
 		pkg.CocoaPodfileLockEntry{},
 		pkg.ConanV1LockEntry{},
@@ -17,37 +19,56 @@ func AllTypes() []any {
 		pkg.ConaninfoEntry{},
 		pkg.DartPubspecLockEntry{},
 		pkg.DotnetDepsEntry{},
+		pkg.DotnetNativeAOTEntry{},
 		pkg.DotnetPortableExecutableEntry{},
+		pkg.DotnetRuntimeConfigEntry{},
 		pkg.DpkgDBEntry{},
 		pkg.ELFBinaryPackageNoteJSONPayload{},
+		pkg.ELFStaticLibrary{},
 		pkg.ElixirMixLockEntry{},
 		pkg.ErlangRebarLockEntry{},
+		pkg.GitSubmoduleEntry{},
 		pkg.GolangBinaryBuildinfoEntry{},
 		pkg.GolangModuleEntry{},
 		pkg.HackageStackYamlEntry{},
 		pkg.HackageStackYamlLockEntry{},
+		pkg.HomebrewFormula{},
+		pkg.InstallDirectoryEntry{},
 		pkg.JavaArchive{},
 		pkg.LinuxKernel{},
 		pkg.LinuxKernelModule{},
+		pkg.MacOSAppBundle{},
+		pkg.MacOSInstallerReceipt{},
 		pkg.MicrosoftKbPatch{},
+		pkg.NixFlakeLockEntry{},
 		pkg.NixStoreEntry{},
 		pkg.NpmPackage{},
 		pkg.NpmPackageLockEntry{},
+		pkg.NpmSourceMapEntry{},
+		pkg.PerlCpanfileEntry{},
+		pkg.PerlCpanfileSnapshotEntry{},
 		pkg.PhpComposerInstalledEntry{},
 		pkg.PhpComposerLockEntry{},
+		pkg.PhpComposerPlatformEntry{},
 		pkg.PhpPeclEntry{},
 		pkg.PortageEntry{},
+		pkg.ProtobufFile{},
 		pkg.PythonPackage{},
 		pkg.PythonPipfileLockEntry{},
 		pkg.PythonPoetryLockEntry{},
 		pkg.PythonRequirementsEntry{},
 		pkg.RDescription{},
+		pkg.RRenvLockEntry{},
 		pkg.RpmArchive{},
 		pkg.RpmDBEntry{},
 		pkg.RubyGemspec{},
 		pkg.RustBinaryAuditEntry{},
 		pkg.RustCargoLockEntry{},
 		pkg.SwiftPackageManagerResolvedEntry{},
+		pkg.VSCodeExtensionEntry{},
+		pkg.VcpkgManifestEntry{},
+		pkg.WasmImportEntry{},
+		pkg.WindowsMSIProduct{},
 		pkg.WordpressPluginEntry{},
 		pkg.YarnLockEntry{},
 	}
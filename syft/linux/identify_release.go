@@ -38,6 +38,11 @@ var identityFiles = []parseEntry{
 		path: "/etc/system-release-cpe",
 		fn:   parseSystemReleaseCPE,
 	},
+	{
+		// older debian-based distros (e.g. ubuntu 10.04) provide lsb-release but not os-release
+		path: "/etc/lsb-release",
+		fn:   parseLsbRelease,
+	},
 	{
 		// last ditch effort for determining older centos version distro information
 		path: "/etc/redhat-release",
@@ -136,6 +141,30 @@ func parseOsRelease(contents string) (*Release, error) {
 	return &r, nil
 }
 
+// parseLsbRelease parses the Linux Standard Base release file, which uses the same key=value format as os-release
+func parseLsbRelease(contents string) (*Release, error) {
+	values, err := osrelease.ReadString(contents)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read lsb-release file: %w", err)
+	}
+
+	name := values["DISTRIB_ID"]
+	if name == "" {
+		return nil, nil
+	}
+
+	prettyName := values["DISTRIB_DESCRIPTION"]
+	if prettyName == "" {
+		prettyName = name
+	}
+
+	r := simpleRelease(prettyName, name, values["DISTRIB_RELEASE"], "")
+	r.ID = strings.ToLower(name)
+	r.IDLike = []string{r.ID}
+	r.VersionCodename = values["DISTRIB_CODENAME"]
+	return r, nil
+}
+
 var busyboxVersionMatcher = regexp.MustCompile(`BusyBox v[\d.]+`)
 
 func parseBusyBox(contents string) (*Release, error) {
@@ -274,6 +274,18 @@ func TestIdentifyRelease(t *testing.T) {
 				VersionID:  "5.7",
 			},
 		},
+		{
+			fixture: "test-fixtures/os/ubuntu-lsb",
+			release: &Release{
+				PrettyName:      "Ubuntu 10.04.4 LTS",
+				Name:            "Ubuntu",
+				ID:              "ubuntu",
+				IDLike:          []string{"ubuntu"},
+				Version:         "10.04",
+				VersionID:       "10.04",
+				VersionCodename: "lucid",
+			},
+		},
 		{
 			fixture: "test-fixtures/os/mariner",
 			release: &Release{
@@ -535,6 +547,43 @@ func TestParseRedhatRelease(t *testing.T) {
 	}
 }
 
+func TestParseLsbRelease(t *testing.T) {
+	tests := []struct {
+		fixture string
+		release *Release
+	}{
+		{
+			fixture: "test-fixtures/os/ubuntu-lsb/etc/lsb-release",
+			release: &Release{
+				PrettyName:      "Ubuntu 10.04.4 LTS",
+				Name:            "Ubuntu",
+				ID:              "ubuntu",
+				IDLike:          []string{"ubuntu"},
+				Version:         "10.04",
+				VersionID:       "10.04",
+				VersionCodename: "lucid",
+			},
+		},
+		{
+			fixture: "test-fixtures/bad-lsb-release",
+			release: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			release, err := parseLsbRelease(retrieveFixtureContentsAsString(test.fixture, t))
+			require.NoError(t, err)
+			if test.release == nil {
+				assert.Nil(t, release)
+				return
+			}
+
+			assert.Equal(t, test.release, release)
+		})
+	}
+}
+
 func retrieveFixtureContentsAsString(fixturePath string, t *testing.T) string {
 	fixture, err := os.Open(fixturePath)
 	if err != nil {
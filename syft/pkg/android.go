@@ -0,0 +1,23 @@
+package pkg
+
+// AndroidApkManifest represents the application identity declared within the binary AndroidManifest.xml of an
+// Android application package (APK).
+type AndroidApkManifest struct {
+	// Package is the unique application ID (e.g. "com.example.app")
+	Package string `mapstructure:"package" json:"package"`
+
+	// VersionCode is the internal version number of the application, used to determine if one version is newer than another
+	VersionCode string `mapstructure:"versionCode" json:"versionCode,omitempty"`
+
+	// VersionName is the version string shown to users
+	VersionName string `mapstructure:"versionName" json:"versionName,omitempty"`
+
+	// MinSDKVersion is the minimum Android API level required to run the application
+	MinSDKVersion string `mapstructure:"minSdkVersion" json:"minSdkVersion,omitempty"`
+
+	// TargetSDKVersion is the Android API level that the application was built to target
+	TargetSDKVersion string `mapstructure:"targetSdkVersion" json:"targetSdkVersion,omitempty"`
+
+	// NativeLibraries are the paths of the shared objects bundled within the APK (e.g. "lib/arm64-v8a/libfoo.so")
+	NativeLibraries []string `mapstructure:"nativeLibraries" json:"nativeLibraries,omitempty"`
+}
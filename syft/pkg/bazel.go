@@ -0,0 +1,14 @@
+package pkg
+
+// BazelModuleEntry represents a single bazel_dep declaration within a MODULE.bazel file (bzlmod).
+type BazelModuleEntry struct {
+	// DeclaredVersion is the version given in the bazel_dep declaration, which may differ from the version
+	// selected during module resolution (as recorded in MODULE.bazel.lock)
+	DeclaredVersion string `mapstructure:"declaredVersion" json:"declaredVersion,omitempty"`
+
+	// RepoName is the name of the repository the module is visible as to the declaring module (if not the module name)
+	RepoName string `mapstructure:"repoName" json:"repoName,omitempty"`
+
+	// DevDependency indicates the dependency is ignored when the declaring module is not the root module
+	DevDependency bool `mapstructure:"devDependency" json:"devDependency,omitempty"`
+}
@@ -2,6 +2,16 @@ package pkg
 
 import "github.com/anchore/syft/syft/file"
 
+const (
+	// SourceAnnotationKey is the location annotation used to indicate how a package was found within the file at the
+	// location, when this differs from how the cataloger typically finds packages.
+	SourceAnnotationKey = "source"
+
+	// BinaryEmbeddedSourceAnnotation indicates the package is a library statically linked into the binary, found by
+	// the version banner the library embeds in the binary.
+	BinaryEmbeddedSourceAnnotation = "binary-embedded"
+)
+
 // BinarySignature represents a set of matched values within a binary file.
 type BinarySignature struct {
 	Matches []ClassifierMatch `mapstructure:"Matches" json:"matches"`
@@ -21,3 +31,22 @@ type ELFBinaryPackageNoteJSONPayload struct {
 	SourceRepo string `json:"sourceRepo,omitempty"`
 	Commit     string `json:"commit,omitempty"`
 }
+
+// ELFStaticLibrary represents metadata captured from the ELF object files within a static library (an ar archive).
+type ELFStaticLibrary struct {
+	// Toolchains are the distinct compiler identification strings found within the .comment section of the objects
+	// (e.g. "GCC: (Debian 12.2.0-14) 12.2.0")
+	Toolchains []string `json:"toolchains,omitempty"`
+
+	// Objects are the ELF object files within the archive
+	Objects []ELFStaticLibraryObject `json:"objects"`
+}
+
+// ELFStaticLibraryObject represents a single ELF object file within a static library.
+type ELFStaticLibraryObject struct {
+	// Name is the name of the archive member
+	Name string `json:"name"`
+
+	// BuildID is the hex-encoded build ID from the .note.gnu.build-id section of the object (when present)
+	BuildID string `json:"buildId,omitempty"`
+}
@@ -0,0 +1,15 @@
+package pkg
+
+const (
+	// BuildpackAnnotationKey is the location annotation used to indicate which Cloud Native Buildpack (e.g.
+	// "paketo-buildpacks/node-engine") wrote the SBOM that a package was found in.
+	BuildpackAnnotationKey = "buildpack"
+
+	// BuildpackLayerAnnotationKey is the location annotation used to indicate which buildpack layer the SBOM
+	// describes. This is not set for SBOMs that describe the buildpack as a whole.
+	BuildpackLayerAnnotationKey = "buildpackLayer"
+
+	// BuildpackPhaseAnnotationKey is the location annotation used to indicate if the buildpack SBOM describes
+	// the "launch" image, the "build" environment, or the "cache".
+	BuildpackPhaseAnnotationKey = "buildpackPhase"
+)
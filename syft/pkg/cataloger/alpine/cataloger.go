@@ -13,3 +13,12 @@ func NewDBCataloger() pkg.Cataloger {
 	return generic.NewCataloger("apk-db-cataloger").
 		WithParserByGlobs(parseApkDB, pkg.ApkDBGlob)
 }
+
+// NewIndexCataloger returns a new cataloger object for Alpine repository indexes (APKINDEX), which inventories the
+// packages a repository offers. Packages are found within both the signed index archives of a repository
+// (APKINDEX.tar.gz) and extracted indexes, and are annotated as available (not installed).
+func NewIndexCataloger() pkg.Cataloger {
+	return generic.NewCataloger("apk-index-cataloger").
+		WithParserByGlobs(parseApkIndexArchive, "**/APKINDEX.tar.gz").
+		WithParserByGlobs(parseApkIndex, "**/APKINDEX")
+}
@@ -29,3 +29,13 @@ func TestCataloger_Globs(t *testing.T) {
 		})
 	}
 }
+
+func TestIndexCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/index").
+		ExpectsResolverContentQueries([]string{
+			"alpine/v3.19/main/x86_64/APKINDEX.tar.gz",
+			"extracted/APKINDEX",
+		}).
+		TestCataloger(t, NewIndexCataloger())
+}
@@ -33,9 +33,43 @@ type parsedData struct {
 
 // parseApkDB parses packages from a given APK "installed" flat-file DB. For more
 // information on specific fields, see https://wiki.alpinelinux.org/wiki/Apk_spec.
-//
-//nolint:funlen,gocognit
 func parseApkDB(_ context.Context, resolver file.Resolver, env *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	apks, err := parseApkEntries(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse APK installed DB file: %w", err)
+	}
+
+	var r *linux.Release
+	if env != nil {
+		r = env.LinuxRelease
+	}
+	// this is somewhat ugly, but better than completely failing when we can't find the release,
+	// e.g. embedded deeper in the tree, like containers or chroots.
+	// but we now have no way of handling different repository sources. On the other hand,
+	// we never could before this. At least now, we can handle some.
+	// This should get fixed with https://gitlab.alpinelinux.org/alpine/apk-tools/-/issues/10875
+	if r == nil {
+		// find the repositories file from the relative directory of the DB file
+		releases := findReleases(resolver, reader.Location.RealPath)
+
+		if len(releases) > 0 {
+			r = &releases[0]
+		}
+	}
+
+	pkgs := make([]pkg.Package, 0, len(apks))
+	for _, apk := range apks {
+		pkgs = append(pkgs, newPackage(apk, r, reader.Location))
+	}
+
+	return pkgs, discoverPackageDependencies(pkgs), nil
+}
+
+// parseApkEntries parses the package entries of an APK flat-file store, which is shared by the installed DB and
+// repository indexes (APKINDEX).
+//
+//nolint:funlen
+func parseApkEntries(reader io.Reader) ([]parsedData, error) {
 	scanner := bufio.NewScanner(reader)
 
 	var apks []parsedData
@@ -105,33 +139,10 @@ func parseApkDB(_ context.Context, resolver file.Resolver, env *generic.Environm
 	}
 
 	if err := scanner.Err(); err != nil {
-		return nil, nil, fmt.Errorf("failed to parse APK installed DB file: %w", err)
+		return nil, err
 	}
 
-	var r *linux.Release
-	if env != nil {
-		r = env.LinuxRelease
-	}
-	// this is somewhat ugly, but better than completely failing when we can't find the release,
-	// e.g. embedded deeper in the tree, like containers or chroots.
-	// but we now have no way of handling different repository sources. On the other hand,
-	// we never could before this. At least now, we can handle some.
-	// This should get fixed with https://gitlab.alpinelinux.org/alpine/apk-tools/-/issues/10875
-	if r == nil {
-		// find the repositories file from the relative directory of the DB file
-		releases := findReleases(resolver, reader.Location.RealPath)
-
-		if len(releases) > 0 {
-			r = &releases[0]
-		}
-	}
-
-	pkgs := make([]pkg.Package, 0, len(apks))
-	for _, apk := range apks {
-		pkgs = append(pkgs, newPackage(apk, r, reader.Location))
-	}
-
-	return pkgs, discoverPackageDependencies(pkgs), nil
+	return apks, nil
 }
 
 func findReleases(resolver file.Resolver, dbPath string) []linux.Release {
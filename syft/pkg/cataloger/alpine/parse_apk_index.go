@@ -0,0 +1,93 @@
+package alpine
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/linux"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// integrity check
+var (
+	_ generic.Parser = parseApkIndex
+	_ generic.Parser = parseApkIndexArchive
+)
+
+// repositories are laid out as <release>/<repository>/<arch>/APKINDEX.tar.gz, e.g.
+// https://dl-cdn.alpinelinux.org/alpine/v3.19/main/x86_64/APKINDEX.tar.gz
+var indexReleaseRegex = regexp.MustCompile(`(?:^|/)v(\d+\.\d+)/[^/]+/[^/]+/APKINDEX(?:\.tar\.gz)?$`)
+
+// parseApkIndexArchive parses packages from the APKINDEX within a signed repository index archive, which is made up
+// of the (gzipped) tar streams of the signature and the index.
+func parseApkIndexArchive(ctx context.Context, resolver file.Resolver, env *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	gzReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read APKINDEX archive: %w", err)
+	}
+	defer gzReader.Close()
+
+	tarReader := tar.NewReader(gzReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, nil, nil
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read APKINDEX archive: %w", err)
+		}
+		if header.Name != "APKINDEX" {
+			continue
+		}
+
+		return parseApkIndex(ctx, resolver, env, file.LocationReadCloser{
+			Location:   reader.Location,
+			ReadCloser: io.NopCloser(tarReader),
+		})
+	}
+}
+
+// parseApkIndex parses packages available from an APK repository index, which shares the format of the installed DB.
+// For more information on specific fields, see https://wiki.alpinelinux.org/wiki/Apk_spec.
+func parseApkIndex(_ context.Context, _ file.Resolver, env *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	apks, err := parseApkEntries(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse APKINDEX file: %w", err)
+	}
+
+	// the release of a repository is best described by the repository layout, since the index may be found outside
+	// of the release being scanned (e.g. a mirror)
+	r := indexRelease(reader.RealPath)
+	if r == nil && env != nil {
+		r = env.LinuxRelease
+	}
+
+	location := reader.Location.WithAnnotation(pkg.AvailabilityAnnotationKey, pkg.AvailableAnnotation)
+	pkgs := make([]pkg.Package, 0, len(apks))
+	for _, apk := range apks {
+		pkgs = append(pkgs, newPackage(apk, r, location))
+	}
+
+	// note: dependencies are not resolved between available packages, since these do not describe what is installed
+	return pkgs, nil, nil
+}
+
+func indexRelease(indexPath string) *linux.Release {
+	match := indexReleaseRegex.FindStringSubmatch(indexPath)
+	if match == nil {
+		return nil
+	}
+	return &linux.Release{
+		Name:      "Alpine Linux",
+		ID:        "alpine",
+		VersionID: match[1],
+	}
+}
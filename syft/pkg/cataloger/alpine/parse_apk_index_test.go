@@ -0,0 +1,120 @@
+package alpine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/linux"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseApkIndex(t *testing.T) {
+	fixture := "test-fixtures/index/extracted/APKINDEX"
+	location := file.NewLocation(fixture)
+
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "musl",
+			Version:   "1.2.4_git20230717-r4",
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", location)),
+			Type:      pkg.ApkPkg,
+			PURL:      "pkg:apk/alpine/musl@1.2.4_git20230717-r4?arch=x86_64&distro=alpine-3.19.1",
+			Locations: file.NewLocationSet(location),
+			Metadata: pkg.ApkDBEntry{
+				Package:       "musl",
+				OriginPackage: "musl",
+				Maintainer:    "Timo Teräs <timo.teras@iki.fi>",
+				Version:       "1.2.4_git20230717-r4",
+				Architecture:  "x86_64",
+				URL:           "https://musl.libc.org/",
+				Description:   "the musl c library (libc) implementation",
+				Size:          407782,
+				InstalledSize: 662528,
+				Checksum:      "Q1Vp7FTD/JX0CfPUDq6ZFkWPkvyeY=",
+				GitCommit:     "ea8f26fbde8e5e1ecd5ded3d56d25e95bd0520fb",
+				Dependencies:  []string{},
+				Provides:      []string{"so:libc.musl-x86_64.so.1=1"},
+				Files:         []pkg.ApkFileRecord{},
+			},
+		},
+		{
+			Name:      "busybox",
+			Version:   "1.36.1-r15",
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("GPL-2.0-only", location)),
+			Type:      pkg.ApkPkg,
+			PURL:      "pkg:apk/alpine/busybox@1.36.1-r15?arch=x86_64&distro=alpine-3.19.1",
+			Locations: file.NewLocationSet(location),
+			Metadata: pkg.ApkDBEntry{
+				Package:       "busybox",
+				OriginPackage: "busybox",
+				Maintainer:    "Sören Tempel <soeren+alpine@soeren-tempel.net>",
+				Version:       "1.36.1-r15",
+				Architecture:  "x86_64",
+				URL:           "https://busybox.net/",
+				Description:   "Size optimized toolbox of many common UNIX utilities",
+				Size:          509218,
+				InstalledSize: 947592,
+				Checksum:      "Q1UJ7JDvqB3IhT7n5XXILx8ysigzk=",
+				GitCommit:     "bf3a04d9e45bbb8bd1f0fa0b23a4a5cd3f8b2a76",
+				Dependencies:  []string{"so:libc.musl-x86_64.so.1"},
+				Provides:      []string{"/bin/sh", "cmd:busybox=1.36.1-r15", "cmd:sh=1.36.1-r15"},
+				Files:         []pkg.ApkFileRecord{},
+			},
+		},
+	}
+
+	// an extracted index does not describe the release within the path, so the release of the environment is used
+	env := generic.Environment{LinuxRelease: &linux.Release{ID: "alpine", VersionID: "3.19.1"}}
+
+	// available packages do not have relationships to each other
+	pkgtest.TestFileParserWithEnv(t, fixture, parseApkIndex, &env, expectedPkgs, nil)
+}
+
+func TestParseApkIndexArchive(t *testing.T) {
+	fixture := "test-fixtures/index/alpine/v3.19/main/x86_64/APKINDEX.tar.gz"
+
+	pkgs, relationships, err := parseApkIndexArchive(context.Background(), nil, new(generic.Environment), newLocationReadCloser(t, fixture))
+	require.NoError(t, err)
+	assert.Empty(t, relationships)
+	assert.Equal(t, []string{"musl", "busybox"}, toPackageNames(pkgs))
+
+	for _, p := range pkgs {
+		// the release is described by the repository layout
+		assert.Contains(t, p.PURL, "distro=alpine-3.19")
+
+		locations := p.Locations.ToSlice()
+		require.Len(t, locations, 1)
+		assert.Equal(t, fixture, locations[0].RealPath)
+		assert.Equal(t, pkg.AvailableAnnotation, locations[0].Annotations[pkg.AvailabilityAnnotationKey])
+		assert.Equal(t, pkg.PrimaryEvidenceAnnotation, locations[0].Annotations[pkg.EvidenceAnnotationKey])
+	}
+}
+
+func Test_indexRelease(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{path: "/mirror/alpine/v3.19/main/x86_64/APKINDEX.tar.gz", expected: "3.19"},
+		{path: "v3.18/community/aarch64/APKINDEX", expected: "3.18"},
+		{path: "/mirror/alpine/edge/main/x86_64/APKINDEX.tar.gz"},
+		{path: "/var/cache/apk/APKINDEX.tar.gz"},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			r := indexRelease(test.path)
+			if test.expected == "" {
+				assert.Nil(t, r)
+				return
+			}
+			require.NotNil(t, r)
+			assert.Equal(t, test.expected, r.VersionID)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+/*
+Package bazel provides a concrete Cataloger implementation for external dependencies of Bazel modules (bzlmod).
+*/
+package bazel
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewModuleCataloger returns a new cataloger object for bazel_dep declarations in MODULE.bazel files, at the versions
+// selected during module resolution as recorded in MODULE.bazel.lock (when present).
+func NewModuleCataloger() pkg.Cataloger {
+	return generic.NewCataloger("bazel-module-cataloger").
+		WithParserByGlobs(parseModuleBazel, "**/MODULE.bazel")
+}
@@ -0,0 +1,33 @@
+package bazel
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestCataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain MODULE.bazel files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"src/MODULE.bazel",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				IgnoreUnfulfilledPathResponses("src/MODULE.bazel.lock").
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewModuleCataloger())
+		})
+	}
+}
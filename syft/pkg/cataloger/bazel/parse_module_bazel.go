@@ -0,0 +1,194 @@
+package bazel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	version "github.com/anchore/go-version"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+const moduleLockfileName = "MODULE.bazel.lock"
+
+var (
+	// matches the arguments of a bazel_dep(...) call, for example:
+	//   bazel_dep(name = "rules_go", version = "0.41.0", repo_name = "io_bazel_rules_go")
+	bazelDepPattern = regexp.MustCompile(`\bbazel_dep\s*\(([^)]*)\)`)
+
+	// matches a keyword argument with a string or boolean literal value
+	keywordArgPattern = regexp.MustCompile(`(\w+)\s*=\s*(?:"([^"]*)"|'([^']*)'|(True|False))`)
+
+	// matches the module files of a registry, for example:
+	//   https://bcr.bazel.build/modules/rules_go/0.41.0/MODULE.bazel
+	registryModuleFilePattern = regexp.MustCompile(`/modules/([^/]+)/([^/]+)/MODULE\.bazel$`)
+)
+
+var _ generic.Parser = parseModuleBazel
+
+// parseModuleBazel is a tolerant parser for the bazel_dep declarations of a MODULE.bazel file, returning a package for
+// each declared module. The version selected during module resolution is read from the MODULE.bazel.lock file next to
+// the MODULE.bazel file, if present. Dependencies of the legacy WORKSPACE file (http_archive, git_repository, ...) are
+// not cataloged, as they are generally macros that cannot be resolved without evaluating the Starlark code.
+func parseModuleBazel(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read MODULE.bazel file: %w", err)
+	}
+
+	resolved, lockLocation := findResolvedVersions(resolver, reader.Location)
+
+	var pkgs []pkg.Package
+	seen := make(map[string]struct{})
+	for _, dep := range bazelDeps(string(contents)) {
+		name := dep["name"]
+		if name == "" {
+			log.WithFields("path", reader.RealPath).Trace("skipping bazel_dep without a name")
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+
+		locations := []file.Location{reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+		v := dep["version"]
+		if r, ok := resolved[name]; ok {
+			v = r
+			locations = append(locations, lockLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+		}
+
+		pkgs = append(pkgs, newPackage(name, v, pkg.BazelModuleEntry{
+			DeclaredVersion: dep["version"],
+			RepoName:        dep["repo_name"],
+			DevDependency:   dep["dev_dependency"] == "True",
+		}, locations...))
+	}
+
+	return pkgs, nil, nil
+}
+
+// bazelDeps returns the keyword arguments of each bazel_dep declaration, in order of appearance.
+func bazelDeps(contents string) []map[string]string {
+	contents = stripComments(contents)
+
+	var deps []map[string]string
+	for _, m := range bazelDepPattern.FindAllStringSubmatch(contents, -1) {
+		args := make(map[string]string)
+		for _, kv := range keywordArgPattern.FindAllStringSubmatch(m[1], -1) {
+			args[kv[1]] = kv[2] + kv[3] + kv[4]
+		}
+		deps = append(deps, args)
+	}
+	return deps
+}
+
+// stripComments removes "#" comments from Starlark source, leaving "#" characters within string literals as-is.
+func stripComments(contents string) string {
+	lines := strings.Split(contents, "\n")
+	for i, line := range lines {
+		var quote rune
+		for j, c := range line {
+			if quote == 0 && c == '#' {
+				lines[i] = line[:j]
+				break
+			}
+			switch {
+			case quote != 0 && c == quote:
+				quote = 0
+			case quote == 0 && (c == '"' || c == '\''):
+				quote = c
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+type moduleLockfile struct {
+	// RegistryFileHashes is keyed by the URL of every registry file fetched during resolution (newer lockfiles)
+	RegistryFileHashes map[string]json.RawMessage `json:"registryFileHashes"`
+
+	// ModuleDepGraph is keyed by the canonical name of each module in the resolved graph (older lockfiles)
+	ModuleDepGraph map[string]struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	} `json:"moduleDepGraph"`
+}
+
+// findResolvedVersions returns the versions selected during module resolution as recorded in the MODULE.bazel.lock
+// file next to the MODULE.bazel file, keyed by module name.
+func findResolvedVersions(resolver file.Resolver, location file.Location) (map[string]string, file.Location) {
+	if resolver == nil {
+		return nil, file.Location{}
+	}
+
+	lockLocation := resolver.RelativeFileByPath(location, path.Join(path.Dir(location.RealPath), moduleLockfileName))
+	if lockLocation == nil {
+		return nil, file.Location{}
+	}
+
+	contents, err := resolver.FileContentsByLocation(*lockLocation)
+	if err != nil {
+		log.WithFields("path", lockLocation.RealPath, "error", err).Debug("unable to read MODULE.bazel.lock")
+		return nil, file.Location{}
+	}
+	defer internal.CloseAndLogError(contents, lockLocation.RealPath)
+
+	var lock moduleLockfile
+	if err := json.NewDecoder(contents).Decode(&lock); err != nil {
+		log.WithFields("path", lockLocation.RealPath, "error", err).Debug("unable to parse MODULE.bazel.lock")
+		return nil, file.Location{}
+	}
+
+	resolved := make(map[string]string)
+	for _, m := range lock.ModuleDepGraph {
+		if m.Name != "" && m.Version != "" {
+			resolved[m.Name] = m.Version
+		}
+	}
+
+	// newer lockfiles no longer record the resolved graph, only the module files of every version considered during
+	// resolution. Minimal version selection picks the highest of these for each module.
+	urls := make([]string, 0, len(lock.RegistryFileHashes))
+	for u := range lock.RegistryFileHashes {
+		urls = append(urls, u)
+	}
+	sort.Strings(urls)
+	for _, u := range urls {
+		match := registryModuleFilePattern.FindStringSubmatch(u)
+		if match == nil {
+			continue
+		}
+		name, v := match[1], match[2]
+		if current, ok := resolved[name]; !ok || isGreaterVersion(v, current) {
+			resolved[name] = v
+		}
+	}
+
+	return resolved, *lockLocation
+}
+
+// isGreaterVersion indicates if version a is greater than version b. Versions that cannot be compared are never
+// considered greater, so the first version seen is kept.
+func isGreaterVersion(a, b string) bool {
+	va, err := version.NewVersion(a)
+	if err != nil {
+		return false
+	}
+	vb, err := version.NewVersion(b)
+	if err != nil {
+		return false
+	}
+	return va.GreaterThan(vb)
+}
@@ -0,0 +1,146 @@
+package bazel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseModuleBazel(t *testing.T) {
+	fixture := "test-fixtures/module/MODULE.bazel"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "rules_go",
+			Version:   "0.41.0",
+			Locations: locations,
+			PURL:      "pkg:generic/rules_go@0.41.0",
+			Type:      pkg.BazelModulePkg,
+			Metadata: pkg.BazelModuleEntry{
+				DeclaredVersion: "0.41.0",
+				RepoName:        "io_bazel_rules_go",
+			},
+		},
+		{
+			Name:      "gazelle",
+			Version:   "0.33.0",
+			Locations: locations,
+			PURL:      "pkg:generic/gazelle@0.33.0",
+			Type:      pkg.BazelModulePkg,
+			Metadata: pkg.BazelModuleEntry{
+				DeclaredVersion: "0.33.0",
+			},
+		},
+		{
+			Name:      "googletest",
+			Version:   "1.14.0",
+			Locations: locations,
+			PURL:      "pkg:generic/googletest@1.14.0",
+			Type:      pkg.BazelModulePkg,
+			Metadata: pkg.BazelModuleEntry{
+				DeclaredVersion: "1.14.0",
+				DevDependency:   true,
+			},
+		},
+		{
+			Name:      "local_only",
+			Locations: locations,
+			PURL:      "pkg:generic/local_only",
+			Type:      pkg.BazelModulePkg,
+			Metadata:  pkg.BazelModuleEntry{},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseModuleBazel, expectedPkgs, nil)
+}
+
+func TestModuleCataloger_ResolvedVersions(t *testing.T) {
+	moduleBazel := file.NewLocation("MODULE.bazel")
+	resolved := file.NewLocationSet(moduleBazel, file.NewLocation("MODULE.bazel.lock"))
+
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []pkg.Package
+	}{
+		{
+			name:    "registry file hashes",
+			fixture: "test-fixtures/module-with-lock",
+			expected: []pkg.Package{
+				{
+					Name:      "rules_go",
+					Version:   "0.41.0",
+					FoundBy:   "bazel-module-cataloger",
+					Locations: resolved,
+					PURL:      "pkg:generic/rules_go@0.41.0",
+					Type:      pkg.BazelModulePkg,
+					Metadata:  pkg.BazelModuleEntry{DeclaredVersion: "0.41.0"},
+				},
+				{
+					// another module in the graph requires a newer version than the one declared
+					Name:      "platforms",
+					Version:   "0.0.10",
+					FoundBy:   "bazel-module-cataloger",
+					Locations: resolved,
+					PURL:      "pkg:generic/platforms@0.0.10",
+					Type:      pkg.BazelModulePkg,
+					Metadata:  pkg.BazelModuleEntry{DeclaredVersion: "0.0.7"},
+				},
+				{
+					// modules missing from the lockfile (e.g. added since it was last updated) keep the declared version
+					Name:      "rules_cc",
+					Version:   "0.0.9",
+					FoundBy:   "bazel-module-cataloger",
+					Locations: file.NewLocationSet(moduleBazel),
+					PURL:      "pkg:generic/rules_cc@0.0.9",
+					Type:      pkg.BazelModulePkg,
+					Metadata:  pkg.BazelModuleEntry{DeclaredVersion: "0.0.9", DevDependency: true},
+				},
+			},
+		},
+		{
+			name:    "module dependency graph",
+			fixture: "test-fixtures/module-with-legacy-lock",
+			expected: []pkg.Package{
+				{
+					Name:      "rules_go",
+					Version:   "0.39.1",
+					FoundBy:   "bazel-module-cataloger",
+					Locations: resolved,
+					PURL:      "pkg:generic/rules_go@0.39.1",
+					Type:      pkg.BazelModulePkg,
+					Metadata:  pkg.BazelModuleEntry{DeclaredVersion: "0.39.1"},
+				},
+				{
+					Name:      "platforms",
+					Version:   "0.0.7",
+					FoundBy:   "bazel-module-cataloger",
+					Locations: resolved,
+					PURL:      "pkg:generic/platforms@0.0.7",
+					Type:      pkg.BazelModulePkg,
+					Metadata:  pkg.BazelModuleEntry{DeclaredVersion: "0.0.5"},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				Expects(test.expected, nil).
+				TestCataloger(t, NewModuleCataloger())
+		})
+	}
+}
+
+func Test_stripComments(t *testing.T) {
+	input := "# a comment\nbazel_dep(name = \"a#b\")  # trailing\nbazel_dep(name = 'c') # 'quoted'\n"
+	expected := "\nbazel_dep(name = \"a#b\")  \nbazel_dep(name = 'c') \n"
+	assert.Equal(t, expected, stripComments(input))
+}
@@ -0,0 +1,100 @@
+package binary
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	arMagic      = "!<arch>\n"
+	arHeaderSize = 60
+)
+
+var errNotArArchive = errors.New("not an ar archive")
+
+// arMember is a single file within an ar archive, with the offset and size of the contents within the archive.
+type arMember struct {
+	Name   string
+	Offset int64
+	Size   int64
+}
+
+// readArMembers returns the members of an ar archive (in the common System V / GNU or BSD variants), excluding the
+// symbol and long name tables. Thin archives are not supported, since members are not stored within the archive.
+func readArMembers(r io.ReaderAt, size int64) ([]arMember, error) {
+	magic := make([]byte, len(arMagic))
+	if _, err := r.ReadAt(magic, 0); err != nil || string(magic) != arMagic {
+		return nil, errNotArArchive
+	}
+
+	var members []arMember
+	var longNames []byte
+	header := make([]byte, arHeaderSize)
+	for offset := int64(len(arMagic)); offset+arHeaderSize <= size; {
+		if _, err := r.ReadAt(header, offset); err != nil {
+			return nil, fmt.Errorf("unable to read ar member header: %w", err)
+		}
+		if string(header[58:60]) != "`\n" {
+			return nil, fmt.Errorf("invalid ar member header at offset %d", offset)
+		}
+		memberSize, err := strconv.ParseInt(strings.TrimSpace(string(header[48:58])), 10, 64)
+		if err != nil || memberSize < 0 || offset+arHeaderSize+memberSize > size {
+			return nil, fmt.Errorf("invalid ar member size at offset %d", offset)
+		}
+
+		m := arMember{
+			Name:   strings.TrimRight(string(header[0:16]), " "),
+			Offset: offset + arHeaderSize,
+			Size:   memberSize,
+		}
+		// member data is aligned to an even offset
+		offset = m.Offset + memberSize + memberSize%2
+
+		switch {
+		case m.Name == "/" || m.Name == "/SYM64/" || strings.HasPrefix(m.Name, "__.SYMDEF"):
+			// symbol tables
+			continue
+		case m.Name == "//":
+			// GNU long name table, referenced by later members
+			longNames = make([]byte, m.Size)
+			if _, err := r.ReadAt(longNames, m.Offset); err != nil {
+				return nil, fmt.Errorf("unable to read ar long name table: %w", err)
+			}
+			continue
+		case strings.HasPrefix(m.Name, "#1/"):
+			// BSD long name, stored at the start of the member data
+			nameLen, err := strconv.ParseInt(m.Name[3:], 10, 64)
+			if err != nil || nameLen < 0 || nameLen > m.Size {
+				return nil, fmt.Errorf("invalid ar member name %q", m.Name)
+			}
+			name := make([]byte, nameLen)
+			if _, err := r.ReadAt(name, m.Offset); err != nil {
+				return nil, fmt.Errorf("unable to read ar member name: %w", err)
+			}
+			m.Name = string(bytes.TrimRight(name, "\x00"))
+			m.Offset += nameLen
+			m.Size -= nameLen
+		case len(m.Name) > 1 && m.Name[0] == '/':
+			// GNU long name, given by the offset of the name within the long name table
+			idx, err := strconv.Atoi(m.Name[1:])
+			if err != nil || idx < 0 || idx >= len(longNames) {
+				return nil, fmt.Errorf("invalid ar member name %q", m.Name)
+			}
+			name := longNames[idx:]
+			if end := bytes.Index(name, []byte("/\n")); end >= 0 {
+				name = name[:end]
+			}
+			m.Name = string(name)
+		default:
+			// GNU names are terminated by a slash
+			m.Name = strings.TrimSuffix(m.Name, "/")
+		}
+
+		members = append(members, m)
+	}
+	return members, nil
+}
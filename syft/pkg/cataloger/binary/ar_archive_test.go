@@ -0,0 +1,67 @@
+package binary
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func arHeader(name string, size int) string {
+	return fmt.Sprintf("%-16s%-12s%-6s%-6s%-8s%-10d`\n", name, "0", "0", "0", "644", size)
+}
+
+func Test_readArMembers(t *testing.T) {
+	tests := []struct {
+		name     string
+		archive  string
+		expected []arMember
+		wantErr  bool
+	}{
+		{
+			name: "gnu archive",
+			archive: arMagic +
+				arHeader("/", 4) + "\x00\x00\x00\x00" +
+				arHeader("//", 30) + "a_really_long_object_name.o/\n\n" +
+				arHeader("hello.o/", 5) + "hello\n" +
+				arHeader("/0", 4) + "long",
+			expected: []arMember{
+				{Name: "hello.o", Offset: 222, Size: 5},
+				{Name: "a_really_long_object_name.o", Offset: 288, Size: 4},
+			},
+		},
+		{
+			name: "bsd archive",
+			archive: arMagic +
+				arHeader("__.SYMDEF SORTED", 4) + "\x00\x00\x00\x00" +
+				arHeader("#1/28", 32) + "a_really_long_object_name.o\x00long",
+			expected: []arMember{
+				{Name: "a_really_long_object_name.o", Offset: 160, Size: 4},
+			},
+		},
+		{
+			name:    "not an archive",
+			archive: "\x7fELF",
+			wantErr: true,
+		},
+		{
+			name:    "truncated member",
+			archive: arMagic + arHeader("hello.o/", 500) + "hello",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := bytes.NewReader([]byte(test.archive))
+			members, err := readArMembers(r, r.Size())
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, members)
+		})
+	}
+}
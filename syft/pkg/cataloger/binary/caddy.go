@@ -0,0 +1,186 @@
+package binary
+
+import (
+	"bytes"
+	"debug/buildinfo"
+	"runtime/debug"
+	"sort"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+const (
+	caddyModulePath = "github.com/caddyserver/caddy/v2"
+
+	// caddyModuleFuncSuffix ends the name of the method every caddy module (plugin) implements to register itself,
+	// as found in the function name table of a go binary, e.g. "github.com/caddy-dns/cloudflare.(*Provider).CaddyModule"
+	caddyModuleFuncSuffix = ".CaddyModule\x00"
+)
+
+// CaddyModulesMatcher returns an EvidenceMatcher for caddy binaries, which catalogs the go modules providing the
+// non-standard caddy modules (plugins) compiled into the binary. Since all plugins register themselves by implementing
+// the CaddyModule method, the plugins are found by the CaddyModule functions within the function name table of the
+// binary, which are then resolved to go modules by the build info of the binary. This should be used with a FileGlob
+// that selects caddy binaries by name, as all binary contents are searched.
+func CaddyModulesMatcher() EvidenceMatcher {
+	return func(resolver file.Resolver, classifier Classifier, location file.Location) ([]pkg.Package, error) {
+		contents, err := getContents(resolver, location)
+		if err != nil {
+			return nil, err
+		}
+
+		bi, err := buildinfo.Read(bytes.NewReader(contents))
+		if err != nil {
+			log.WithFields("path", location.RealPath, "error", err).Trace("unable to read go build info from caddy binary")
+			return nil, nil
+		}
+
+		var pkgs []pkg.Package
+		for _, m := range caddyPluginModules(bi, contents) {
+			pkgs = append(pkgs, newCaddyPluginPackage(classifier, m, location))
+		}
+		return pkgs, nil
+	}
+}
+
+// caddyPluginModules returns the go modules (other than caddy itself) that provide caddy modules, sorted by path. Nothing
+// is returned for binaries that are not built with caddy.
+func caddyPluginModules(bi *debug.BuildInfo, contents []byte) []debug.Module {
+	var deps []debug.Module
+	var isCaddy bool
+	for _, dep := range bi.Deps {
+		if dep.Replace != nil {
+			replaced := *dep.Replace
+			replaced.Path = dep.Path
+			dep = &replaced
+		}
+		if dep.Path == caddyModulePath {
+			isCaddy = true
+			continue
+		}
+		deps = append(deps, *dep)
+	}
+	if !isCaddy && bi.Main.Path != caddyModulePath && !strings.HasPrefix(bi.Path, caddyModulePath+"/") {
+		return nil
+	}
+
+	found := make(map[string]debug.Module)
+	for _, pkgPath := range caddyModulePackages(contents) {
+		if m, ok := moduleForPackage(deps, pkgPath); ok {
+			found[m.Path] = m
+		}
+	}
+
+	modules := make([]debug.Module, 0, len(found))
+	for _, m := range found {
+		modules = append(modules, m)
+	}
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].Path < modules[j].Path
+	})
+	return modules
+}
+
+// caddyModulePackages returns the paths of the go packages defining CaddyModule methods within the binary contents.
+func caddyModulePackages(contents []byte) []string {
+	var pkgPaths []string
+	for offset := 0; ; {
+		idx := bytes.Index(contents[offset:], []byte(caddyModuleFuncSuffix))
+		if idx < 0 {
+			return pkgPaths
+		}
+		end := offset + idx
+		offset = end + len(caddyModuleFuncSuffix)
+
+		start := bytes.LastIndexByte(contents[:end], 0) + 1
+		if pkgPath := packageOfMethod(string(contents[start:end])); pkgPath != "" {
+			pkgPaths = append(pkgPaths, pkgPath)
+		}
+	}
+}
+
+// packageOfMethod returns the package path of a method given by the name of the receiver type, for example
+// "github.com/caddy-dns/cloudflare.(*Provider)" or "github.com/caddy-dns/cloudflare.Provider".
+func packageOfMethod(receiver string) string {
+	if strings.HasSuffix(receiver, ")") {
+		if idx := strings.LastIndex(receiver, ".("); idx >= 0 {
+			receiver = receiver[:idx]
+		}
+	} else if idx := strings.LastIndex(receiver, "."); idx > strings.LastIndex(receiver, "/") {
+		receiver = receiver[:idx]
+	}
+	if !strings.Contains(receiver, "/") || strings.ContainsAny(receiver, " ()*") {
+		// standard library and main packages cannot provide a caddy plugin
+		return ""
+	}
+	// the go toolchain escapes dots within the last element of package paths
+	return strings.ReplaceAll(receiver, "%2e", ".")
+}
+
+// moduleForPackage returns the module providing the given package, which is the module with the longest path that
+// is a prefix of the package path.
+func moduleForPackage(deps []debug.Module, pkgPath string) (debug.Module, bool) {
+	var best debug.Module
+	for _, m := range deps {
+		if (pkgPath == m.Path || strings.HasPrefix(pkgPath, m.Path+"/")) && len(m.Path) > len(best.Path) {
+			best = m
+		}
+	}
+	return best, best.Path != ""
+}
+
+func newCaddyPluginPackage(classifier Classifier, m debug.Module, location file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:    m.Path,
+		Version: m.Version,
+		Locations: file.NewLocationSet(
+			location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		),
+		Type:    pkg.BinaryPkg,
+		PURL:    caddyPluginPackageURL(m),
+		FoundBy: catalogerName,
+		Metadata: pkg.BinarySignature{
+			Matches: []pkg.ClassifierMatch{
+				{
+					Classifier: classifier.Class,
+					Location:   location,
+				},
+			},
+		},
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// caddyPluginPackageURL returns the golang package URL of the module providing a caddy plugin, the same as the
+// package URL of the module cataloged from the build info of the binary.
+func caddyPluginPackageURL(m debug.Module) string {
+	fields := strings.Split(m.Path, "/")
+
+	var namespace, name, subpath string
+	switch len(fields) {
+	case 1:
+		name = fields[0]
+	case 2:
+		namespace, name = fields[0], fields[1]
+	default:
+		namespace = strings.Join(fields[0:2], "/")
+		name = fields[2]
+		subpath = strings.Join(fields[3:], "/")
+	}
+
+	return packageurl.NewPackageURL(
+		packageurl.TypeGolang,
+		namespace,
+		name,
+		m.Version,
+		nil,
+		subpath,
+	).ToString()
+}
@@ -0,0 +1,133 @@
+package binary
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/directorysource"
+)
+
+// the function name table of a caddy binary built with two plugins
+var caddyFuncNames = []byte("\x00runtime.main\x00" +
+	"github.com/caddyserver/caddy/v2/modules/caddyhttp.(*Server).CaddyModule\x00" +
+	"github.com/caddy-dns/cloudflare.Provider.CaddyModule\x00" +
+	"github.com/caddy-dns/cloudflare.(*Provider).CaddyModule\x00" +
+	"github.com/greenpau/caddy-security.(*App).CaddyModule\x00" +
+	"github.com/greenpau/caddy-security/pkg/authn.(*Middleware).CaddyModule\x00" +
+	"github.com/libdns/cloudflare.(*Provider).AppendRecords\x00")
+
+func Test_caddyPluginModules(t *testing.T) {
+	tests := []struct {
+		name      string
+		buildInfo string
+		expected  []string
+	}{
+		{
+			name: "caddy built with plugins",
+			buildInfo: `path	caddy
+mod	caddy	(devel)
+dep	github.com/caddy-dns/cloudflare	v0.0.0-20240703190432-89f16b99c18e	h1:abc=
+dep	github.com/caddyserver/caddy/v2	v2.8.4	h1:abc=
+dep	github.com/greenpau/caddy-security	v1.1.29	h1:abc=
+dep	github.com/libdns/cloudflare	v0.1.1	h1:abc=
+`,
+			expected: []string{
+				"github.com/caddy-dns/cloudflare@v0.0.0-20240703190432-89f16b99c18e",
+				"github.com/greenpau/caddy-security@v1.1.29",
+			},
+		},
+		{
+			name: "replaced plugin",
+			buildInfo: `path	caddy
+mod	caddy	(devel)
+dep	github.com/caddy-dns/cloudflare	v0.0.0-20240703190432-89f16b99c18e
+=>	github.com/example/cloudflare	v0.1.0	h1:abc=
+dep	github.com/caddyserver/caddy/v2	v2.8.4	h1:abc=
+`,
+			expected: []string{
+				"github.com/caddy-dns/cloudflare@v0.1.0",
+			},
+		},
+		{
+			name: "not built with caddy",
+			buildInfo: `path	example.com/server
+mod	example.com/server	(devel)
+dep	github.com/caddy-dns/cloudflare	v0.0.0-20240703190432-89f16b99c18e	h1:abc=
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bi, err := debug.ParseBuildInfo(tt.buildInfo)
+			require.NoError(t, err)
+
+			var actual []string
+			for _, m := range caddyPluginModules(bi, caddyFuncNames) {
+				actual = append(actual, m.Path+"@"+m.Version)
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_packageOfMethod(t *testing.T) {
+	tests := []struct {
+		receiver string
+		expected string
+	}{
+		{receiver: "github.com/caddy-dns/cloudflare.(*Provider)", expected: "github.com/caddy-dns/cloudflare"},
+		{receiver: "github.com/caddy-dns/cloudflare.Provider", expected: "github.com/caddy-dns/cloudflare"},
+		{receiver: "gopkg.in/example%2ev1.(*Module)", expected: "gopkg.in/example.v1"},
+		{receiver: "main.(*Module)", expected: ""},
+		{receiver: "go.shape.*uint8", expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.receiver, func(t *testing.T) {
+			assert.Equal(t, tt.expected, packageOfMethod(tt.receiver))
+		})
+	}
+}
+
+func Test_newCaddyPluginPackage(t *testing.T) {
+	location := file.NewLocation("/usr/bin/caddy")
+	p := newCaddyPluginPackage(Classifier{Class: "caddy-module"}, debug.Module{
+		Path:    "github.com/greenpau/caddy-security/v2",
+		Version: "v2.0.1",
+	}, location)
+
+	assert.Equal(t, "github.com/greenpau/caddy-security/v2", p.Name)
+	assert.Equal(t, "v2.0.1", p.Version)
+	assert.Equal(t, pkg.BinaryPkg, p.Type)
+	assert.Equal(t, "pkg:golang/github.com/greenpau/caddy-security@v2.0.1#v2", p.PURL)
+	assert.Equal(t, pkg.BinarySignature{
+		Matches: []pkg.ClassifierMatch{{Classifier: "caddy-module", Location: location}},
+	}, p.Metadata)
+}
+
+func TestCaddyModulesMatcher_notGoBinary(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "caddy"), caddyFuncNames, 0o600))
+
+	src, err := directorysource.NewFromPath(dir)
+	require.NoError(t, err)
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	c := NewClassifierCataloger(ClassifierCatalogerConfig{
+		Classifiers: []Classifier{
+			{Class: "caddy-module", FileGlob: "**/caddy", EvidenceMatcher: CaddyModulesMatcher()},
+		},
+	})
+	pkgs, _, err := c.Catalog(context.Background(), resolver)
+	require.NoError(t, err)
+	assert.Empty(t, pkgs)
+}
@@ -218,14 +218,6 @@ func sharedLibraryLookup(sharedLibraryPattern string, sharedLibraryMatcher Evide
 	}
 }
 
-func mustPURL(purl string) packageurl.PackageURL {
-	p, err := packageurl.FromString(purl)
-	if err != nil {
-		panic(fmt.Sprintf("invalid PURL: %s", p))
-	}
-	return p
-}
-
 func getContents(resolver file.Resolver, location file.Location) ([]byte, error) {
 	reader, err := resolver.FileContentsByLocation(location)
 	if err != nil {
@@ -246,14 +238,6 @@ func getContents(resolver file.Resolver, location file.Location) ([]byte, error)
 	return contents, nil
 }
 
-// singleCPE returns a []cpe.CPE with Source: Generated based on the cpe string or panics if the
-// cpe string cannot be parsed into valid CPE Attributes
-func singleCPE(cpeString string) []cpe.CPE {
-	return []cpe.CPE{
-		cpe.Must(cpeString, cpe.GeneratedSource),
-	}
-}
-
 // sharedLibraries returns a list of all shared libraries found within a binary, currently
 // supporting: elf, macho, and windows pe
 func sharedLibraries(resolver file.Resolver, location file.Location) ([]string, error) {
@@ -20,6 +20,7 @@ import (
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/pkg/cataloger/binary/test-fixtures/manager/testutil"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/binutils"
 	"github.com/anchore/syft/syft/source"
 	"github.com/anchore/syft/syft/source/directorysource"
 	"github.com/anchore/syft/syft/source/stereoscopesource"
@@ -1058,8 +1059,8 @@ func Test_Cataloger_CustomClassifiers(t *testing.T) {
 		EvidenceMatcher: FileContentsVersionMatcher(
 			`(?m)foobar\s(?P<version>[0-9]+\.[0-9]+\.[0-9]+)`),
 		Package: "foo",
-		PURL:    mustPURL("pkg:generic/foo@version"),
-		CPEs:    singleCPE("cpe:2.3:a:foo:foo:*:*:*:*:*:*:*:*"),
+		PURL:    binutils.MustPURL("pkg:generic/foo@version"),
+		CPEs:    binutils.SingleCPE("cpe:2.3:a:foo:foo:*:*:*:*:*:*:*:*"),
 	}
 
 	tests := []struct {
@@ -1114,8 +1115,8 @@ func Test_Cataloger_CustomClassifiers(t *testing.T) {
 						FileGlob:        "**/foo",
 						EvidenceMatcher: FileContentsVersionMatcher(`(?m)not there`),
 						Package:         "foo",
-						PURL:            mustPURL("pkg:generic/foo@version"),
-						CPEs:            singleCPE("cpe:2.3:a:foo:foo:*:*:*:*:*:*:*:*"),
+						PURL:            binutils.MustPURL("pkg:generic/foo@version"),
+						CPEs:            binutils.SingleCPE("cpe:2.3:a:foo:foo:*:*:*:*:*:*:*:*"),
 					},
 				),
 			},
@@ -2,6 +2,7 @@ package binary
 
 import (
 	"github.com/anchore/syft/syft/cpe"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/binutils"
 )
 
 //nolint:funlen
@@ -21,7 +22,7 @@ func DefaultClassifiers() []Classifier {
 					pythonVersionTemplate),
 			),
 			Package: "python",
-			PURL:    mustPURL("pkg:generic/python@version"),
+			PURL:    binutils.MustPURL("pkg:generic/python@version"),
 			CPEs: []cpe.CPE{
 				cpe.Must("cpe:2.3:a:python_software_foundation:python:*:*:*:*:*:*:*:*", cpe.GeneratedSource),
 				cpe.Must("cpe:2.3:a:python:python:*:*:*:*:*:*:*:*", cpe.GeneratedSource),
@@ -32,7 +33,7 @@ func DefaultClassifiers() []Classifier {
 			FileGlob:        "**/libpython*.so*",
 			EvidenceMatcher: libpythonMatcher,
 			Package:         "python",
-			PURL:            mustPURL("pkg:generic/python@version"),
+			PURL:            binutils.MustPURL("pkg:generic/python@version"),
 			CPEs: []cpe.CPE{
 				cpe.Must("cpe:2.3:a:python_software_foundation:python:*:*:*:*:*:*:*:*", cpe.GeneratedSource),
 				cpe.Must("cpe:2.3:a:python:python:*:*:*:*:*:*:*:*", cpe.GeneratedSource),
@@ -44,7 +45,7 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m)\[PyPy (?P<version>[0-9]+\.[0-9]+\.[0-9]+)`),
 			Package: "pypy",
-			PURL:    mustPURL("pkg:generic/pypy@version"),
+			PURL:    binutils.MustPURL("pkg:generic/pypy@version"),
 		},
 		{
 			Class:    "go-binary",
@@ -52,8 +53,8 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m)go(?P<version>[0-9]+\.[0-9]+(\.[0-9]+|beta[0-9]+|alpha[0-9]+|rc[0-9]+)?)\x00`),
 			Package: "go",
-			PURL:    mustPURL("pkg:generic/go@version"),
-			CPEs:    singleCPE("cpe:2.3:a:golang:go:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/go@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:golang:go:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "julia-binary",
@@ -61,8 +62,8 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m)__init__\x00(?P<version>[0-9]+\.[0-9]+\.[0-9]+)\x00verify`),
 			Package: "julia",
-			PURL:    mustPURL("pkg:generic/julia@version"),
-			CPEs:    singleCPE("cpe:2.3:a:julialang:julia:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/julia@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:julialang:julia:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "helm",
@@ -70,8 +71,8 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m)\x00v(?P<version>[0-9]+\.[0-9]+\.[0-9]+)\x00`),
 			Package: "helm",
-			PURL:    mustPURL("pkg:golang/helm.sh/helm@version"),
-			CPEs:    singleCPE("cpe:2.3:a:helm:helm:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:golang/helm.sh/helm@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:helm:helm:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "redis-binary",
@@ -81,8 +82,8 @@ func DefaultClassifiers() []Classifier {
 				FileContentsVersionMatcher(`(?s)\x00(?P<version>\d.\d\.\d\d*)[a-z0-9]{12}-[0-9]{19}\x00.*?payload %5`),
 			),
 			Package: "redis",
-			PURL:    mustPURL("pkg:generic/redis@version"),
-			CPEs:    singleCPE("cpe:2.3:a:redislabs:redis:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/redis@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:redislabs:redis:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "java-binary-openjdk",
@@ -101,9 +102,9 @@ func DefaultClassifiers() []Classifier {
 				"-jvmci-",
 			),
 			Package: "java/jre",
-			PURL:    mustPURL("pkg:generic/java/jre@version"),
+			PURL:    binutils.MustPURL("pkg:generic/java/jre@version"),
 			// TODO the updates might need to be part of the CPE Attributes, like: 1.8.0:update152
-			CPEs: singleCPE("cpe:2.3:a:oracle:openjdk:*:*:*:*:*:*:*:*"),
+			CPEs: binutils.SingleCPE("cpe:2.3:a:oracle:openjdk:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "java-binary-ibm",
@@ -112,8 +113,8 @@ func DefaultClassifiers() []Classifier {
 				// [NUL]java[NUL]1.8[NUL][NUL][NUL][NUL]1.8.0-foreman_2022_09_22_15_30-b00[NUL]
 				`(?m)\x00java\x00(?P<release>[0-9]+[.0-9]+)\x00{4}(?P<version>[0-9]+[-._a-zA-Z0-9]+)\x00`),
 			Package: "java/jre",
-			PURL:    mustPURL("pkg:generic/java/jre@version"),
-			CPEs:    singleCPE("cpe:2.3:a:ibm:java:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/java/jre@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:ibm:java:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "java-binary-oracle",
@@ -126,8 +127,8 @@ func DefaultClassifiers() []Classifier {
 				`\x00openjdk\x00`,
 			),
 			Package: "java/jre",
-			PURL:    mustPURL("pkg:generic/java/jre@version"),
-			CPEs:    singleCPE("cpe:2.3:a:oracle:jre:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/java/jre@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:oracle:jre:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "java-binary-graalvm",
@@ -135,8 +136,8 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m)\x00(?P<version>[0-9]+[.0-9]+[.0-9]+\+[0-9]+-jvmci-[0-9]+[.0-9]+-b[0-9]+)\x00`),
 			Package: "java/graalvm",
-			PURL:    mustPURL("pkg:generic/java/graalvm@version"),
-			CPEs:    singleCPE("cpe:2.3:a:oracle:graalvm:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/java/graalvm@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:oracle:graalvm:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "java-binary-jdk",
@@ -144,8 +145,8 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m)\x00(?P<version>[0-9]+\.[0-9]+\.[0-9]+(\+[0-9]+)?([-._a-zA-Z0-9]+)?)\x00`),
 			Package: "java/jdk",
-			PURL:    mustPURL("pkg:generic/java/jdk@version"),
-			CPEs:    singleCPE("cpe:2.3:a:oracle:jdk:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/java/jdk@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:oracle:jdk:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "nodejs-binary",
@@ -153,8 +154,8 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m)node\.js\/v(?P<version>[0-9]+\.[0-9]+\.[0-9]+)`),
 			Package: "node",
-			PURL:    mustPURL("pkg:generic/node@version"),
-			CPEs:    singleCPE("cpe:2.3:a:nodejs:node.js:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/node@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:nodejs:node.js:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "go-binary-hint",
@@ -162,7 +163,7 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m)go(?P<version>[0-9]+\.[0-9]+(\.[0-9]+|beta[0-9]+|alpha[0-9]+|rc[0-9]+)?)`),
 			Package: "go",
-			PURL:    mustPURL("pkg:generic/go@version"),
+			PURL:    binutils.MustPURL("pkg:generic/go@version"),
 		},
 		{
 			Class:    "busybox-binary",
@@ -170,8 +171,8 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m)BusyBox\s+v(?P<version>[0-9]+\.[0-9]+\.[0-9]+)`),
 			Package: "busybox",
-			PURL:    mustPURL("pkg:generic/busybox@version"),
-			CPEs:    singleCPE("cpe:2.3:a:busybox:busybox:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/busybox@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:busybox:busybox:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "haproxy-binary",
@@ -181,8 +182,8 @@ func DefaultClassifiers() []Classifier {
 				FileContentsVersionMatcher(`(?m)(?P<version>[0-9]+\.[0-9]+\.[0-9]+)-[0-9a-zA-Z]{7}.+HAProxy version`),
 			),
 			Package: "haproxy",
-			PURL:    mustPURL("pkg:generic/haproxy@version"),
-			CPEs:    singleCPE("cpe:2.3:a:haproxy:haproxy:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/haproxy@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:haproxy:haproxy:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "perl-binary",
@@ -190,8 +191,8 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m)\/usr\/local\/lib\/perl\d\/(?P<version>[0-9]+\.[0-9]+\.[0-9]+)`),
 			Package: "perl",
-			PURL:    mustPURL("pkg:generic/perl@version"),
-			CPEs:    singleCPE("cpe:2.3:a:perl:perl:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/perl@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:perl:perl:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "php-cli-binary",
@@ -200,8 +201,8 @@ func DefaultClassifiers() []Classifier {
 				`(.*/|^)php[0-9]*$`,
 				`(?m)X-Powered-By: PHP\/(?P<version>[0-9]+\.[0-9]+\.[0-9]+(beta[0-9]+|alpha[0-9]+|RC[0-9]+)?)`),
 			Package: "php-cli",
-			PURL:    mustPURL("pkg:generic/php-cli@version"),
-			CPEs:    singleCPE("cpe:2.3:a:php:php:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/php-cli@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:php:php:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "php-fpm-binary",
@@ -209,8 +210,8 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m)X-Powered-By: PHP\/(?P<version>[0-9]+\.[0-9]+\.[0-9]+(beta[0-9]+|alpha[0-9]+|RC[0-9]+)?)`),
 			Package: "php-fpm",
-			PURL:    mustPURL("pkg:generic/php-fpm@version"),
-			CPEs:    singleCPE("cpe:2.3:a:php:php:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/php-fpm@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:php:php:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "php-apache-binary",
@@ -218,8 +219,8 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m)X-Powered-By: PHP\/(?P<version>[0-9]+\.[0-9]+\.[0-9]+(beta[0-9]+|alpha[0-9]+|RC[0-9]+)?)`),
 			Package: "libphp",
-			PURL:    mustPURL("pkg:generic/php@version"),
-			CPEs:    singleCPE("cpe:2.3:a:php:php:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/php@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:php:php:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "php-composer-binary",
@@ -227,8 +228,8 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m)'pretty_version'\s*=>\s*'(?P<version>[0-9]+\.[0-9]+\.[0-9]+(beta[0-9]+|alpha[0-9]+|RC[0-9]+)?)'`),
 			Package: "composer",
-			PURL:    mustPURL("pkg:generic/composer@version"),
-			CPEs:    singleCPE("cpe:2.3:a:getcomposer:composer:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/composer@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:getcomposer:composer:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "httpd-binary",
@@ -236,8 +237,8 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m)Apache\/(?P<version>[0-9]+\.[0-9]+\.[0-9]+)`),
 			Package: "httpd",
-			PURL:    mustPURL("pkg:generic/httpd@version"),
-			CPEs:    singleCPE("cpe:2.3:a:apache:http_server:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/httpd@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:apache:http_server:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "memcached-binary",
@@ -245,7 +246,7 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m)memcached\s(?P<version>[0-9]+\.[0-9]+\.[0-9]+)`),
 			Package: "memcached",
-			PURL:    mustPURL("pkg:generic/memcached@version"),
+			PURL:    binutils.MustPURL("pkg:generic/memcached@version"),
 		},
 		{
 			Class:    "traefik-binary",
@@ -255,7 +256,7 @@ func DefaultClassifiers() []Classifier {
 				// [NUL]2.9.6[NUL]
 				`(?m)(\x00|\x{FFFD})v?(?P<version>[0-9]+\.[0-9]+\.[0-9]+(-alpha[0-9]|-beta[0-9]|-rc[0-9])?)\x00`),
 			Package: "traefik",
-			PURL:    mustPURL("pkg:generic/traefik@version"),
+			PURL:    binutils.MustPURL("pkg:generic/traefik@version"),
 		},
 		{
 			Class:    "postgresql-binary",
@@ -267,7 +268,7 @@ func DefaultClassifiers() []Classifier {
 				// ?PostgreSQL 9.5alpha1
 				`(?m)(\x00|\?)PostgreSQL (?P<version>[0-9]+(\.[0-9]+)?(\.[0-9]+)?(alpha[0-9]|beta[0-9]|rc[0-9])?)`),
 			Package: "postgresql",
-			PURL:    mustPURL("pkg:generic/postgresql@version"),
+			PURL:    binutils.MustPURL("pkg:generic/postgresql@version"),
 		},
 		{
 			Class:    "mysql-binary",
@@ -275,8 +276,8 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m).*/mysql-(?P<version>[0-9]+(\.[0-9]+)?(\.[0-9]+)?(alpha[0-9]|beta[0-9]|rc[0-9])?)`),
 			Package: "mysql",
-			PURL:    mustPURL("pkg:generic/mysql@version"),
-			CPEs:    singleCPE("cpe:2.3:a:oracle:mysql:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/mysql@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:oracle:mysql:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "mysql-binary",
@@ -284,7 +285,7 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m).*/percona-server-(?P<version>[0-9]+(\.[0-9]+)?(\.[0-9]+)?(alpha[0-9]|beta[0-9]|rc[0-9])?)`),
 			Package: "percona-server",
-			PURL:    mustPURL("pkg:generic/percona-server@version"),
+			PURL:    binutils.MustPURL("pkg:generic/percona-server@version"),
 			CPEs: []cpe.CPE{
 				cpe.Must("cpe:2.3:a:oracle:mysql:*:*:*:*:*:*:*:*", cpe.GeneratedSource),
 				cpe.Must("cpe:2.3:a:percona:percona_server:*:*:*:*:*:*:*:*", cpe.GeneratedSource),
@@ -296,7 +297,7 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m).*/Percona-XtraDB-Cluster-(?P<version>[0-9]+(\.[0-9]+)?(\.[0-9]+)?(alpha[0-9]|beta[0-9]|rc[0-9])?)`),
 			Package: "percona-xtradb-cluster",
-			PURL:    mustPURL("pkg:generic/percona-xtradb-cluster@version"),
+			PURL:    binutils.MustPURL("pkg:generic/percona-xtradb-cluster@version"),
 			CPEs: []cpe.CPE{
 				cpe.Must("cpe:2.3:a:oracle:mysql:*:*:*:*:*:*:*:*", cpe.GeneratedSource),
 				cpe.Must("cpe:2.3:a:percona:percona_server:*:*:*:*:*:*:*:*", cpe.GeneratedSource),
@@ -309,8 +310,8 @@ func DefaultClassifiers() []Classifier {
 			EvidenceMatcher: FileContentsVersionMatcher(
 				`(?m).*/percona-xtrabackup-(?P<version>[0-9]+(\.[0-9]+)?(\.[0-9]+)?(alpha[0-9]|beta[0-9]|rc[0-9])?)`),
 			Package: "percona-xtrabackup",
-			PURL:    mustPURL("pkg:generic/percona-xtrabackup@version"),
-			CPEs:    singleCPE("cpe:2.3:a:percona:xtrabackup:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/percona-xtrabackup@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:percona:xtrabackup:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "mariadb-binary",
@@ -319,7 +320,7 @@ func DefaultClassifiers() []Classifier {
 				// 10.6.15-MariaDB
 				`(?m)(?P<version>[0-9]+(\.[0-9]+)?(\.[0-9]+)?(alpha[0-9]|beta[0-9]|rc[0-9])?)-MariaDB`),
 			Package: "mariadb",
-			PURL:    mustPURL("pkg:generic/mariadb@version"),
+			PURL:    binutils.MustPURL("pkg:generic/mariadb@version"),
 		},
 		{
 			Class:    "rust-standard-library-linux",
@@ -328,8 +329,8 @@ func DefaultClassifiers() []Classifier {
 				// clang LLVM (rustc version 1.48.0 (7eac88abb 2020-11-16))
 				`(?m)(\x00)clang LLVM \(rustc version (?P<version>[0-9]+(\.[0-9]+)?(\.[0-9]+)) \(\w+ \d{4}\-\d{2}\-\d{2}\)`),
 			Package: "rust",
-			PURL:    mustPURL("pkg:generic/rust@version"),
-			CPEs:    singleCPE("cpe:2.3:a:rust-lang:rust:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/rust@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:rust-lang:rust:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "rust-standard-library-macos",
@@ -338,8 +339,8 @@ func DefaultClassifiers() []Classifier {
 				// c 1.48.0 (7eac88abb 2020-11-16)
 				`(?m)c (?P<version>[0-9]+(\.[0-9]+)?(\.[0-9]+)) \(\w+ \d{4}\-\d{2}\-\d{2}\)`),
 			Package: "rust",
-			PURL:    mustPURL("pkg:generic/rust@version"),
-			CPEs:    singleCPE("cpe:2.3:a:rust-lang:rust:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/rust@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:rust-lang:rust:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "ruby-binary",
@@ -352,8 +353,8 @@ func DefaultClassifiers() []Classifier {
 					rubyMatcher),
 			),
 			Package: "ruby",
-			PURL:    mustPURL("pkg:generic/ruby@version"),
-			CPEs:    singleCPE("cpe:2.3:a:ruby-lang:ruby:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/ruby@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:ruby-lang:ruby:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "erlang-binary",
@@ -369,8 +370,8 @@ func DefaultClassifiers() []Classifier {
 				),
 			),
 			Package: "erlang",
-			PURL:    mustPURL("pkg:generic/erlang@version"),
-			CPEs:    singleCPE("cpe:2.3:a:erlang:erlang\\/otp:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/erlang@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:erlang:erlang\\/otp:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "consul-binary",
@@ -380,8 +381,8 @@ func DefaultClassifiers() []Classifier {
 				`CONSUL_VERSION: (?P<version>\d+\.\d+\.\d+)`,
 			),
 			Package: "consul",
-			PURL:    mustPURL("pkg:golang/github.com/hashicorp/consul@version"),
-			CPEs:    singleCPE("cpe:2.3:a:hashicorp:consul:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:golang/github.com/hashicorp/consul@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:hashicorp:consul:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "nginx-binary",
@@ -392,7 +393,7 @@ func DefaultClassifiers() []Classifier {
 				`(?m)(\x00|\?)nginx version: [^\/]+\/(?P<version>[0-9]+\.[0-9]+\.[0-9]+(?:\+\d+)?(?:-\d+)?)`,
 			),
 			Package: "nginx",
-			PURL:    mustPURL("pkg:generic/nginx@version"),
+			PURL:    binutils.MustPURL("pkg:generic/nginx@version"),
 			CPEs: []cpe.CPE{
 				cpe.Must("cpe:2.3:a:f5:nginx:*:*:*:*:*:*:*:*", cpe.GeneratedSource),
 				cpe.Must("cpe:2.3:a:nginx:nginx:*:*:*:*:*:*:*:*", cpe.GeneratedSource),
@@ -409,8 +410,8 @@ func DefaultClassifiers() []Classifier {
 				`(?m)@\(#\)Bash version (?P<version>[0-9]+\.[0-9]+\.[0-9]+)\([0-9]\) [a-z0-9]+ GNU`,
 			),
 			Package: "bash",
-			PURL:    mustPURL("pkg:generic/bash@version"),
-			CPEs:    singleCPE("cpe:2.3:a:gnu:bash:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/bash@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:gnu:bash:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "openssl-binary",
@@ -421,8 +422,8 @@ func DefaultClassifiers() []Classifier {
 				`\x00OpenSSL (?P<version>[0-9]+\.[0-9]+\.[0-9]+([a-z]|-alpha[0-9]|-beta[0-9]|-rc[0-9])?)`,
 			),
 			Package: "openssl",
-			PURL:    mustPURL("pkg:generic/openssl@version"),
-			CPEs:    singleCPE("cpe:2.3:a:openssl:openssl:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/openssl@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:openssl:openssl:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "gcc-binary",
@@ -432,8 +433,8 @@ func DefaultClassifiers() []Classifier {
 				`GCC: \(GNU\) (?P<version>[0-9]+\.[0-9]+\.[0-9]+)`,
 			),
 			Package: "gcc",
-			PURL:    mustPURL("pkg:generic/gcc@version"),
-			CPEs:    singleCPE("cpe:2.3:a:gnu:gcc:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/gcc@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:gnu:gcc:*:*:*:*:*:*:*:*"),
 		},
 		{
 			Class:    "wordpress-cli-binary",
@@ -443,8 +444,15 @@ func DefaultClassifiers() []Classifier {
 				`(?m)wp-cli/wp-cli (?P<version>[0-9]+\.[0-9]+\.[0-9]+)`,
 			),
 			Package: "wp-cli",
-			PURL:    mustPURL("pkg:generic/wp-cli@version"),
-			CPEs:    singleCPE("cpe:2.3:a:wp-cli:wp-cli:*:*:*:*:*:*:*:*"),
+			PURL:    binutils.MustPURL("pkg:generic/wp-cli@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:wp-cli:wp-cli:*:*:*:*:*:*:*:*"),
+		},
+		{
+			// a package is cataloged for each go module providing caddy plugins (the package name and PURL are
+			// derived from the module)
+			Class:           "caddy-module",
+			FileGlob:        "**/caddy",
+			EvidenceMatcher: CaddyModulesMatcher(),
 		},
 	}
 }
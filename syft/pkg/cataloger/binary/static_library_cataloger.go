@@ -0,0 +1,137 @@
+package binary
+
+import (
+	"context"
+	"debug/elf"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewStaticLibraryCataloger returns a new cataloger object for static libraries (ar archives of ELF object files),
+// which captures the toolchains used to compile the objects and any object build IDs.
+func NewStaticLibraryCataloger() pkg.Cataloger {
+	return generic.NewCataloger("elf-static-library-cataloger").
+		WithParserByGlobs(parseStaticLibrary, "**/*.a")
+}
+
+func parseStaticLibrary(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	unionReader, err := unionreader.GetUnionReader(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get union reader for static library: %w", err)
+	}
+
+	size, err := unionReader.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to determine static library size: %w", err)
+	}
+
+	members, err := readArMembers(unionReader, size)
+	if err != nil {
+		log.WithFields("path", reader.RealPath, "error", err).Trace("unable to read static library as an ar archive")
+		return nil, nil, nil
+	}
+
+	metadata := staticLibraryMetadata(unionReader, members)
+	if len(metadata.Objects) == 0 {
+		// not a static library of ELF objects (e.g. a Mach-O static library)
+		return nil, nil, nil
+	}
+
+	return []pkg.Package{newStaticLibraryPackage(metadata, reader.Location)}, nil, nil
+}
+
+func staticLibraryMetadata(r io.ReaderAt, members []arMember) pkg.ELFStaticLibrary {
+	var metadata pkg.ELFStaticLibrary
+	toolchains := make(map[string]struct{})
+	for _, m := range members {
+		f, err := elf.NewFile(io.NewSectionReader(r, m.Offset, m.Size))
+		if err != nil {
+			continue
+		}
+
+		for _, t := range elfComments(f) {
+			toolchains[t] = struct{}{}
+		}
+		metadata.Objects = append(metadata.Objects, pkg.ELFStaticLibraryObject{
+			Name:    m.Name,
+			BuildID: elfBuildID(f),
+		})
+	}
+
+	for t := range toolchains {
+		metadata.Toolchains = append(metadata.Toolchains, t)
+	}
+	sort.Strings(metadata.Toolchains)
+	return metadata
+}
+
+// elfComments returns the null-separated strings within the .comment section, which compilers (and other tools such
+// as linkers and assemblers) use to identify themselves.
+func elfComments(f *elf.File) []string {
+	section := f.Section(".comment")
+	if section == nil {
+		return nil
+	}
+	data, err := section.Data()
+	if err != nil {
+		return nil
+	}
+
+	var comments []string
+	for _, c := range strings.Split(string(data), "\x00") {
+		if c = strings.TrimSpace(c); c != "" {
+			comments = append(comments, c)
+		}
+	}
+	return comments
+}
+
+// elfBuildID returns the hex-encoded descriptor of the GNU build ID note.
+func elfBuildID(f *elf.File) string {
+	section := f.Section(".note.gnu.build-id")
+	if section == nil {
+		return ""
+	}
+	data, err := section.Data()
+	if err != nil || len(data) < 12 {
+		return ""
+	}
+
+	// the note is made up of the name size, descriptor size, and type, followed by the name and descriptor (each
+	// padded to 4 bytes)
+	nameSize := int(f.ByteOrder.Uint32(data[0:4]))
+	descSize := int(f.ByteOrder.Uint32(data[4:8]))
+	descOffset := 12 + (nameSize+3)/4*4
+	if descSize == 0 || descOffset+descSize > len(data) {
+		return ""
+	}
+	return hex.EncodeToString(data[descOffset : descOffset+descSize])
+}
+
+func newStaticLibraryPackage(metadata pkg.ELFStaticLibrary, location file.Location) pkg.Package {
+	name := strings.TrimSuffix(path.Base(location.RealPath), ".a")
+
+	p := pkg.Package{
+		Name:      name,
+		Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+		Type:      pkg.BinaryPkg,
+		PURL:      packageurl.NewPackageURL(packageurl.TypeGeneric, "", name, "", nil, "").ToString(),
+		Metadata:  metadata,
+	}
+
+	p.SetID()
+
+	return p
+}
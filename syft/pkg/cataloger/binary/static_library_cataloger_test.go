@@ -0,0 +1,37 @@
+package binary
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseStaticLibrary(t *testing.T) {
+	// the fixture is an archive of two objects compiled with gcc (one given a build ID note with objcopy, and one with
+	// a name requiring the GNU long name table) and a non-object member
+	fixture := "test-fixtures/static-library/libhello.a"
+
+	expected := []pkg.Package{
+		{
+			Name:      "libhello",
+			PURL:      "pkg:generic/libhello",
+			Type:      pkg.BinaryPkg,
+			Locations: file.NewLocationSet(file.NewLocation(fixture)),
+			Metadata: pkg.ELFStaticLibrary{
+				Toolchains: []string{"GCC: (Debian 12.2.0-14+deb12u1) 12.2.0"},
+				Objects: []pkg.ELFStaticLibraryObject{
+					{Name: "hello.o", BuildID: "4f3c2a1b0e9d8c7b6a5948372615f4e3d2c1b0a9"},
+					{Name: "a_really_long_object_name.o"},
+				},
+			},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseStaticLibrary, expected, nil)
+}
+
+func TestParseStaticLibrary_notArchive(t *testing.T) {
+	pkgtest.TestFileParser(t, "test-fixtures/static-library/README.md", parseStaticLibrary, nil, nil)
+}
@@ -8,15 +8,41 @@ import (
 	"github.com/anchore/syft/syft/pkg/cataloger/generic"
 )
 
-// NewConanCataloger returns a new C/C++ conanfile.txt and conan.lock cataloger object.
+// NewConanCataloger returns a new C/C++ conanfile.txt, conanfile.py, and conan.lock cataloger object.
 func NewConanCataloger() pkg.Cataloger {
 	return generic.NewCataloger("conan-cataloger").
 		WithParserByGlobs(parseConanfile, "**/conanfile.txt").
-		WithParserByGlobs(parseConanLock, "**/conan.lock")
+		WithParserByGlobs(parseConanfilePy, "**/conanfile.py").
+		WithParserByGlobs(parseConanLock, "**/conan.lock").
+		WithProcessors(preferLockedPackages)
 }
 
+// preferLockedPackages drops packages required by conanfile.txt and conanfile.py files when the same package is
+// locked by a conan.lock file within the same directory, since the lockfile records the exact reference used.
+var preferLockedPackages = generic.PreferLockedPackages(
+	func(p pkg.Package) []string {
+		switch p.Metadata.(type) {
+		case pkg.ConanV1LockEntry, pkg.ConanV2LockEntry:
+			return []string{p.Name}
+		}
+		return nil
+	},
+	func(p pkg.Package) string {
+		if _, ok := p.Metadata.(pkg.ConanfileEntry); ok {
+			return p.Name
+		}
+		return ""
+	},
+)
+
 // NewConanInfoCataloger returns a new C/C++ conaninfo.txt cataloger object.
 func NewConanInfoCataloger() pkg.Cataloger {
 	return generic.NewCataloger("conan-info-cataloger").
 		WithParserByGlobs(parseConaninfo, "**/conaninfo.txt")
 }
+
+// NewVcpkgCataloger returns a new C/C++ vcpkg.json manifest cataloger object.
+func NewVcpkgCataloger() pkg.Cataloger {
+	return generic.NewCataloger("vcpkg-cataloger").
+		WithParserByGlobs(parseVcpkgManifest, "**/vcpkg.json")
+}
@@ -3,6 +3,8 @@ package cpp
 import (
 	"testing"
 
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
 )
 
@@ -17,6 +19,7 @@ func TestCataloger_Globs(t *testing.T) {
 			fixture: "test-fixtures/glob-paths",
 			expected: []string{
 				"somewhere/src/conanfile.txt",
+				"somewhere/src/conanfile.py",
 				"somewhere/src/conan.lock",
 			},
 		},
@@ -56,3 +59,67 @@ func TestCatalogerInfo_Globs(t *testing.T) {
 		})
 	}
 }
+
+func TestConanCataloger_PrefersLockedPackages(t *testing.T) {
+	lockLocation := file.NewLocation("conan.lock")
+	conanfileLocation := file.NewLocation("conanfile.txt")
+
+	expected := []pkg.Package{
+		{
+			Name:      "fmt",
+			Version:   "10.1.1",
+			PURL:      "pkg:conan/fmt@10.1.1",
+			FoundBy:   "conan-cataloger",
+			Locations: file.NewLocationSet(conanfileLocation),
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanfileEntry{
+				Ref: "fmt/10.1.1",
+			},
+		},
+		{
+			Name:      "zlib",
+			Version:   "1.2.13",
+			PURL:      "pkg:conan/zlib@1.2.13",
+			FoundBy:   "conan-cataloger",
+			Locations: file.NewLocationSet(lockLocation),
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanV2LockEntry{
+				Ref:            "zlib/1.2.13#97d5730b529b4224045fe7090592d4c1%1692672717.049",
+				RecipeRevision: "97d5730b529b4224045fe7090592d4c1",
+				TimeStamp:      "1692672717.049",
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/conan-locked").
+		Expects(expected, nil).
+		TestCataloger(t, NewConanCataloger())
+}
+
+func TestVcpkgCataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain vcpkg files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"somewhere/src/vcpkg.json",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewVcpkgCataloger())
+		})
+	}
+}
@@ -120,3 +120,30 @@ func packageURL(ref *conanRef) string {
 		"",
 	).ToString()
 }
+
+func newVcpkgPackage(name, version string, metadata pkg.VcpkgManifestEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      vcpkgPackageURL(name, version),
+		Language:  pkg.CPP,
+		Type:      pkg.VcpkgPkg,
+		Metadata:  metadata,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func vcpkgPackageURL(name, version string) string {
+	return packageurl.NewPackageURL(
+		pkg.VcpkgPkg.PackageURLType(),
+		"",
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
@@ -0,0 +1,93 @@
+package cpp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseConanfilePy
+
+var (
+	// conanfilePyRequiresAttrExp matches the "requires" attribute of a conanfile.py recipe, which is either a single
+	// reference or a tuple (or list) of references, for example:
+	//	requires = "zlib/1.2.13", "fmt/10.1.1"
+	//	requires = ("zlib/1.2.13",
+	//	            "fmt/10.1.1")
+	conanfilePyRequiresAttrExp = regexp.MustCompile(`(?m)^\s*requires\s*=\s*(\([^)]*\)|\[[^\]]*]|(?:["'][^"'\n]*["']\s*,?\s*)+)`)
+
+	// conanfilePyRequiresCallExp matches the requirements added within the requirements() method of a conanfile.py
+	// recipe, for example: self.requires("boost/1.83.0", transitive_headers=True)
+	conanfilePyRequiresCallExp = regexp.MustCompile(`self\.requires\(\s*["']([^"']+)["']`)
+
+	conanfilePyStringExp = regexp.MustCompile(`["']([^"']+)["']`)
+)
+
+// parseConanfilePy is a parser function for (conan 2) conanfile.py recipes, returning the packages required by the
+// "requires" attribute or the requirements() method. Note that the recipe is not evaluated, so only references given
+// as string literals with a fixed version are found (version ranges and references built at runtime are skipped).
+func parseConanfilePy(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read conanfile.py file: %w", err)
+	}
+	source := stripPythonCommentLines(string(contents))
+
+	var refs []string
+	for _, match := range conanfilePyRequiresAttrExp.FindAllStringSubmatch(source, -1) {
+		for _, s := range conanfilePyStringExp.FindAllStringSubmatch(match[1], -1) {
+			refs = append(refs, s[1])
+		}
+	}
+	for _, match := range conanfilePyRequiresCallExp.FindAllStringSubmatch(source, -1) {
+		refs = append(refs, match[1])
+	}
+
+	var pkgs []pkg.Package
+	seen := strset.New()
+	for _, ref := range refs {
+		ref = strings.TrimSpace(ref)
+		if seen.Has(ref) {
+			continue
+		}
+		seen.Add(ref)
+
+		if strings.ContainsAny(ref, "{}[]$") {
+			log.WithFields("path", reader.RealPath, "ref", ref).Trace("skipping conan reference without a fixed version")
+			continue
+		}
+
+		p := newConanfilePackage(
+			pkg.ConanfileEntry{Ref: ref},
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		)
+		if p == nil {
+			continue
+		}
+		pkgs = append(pkgs, *p)
+	}
+
+	return pkgs, nil, nil
+}
+
+// stripPythonCommentLines removes the lines that are entirely comments. Trailing comments are kept, since a "#" may
+// be part of a conan reference (the recipe revision).
+func stripPythonCommentLines(source string) string {
+	lines := strings.Split(source, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			lines[i] = ""
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,62 @@
+package cpp
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseConanfilePy(t *testing.T) {
+	fixture := "test-fixtures/conanfile.py"
+	fixtureLocationSet := file.NewLocationSet(file.NewLocation(fixture))
+	expected := []pkg.Package{
+		{
+			Name:      "zlib",
+			Version:   "1.2.13",
+			PURL:      "pkg:conan/zlib@1.2.13",
+			Locations: fixtureLocationSet,
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanfileEntry{
+				Ref: "zlib/1.2.13",
+			},
+		},
+		{
+			Name:      "openssl",
+			Version:   "3.1.2",
+			PURL:      "pkg:conan/my_user/openssl@3.1.2?channel=my_channel",
+			Locations: fixtureLocationSet,
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanfileEntry{
+				Ref: "openssl/3.1.2@my_user/my_channel",
+			},
+		},
+		{
+			Name:      "boost",
+			Version:   "1.83.0",
+			PURL:      "pkg:conan/boost@1.83.0",
+			Locations: fixtureLocationSet,
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanfileEntry{
+				Ref: "boost/1.83.0",
+			},
+		},
+		{
+			Name:      "fmt",
+			Version:   "10.1.1",
+			PURL:      "pkg:conan/fmt@10.1.1",
+			Locations: fixtureLocationSet,
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanfileEntry{
+				Ref: "fmt/10.1.1#9b9f2b7d5bd7d5ad3ddde4e1d8e1ff49",
+			},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseConanfilePy, expected, nil)
+}
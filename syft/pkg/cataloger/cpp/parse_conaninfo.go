@@ -67,6 +67,16 @@ func parseFullRequiresLine(line string, reader file.LocationReadCloser, pkgs *[]
 	}
 }
 
+// parseConaninfoKeyValue parses a "key=value" line of the settings or options sections.
+func parseConaninfoKeyValue(line string) (pkg.KeyValue, bool) {
+	key, value, ok := strings.Cut(line, "=")
+	key = strings.TrimSpace(key)
+	if !ok || key == "" {
+		return pkg.KeyValue{}, false
+	}
+	return pkg.KeyValue{Key: key, Value: strings.TrimSpace(value)}, true
+}
+
 // parseConaninfo is a parser function for conaninfo.txt contents, returning all packages discovered.
 // The conaninfo.txt file is typically present for an installed conan package under:
 // $HOME/.conan/data/<pkg-name>/<pkg-version>/<user>/<channel>/package/<package_id>/conaninfo.txt
@@ -79,6 +89,7 @@ func parseFullRequiresLine(line string, reader file.LocationReadCloser, pkgs *[]
 // The conaninfo.txt gives:
 // - package requires (full_requires)
 // - recipe revision (recipe_hash)
+// - settings and options the package was built with (settings, options)
 func parseConaninfo(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
 	// First set the base package info by checking the relative path
 	fullFilePath := string(reader.Location.LocationData.Reference().RealPath)
@@ -92,8 +103,7 @@ func parseConaninfo(_ context.Context, _ file.Resolver, _ *generic.Environment,
 	}
 
 	r := bufio.NewReader(reader)
-	inRequirements := false
-	inRecipeHash := false
+	section := ""
 	var pkgs []pkg.Package
 
 	for {
@@ -115,28 +125,33 @@ func parseConaninfo(_ context.Context, _ file.Resolver, _ *generic.Environment,
 			return nil, nil, fmt.Errorf("failed to parse conaninfo.txt file: %w", err)
 		}
 
+		line = strings.Trim(line, "\n\r ")
 		switch {
-		case strings.Contains(line, "[full_requires]"):
-			inRequirements = true
-			inRecipeHash = false
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = strings.TrimSpace(line[1 : len(line)-1])
 			continue
-		case strings.Contains(line, "[recipe_hash]"):
-			inRequirements = false
-			inRecipeHash = true
+		case strings.ContainsAny(line, "[]") || strings.HasPrefix(line, "#"):
+			section = ""
 			continue
-		case strings.ContainsAny(line, "[]") || strings.HasPrefix(strings.TrimSpace(line), "#"):
-			inRequirements = false
-			inRecipeHash = false
+		case line == "":
 			continue
 		}
 
-		if inRequirements {
-			parseFullRequiresLine(strings.Trim(line, "\n "), reader, &pkgs)
-		}
-		if inRecipeHash {
+		switch section {
+		case "full_requires":
+			parseFullRequiresLine(line, reader, &pkgs)
+		case "recipe_hash":
 			// add recipe hash to the metadata ref
-			mainMetadata.Ref = mainMetadata.Ref + "#" + strings.Trim(line, "\n ")
-			inRecipeHash = false
+			mainMetadata.Ref = mainMetadata.Ref + "#" + line
+			section = ""
+		case "settings":
+			if kv, ok := parseConaninfoKeyValue(line); ok {
+				mainMetadata.Settings = append(mainMetadata.Settings, kv)
+			}
+		case "options":
+			if kv, ok := parseConaninfoKeyValue(line); ok {
+				mainMetadata.Options = append(mainMetadata.Options, kv)
+			}
 		}
 	}
 }
@@ -22,6 +22,19 @@ func TestParseConaninfo(t *testing.T) {
 			Metadata: pkg.ConaninfoEntry{
 				Ref:       "mfast/1.2.2@my_user/my_channel#c6f6387c9b99780f0ee05e25f99d0f39",
 				PackageID: "9d1f076b471417647c2022a78d5e2c1f834289ac",
+				Settings: pkg.KeyValues{
+					{Key: "arch", Value: "x86_64"},
+					{Key: "build_type", Value: "Release"},
+					{Key: "compiler", Value: "gcc"},
+					{Key: "compiler.libcxx", Value: "libstdc++11"},
+					{Key: "compiler.version", Value: "11"},
+					{Key: "os", Value: "Linux"},
+				},
+				Options: pkg.KeyValues{
+					{Key: "fPIC", Value: "True"},
+					{Key: "shared", Value: "False"},
+					{Key: "with_sqlite3", Value: "False"},
+				},
 			},
 		},
 		{
@@ -0,0 +1,137 @@
+package cpp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseVcpkgManifest
+
+// vcpkgManifest represents the contents of a vcpkg.json manifest (see https://learn.microsoft.com/en-us/vcpkg/reference/vcpkg-json).
+type vcpkgManifest struct {
+	Name         string            `json:"name"`
+	Dependencies []json.RawMessage `json:"dependencies"`
+	Overrides    []vcpkgVersion    `json:"overrides"`
+}
+
+// vcpkgDependency is a dependency given as an object, where a dependency may also be given as just the port name.
+type vcpkgDependency struct {
+	Name       string            `json:"name"`
+	MinVersion string            `json:"version>="`
+	Features   []json.RawMessage `json:"features"`
+	Host       bool              `json:"host"`
+	Platform   string            `json:"platform"`
+}
+
+// vcpkgVersion is an override of the version of a port, which is given by one of the version fields (depending on
+// the versioning scheme of the port).
+type vcpkgVersion struct {
+	Name          string `json:"name"`
+	Version       string `json:"version"`
+	VersionSemver string `json:"version-semver"`
+	VersionDate   string `json:"version-date"`
+	VersionString string `json:"version-string"`
+	PortVersion   int    `json:"port-version"`
+}
+
+// parseVcpkgManifest is a parser function for vcpkg.json manifest contents, returning the ports declared as
+// dependencies. The version of a port is only known when pinned by an override, since the version used otherwise
+// depends on the baseline of the registry.
+func parseVcpkgManifest(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	if isVcpkgPortManifest(reader.RealPath) {
+		// the manifests of ports (within the ports directory of a registry) describe the port itself
+		return nil, nil, nil
+	}
+
+	var manifest vcpkgManifest
+	if err := json.NewDecoder(reader).Decode(&manifest); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse vcpkg.json file: %w", err)
+	}
+
+	overrides := make(map[string]string)
+	for _, o := range manifest.Overrides {
+		if v := o.version(); o.Name != "" && v != "" {
+			overrides[o.Name] = v
+		}
+	}
+
+	var pkgs []pkg.Package
+	seen := make(map[string]bool)
+	for _, raw := range manifest.Dependencies {
+		dep, err := parseVcpkgDependency(raw)
+		if err != nil {
+			log.WithFields("path", reader.RealPath, "error", err).Debug("unable to parse vcpkg dependency")
+			continue
+		}
+		if dep.Name == "" || seen[dep.Name] {
+			continue
+		}
+		seen[dep.Name] = true
+
+		pkgs = append(pkgs, newVcpkgPackage(dep.Name, overrides[dep.Name], dep.metadata(), reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)))
+	}
+
+	return pkgs, nil, nil
+}
+
+func parseVcpkgDependency(raw json.RawMessage) (vcpkgDependency, error) {
+	var name string
+	if err := json.Unmarshal(raw, &name); err == nil {
+		return vcpkgDependency{Name: name}, nil
+	}
+	var dep vcpkgDependency
+	err := json.Unmarshal(raw, &dep)
+	return dep, err
+}
+
+func (d vcpkgDependency) metadata() pkg.VcpkgManifestEntry {
+	var features []string
+	for _, raw := range d.Features {
+		// features are given by name, or as an object with a name and platform expression
+		var feature struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(raw, &feature.Name); err != nil {
+			_ = json.Unmarshal(raw, &feature)
+		}
+		if feature.Name != "" {
+			features = append(features, feature.Name)
+		}
+	}
+
+	return pkg.VcpkgManifestEntry{
+		VersionConstraint: d.MinVersion,
+		Features:          features,
+		Host:              d.Host,
+		Platform:          d.Platform,
+	}
+}
+
+// version returns the version of the override, where a port version (a revision of the port itself) is given as a
+// "#" suffix (as done by vcpkg).
+func (v vcpkgVersion) version() string {
+	var version string
+	for _, candidate := range []string{v.Version, v.VersionSemver, v.VersionDate, v.VersionString} {
+		if candidate != "" {
+			version = candidate
+			break
+		}
+	}
+	if version != "" && v.PortVersion > 0 {
+		version = fmt.Sprintf("%s#%d", version, v.PortVersion)
+	}
+	return version
+}
+
+// isVcpkgPortManifest indicates if the given manifest is that of a port within a registry (ports/<name>/vcpkg.json).
+func isVcpkgPortManifest(p string) bool {
+	return path.Base(path.Dir(path.Dir(p))) == "ports"
+}
@@ -0,0 +1,79 @@
+package cpp
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseVcpkgManifest(t *testing.T) {
+	fixture := "test-fixtures/vcpkg/vcpkg.json"
+	fixtureLocationSet := file.NewLocationSet(file.NewLocation(fixture))
+	expected := []pkg.Package{
+		{
+			Name:      "fmt",
+			Version:   "10.1.1",
+			PURL:      "pkg:vcpkg/fmt@10.1.1",
+			Locations: fixtureLocationSet,
+			Language:  pkg.CPP,
+			Type:      pkg.VcpkgPkg,
+			Metadata:  pkg.VcpkgManifestEntry{},
+		},
+		{
+			Name:      "boost-asio",
+			PURL:      "pkg:vcpkg/boost-asio",
+			Locations: fixtureLocationSet,
+			Language:  pkg.CPP,
+			Type:      pkg.VcpkgPkg,
+			Metadata: pkg.VcpkgManifestEntry{
+				VersionConstraint: "1.83.0",
+			},
+		},
+		{
+			Name:      "curl",
+			Version:   "8.4.0#2",
+			PURL:      "pkg:vcpkg/curl@8.4.0%232",
+			Locations: fixtureLocationSet,
+			Language:  pkg.CPP,
+			Type:      pkg.VcpkgPkg,
+			Metadata: pkg.VcpkgManifestEntry{
+				Features: []string{"ssl", "http2"},
+			},
+		},
+		{
+			Name:      "vcpkg-cmake",
+			PURL:      "pkg:vcpkg/vcpkg-cmake",
+			Locations: fixtureLocationSet,
+			Language:  pkg.CPP,
+			Type:      pkg.VcpkgPkg,
+			Metadata: pkg.VcpkgManifestEntry{
+				Host: true,
+			},
+		},
+		{
+			Name:      "liburing",
+			PURL:      "pkg:vcpkg/liburing",
+			Locations: fixtureLocationSet,
+			Language:  pkg.CPP,
+			Type:      pkg.VcpkgPkg,
+			Metadata: pkg.VcpkgManifestEntry{
+				Platform: "linux",
+			},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseVcpkgManifest, expected, nil)
+}
+
+func TestParseVcpkgManifest_PortManifest(t *testing.T) {
+	pkgtest.TestFileParser(t, "test-fixtures/vcpkg-ports/ports/zlib/vcpkg.json", parseVcpkgManifest, nil, nil)
+}
+
+func TestParseVcpkgManifest_Malformed(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromFile(t, "test-fixtures/vcpkg/malformed.json").
+		WithError().
+		TestParser(t, parseVcpkgManifest)
+}
@@ -0,0 +1,23 @@
+from conan import ConanFile
+from conan.tools.cmake import cmake_layout
+
+
+class AppConan(ConanFile):
+    name = "app"
+    version = "0.1.0"
+    settings = "os", "compiler", "build_type", "arch"
+    generators = "CMakeDeps", "CMakeToolchain"
+
+    requires = ("zlib/1.2.13",
+                "openssl/3.1.2@my_user/my_channel")
+    # requires = "ignored/1.0.0"
+
+    def requirements(self):
+        self.requires("boost/1.83.0", transitive_headers=True)
+        self.requires('fmt/10.1.1#9b9f2b7d5bd7d5ad3ddde4e1d8e1ff49')
+        self.requires("zlib/1.2.13")
+        self.requires("libpng/[>=1.6 <2]")
+        self.requires(f"spdlog/{self.version}")
+
+    def layout(self):
+        cmake_layout(self)
@@ -8,10 +8,26 @@ import (
 	"github.com/anchore/syft/syft/pkg/cataloger/generic"
 )
 
+type CatalogerConfig struct {
+	// ExcludeRemovedPackages will skip packages that the dpkg status records as removed (with only the config files
+	// remaining, or not installed at all), which are otherwise reported along with their status.
+	ExcludeRemovedPackages bool `yaml:"exclude-removed-packages" json:"exclude-removed-packages" mapstructure:"exclude-removed-packages"`
+}
+
+func DefaultCatalogerConfig() CatalogerConfig {
+	return CatalogerConfig{}
+}
+
+func (c CatalogerConfig) WithExcludeRemovedPackages(exclude bool) CatalogerConfig {
+	c.ExcludeRemovedPackages = exclude
+	return c
+}
+
 // NewDBCataloger returns a new Deb package cataloger capable of parsing DPKG status DB flat-file stores.
-func NewDBCataloger() pkg.Cataloger {
+func NewDBCataloger(cfg CatalogerConfig) pkg.Cataloger {
+	p := dpkgDBParser{cfg: cfg}
 	return generic.NewCataloger("dpkg-db-cataloger").
 		// note: these globs have been intentionally split up in order to improve search performance,
 		// please do NOT combine into: "**/var/lib/dpkg/{status,status.d/*}"
-		WithParserByGlobs(parseDpkgDB, "**/var/lib/dpkg/status", "**/var/lib/dpkg/status.d/*", "**/lib/opkg/info/*.control", "**/lib/opkg/status")
+		WithParserByGlobs(p.parseDpkgDB, "**/var/lib/dpkg/status", "**/var/lib/dpkg/status.d/*", "**/lib/opkg/info/*.control", "**/lib/opkg/status")
 }
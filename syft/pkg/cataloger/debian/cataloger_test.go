@@ -150,7 +150,7 @@ func TestDpkgCataloger(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c := NewDBCataloger()
+			c := NewDBCataloger(DefaultCatalogerConfig())
 			pkgtest.NewCatalogTester().
 				WithImageResolver(t, tt.name).
 				IgnoreLocationLayer(). // this fixture can be rebuilt, thus the layer ID will change
@@ -160,6 +160,71 @@ func TestDpkgCataloger(t *testing.T) {
 	}
 }
 
+func TestDpkgCataloger_distroless(t *testing.T) {
+	// distroless images hold a control file (and md5sums) per package instead of a single status file
+	libc6 := pkg.Package{
+		Name:    "libc6",
+		Version: "2.36-9+deb12u7",
+		FoundBy: "dpkg-db-cataloger",
+		Locations: file.NewLocationSet(
+			file.NewLocation("var/lib/dpkg/status.d/libc6"),
+			file.NewLocation("var/lib/dpkg/status.d/libc6.md5sums"),
+		),
+		Type: pkg.DebPkg,
+		Metadata: pkg.DpkgDBEntry{
+			Package:       "libc6",
+			Source:        "glibc",
+			Version:       "2.36-9+deb12u7",
+			Architecture:  "amd64",
+			Maintainer:    "GNU Libc Maintainers <debian-glibc@lists.debian.org>",
+			InstalledSize: 12985,
+			Description: `GNU C Library: Shared libraries
+ Contains the standard libraries that are used by nearly all programs on
+ the system.`,
+			Depends: []string{"libgcc-s1"},
+			Files: []pkg.DpkgFileRecord{
+				{Path: "/lib/x86_64-linux-gnu/libc.so.6", Digest: &file.Digest{
+					Algorithm: "md5",
+					Value:     "0a3b1ed8f6ab1ac9b8a6b1d5b31b9a0e",
+				}},
+				{Path: "/usr/share/doc/libc6/copyright", Digest: &file.Digest{
+					Algorithm: "md5",
+					Value:     "5c4c88c81e5b0c5d84ec5b55b6d8e02e",
+				}},
+			},
+		},
+	}
+
+	// the control file is not newline terminated, and ends with the version
+	libgmp10 := pkg.Package{
+		Name:    "libgmp10",
+		Version: "2:6.2.1+dfsg1-1.1",
+		FoundBy: "dpkg-db-cataloger",
+		Locations: file.NewLocationSet(
+			file.NewLocation("var/lib/dpkg/status.d/libgmp10"),
+		),
+		Type: pkg.DebPkg,
+		Metadata: pkg.DpkgDBEntry{
+			Package:       "libgmp10",
+			Source:        "gmp",
+			Version:       "2:6.2.1+dfsg1-1.1",
+			Architecture:  "amd64",
+			Maintainer:    "Debian Science Team <debian-science-maintainers@lists.alioth.debian.org>",
+			InstalledSize: 864,
+			Description: `Multiprecision arithmetic library
+ GNU MP is a programmer's library for arbitrary precision
+ arithmetic (ie, a bignum package).`,
+			Depends: []string{"libc6 (>= 2.14)"},
+			Files:   []pkg.DpkgFileRecord{},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/distroless").
+		Expects([]pkg.Package{libc6, libgmp10}, nil).
+		TestCataloger(t, NewDBCataloger(DefaultCatalogerConfig()))
+}
+
 func TestCataloger_Globs(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -183,7 +248,7 @@ func TestCataloger_Globs(t *testing.T) {
 			pkgtest.NewCatalogTester().
 				FromDirectory(t, test.fixture).
 				ExpectsResolverContentQueries(test.expected).
-				TestCataloger(t, NewDBCataloger())
+				TestCataloger(t, NewDBCataloger(DefaultCatalogerConfig()))
 		})
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path"
 	"regexp"
 	"strings"
 
@@ -25,8 +26,18 @@ var (
 	sourceRegexp     = regexp.MustCompile(`(?P<name>\S+)( \((?P<version>.*)\))?`)
 )
 
+// dpkgDBParser parses dpkg databases according to the cataloger config.
+type dpkgDBParser struct {
+	cfg CatalogerConfig
+}
+
 // parseDpkgDB reads a dpkg database "status" file (and surrounding data files) and returns the packages and relationships found.
-func parseDpkgDB(_ context.Context, resolver file.Resolver, env *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+func (p dpkgDBParser) parseDpkgDB(_ context.Context, resolver file.Resolver, env *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	if isDistrolessDataFile(reader.RealPath) {
+		// these are read alongside the control file of the package (see fetchMd5Contents)
+		return nil, nil, nil
+	}
+
 	metadata, err := parseDpkgStatus(reader)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to catalog dpkg DB=%q: %w", reader.RealPath, err)
@@ -34,12 +45,22 @@ func parseDpkgDB(_ context.Context, resolver file.Resolver, env *generic.Environ
 
 	var pkgs []pkg.Package
 	for _, m := range metadata {
+		if p.cfg.ExcludeRemovedPackages && isRemoved(m.Status) {
+			log.WithFields("package", m.Package, "status", m.Status).Trace("skipping removed dpkg package")
+			continue
+		}
 		pkgs = append(pkgs, newDpkgPackage(m, reader.Location, resolver, env.LinuxRelease))
 	}
 
 	return pkgs, associateRelationships(pkgs), nil
 }
 
+// isDistrolessDataFile indicates if the given path is a data file of a package (e.g. NAME.md5sums) within a distroless
+// status.d directory, which otherwise holds a single control file (status stanza) per package.
+func isDistrolessDataFile(p string) bool {
+	return path.Base(path.Dir(p)) == "status.d" && strings.HasSuffix(p, md5sumsExt)
+}
+
 // parseDpkgStatus is a parser function for Debian DB status contents, returning all Debian packages listed.
 func parseDpkgStatus(reader io.Reader) ([]pkg.DpkgDBEntry, error) {
 	buffedReader := bufio.NewReader(reader)
@@ -73,6 +94,7 @@ type dpkgExtractedMetadata struct {
 	Version       string `mapstructure:"Version"`
 	SourceVersion string `mapstructure:"SourceVersion"`
 	Architecture  string `mapstructure:"Architecture"`
+	Status        string `mapstructure:"Status"`
 	Maintainer    string `mapstructure:"Maintainer"`
 	InstalledSize int    `mapstructure:"InstalledSize"`
 	Description   string `mapstructure:"Description"`
@@ -125,6 +147,12 @@ func parseDpkgStatusEntry(reader *bufio.Reader) (*pkg.DpkgDBEntry, error) {
 		PreDepends:    splitPkgList(raw.PreDepends),
 	}
 
+	// the status is only kept when the package is not fully installed (e.g. an interrupted install or upgrade) so
+	// the metadata of installed packages is unchanged
+	if raw.Status != "" && dpkgState(raw.Status) != "installed" {
+		entry.Status = raw.Status
+	}
+
 	// there may be an optional conffiles section that we should persist as files
 	if conffilesSection, exists := dpkgFields["Conffiles"]; exists && conffilesSection != nil {
 		if sectionStr, ok := conffilesSection.(string); ok {
@@ -140,6 +168,26 @@ func parseDpkgStatusEntry(reader *bufio.Reader) (*pkg.DpkgDBEntry, error) {
 	return &entry, retErr
 }
 
+// dpkgState returns the state of the package within the given "Status" field value (the last of the want, error, and
+// state words, e.g. "half-configured" from "install ok half-configured").
+func dpkgState(status string) string {
+	fields := strings.Fields(status)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[len(fields)-1]
+}
+
+// isRemoved indicates the given "Status" field value describes a package that has been removed, where either only the
+// config files remain or nothing remains at all.
+func isRemoved(status string) bool {
+	switch dpkgState(status) {
+	case "config-files", "not-installed":
+		return true
+	}
+	return false
+}
+
 func splitPkgList(pkgList string) (ret []string) {
 	fields := strings.Split(pkgList, ",")
 	for _, field := range fields {
@@ -157,12 +205,14 @@ func extractAllFields(reader *bufio.Reader) (map[string]interface{}, error) {
 
 	for {
 		line, err := reader.ReadString('\n')
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return dpkgFields, errEndOfPackages
-			}
+		if err != nil && !errors.Is(err, io.EOF) {
 			return nil, err
 		}
+		// the last line may not be newline terminated (common for the per-package files of distroless images)
+		atEOF := err != nil
+		if atEOF && len(line) == 0 {
+			return dpkgFields, errEndOfPackages
+		}
 
 		line = strings.TrimRight(line, "\n")
 
@@ -203,6 +253,10 @@ func extractAllFields(reader *bufio.Reader) (map[string]interface{}, error) {
 			}
 			dpkgFields[key] = val
 		}
+
+		if atEOF {
+			return dpkgFields, errEndOfPackages
+		}
 	}
 	return dpkgFields, nil
 }
@@ -312,8 +312,21 @@ func Test_parseDpkgStatus_negativeCases(t *testing.T) {
 		wantErr require.ErrorAssertionFunc
 	}{
 		{
-			name:    "no more packages",
-			input:   `Package: apt`,
+			name:  "last line not newline terminated",
+			input: `Package: apt`,
+			want: []pkg.Package{
+				{
+					Name:      "apt",
+					Type:      "deb",
+					PURL:      "pkg:deb/debian/apt?distro=debian-10",
+					Licenses:  pkg.NewLicenseSet(),
+					Locations: file.NewLocationSet(file.NewLocation("place")),
+					Metadata: pkg.DpkgDBEntry{
+						Package: "apt",
+						Files:   []pkg.DpkgFileRecord{},
+					},
+				},
+			},
 			wantErr: require.NoError,
 		},
 		{
@@ -363,7 +376,7 @@ Installed-Size: 10kib
 				WithErrorAssertion(tt.wantErr).
 				WithLinuxRelease(linux.Release{ID: "debian", VersionID: "10"}).
 				Expects(tt.want, nil).
-				TestParser(t, parseDpkgDB)
+				TestParser(t, dpkgDBParser{}.parseDpkgDB)
 		})
 	}
 }
@@ -520,7 +533,7 @@ func Test_associateRelationships(t *testing.T) {
 
 			reader := file.NewLocationReadCloser(file.NewLocation(tt.fixture), f)
 
-			pkgs, relationships, err := parseDpkgDB(context.Background(), nil, &generic.Environment{}, reader)
+			pkgs, relationships, err := dpkgDBParser{}.parseDpkgDB(context.Background(), nil, &generic.Environment{}, reader)
 			require.NotEmpty(t, pkgs)
 			require.NotEmpty(t, relationships)
 			require.NoError(t, err)
@@ -552,3 +565,57 @@ func abstractRelationships(t testing.TB, relationships []artifact.Relationship)
 
 	return abstracted
 }
+
+func Test_parseDpkgDB_packageStates(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  CatalogerConfig
+		want map[string]string
+	}{
+		{
+			name: "packages not fully installed are reported with their status",
+			cfg:  DefaultCatalogerConfig(),
+			want: map[string]string{
+				"libc6":        "",
+				"openssl":      "install ok half-configured",
+				"curl":         "install reinstreq half-installed",
+				"nginx-common": "deinstall ok config-files",
+				"telnet":       "purge ok not-installed",
+			},
+		},
+		{
+			name: "removed packages are excluded",
+			cfg:  DefaultCatalogerConfig().WithExcludeRemovedPackages(true),
+			want: map[string]string{
+				"libc6":   "",
+				"openssl": "install ok half-configured",
+				"curl":    "install reinstreq half-installed",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture := "test-fixtures/status/mixed-states"
+			f, err := os.Open(fixture)
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = f.Close() })
+
+			reader := file.NewLocationReadCloser(file.NewLocation(fixture), f)
+
+			pkgs, relationships, err := dpkgDBParser{cfg: tt.cfg}.parseDpkgDB(context.Background(), nil, &generic.Environment{}, reader)
+			require.NoError(t, err)
+
+			got := make(map[string]string)
+			for _, p := range pkgs {
+				got[p.Name] = p.Metadata.(pkg.DpkgDBEntry).Status
+			}
+			assert.Equal(t, tt.want, got)
+
+			// the relationships are only between the reported packages
+			for _, r := range relationships {
+				assert.Contains(t, tt.want, r.From.(pkg.Package).Name)
+				assert.Contains(t, tt.want, r.To.(pkg.Package).Name)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ Package dotnet provides a concrete Cataloger implementation relating to packages
 package dotnet
 
 import (
+	"github.com/anchore/syft/internal/mimetype"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/pkg/cataloger/generic"
 )
@@ -14,8 +15,20 @@ func NewDotnetDepsCataloger() pkg.Cataloger {
 		WithParserByGlobs(parseDotnetDeps, "**/*.deps.json")
 }
 
+// NewDotnetRuntimeFrameworkCataloger returns a new Dotnet cataloger object base on the framework references in runtimeconfig json files.
+func NewDotnetRuntimeFrameworkCataloger() pkg.Cataloger {
+	return generic.NewCataloger("dotnet-runtimeconfig-cataloger").
+		WithParserByGlobs(parseDotnetRuntimeConfig, "**/*.runtimeconfig.json")
+}
+
 // NewDotnetPortableExecutableCataloger returns a new Dotnet cataloger object base on portable executable files.
 func NewDotnetPortableExecutableCataloger() pkg.Cataloger {
 	return generic.NewCataloger("dotnet-portable-executable-cataloger").
 		WithParserByGlobs(parseDotnetPortableExecutable, "**/*.dll", "**/*.exe")
 }
+
+// NewDotnetNativeAOTCataloger returns a new Dotnet cataloger object that detects the .NET runtime compiled into Native AOT binaries.
+func NewDotnetNativeAOTCataloger() pkg.Cataloger {
+	return generic.NewCataloger("dotnet-native-aot-cataloger").
+		WithParserByMimeTypes(parseDotnetNativeAOT, mimetype.ExecutableMIMETypeSet.List()...)
+}
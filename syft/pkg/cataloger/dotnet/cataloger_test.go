@@ -22,6 +22,14 @@ func TestCataloger_Globs(t *testing.T) {
 				"src/something.deps.json",
 			},
 		},
+		{
+			name:      "obtain runtimeconfig.json files",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewDotnetRuntimeFrameworkCataloger(),
+			expected: []string{
+				"src/something.runtimeconfig.json",
+			},
+		},
 		{
 			name:      "obtain portable executable files",
 			fixture:   "test-fixtures/glob-paths",
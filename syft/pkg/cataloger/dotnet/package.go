@@ -10,22 +10,72 @@ import (
 	"github.com/anchore/syft/syft/pkg"
 )
 
-func newDotnetDepsPackage(nameVersion string, lib dotnetDepsLibrary, locations ...file.Location) *pkg.Package {
+func newDotnetDepsPackage(nameVersion string, lib dotnetDepsLibrary, kind pkg.DependencyKind, locations ...file.Location) *pkg.Package {
 	name, version := extractNameAndVersion(nameVersion)
 
 	m := pkg.DotnetDepsEntry{
-		Name:     name,
-		Version:  version,
-		Path:     lib.Path,
-		Sha512:   lib.Sha512,
-		HashPath: lib.HashPath,
+		Name:           name,
+		Version:        version,
+		Path:           lib.Path,
+		Sha512:         lib.Sha512,
+		HashPath:       lib.HashPath,
+		DependencyKind: kind,
 	}
 
 	p := &pkg.Package{
 		Name:      name,
 		Version:   version,
 		Locations: file.NewLocationSet(locations...),
-		PURL:      packageURL(m),
+		PURL:      packageURL(m.Name, m.Version),
+		Language:  pkg.Dotnet,
+		Type:      pkg.DotnetPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func newDotnetRuntimeConfigPackage(ref dotnetRuntimeConfigFramework, opts dotnetRuntimeConfigOptions, selfContained bool, locations ...file.Location) *pkg.Package {
+	if ref.Name == "" {
+		return nil
+	}
+
+	m := pkg.DotnetRuntimeConfigEntry{
+		Name:            ref.Name,
+		Version:         ref.Version,
+		TargetFramework: opts.TargetFramework,
+		RollForward:     opts.RollForward,
+		SelfContained:   selfContained,
+	}
+
+	p := &pkg.Package{
+		Name:      ref.Name,
+		Version:   ref.Version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(m.Name, m.Version),
+		Language:  pkg.Dotnet,
+		Type:      pkg.DotnetPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func newDotnetNativeAOTPackage(m pkg.DotnetNativeAOTEntry, locations ...file.Location) *pkg.Package {
+	version := nativeAOTRuntimeVersion(m.RuntimeFileVersion)
+	if version == "" {
+		return nil
+	}
+
+	p := &pkg.Package{
+		Name:      nativeAOTRuntimeName,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(nativeAOTRuntimeName, version),
 		Language:  pkg.Dotnet,
 		Type:      pkg.DotnetPkg,
 		Metadata:  m,
@@ -57,7 +107,7 @@ func createNameAndVersion(name, version string) (nameVersion string) {
 	return
 }
 
-func packageURL(m pkg.DotnetDepsEntry) string {
+func packageURL(name, version string) string {
 	var qualifiers packageurl.Qualifiers
 
 	return packageurl.NewPackageURL(
@@ -73,8 +123,8 @@ func packageURL(m pkg.DotnetDepsEntry) string {
 		// official PURL type available.
 		packageurl.TypeNuget,
 		"",
-		m.Name,
-		m.Version,
+		name,
+		version,
 		qualifiers,
 		"",
 	).ToString()
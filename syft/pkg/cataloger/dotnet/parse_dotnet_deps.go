@@ -62,6 +62,7 @@ func parseDotnetDeps(_ context.Context, _ file.Resolver, _ *generic.Environment,
 			rootPkg = newDotnetDepsPackage(
 				nameVersion,
 				lib,
+				"",
 				reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
 			)
 		}
@@ -72,6 +73,9 @@ func parseDotnetDeps(_ context.Context, _ file.Resolver, _ *generic.Environment,
 	pkgs = append(pkgs, *rootPkg)
 	pkgMap[createNameAndVersion(rootPkg.Name, rootPkg.Version)] = *rootPkg
 
+	// the dependencies of the root project (within the runtime target) are the direct dependencies
+	rootTarget, hasRootTarget := depsDoc.Targets[depsDoc.RuntimeTarget.Name][createNameAndVersion(rootPkg.Name, rootPkg.Version)]
+
 	var names []string
 	for nameVersion := range depsDoc.Libraries {
 		names = append(names, nameVersion)
@@ -86,10 +90,19 @@ func parseDotnetDeps(_ context.Context, _ file.Resolver, _ *generic.Environment,
 			continue
 		}
 
+		var kind pkg.DependencyKind
+		if hasRootTarget {
+			kind = pkg.TransitiveDependency
+			if depVersion, ok := rootTarget.Dependencies[name]; ok && depVersion == version {
+				kind = pkg.DirectDependency
+			}
+		}
+
 		lib := depsDoc.Libraries[nameVersion]
 		dotnetPkg := newDotnetDepsPackage(
 			nameVersion,
 			lib,
+			kind,
 			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
 		)
 
@@ -32,8 +32,9 @@ func TestParseDotnetDeps(t *testing.T) {
 		Language:  pkg.Dotnet,
 		Type:      pkg.DotnetPkg,
 		Metadata: pkg.DotnetDepsEntry{
-			Name:    "TestCommon",
-			Version: "1.0.0",
+			Name:           "TestCommon",
+			Version:        "1.0.0",
+			DependencyKind: pkg.DirectDependency,
 		},
 	}
 	awssdkcore := pkg.Package{
@@ -44,11 +45,12 @@ func TestParseDotnetDeps(t *testing.T) {
 		Language:  pkg.Dotnet,
 		Type:      pkg.DotnetPkg,
 		Metadata: pkg.DotnetDepsEntry{
-			Name:     "AWSSDK.Core",
-			Version:  "3.7.10.6",
-			Sha512:   "sha512-kHBB+QmosVaG6DpngXQ8OlLVVNMzltNITfsRr68Z90qO7dSqJ2EHNd8dtBU1u3AQQLqqFHOY0lfmbpexeH6Pew==",
-			Path:     "awssdk.core/3.7.10.6",
-			HashPath: "awssdk.core.3.7.10.6.nupkg.sha512",
+			Name:           "AWSSDK.Core",
+			Version:        "3.7.10.6",
+			Sha512:         "sha512-kHBB+QmosVaG6DpngXQ8OlLVVNMzltNITfsRr68Z90qO7dSqJ2EHNd8dtBU1u3AQQLqqFHOY0lfmbpexeH6Pew==",
+			Path:           "awssdk.core/3.7.10.6",
+			HashPath:       "awssdk.core.3.7.10.6.nupkg.sha512",
+			DependencyKind: pkg.TransitiveDependency,
 		},
 	}
 	msftDependencyInjectionAbstractions := pkg.Package{
@@ -59,11 +61,12 @@ func TestParseDotnetDeps(t *testing.T) {
 		Language:  pkg.Dotnet,
 		Type:      pkg.DotnetPkg,
 		Metadata: pkg.DotnetDepsEntry{
-			Name:     "Microsoft.Extensions.DependencyInjection.Abstractions",
-			Version:  "6.0.0",
-			Sha512:   "sha512-xlzi2IYREJH3/m6+lUrQlujzX8wDitm4QGnUu6kUXTQAWPuZY8i+ticFJbzfqaetLA6KR/rO6Ew/HuYD+bxifg==",
-			Path:     "microsoft.extensions.dependencyinjection.abstractions/6.0.0",
-			HashPath: "microsoft.extensions.dependencyinjection.abstractions.6.0.0.nupkg.sha512",
+			Name:           "Microsoft.Extensions.DependencyInjection.Abstractions",
+			Version:        "6.0.0",
+			Sha512:         "sha512-xlzi2IYREJH3/m6+lUrQlujzX8wDitm4QGnUu6kUXTQAWPuZY8i+ticFJbzfqaetLA6KR/rO6Ew/HuYD+bxifg==",
+			Path:           "microsoft.extensions.dependencyinjection.abstractions/6.0.0",
+			HashPath:       "microsoft.extensions.dependencyinjection.abstractions.6.0.0.nupkg.sha512",
+			DependencyKind: pkg.TransitiveDependency,
 		},
 	}
 	msftDependencyInjection := pkg.Package{
@@ -74,11 +77,12 @@ func TestParseDotnetDeps(t *testing.T) {
 		Language:  pkg.Dotnet,
 		Type:      pkg.DotnetPkg,
 		Metadata: pkg.DotnetDepsEntry{
-			Name:     "Microsoft.Extensions.DependencyInjection",
-			Version:  "6.0.0",
-			Sha512:   "sha512-k6PWQMuoBDGGHOQTtyois2u4AwyVcIwL2LaSLlTZQm2CYcJ1pxbt6jfAnpWmzENA/wfrYRI/X9DTLoUkE4AsLw==",
-			Path:     "microsoft.extensions.dependencyinjection/6.0.0",
-			HashPath: "microsoft.extensions.dependencyinjection.6.0.0.nupkg.sha512",
+			Name:           "Microsoft.Extensions.DependencyInjection",
+			Version:        "6.0.0",
+			Sha512:         "sha512-k6PWQMuoBDGGHOQTtyois2u4AwyVcIwL2LaSLlTZQm2CYcJ1pxbt6jfAnpWmzENA/wfrYRI/X9DTLoUkE4AsLw==",
+			Path:           "microsoft.extensions.dependencyinjection/6.0.0",
+			HashPath:       "microsoft.extensions.dependencyinjection.6.0.0.nupkg.sha512",
+			DependencyKind: pkg.DirectDependency,
 		},
 	}
 	msftLoggingAbstractions := pkg.Package{
@@ -89,11 +93,12 @@ func TestParseDotnetDeps(t *testing.T) {
 		Language:  pkg.Dotnet,
 		Type:      pkg.DotnetPkg,
 		Metadata: pkg.DotnetDepsEntry{
-			Name:     "Microsoft.Extensions.Logging.Abstractions",
-			Version:  "6.0.0",
-			Sha512:   "sha512-/HggWBbTwy8TgebGSX5DBZ24ndhzi93sHUBDvP1IxbZD7FDokYzdAr6+vbWGjw2XAfR2EJ1sfKUotpjHnFWPxA==",
-			Path:     "microsoft.extensions.logging.abstractions/6.0.0",
-			HashPath: "microsoft.extensions.logging.abstractions.6.0.0.nupkg.sha512",
+			Name:           "Microsoft.Extensions.Logging.Abstractions",
+			Version:        "6.0.0",
+			Sha512:         "sha512-/HggWBbTwy8TgebGSX5DBZ24ndhzi93sHUBDvP1IxbZD7FDokYzdAr6+vbWGjw2XAfR2EJ1sfKUotpjHnFWPxA==",
+			Path:           "microsoft.extensions.logging.abstractions/6.0.0",
+			HashPath:       "microsoft.extensions.logging.abstractions.6.0.0.nupkg.sha512",
+			DependencyKind: pkg.TransitiveDependency,
 		},
 	}
 	msftExtensionsLogging := pkg.Package{
@@ -104,11 +109,12 @@ func TestParseDotnetDeps(t *testing.T) {
 		Language:  pkg.Dotnet,
 		Type:      pkg.DotnetPkg,
 		Metadata: pkg.DotnetDepsEntry{
-			Name:     "Microsoft.Extensions.Logging",
-			Version:  "6.0.0",
-			Sha512:   "sha512-eIbyj40QDg1NDz0HBW0S5f3wrLVnKWnDJ/JtZ+yJDFnDj90VoPuoPmFkeaXrtu+0cKm5GRAwoDf+dBWXK0TUdg==",
-			Path:     "microsoft.extensions.logging/6.0.0",
-			HashPath: "microsoft.extensions.logging.6.0.0.nupkg.sha512",
+			Name:           "Microsoft.Extensions.Logging",
+			Version:        "6.0.0",
+			Sha512:         "sha512-eIbyj40QDg1NDz0HBW0S5f3wrLVnKWnDJ/JtZ+yJDFnDj90VoPuoPmFkeaXrtu+0cKm5GRAwoDf+dBWXK0TUdg==",
+			Path:           "microsoft.extensions.logging/6.0.0",
+			HashPath:       "microsoft.extensions.logging.6.0.0.nupkg.sha512",
+			DependencyKind: pkg.DirectDependency,
 		},
 	}
 	msftExtensionsOptions := pkg.Package{
@@ -119,11 +125,12 @@ func TestParseDotnetDeps(t *testing.T) {
 		Language:  pkg.Dotnet,
 		Type:      pkg.DotnetPkg,
 		Metadata: pkg.DotnetDepsEntry{
-			Name:     "Microsoft.Extensions.Options",
-			Version:  "6.0.0",
-			Sha512:   "sha512-dzXN0+V1AyjOe2xcJ86Qbo233KHuLEY0njf/P2Kw8SfJU+d45HNS2ctJdnEnrWbM9Ye2eFgaC5Mj9otRMU6IsQ==",
-			Path:     "microsoft.extensions.options/6.0.0",
-			HashPath: "microsoft.extensions.options.6.0.0.nupkg.sha512",
+			Name:           "Microsoft.Extensions.Options",
+			Version:        "6.0.0",
+			Sha512:         "sha512-dzXN0+V1AyjOe2xcJ86Qbo233KHuLEY0njf/P2Kw8SfJU+d45HNS2ctJdnEnrWbM9Ye2eFgaC5Mj9otRMU6IsQ==",
+			Path:           "microsoft.extensions.options/6.0.0",
+			HashPath:       "microsoft.extensions.options.6.0.0.nupkg.sha512",
+			DependencyKind: pkg.TransitiveDependency,
 		},
 	}
 	msftExtensionsPrimitives := pkg.Package{
@@ -134,11 +141,12 @@ func TestParseDotnetDeps(t *testing.T) {
 		Language:  pkg.Dotnet,
 		Type:      pkg.DotnetPkg,
 		Metadata: pkg.DotnetDepsEntry{
-			Name:     "Microsoft.Extensions.Primitives",
-			Version:  "6.0.0",
-			Sha512:   "sha512-9+PnzmQFfEFNR9J2aDTfJGGupShHjOuGw4VUv+JB044biSHrnmCIMD+mJHmb2H7YryrfBEXDurxQ47gJZdCKNQ==",
-			Path:     "microsoft.extensions.primitives/6.0.0",
-			HashPath: "microsoft.extensions.primitives.6.0.0.nupkg.sha512",
+			Name:           "Microsoft.Extensions.Primitives",
+			Version:        "6.0.0",
+			Sha512:         "sha512-9+PnzmQFfEFNR9J2aDTfJGGupShHjOuGw4VUv+JB044biSHrnmCIMD+mJHmb2H7YryrfBEXDurxQ47gJZdCKNQ==",
+			Path:           "microsoft.extensions.primitives/6.0.0",
+			HashPath:       "microsoft.extensions.primitives.6.0.0.nupkg.sha512",
+			DependencyKind: pkg.TransitiveDependency,
 		},
 	}
 	newtonsoftJson := pkg.Package{
@@ -149,11 +157,12 @@ func TestParseDotnetDeps(t *testing.T) {
 		Language:  pkg.Dotnet,
 		Type:      pkg.DotnetPkg,
 		Metadata: pkg.DotnetDepsEntry{
-			Name:     "Newtonsoft.Json",
-			Version:  "13.0.1",
-			Sha512:   "sha512-ppPFpBcvxdsfUonNcvITKqLl3bqxWbDCZIzDWHzjpdAHRFfZe0Dw9HmA0+za13IdyrgJwpkDTDA9fHaxOrt20A==",
-			Path:     "newtonsoft.json/13.0.1",
-			HashPath: "newtonsoft.json.13.0.1.nupkg.sha512",
+			Name:           "Newtonsoft.Json",
+			Version:        "13.0.1",
+			Sha512:         "sha512-ppPFpBcvxdsfUonNcvITKqLl3bqxWbDCZIzDWHzjpdAHRFfZe0Dw9HmA0+za13IdyrgJwpkDTDA9fHaxOrt20A==",
+			Path:           "newtonsoft.json/13.0.1",
+			HashPath:       "newtonsoft.json.13.0.1.nupkg.sha512",
+			DependencyKind: pkg.DirectDependency,
 		},
 	}
 	serilogSinksConsole := pkg.Package{
@@ -164,11 +173,12 @@ func TestParseDotnetDeps(t *testing.T) {
 		Language:  pkg.Dotnet,
 		Type:      pkg.DotnetPkg,
 		Metadata: pkg.DotnetDepsEntry{
-			Name:     "Serilog.Sinks.Console",
-			Version:  "4.0.1",
-			Sha512:   "sha512-apLOvSJQLlIbKlbx+Y2UDHSP05kJsV7mou+fvJoRGs/iR+jC22r8cuFVMjjfVxz/AD4B2UCltFhE1naRLXwKNw==",
-			Path:     "serilog.sinks.console/4.0.1",
-			HashPath: "serilog.sinks.console.4.0.1.nupkg.sha512",
+			Name:           "Serilog.Sinks.Console",
+			Version:        "4.0.1",
+			Sha512:         "sha512-apLOvSJQLlIbKlbx+Y2UDHSP05kJsV7mou+fvJoRGs/iR+jC22r8cuFVMjjfVxz/AD4B2UCltFhE1naRLXwKNw==",
+			Path:           "serilog.sinks.console/4.0.1",
+			HashPath:       "serilog.sinks.console.4.0.1.nupkg.sha512",
+			DependencyKind: pkg.DirectDependency,
 		},
 	}
 	serilog := pkg.Package{
@@ -179,11 +189,12 @@ func TestParseDotnetDeps(t *testing.T) {
 		Language:  pkg.Dotnet,
 		Type:      pkg.DotnetPkg,
 		Metadata: pkg.DotnetDepsEntry{
-			Name:     "Serilog",
-			Version:  "2.10.0",
-			Sha512:   "sha512-+QX0hmf37a0/OZLxM3wL7V6/ADvC1XihXN4Kq/p6d8lCPfgkRdiuhbWlMaFjR9Av0dy5F0+MBeDmDdRZN/YwQA==",
-			Path:     "serilog/2.10.0",
-			HashPath: "serilog.2.10.0.nupkg.sha512",
+			Name:           "Serilog",
+			Version:        "2.10.0",
+			Sha512:         "sha512-+QX0hmf37a0/OZLxM3wL7V6/ADvC1XihXN4Kq/p6d8lCPfgkRdiuhbWlMaFjR9Av0dy5F0+MBeDmDdRZN/YwQA==",
+			Path:           "serilog/2.10.0",
+			HashPath:       "serilog.2.10.0.nupkg.sha512",
+			DependencyKind: pkg.DirectDependency,
 		},
 	}
 	systemDiagnosticsDiagnosticsource := pkg.Package{
@@ -194,11 +205,12 @@ func TestParseDotnetDeps(t *testing.T) {
 		Language:  pkg.Dotnet,
 		Type:      pkg.DotnetPkg,
 		Metadata: pkg.DotnetDepsEntry{
-			Name:     "System.Diagnostics.DiagnosticSource",
-			Version:  "6.0.0",
-			Sha512:   "sha512-frQDfv0rl209cKm1lnwTgFPzNigy2EKk1BS3uAvHvlBVKe5cymGyHO+Sj+NLv5VF/AhHsqPIUUwya5oV4CHMUw==",
-			Path:     "system.diagnostics.diagnosticsource/6.0.0",
-			HashPath: "system.diagnostics.diagnosticsource.6.0.0.nupkg.sha512",
+			Name:           "System.Diagnostics.DiagnosticSource",
+			Version:        "6.0.0",
+			Sha512:         "sha512-frQDfv0rl209cKm1lnwTgFPzNigy2EKk1BS3uAvHvlBVKe5cymGyHO+Sj+NLv5VF/AhHsqPIUUwya5oV4CHMUw==",
+			Path:           "system.diagnostics.diagnosticsource/6.0.0",
+			HashPath:       "system.diagnostics.diagnosticsource.6.0.0.nupkg.sha512",
+			DependencyKind: pkg.TransitiveDependency,
 		},
 	}
 	systemRuntimeCompilerServicesUnsafe := pkg.Package{
@@ -209,11 +221,12 @@ func TestParseDotnetDeps(t *testing.T) {
 		Language:  pkg.Dotnet,
 		Type:      pkg.DotnetPkg,
 		Metadata: pkg.DotnetDepsEntry{
-			Name:     "System.Runtime.CompilerServices.Unsafe",
-			Version:  "6.0.0",
-			Sha512:   "sha512-/iUeP3tq1S0XdNNoMz5C9twLSrM/TH+qElHkXWaPvuNOt+99G75NrV0OS2EqHx5wMN7popYjpc8oTjC1y16DLg==",
-			Path:     "system.runtime.compilerservices.unsafe/6.0.0",
-			HashPath: "system.runtime.compilerservices.unsafe.6.0.0.nupkg.sha512",
+			Name:           "System.Runtime.CompilerServices.Unsafe",
+			Version:        "6.0.0",
+			Sha512:         "sha512-/iUeP3tq1S0XdNNoMz5C9twLSrM/TH+qElHkXWaPvuNOt+99G75NrV0OS2EqHx5wMN7popYjpc8oTjC1y16DLg==",
+			Path:           "system.runtime.compilerservices.unsafe/6.0.0",
+			HashPath:       "system.runtime.compilerservices.unsafe.6.0.0.nupkg.sha512",
+			DependencyKind: pkg.TransitiveDependency,
 		}}
 
 	expectedPkgs := []pkg.Package{
@@ -0,0 +1,163 @@
+package dotnet
+
+import (
+	"bytes"
+	"context"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// nativeAOTRuntimeName is the name of the shared framework the runtime compiled into a Native AOT binary is part of,
+// which is how the same runtime is referenced by framework-dependent applications.
+const nativeAOTRuntimeName = "Microsoft.NETCore.App"
+
+const (
+	// nativeAOTManagedCodeSection is the section the ILCompiler emits the compiled managed code into (ELF and Mach-O)
+	nativeAOTManagedCodeSection = "__managedcode"
+
+	// nativeAOTManagedCodeSectionPE is the section the compiled managed code is merged into within PE files (the
+	// names of sections are limited to 8 characters within PE images)
+	nativeAOTManagedCodeSectionPE = ".managed"
+)
+
+var (
+	// nativeAOTVersionMarker prefixes the version string embedded by the .NET runtime, for example:
+	//   @(#)Version 8.0.1224.60305 @Commit: bf5e279d9239bfef5bb1b8d6212f1b971c434606
+	nativeAOTVersionMarker = []byte("@(#)Version ")
+
+	nativeAOTVersionPattern = regexp.MustCompile(`^@\(#\)Version (\d+\.\d+\.\d+(?:\.\d+)?)(?: @Commit: ([0-9a-fA-F]+))?`)
+)
+
+// nativeAOTMarkerMaxLength bounds how much is read past the start of the version marker
+const nativeAOTMarkerMaxLength = 128
+
+// nativeAOTScanBufferSize is the size of the chunks the binary is searched for the version marker in
+const nativeAOTScanBufferSize = 1 << 20
+
+var _ generic.Parser = parseDotnetNativeAOT
+
+// parseDotnetNativeAOT reports the .NET runtime compiled into a Native AOT binary. Native AOT binaries are identified
+// by the section holding the compiled managed code, and the version of the runtime is read from the version marker
+// embedded within the runtime (the same marker found within libcoreclr for framework-dependent applications).
+func parseDotnetNativeAOT(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	unionReader, err := unionreader.GetUnionReader(reader.ReadCloser)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// NOTE: multiple readers are returned to cover universal binaries, which are files
+	// with more than one binary
+	readers, err := unionreader.GetReaders(unionReader)
+	if err != nil {
+		log.WithFields("path", reader.RealPath, "error", err).Trace("unable to open binary")
+		return nil, nil, nil
+	}
+
+	for _, r := range readers {
+		if !isNativeAOT(r) {
+			continue
+		}
+
+		m, err := findNativeAOTVersionMarker(r)
+		if err != nil {
+			log.WithFields("path", reader.RealPath, "error", err).Trace("unable to read .NET Native AOT binary")
+			continue
+		}
+		if m.RuntimeFileVersion == "" {
+			log.WithFields("path", reader.RealPath).Trace("no runtime version found in .NET Native AOT binary")
+			continue
+		}
+
+		p := newDotnetNativeAOTPackage(m, reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation))
+		if p == nil {
+			continue
+		}
+		// every binary within a universal binary is built from the same runtime
+		return []pkg.Package{*p}, nil, nil
+	}
+
+	return nil, nil, nil
+}
+
+// isNativeAOT indicates if the given ELF, Mach-O or PE binary was produced by the .NET Native AOT compiler.
+func isNativeAOT(r io.ReaderAt) bool {
+	if f, err := elf.NewFile(r); err == nil {
+		return f.Section(nativeAOTManagedCodeSection) != nil
+	}
+	if f, err := macho.NewFile(r); err == nil {
+		return f.Section(nativeAOTManagedCodeSection) != nil
+	}
+	if f, err := pe.NewFile(r); err == nil {
+		for _, s := range f.Sections {
+			if strings.HasPrefix(s.Name, nativeAOTManagedCodeSectionPE) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// findNativeAOTVersionMarker searches the given binary for the version marker embedded by the .NET runtime.
+func findNativeAOTVersionMarker(r io.ReaderAt) (pkg.DotnetNativeAOTEntry, error) {
+	buf := make([]byte, nativeAOTScanBufferSize+nativeAOTMarkerMaxLength)
+	var offset int64
+	for {
+		n, err := r.ReadAt(buf, offset)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return pkg.DotnetNativeAOTEntry{}, fmt.Errorf("unable to read binary: %w", err)
+		}
+
+		chunk := buf[:n]
+		last := err != nil || n < len(buf)
+		for start := 0; ; {
+			i := bytes.Index(chunk[start:], nativeAOTVersionMarker)
+			if i < 0 {
+				break
+			}
+			// matches starting within the overlap with the next chunk are found within the next chunk
+			if !last && start+i >= nativeAOTScanBufferSize {
+				break
+			}
+			if match := nativeAOTVersionPattern.FindSubmatch(chunk[start+i:]); match != nil {
+				return pkg.DotnetNativeAOTEntry{
+					RuntimeFileVersion: string(match[1]),
+					Commit:             string(match[2]),
+				}, nil
+			}
+			start += i + len(nativeAOTVersionMarker)
+		}
+
+		if last {
+			return pkg.DotnetNativeAOTEntry{}, nil
+		}
+		offset += nativeAOTScanBufferSize
+	}
+}
+
+// nativeAOTRuntimeVersion returns the product version of the runtime (e.g. 8.0.12) from its file version (e.g.
+// 8.0.1224.60305). The build number of the file version is calculated as patch*100 plus the (two digit) year.
+func nativeAOTRuntimeVersion(fileVersion string) string {
+	fields := strings.Split(fileVersion, ".")
+	if len(fields) < 3 {
+		return ""
+	}
+	build, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s.%s.%d", fields[0], fields[1], build/100)
+}
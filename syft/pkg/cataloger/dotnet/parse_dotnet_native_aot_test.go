@@ -0,0 +1,182 @@
+package dotnet
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+const testNativeAOTVersionMarker = "@(#)Version 8.0.1224.60305 @Commit: bf5e279d9239bfef5bb1b8d6212f1b971c434606\x00"
+
+func TestParseDotnetNativeAOT(t *testing.T) {
+	tests := []struct {
+		name     string
+		section  string
+		contents string
+		expected bool
+	}{
+		{
+			name:     "native aot binary",
+			section:  nativeAOTManagedCodeSection,
+			contents: "\x00\x01\x02" + testNativeAOTVersionMarker + "\x03",
+			expected: true,
+		},
+		{
+			name:     "runtime version marker without managed code (e.g. libcoreclr)",
+			section:  ".data",
+			contents: testNativeAOTVersionMarker,
+		},
+		{
+			name:     "native aot binary without runtime version marker",
+			section:  nativeAOTManagedCodeSection,
+			contents: "@(#)Version unknown\x00",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fixture := writeTestElf(t, test.section, []byte(test.contents))
+
+			var expected []pkg.Package
+			if test.expected {
+				expected = []pkg.Package{
+					{
+						Name:      "Microsoft.NETCore.App",
+						Version:   "8.0.12",
+						PURL:      "pkg:nuget/Microsoft.NETCore.App@8.0.12",
+						Locations: file.NewLocationSet(file.NewLocation(fixture)),
+						Language:  pkg.Dotnet,
+						Type:      pkg.DotnetPkg,
+						Metadata: pkg.DotnetNativeAOTEntry{
+							RuntimeFileVersion: "8.0.1224.60305",
+							Commit:             "bf5e279d9239bfef5bb1b8d6212f1b971c434606",
+						},
+					},
+				}
+			}
+
+			pkgtest.TestFileParser(t, fixture, parseDotnetNativeAOT, expected, nil)
+		})
+	}
+}
+
+func Test_findNativeAOTVersionMarker(t *testing.T) {
+	tests := []struct {
+		name     string
+		offset   int
+		expected pkg.DotnetNativeAOTEntry
+	}{
+		{
+			name:     "start of the binary",
+			offset:   0,
+			expected: pkg.DotnetNativeAOTEntry{RuntimeFileVersion: "8.0.1224.60305", Commit: "bf5e279d9239bfef5bb1b8d6212f1b971c434606"},
+		},
+		{
+			name:     "spanning chunks",
+			offset:   nativeAOTScanBufferSize - 10,
+			expected: pkg.DotnetNativeAOTEntry{RuntimeFileVersion: "8.0.1224.60305", Commit: "bf5e279d9239bfef5bb1b8d6212f1b971c434606"},
+		},
+		{
+			name:     "within the overlap of chunks",
+			offset:   nativeAOTScanBufferSize + 10,
+			expected: pkg.DotnetNativeAOTEntry{RuntimeFileVersion: "8.0.1224.60305", Commit: "bf5e279d9239bfef5bb1b8d6212f1b971c434606"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			contents := make([]byte, test.offset, test.offset+len(testNativeAOTVersionMarker)+1)
+			contents = append(contents, testNativeAOTVersionMarker...)
+
+			m, err := findNativeAOTVersionMarker(bytes.NewReader(contents))
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, m)
+		})
+	}
+
+	t.Run("no marker", func(t *testing.T) {
+		m, err := findNativeAOTVersionMarker(bytes.NewReader([]byte("@(#)Version of something else")))
+		require.NoError(t, err)
+		assert.Empty(t, m)
+	})
+}
+
+func Test_nativeAOTRuntimeVersion(t *testing.T) {
+	tests := []struct {
+		fileVersion string
+		expected    string
+	}{
+		{fileVersion: "8.0.1224.60305", expected: "8.0.12"},
+		{fileVersion: "8.0.123.58001", expected: "8.0.1"},
+		{fileVersion: "8.0.23.53103", expected: "8.0.0"},
+		{fileVersion: "9.0.24.52809", expected: "9.0.0"},
+		{fileVersion: "8.0", expected: ""},
+		{fileVersion: "", expected: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.fileVersion, func(t *testing.T) {
+			assert.Equal(t, test.expected, nativeAOTRuntimeVersion(test.fileVersion))
+		})
+	}
+}
+
+// writeTestElf writes a minimal ELF executable with a single section of the given name and contents.
+func writeTestElf(t *testing.T, section string, contents []byte) string {
+	t.Helper()
+
+	shstrtab := []byte("\x00" + section + "\x00.shstrtab\x00")
+	headerSize := binary.Size(elf.Header64{})
+	dataOffset := uint64(headerSize)
+	shstrtabOffset := dataOffset + uint64(len(contents))
+	sectionsOffset := shstrtabOffset + uint64(len(shstrtab))
+
+	header := elf.Header64{
+		Type:      uint16(elf.ET_EXEC),
+		Machine:   uint16(elf.EM_X86_64),
+		Version:   uint32(elf.EV_CURRENT),
+		Shoff:     sectionsOffset,
+		Ehsize:    uint16(headerSize),
+		Shentsize: uint16(binary.Size(elf.Section64{})),
+		Shnum:     3,
+		Shstrndx:  2,
+	}
+	copy(header.Ident[:], elf.ELFMAG)
+	header.Ident[elf.EI_CLASS] = byte(elf.ELFCLASS64)
+	header.Ident[elf.EI_DATA] = byte(elf.ELFDATA2LSB)
+	header.Ident[elf.EI_VERSION] = byte(elf.EV_CURRENT)
+
+	sections := []elf.Section64{
+		{},
+		{
+			Name:  1,
+			Type:  uint32(elf.SHT_PROGBITS),
+			Flags: uint64(elf.SHF_ALLOC),
+			Off:   dataOffset,
+			Size:  uint64(len(contents)),
+		},
+		{
+			Name: uint32(len(section) + 2),
+			Type: uint32(elf.SHT_STRTAB),
+			Off:  shstrtabOffset,
+			Size: uint64(len(shstrtab)),
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, header))
+	buf.Write(contents)
+	buf.Write(shstrtab)
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, sections))
+
+	fixture := filepath.Join(t.TempDir(), "app")
+	require.NoError(t, os.WriteFile(fixture, buf.Bytes(), 0o755))
+	return fixture
+}
@@ -40,7 +40,7 @@ func parseDotnetPortableExecutable(_ context.Context, _ file.Resolver, _ *generi
 		return nil, nil, nil
 	}
 
-	versionResources, err := peFile.ParseVersionResources()
+	versionResources, err := peVersionResources(peFile)
 	if err != nil {
 		// TODO: known-unknown
 		log.Tracef("unable to parse version resources in PE file: %s: %v", f.RealPath, err)
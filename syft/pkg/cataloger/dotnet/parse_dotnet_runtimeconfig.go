@@ -0,0 +1,65 @@
+package dotnet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseDotnetRuntimeConfig
+
+type dotnetRuntimeConfig struct {
+	RuntimeOptions dotnetRuntimeConfigOptions `json:"runtimeOptions"`
+}
+
+type dotnetRuntimeConfigOptions struct {
+	TargetFramework string `json:"tfm"`
+	RollForward     string `json:"rollForward"`
+	// Framework is the single framework reference form (older SDKs and single-framework apps)
+	Framework *dotnetRuntimeConfigFramework `json:"framework"`
+	// Frameworks is the multi-framework reference form (e.g. an ASP.NET Core app referencing both Microsoft.NETCore.App and Microsoft.AspNetCore.App)
+	Frameworks []dotnetRuntimeConfigFramework `json:"frameworks"`
+	// IncludedFrameworks is written for self-contained apps, where the framework is bundled with the application
+	IncludedFrameworks []dotnetRuntimeConfigFramework `json:"includedFrameworks"`
+}
+
+type dotnetRuntimeConfigFramework struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// parseDotnetRuntimeConfig emits a package for each framework reference found in a [*.]runtimeconfig.json file,
+// describing the runtime that the application expects (independent of what is installed on the host).
+func parseDotnetRuntimeConfig(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var doc dotnetRuntimeConfig
+	if err := json.NewDecoder(reader).Decode(&doc); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse runtimeconfig.json file: %w", err)
+	}
+
+	opts := doc.RuntimeOptions
+	location := reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	var pkgs []pkg.Package
+	addPkg := func(ref dotnetRuntimeConfigFramework, selfContained bool) {
+		if p := newDotnetRuntimeConfigPackage(ref, opts, selfContained, location); p != nil {
+			pkgs = append(pkgs, *p)
+		}
+	}
+
+	if opts.Framework != nil {
+		addPkg(*opts.Framework, false)
+	}
+	for _, ref := range opts.Frameworks {
+		addPkg(ref, false)
+	}
+	for _, ref := range opts.IncludedFrameworks {
+		addPkg(ref, true)
+	}
+
+	return pkgs, nil, nil
+}
@@ -0,0 +1,104 @@
+package dotnet
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseDotnetRuntimeConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected func(locations file.LocationSet) []pkg.Package
+	}{
+		{
+			name:    "multiple framework references",
+			fixture: "test-fixtures/TestLibrary.runtimeconfig.json",
+			expected: func(locations file.LocationSet) []pkg.Package {
+				return []pkg.Package{
+					{
+						Name:      "Microsoft.NETCore.App",
+						Version:   "8.0.0",
+						PURL:      "pkg:nuget/Microsoft.NETCore.App@8.0.0",
+						Locations: locations,
+						Language:  pkg.Dotnet,
+						Type:      pkg.DotnetPkg,
+						Metadata: pkg.DotnetRuntimeConfigEntry{
+							Name:            "Microsoft.NETCore.App",
+							Version:         "8.0.0",
+							TargetFramework: "net8.0",
+							RollForward:     "LatestMinor",
+						},
+					},
+					{
+						Name:      "Microsoft.AspNetCore.App",
+						Version:   "8.0.0",
+						PURL:      "pkg:nuget/Microsoft.AspNetCore.App@8.0.0",
+						Locations: locations,
+						Language:  pkg.Dotnet,
+						Type:      pkg.DotnetPkg,
+						Metadata: pkg.DotnetRuntimeConfigEntry{
+							Name:            "Microsoft.AspNetCore.App",
+							Version:         "8.0.0",
+							TargetFramework: "net8.0",
+							RollForward:     "LatestMinor",
+						},
+					},
+				}
+			},
+		},
+		{
+			name:    "single framework reference",
+			fixture: "test-fixtures/SingleFramework.runtimeconfig.json",
+			expected: func(locations file.LocationSet) []pkg.Package {
+				return []pkg.Package{
+					{
+						Name:      "Microsoft.NETCore.App",
+						Version:   "6.0.0",
+						PURL:      "pkg:nuget/Microsoft.NETCore.App@6.0.0",
+						Locations: locations,
+						Language:  pkg.Dotnet,
+						Type:      pkg.DotnetPkg,
+						Metadata: pkg.DotnetRuntimeConfigEntry{
+							Name:            "Microsoft.NETCore.App",
+							Version:         "6.0.0",
+							TargetFramework: "net6.0",
+						},
+					},
+				}
+			},
+		},
+		{
+			name:    "self-contained application",
+			fixture: "test-fixtures/SelfContained.runtimeconfig.json",
+			expected: func(locations file.LocationSet) []pkg.Package {
+				return []pkg.Package{
+					{
+						Name:      "Microsoft.NETCore.App",
+						Version:   "7.0.14",
+						PURL:      "pkg:nuget/Microsoft.NETCore.App@7.0.14",
+						Locations: locations,
+						Language:  pkg.Dotnet,
+						Type:      pkg.DotnetPkg,
+						Metadata: pkg.DotnetRuntimeConfigEntry{
+							Name:            "Microsoft.NETCore.App",
+							Version:         "7.0.14",
+							TargetFramework: "net7.0",
+							SelfContained:   true,
+						},
+					},
+				}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			locations := file.NewLocationSet(file.NewLocation(test.fixture))
+			pkgtest.TestFileParser(t, test.fixture, parseDotnetRuntimeConfig, test.expected(locations), nil)
+		})
+	}
+}
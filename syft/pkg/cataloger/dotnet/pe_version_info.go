@@ -0,0 +1,77 @@
+package dotnet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/saferwall/pe"
+)
+
+// peVersionResources returns the version strings from the VS_VERSIONINFO resource of the given (parsed) PE file.
+// Native binaries do not always provide the version strings, in which case the FileVersion and ProductVersion are
+// taken from the language-independent VS_FIXEDFILEINFO structure of the same resource.
+func peVersionResources(peFile *pe.File) (map[string]string, error) {
+	versionResources, err := peFile.ParseVersionResources()
+	if err != nil {
+		return nil, err
+	}
+
+	if versionResources["FileVersion"] != "" && versionResources["ProductVersion"] != "" {
+		return versionResources, nil
+	}
+
+	if info := peFixedFileInfo(peFile); info != nil {
+		withFixedFileInfoVersions(versionResources, *info)
+	}
+	return versionResources, nil
+}
+
+// withFixedFileInfoVersions fills in the FileVersion and ProductVersion from the fixed file info when missing.
+func withFixedFileInfoVersions(versionResources map[string]string, info pe.VsFixedFileInfo) {
+	if versionResources["FileVersion"] == "" {
+		if v := fixedFileInfoVersion(info.FileVersionMS, info.FileVersionLS); v != "" {
+			versionResources["FileVersion"] = v
+		}
+	}
+	if versionResources["ProductVersion"] == "" {
+		if v := fixedFileInfoVersion(info.ProductVersionMS, info.ProductVersionLS); v != "" {
+			versionResources["ProductVersion"] = v
+		}
+	}
+}
+
+// fixedFileInfoVersion formats a binary version number as "major.minor.build.revision", or returns an empty string
+// if no version is set.
+func fixedFileInfoVersion(ms, ls uint32) string {
+	if ms == 0 && ls == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d.%d.%d.%d", ms>>16, ms&0xffff, ls>>16, ls&0xffff)
+}
+
+// peFixedFileInfo returns the VS_FIXEDFILEINFO structure of the first version resource, if any.
+func peFixedFileInfo(peFile *pe.File) *pe.VsFixedFileInfo {
+	for _, typeEntry := range peFile.Resources.Entries {
+		if typeEntry.ID != pe.VersionResourceType {
+			continue
+		}
+		for _, nameEntry := range typeEntry.Directory.Entries {
+			for _, langEntry := range nameEntry.Directory.Entries {
+				var info pe.VsFixedFileInfo
+				b, err := peFile.ReadBytesAtOffset(info.GetOffset(langEntry, peFile), info.Size())
+				if err != nil {
+					continue
+				}
+				if err := binary.Read(bytes.NewReader(b), binary.LittleEndian, &info); err != nil {
+					continue
+				}
+				if info.Signature != pe.VsFileInfoSignature {
+					continue
+				}
+				return &info
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package dotnet
+
+import (
+	"testing"
+
+	"github.com/saferwall/pe"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_withFixedFileInfoVersions(t *testing.T) {
+	info := pe.VsFixedFileInfo{
+		FileVersionMS:    0x00080001, // 8.1
+		FileVersionLS:    0x00020003, // 2.3
+		ProductVersionMS: 0x00080001, // 8.1
+		ProductVersionLS: 0x00000000, // 0.0
+	}
+
+	tests := []struct {
+		name      string
+		resources map[string]string
+		info      pe.VsFixedFileInfo
+		want      map[string]string
+	}{
+		{
+			name:      "versions missing from the string table",
+			resources: map[string]string{"ProductName": "curl"},
+			info:      info,
+			want: map[string]string{
+				"ProductName":    "curl",
+				"FileVersion":    "8.1.2.3",
+				"ProductVersion": "8.1.0.0",
+			},
+		},
+		{
+			name: "string table versions are preferred",
+			resources: map[string]string{
+				"ProductName":    "curl",
+				"ProductVersion": "8.1.2",
+			},
+			info: info,
+			want: map[string]string{
+				"ProductName":    "curl",
+				"FileVersion":    "8.1.2.3",
+				"ProductVersion": "8.1.2",
+			},
+		},
+		{
+			name:      "no fixed versions",
+			resources: map[string]string{"ProductName": "curl"},
+			want:      map[string]string{"ProductName": "curl"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withFixedFileInfoVersions(tt.resources, tt.info)
+			assert.Equal(t, tt.want, tt.resources)
+		})
+	}
+}
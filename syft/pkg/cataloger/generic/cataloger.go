@@ -2,32 +2,50 @@ package generic
 
 import (
 	"context"
+	"path"
+	"strings"
+	"sync/atomic"
 
 	"github.com/anchore/go-logger"
 	"github.com/anchore/syft/internal"
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/diagnostic"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/linux"
 	"github.com/anchore/syft/syft/pkg"
 )
 
-type processor func(resolver file.Resolver, env Environment) []request
+type requester func(resolver file.Resolver, env Environment) []request
+
+// Processor transforms the results of all parsers of a cataloger once cataloging has completed (e.g. to drop packages
+// that are better described by other packages of the same results).
+type Processor func([]pkg.Package, []artifact.Relationship, error) ([]pkg.Package, []artifact.Relationship, error)
 
 type request struct {
 	file.Location
 	Parser
+	pattern string
+}
+
+// MatchResult describes a file selected for parsing by a cataloger, along with the glob, path, or MIME type(s)
+// responsible for the selection.
+type MatchResult struct {
+	Path    string
+	Pattern string
 }
 
 // Cataloger implements the Catalog interface and is responsible for dispatching the proper parser function for
 // a given path or glob pattern. This is intended to be reusable across many package cataloger types.
 type Cataloger struct {
-	processor         []processor
+	requesters        []requester
+	processors        []Processor
 	upstreamCataloger string
+	recordUnknowns    bool
 }
 
 func (c *Cataloger) WithParserByGlobs(parser Parser, globs ...string) *Cataloger {
-	c.processor = append(c.processor,
+	c.requesters = append(c.requesters,
 		func(resolver file.Resolver, _ Environment) []request {
 			var requests []request
 			for _, g := range globs {
@@ -38,7 +56,7 @@ func (c *Cataloger) WithParserByGlobs(parser Parser, globs ...string) *Cataloger
 					log.Warnf("unable to process glob=%q: %+v", g, err)
 					continue
 				}
-				requests = append(requests, makeRequests(parser, matches)...)
+				requests = append(requests, makeRequests(parser, g, matches)...)
 			}
 			return requests
 		},
@@ -47,7 +65,7 @@ func (c *Cataloger) WithParserByGlobs(parser Parser, globs ...string) *Cataloger
 }
 
 func (c *Cataloger) WithParserByMimeTypes(parser Parser, types ...string) *Cataloger {
-	c.processor = append(c.processor,
+	c.requesters = append(c.requesters,
 		func(resolver file.Resolver, _ Environment) []request {
 			var requests []request
 			log.WithFields("mimetypes", types).Trace("searching for paths matching mimetype")
@@ -56,7 +74,7 @@ func (c *Cataloger) WithParserByMimeTypes(parser Parser, types ...string) *Catal
 				log.Warnf("unable to process mimetypes=%+v: %+v", types, err)
 				return nil
 			}
-			requests = append(requests, makeRequests(parser, matches)...)
+			requests = append(requests, makeRequests(parser, strings.Join(types, ","), matches)...)
 			return requests
 		},
 	)
@@ -64,7 +82,7 @@ func (c *Cataloger) WithParserByMimeTypes(parser Parser, types ...string) *Catal
 }
 
 func (c *Cataloger) WithParserByPath(parser Parser, paths ...string) *Cataloger {
-	c.processor = append(c.processor,
+	c.requesters = append(c.requesters,
 		func(resolver file.Resolver, _ Environment) []request {
 			var requests []request
 			for _, p := range paths {
@@ -75,7 +93,7 @@ func (c *Cataloger) WithParserByPath(parser Parser, paths ...string) *Cataloger
 					log.Warnf("unable to process path=%q: %+v", p, err)
 					continue
 				}
-				requests = append(requests, makeRequests(parser, matches)...)
+				requests = append(requests, makeRequests(parser, p, matches)...)
 			}
 			return requests
 		},
@@ -83,17 +101,32 @@ func (c *Cataloger) WithParserByPath(parser Parser, paths ...string) *Cataloger
 	return c
 }
 
-func makeRequests(parser Parser, locations []file.Location) []request {
+func makeRequests(parser Parser, pattern string, locations []file.Location) []request {
 	var requests []request
 	for _, l := range locations {
 		requests = append(requests, request{
 			Location: l,
 			Parser:   parser,
+			pattern:  pattern,
 		})
 	}
 	return requests
 }
 
+// WithProcessors adds processors that are applied (in order) to the results of every Catalog call.
+func (c *Cataloger) WithProcessors(processors ...Processor) *Cataloger {
+	c.processors = append(c.processors, processors...)
+	return c
+}
+
+// WithUnknowns allows for reporting each file that was selected for parsing but could not be read or parsed as a
+// package of the UnknownPkg type (named after the file, and located at the file), so the cataloging results reflect
+// the files that could not be fully processed instead of silently dropping them.
+func (c *Cataloger) WithUnknowns(record bool) *Cataloger {
+	c.recordUnknowns = record
+	return c
+}
+
 // NewCataloger if provided path-to-parser-function and glob-to-parser-function lookups creates a Cataloger
 func NewCataloger(upstreamCataloger string) *Cataloger {
 	return &Cataloger{
@@ -112,6 +145,8 @@ func (c *Cataloger) Catalog(ctx context.Context, resolver file.Resolver) ([]pkg.
 	var relationships []artifact.Relationship
 
 	logger := log.Nested("cataloger", c.upstreamCataloger)
+	warnings := diagnostic.CollectionFromContext(ctx)
+	progress := progressFromContext(ctx)
 
 	env := Environment{
 		// TODO: consider passing into the cataloger, this would affect the cataloger interface (and all implementations). This can be deferred until later.
@@ -119,13 +154,28 @@ func (c *Cataloger) Catalog(ctx context.Context, resolver file.Resolver) ([]pkg.
 	}
 
 	for _, req := range c.selectFiles(resolver) {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
 		location, parser := req.Location, req.Parser
 
-		log.WithFields("path", location.RealPath).Trace("parsing file contents")
+		log.WithFields("path", location.RealPath, "pattern", req.pattern).Trace("parsing file contents")
 
+		progress.set(&location)
 		discoveredPackages, discoveredRelationships, err := invokeParser(ctx, resolver, location, logger, parser, &env)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			// the results are discarded when canceled, and the file that was being parsed is retained to be reported
+			return nil, nil, ctxErr
+		}
+		progress.set(nil)
 		if err != nil {
-			continue // logging is handled within invokeParser
+			// logging is handled within invokeParser
+			warnings.Add(c.upstreamCataloger, &location, err.Error())
+			if c.recordUnknowns {
+				packages = append(packages, NewUnknownPackage(location, c.upstreamCataloger))
+			}
+			continue
 		}
 
 		for _, p := range discoveredPackages {
@@ -135,7 +185,12 @@ func (c *Cataloger) Catalog(ctx context.Context, resolver file.Resolver) ([]pkg.
 
 		relationships = append(relationships, discoveredRelationships...)
 	}
-	return packages, relationships, nil
+
+	var err error
+	for _, proc := range c.processors {
+		packages, relationships, err = proc(packages, relationships, err)
+	}
+	return packages, relationships, err
 }
 
 func invokeParser(ctx context.Context, resolver file.Resolver, location file.Location, logger logger.Logger, parser Parser, env *Environment) ([]pkg.Package, []artifact.Relationship, error) {
@@ -155,11 +210,71 @@ func invokeParser(ctx context.Context, resolver file.Resolver, location file.Loc
 	return discoveredPackages, discoveredRelationships, nil
 }
 
+// Progress records the file being parsed within a single Catalog call, which is useful for reporting the file a
+// cataloger was stuck on when abandoned (e.g. after a timeout). Since a cataloger may be invoked concurrently, the
+// progress is provided per call through the context (see ContextWithProgress) instead of being held by the cataloger.
+type Progress struct {
+	parsing atomic.Pointer[file.Location]
+}
+
+type progressContextKey struct{}
+
+// ContextWithProgress returns a context that records the file being parsed by a Catalog call within the given progress.
+func ContextWithProgress(ctx context.Context, p *Progress) context.Context {
+	return context.WithValue(ctx, progressContextKey{}, p)
+}
+
+func progressFromContext(ctx context.Context) *Progress {
+	p, _ := ctx.Value(progressContextKey{}).(*Progress)
+	return p
+}
+
+// InProgress returns the location of the file being parsed. When cataloging is canceled, the file being parsed at the
+// time of cancellation is retained.
+func (p *Progress) InProgress() (file.Location, bool) {
+	if l := p.parsing.Load(); l != nil {
+		return *l, true
+	}
+	return file.Location{}, false
+}
+
+func (p *Progress) set(l *file.Location) {
+	if p != nil {
+		p.parsing.Store(l)
+	}
+}
+
+// NewUnknownPackage returns a package of the UnknownPkg type for a file that could not be cataloged, which is named
+// after the file and located at the file.
+func NewUnknownPackage(location file.Location, foundBy string) pkg.Package {
+	p := pkg.Package{
+		Name:      path.Base(location.RealPath),
+		FoundBy:   foundBy,
+		Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+		Type:      pkg.UnknownPkg,
+	}
+	p.SetID()
+	return p
+}
+
+// FileMatches returns the files that would be parsed by this cataloger, along with the pattern that selected each
+// file. No file contents are read. This is useful for explaining why a file was (or was not) cataloged.
+func (c *Cataloger) FileMatches(resolver file.Resolver) []MatchResult {
+	var results []MatchResult
+	for _, req := range c.selectFiles(resolver) {
+		results = append(results, MatchResult{
+			Path:    req.RealPath,
+			Pattern: req.pattern,
+		})
+	}
+	return results
+}
+
 // selectFiles takes a set of file trees and resolves and file references of interest for future cataloging
 func (c *Cataloger) selectFiles(resolver file.Resolver) []request {
 	var requests []request
-	for _, proc := range c.processor {
-		requests = append(requests, proc(resolver, Environment{})...)
+	for _, req := range c.requesters {
+		requests = append(requests, req(resolver, Environment{})...)
 	}
 	return requests
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/diagnostic"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
 )
@@ -88,6 +89,146 @@ func Test_Cataloger(t *testing.T) {
 	}
 }
 
+func Test_Cataloger_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var parsed bool
+	parser := func(_ context.Context, _ file.Resolver, _ *Environment, _ file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+		parsed = true
+		return nil, nil, nil
+	}
+
+	resolver := file.NewMockResolverForPaths("test-fixtures/a-path.txt")
+	cataloger := NewCataloger("some-cataloger").WithParserByGlobs(parser, "**/a-path.txt")
+
+	_, _, err := cataloger.Catalog(ctx, resolver)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, parsed)
+}
+
+func Test_Cataloger_WithUnknowns(t *testing.T) {
+	parser := func(_ context.Context, _ file.Resolver, _ *Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+		if reader.RealPath == "test-fixtures/a-path.txt" {
+			return nil, nil, fmt.Errorf("malformed file")
+		}
+		return nil, nil, nil
+	}
+
+	resolver := file.NewMockResolverForPaths("test-fixtures/a-path.txt", "test-fixtures/empty.txt")
+	newCataloger := func() *Cataloger {
+		return NewCataloger("some-cataloger").WithParserByGlobs(parser, "**/a-path.txt", "**/empty.txt")
+	}
+
+	pkgs, _, err := newCataloger().Catalog(context.Background(), resolver)
+	require.NoError(t, err)
+	assert.Empty(t, pkgs)
+
+	pkgs, _, err = newCataloger().WithUnknowns(true).Catalog(context.Background(), resolver)
+	require.NoError(t, err)
+	require.Len(t, pkgs, 1)
+
+	p := pkgs[0]
+	assert.Equal(t, "a-path.txt", p.Name)
+	assert.Equal(t, pkg.UnknownPkg, p.Type)
+	assert.Equal(t, "some-cataloger", p.FoundBy)
+	require.Len(t, p.Locations.ToSlice(), 1)
+	assert.Equal(t, "test-fixtures/a-path.txt", p.Locations.ToSlice()[0].RealPath)
+	assert.NotEmpty(t, p.ID())
+}
+
+func Test_Cataloger_Warnings(t *testing.T) {
+	parser := func(_ context.Context, _ file.Resolver, _ *Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+		if reader.RealPath == "test-fixtures/a-path.txt" {
+			return nil, nil, fmt.Errorf("malformed file")
+		}
+		return nil, nil, nil
+	}
+
+	c := NewCataloger("some-cataloger").WithParserByGlobs(parser, "**/a-path.txt", "**/empty.txt")
+
+	collection := &diagnostic.Collection{}
+	_, _, err := c.Catalog(diagnostic.ContextWithCollection(context.Background(), collection), file.NewMockResolverForPaths("test-fixtures/a-path.txt", "test-fixtures/empty.txt"))
+	require.NoError(t, err)
+
+	warnings := collection.Warnings()
+	require.Len(t, warnings, 1)
+	assert.Equal(t, "some-cataloger", warnings[0].Cataloger)
+	require.NotNil(t, warnings[0].Location)
+	assert.Equal(t, "test-fixtures/a-path.txt", warnings[0].Location.RealPath)
+	assert.Equal(t, "malformed file", warnings[0].Reason)
+
+	// warnings are collected per call
+	other := &diagnostic.Collection{}
+	_, _, err = c.Catalog(diagnostic.ContextWithCollection(context.Background(), other), file.NewMockResolverForPaths("test-fixtures/empty.txt"))
+	require.NoError(t, err)
+	assert.Empty(t, other.Warnings())
+	assert.Len(t, collection.Warnings(), 1)
+
+	// warnings are dropped when the context does not collect them
+	_, _, err = c.Catalog(context.Background(), file.NewMockResolverForPaths("test-fixtures/a-path.txt"))
+	require.NoError(t, err)
+}
+
+func Test_Cataloger_Progress(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	progress := &Progress{}
+	ctx = ContextWithProgress(ctx, progress)
+
+	c := NewCataloger("some-cataloger")
+	parser := func(ctx context.Context, _ file.Resolver, _ *Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+		location, ok := progressFromContext(ctx).InProgress()
+		require.True(t, ok)
+		assert.Equal(t, reader.RealPath, location.RealPath)
+
+		if reader.RealPath == "test-fixtures/empty.txt" {
+			cancel()
+		}
+		return nil, nil, nil
+	}
+	c.WithParserByGlobs(parser, "**/a-path.txt", "**/empty.txt")
+
+	_, ok := progress.InProgress()
+	assert.False(t, ok)
+
+	// a concurrent call (with its own progress) does not affect the progress of this call
+	other := &Progress{}
+	_, _, err := c.Catalog(ContextWithProgress(context.Background(), other), file.NewMockResolverForPaths("test-fixtures/a-path.txt"))
+	require.NoError(t, err)
+	_, ok = other.InProgress()
+	assert.False(t, ok)
+
+	_, _, err = c.Catalog(ctx, file.NewMockResolverForPaths("test-fixtures/a-path.txt", "test-fixtures/empty.txt"))
+	require.ErrorIs(t, err, context.Canceled)
+
+	// the file being parsed when canceled is retained
+	location, ok := progress.InProgress()
+	require.True(t, ok)
+	assert.Equal(t, "test-fixtures/empty.txt", location.RealPath)
+}
+
+func Test_Cataloger_FileMatches(t *testing.T) {
+	parser := func(context.Context, file.Resolver, *Environment, file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+		t.Fatal("parser should not be invoked when matching files")
+		return nil, nil, nil
+	}
+
+	resolver := file.NewMockResolverForPaths("test-fixtures/last/path.txt", "test-fixtures/another-path.txt", "test-fixtures/a-path.txt")
+	cataloger := NewCataloger("some-cataloger").
+		WithParserByPath(parser, "test-fixtures/another-path.txt").
+		WithParserByGlobs(parser, "**/a-path.txt", "**/last/*.txt", "**/missing.txt")
+
+	expected := []MatchResult{
+		{Path: "test-fixtures/another-path.txt", Pattern: "test-fixtures/another-path.txt"},
+		{Path: "test-fixtures/a-path.txt", Pattern: "**/a-path.txt"},
+		{Path: "test-fixtures/last/path.txt", Pattern: "**/last/*.txt"},
+	}
+
+	assert.Equal(t, expected, cataloger.FileMatches(resolver))
+}
+
 type spyReturningFileResolver struct {
 	m *file.MockResolver
 	s *spyingIoReadCloser
@@ -159,7 +300,7 @@ func TestClosesFileOnParserPanic(t *testing.T) {
 	resolver := newSpyReturningFileResolver(&spy, "test-fixtures/another-path.txt")
 	ctx := context.TODO()
 
-	processors := []processor{
+	requesters := []requester{
 		func(resolver file.Resolver, env Environment) []request {
 			return []request{
 				{
@@ -178,7 +319,7 @@ func TestClosesFileOnParserPanic(t *testing.T) {
 	}
 
 	c := Cataloger{
-		processor:         processors,
+		requesters:        requesters,
 		upstreamCataloger: "unit-test-cataloger",
 	}
 
@@ -0,0 +1,65 @@
+package generic
+
+import (
+	"path"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// LockedNamesFunc returns the names of the packages pinned by a package found from a lockfile (e.g. the package name,
+// along with any modules the package provides), or nothing for packages that were not found from a lockfile.
+type LockedNamesFunc func(p pkg.Package) []string
+
+// DeclaredNameFunc returns the name of a package declared by a manifest that lockfiles resolve, or an empty string
+// for any other package.
+type DeclaredNameFunc func(p pkg.Package) string
+
+// PreferLockedPackages returns a Processor that drops packages declared by a manifest when a lockfile within the same
+// directory pins a package of the same name, since the lockfile records the exact version that is used. Packages found
+// within other directories (e.g. installed within a library, or of another project) are always kept.
+func PreferLockedPackages(lockedNames LockedNamesFunc, declaredName DeclaredNameFunc) Processor {
+	return func(pkgs []pkg.Package, relationships []artifact.Relationship, err error) ([]pkg.Package, []artifact.Relationship, error) {
+		locked := strset.New()
+		for _, p := range pkgs {
+			names := lockedNames(p)
+			if len(names) == 0 {
+				continue
+			}
+			for _, l := range p.Locations.ToSlice() {
+				for _, name := range names {
+					locked.Add(lockKey(l.RealPath, name))
+				}
+			}
+		}
+
+		if locked.IsEmpty() {
+			return pkgs, relationships, err
+		}
+
+		var result []pkg.Package
+		for _, p := range pkgs {
+			if name := declaredName(p); name != "" && isLocked(locked, p, name) {
+				continue
+			}
+			result = append(result, p)
+		}
+		return result, relationships, err
+	}
+}
+
+func isLocked(locked *strset.Set, p pkg.Package, name string) bool {
+	for _, l := range p.Locations.ToSlice() {
+		if locked.Has(lockKey(l.RealPath, name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// lockKey identifies a package within the directory of the given file.
+func lockKey(filePath, name string) string {
+	return path.Dir(filePath) + ":" + name
+}
@@ -0,0 +1,82 @@
+package generic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestPreferLockedPackages(t *testing.T) {
+	newPackage := func(name, version, location string) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			Locations: file.NewLocationSet(file.NewLocation(location)),
+		}
+	}
+
+	isLockfile := func(p pkg.Package) bool {
+		return p.Locations.ToSlice()[0].RealPath == "project/some.lock"
+	}
+
+	proc := PreferLockedPackages(
+		func(p pkg.Package) []string {
+			if isLockfile(p) {
+				return []string{p.Name}
+			}
+			return nil
+		},
+		func(p pkg.Package) string {
+			if isLockfile(p) {
+				return ""
+			}
+			return p.Name
+		},
+	)
+
+	tests := []struct {
+		name string
+		pkgs []pkg.Package
+		want []string
+	}{
+		{
+			name: "no lockfile",
+			pkgs: []pkg.Package{
+				newPackage("a", "1.0", "project/manifest"),
+			},
+			want: []string{"project/manifest:a@1.0"},
+		},
+		{
+			name: "only drop packages declared within the lockfile directory",
+			pkgs: []pkg.Package{
+				newPackage("a", "1.0", "project/manifest"),
+				newPackage("b", "2.0", "project/manifest"),
+				newPackage("a", "1.0", "project/lib/a/manifest"),
+				newPackage("a", "1.0", "other/manifest"),
+				newPackage("a", "1.1", "project/some.lock"),
+			},
+			want: []string{
+				"project/manifest:b@2.0",
+				"project/lib/a/manifest:a@1.0",
+				"other/manifest:a@1.0",
+				"project/some.lock:a@1.1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := proc(tt.pkgs, nil, nil)
+			assert.NoError(t, err)
+
+			var found []string
+			for _, p := range got {
+				found = append(found, fmt.Sprintf("%s:%s@%s", p.Locations.ToSlice()[0].RealPath, p.Name, p.Version))
+			}
+			assert.Equal(t, tt.want, found)
+		})
+	}
+}
@@ -20,12 +20,18 @@ func TestPortageCataloger(t *testing.T) {
 			Locations: file.NewLocationSet(
 				file.NewLocation("var/db/pkg/app-containers/skopeo-1.5.1/CONTENTS"),
 				file.NewLocation("var/db/pkg/app-containers/skopeo-1.5.1/SIZE"),
+				file.NewLocation("var/db/pkg/app-containers/skopeo-1.5.1/USE"),
+				file.NewLocation("var/db/pkg/app-containers/skopeo-1.5.1/SLOT"),
+				file.NewLocation("var/db/pkg/app-containers/skopeo-1.5.1/repository"),
 				expectedLicenseLocation,
 			),
 			Licenses: pkg.NewLicenseSet(pkg.NewLicensesFromLocation(expectedLicenseLocation, "Apache-2.0", "BSD", "BSD-2", "CC-BY-SA-4.0", "ISC", "MIT")...),
 			Type:     pkg.PortagePkg,
 			Metadata: pkg.PortageEntry{
 				InstalledSize: 27937835,
+				UseFlags:      []string{"abi_x86_64", "amd64", "btrfs", "device-mapper", "elibc_glibc", "kernel_linux", "userland_GNU"},
+				Slot:          "0",
+				Repository:    "gentoo",
 				Files: []pkg.PortageFileRecord{
 					{
 						Path: "/usr/bin/skopeo",
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"path"
 	"path/filepath"
 	"regexp"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/scylladb/go-set/strset"
 
+	"github.com/anchore/syft/internal"
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/file"
@@ -52,6 +54,7 @@ func parsePortageContents(_ context.Context, resolver file.Resolver, _ *generic.
 	}
 	addLicenses(resolver, reader.Location, &p)
 	addSize(resolver, reader.Location, &p)
+	addBuildConfig(resolver, reader.Location, &p)
 	addFiles(resolver, reader.Location, &p)
 
 	p.SetID()
@@ -154,3 +157,48 @@ func addSize(resolver file.Resolver, dbLocation file.Location, p *pkg.Package) {
 	p.Metadata = entry
 	p.Locations.Add(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
 }
+
+// addBuildConfig records the USE flags, slot, and repository of the package, from the files next to the CONTENTS file.
+func addBuildConfig(resolver file.Resolver, dbLocation file.Location, p *pkg.Package) {
+	entry, ok := p.Metadata.(pkg.PortageEntry)
+	if !ok {
+		return
+	}
+
+	if contents, ok := readSiblingFile(resolver, dbLocation, p, "USE"); ok {
+		entry.UseFlags = strings.Fields(contents)
+	}
+	if contents, ok := readSiblingFile(resolver, dbLocation, p, "SLOT"); ok {
+		entry.Slot = strings.TrimSpace(contents)
+	}
+	if contents, ok := readSiblingFile(resolver, dbLocation, p, "repository"); ok {
+		entry.Repository = strings.TrimSpace(contents)
+	}
+
+	p.Metadata = entry
+}
+
+// readSiblingFile returns the contents of the file with the given name next to the CONTENTS file, adding the file to
+// the locations of the package (as supporting evidence) when found.
+func readSiblingFile(resolver file.Resolver, dbLocation file.Location, p *pkg.Package, name string) (string, bool) {
+	location := resolver.RelativeFileByPath(dbLocation, path.Join(filepath.Dir(dbLocation.RealPath), name))
+	if location == nil {
+		return "", false
+	}
+
+	reader, err := resolver.FileContentsByLocation(*location)
+	if err != nil {
+		log.WithFields("name", p.Name).Warnf("failed to fetch portage %s: %+v", name, err)
+		return "", false
+	}
+	defer internal.CloseAndLogError(reader, location.RealPath)
+
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		log.WithFields("name", p.Name).Warnf("failed to read portage %s: %+v", name, err)
+		return "", false
+	}
+
+	p.Locations.Add(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+	return string(contents), true
+}
@@ -0,0 +1,16 @@
+/*
+Package git provides a concrete Cataloger implementation for source dependencies vendored as git submodules.
+*/
+package git
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewSubmoduleCataloger returns a new cataloger object for git submodules declared in .gitmodules files, pinned at
+// the commits recorded in the index of the superproject.
+func NewSubmoduleCataloger() pkg.Cataloger {
+	return generic.NewCataloger("git-submodule-cataloger").
+		WithParserByGlobs(parseGitmodules, "**/.gitmodules")
+}
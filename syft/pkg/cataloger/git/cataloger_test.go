@@ -0,0 +1,33 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestCataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain gitmodules files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"src/.gitmodules",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				IgnoreUnfulfilledPathResponses("src/.git/index").
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewSubmoduleCataloger())
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package git
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// scpLikeURLPattern matches the scp-like syntax for ssh remotes (e.g. "git@github.com:anchore/syft.git")
+var scpLikeURLPattern = regexp.MustCompile(`^(?:[^@/]+@)?([^:/]+):(.+)$`)
+
+func newPackage(commit string, m pkg.GitSubmoduleEntry, locations ...file.Location) pkg.Package {
+	name := nameFromURL(m.URL)
+
+	p := pkg.Package{
+		Name:      name,
+		Version:   commit,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(name, commit, m.URL),
+		Type:      pkg.GitSubmodulePkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// nameFromURL returns the repository URL without the scheme, user info, or ".git" suffix
+// (e.g. "https://github.com/anchore/syft.git" becomes "github.com/anchore/syft").
+func nameFromURL(u string) string {
+	name := strings.TrimSpace(u)
+	if idx := strings.Index(name, "://"); idx >= 0 {
+		name = name[idx+3:]
+		if at := strings.Index(name, "@"); at >= 0 && at < strings.Index(name+"/", "/") {
+			name = name[at+1:]
+		}
+	} else if match := scpLikeURLPattern.FindStringSubmatch(name); match != nil && !strings.HasPrefix(name, ".") {
+		name = match[1] + "/" + match[2]
+	}
+	name = strings.TrimSuffix(name, "/")
+	return strings.TrimSuffix(name, ".git")
+}
+
+func packageURL(name, commit, vcsURL string) string {
+	var qualifiers packageurl.Qualifiers
+	if vcsURL != "" {
+		vcs := "git+" + vcsURL
+		if commit != "" {
+			vcs += "@" + commit
+		}
+		qualifiers = packageurl.QualifiersFromMap(map[string]string{
+			pkg.PURLQualifierVCSURL: vcs,
+		})
+	}
+
+	return packageurl.NewPackageURL(
+		pkg.GitSubmodulePkg.PackageURLType(),
+		"",
+		path.Base(name),
+		commit,
+		qualifiers,
+		"",
+	).ToString()
+}
@@ -0,0 +1,99 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseGitmodules
+
+// parseGitmodules is a parser function for .gitmodules contents, returning a package for each declared submodule. The
+// commit each submodule is pinned at is read from the index of the superproject (the .git/index file next to the
+// .gitmodules file), if present.
+func parseGitmodules(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read .gitmodules file: %w", err)
+	}
+
+	modules := config.NewModules()
+	if err := modules.Unmarshal(contents); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse .gitmodules file: %w", err)
+	}
+
+	commits, indexLocation := findSubmoduleCommits(resolver, reader.Location)
+
+	var pkgs []pkg.Package
+	for _, s := range modules.Submodules {
+		if s.Path == "" || s.URL == "" {
+			log.WithFields("path", reader.RealPath, "submodule", s.Name).Trace("skipping incomplete git submodule entry")
+			continue
+		}
+
+		locations := []file.Location{reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+		commit, ok := commits[path.Clean(s.Path)]
+		if ok {
+			locations = append(locations, indexLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+		}
+
+		pkgs = append(pkgs, newPackage(commit, pkg.GitSubmoduleEntry{
+			Path:   s.Path,
+			URL:    s.URL,
+			Branch: s.Branch,
+		}, locations...))
+	}
+
+	sort.Slice(pkgs, func(i, j int) bool {
+		return pkgs[i].Metadata.(pkg.GitSubmoduleEntry).Path < pkgs[j].Metadata.(pkg.GitSubmoduleEntry).Path
+	})
+
+	return pkgs, nil, nil
+}
+
+// findSubmoduleCommits returns the commits recorded for all submodules (gitlink entries) in the superproject index,
+// keyed by the submodule path.
+func findSubmoduleCommits(resolver file.Resolver, location file.Location) (map[string]string, file.Location) {
+	if resolver == nil {
+		return nil, file.Location{}
+	}
+
+	indexLocation := resolver.RelativeFileByPath(location, path.Join(path.Dir(location.RealPath), ".git", "index"))
+	if indexLocation == nil {
+		return nil, file.Location{}
+	}
+
+	contents, err := resolver.FileContentsByLocation(*indexLocation)
+	if err != nil {
+		log.WithFields("path", indexLocation.RealPath, "error", err).Debug("unable to read git index")
+		return nil, file.Location{}
+	}
+	defer internal.CloseAndLogError(contents, indexLocation.RealPath)
+
+	var idx index.Index
+	if err := index.NewDecoder(contents).Decode(&idx); err != nil {
+		log.WithFields("path", indexLocation.RealPath, "error", err).Debug("unable to parse git index")
+		return nil, file.Location{}
+	}
+
+	commits := make(map[string]string)
+	for _, e := range idx.Entries {
+		if e.Mode == filemode.Submodule {
+			commits[e.Name] = e.Hash.String()
+		}
+	}
+	return commits, *indexLocation
+}
@@ -0,0 +1,151 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseGitmodules(t *testing.T) {
+	fixture := "test-fixtures/submodules/.gitmodules"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "github.com/anchore/packageurl-go",
+			Locations: locations,
+			PURL:      "pkg:generic/packageurl-go?vcs_url=git%2Bgit%40github.com:anchore/packageurl-go.git",
+			Type:      pkg.GitSubmodulePkg,
+			Metadata: pkg.GitSubmoduleEntry{
+				Path:   "vendor/packageurl-go",
+				URL:    "git@github.com:anchore/packageurl-go.git",
+				Branch: "main",
+			},
+		},
+		{
+			Name:      "github.com/anchore/stereoscope",
+			Locations: locations,
+			PURL:      "pkg:generic/stereoscope?vcs_url=git%2Bhttps://github.com/anchore/stereoscope.git",
+			Type:      pkg.GitSubmodulePkg,
+			Metadata: pkg.GitSubmoduleEntry{
+				Path: "vendor/stereoscope",
+				URL:  "https://github.com/anchore/stereoscope.git",
+			},
+		},
+		{
+			Name:      "gitlab.com/example/unpinned",
+			Locations: locations,
+			PURL:      "pkg:generic/unpinned?vcs_url=git%2Bhttps://gitlab.com/example/unpinned",
+			Type:      pkg.GitSubmodulePkg,
+			Metadata: pkg.GitSubmoduleEntry{
+				Path: "vendor/unpinned",
+				URL:  "https://gitlab.com/example/unpinned",
+			},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseGitmodules, expectedPkgs, nil)
+}
+
+func TestSubmoduleCataloger_PinnedCommits(t *testing.T) {
+	dir := t.TempDir()
+
+	contents, err := os.ReadFile("test-fixtures/submodules/.gitmodules")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".gitmodules"), contents, 0o600))
+
+	// a .git directory cannot be committed as a fixture, so the superproject index is written at test time
+	writeIndex(t, filepath.Join(dir, ".git", "index"), &index.Index{
+		Version: 2,
+		Entries: []*index.Entry{
+			{Name: ".gitmodules", Mode: filemode.Regular, Hash: plumbing.NewHash("4b825dc642cb6eb9a060e54bf8d69288fbee4904")},
+			{Name: "vendor/packageurl-go", Mode: filemode.Submodule, Hash: plumbing.NewHash("a2f1d3a4b5c6d7e8f90112233445566778899aab")},
+			{Name: "vendor/stereoscope", Mode: filemode.Submodule, Hash: plumbing.NewHash("0123456789abcdef0123456789abcdef01234567")},
+		},
+	})
+
+	gitmodules := file.NewLocation(".gitmodules")
+	pinned := file.NewLocationSet(gitmodules, file.NewLocation(".git/index"))
+
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "github.com/anchore/packageurl-go",
+			Version:   "a2f1d3a4b5c6d7e8f90112233445566778899aab",
+			FoundBy:   "git-submodule-cataloger",
+			Locations: pinned,
+			PURL:      "pkg:generic/packageurl-go@a2f1d3a4b5c6d7e8f90112233445566778899aab?vcs_url=git%2Bgit%40github.com:anchore/packageurl-go.git%40a2f1d3a4b5c6d7e8f90112233445566778899aab",
+			Type:      pkg.GitSubmodulePkg,
+			Metadata: pkg.GitSubmoduleEntry{
+				Path:   "vendor/packageurl-go",
+				URL:    "git@github.com:anchore/packageurl-go.git",
+				Branch: "main",
+			},
+		},
+		{
+			Name:      "github.com/anchore/stereoscope",
+			Version:   "0123456789abcdef0123456789abcdef01234567",
+			FoundBy:   "git-submodule-cataloger",
+			Locations: pinned,
+			PURL:      "pkg:generic/stereoscope@0123456789abcdef0123456789abcdef01234567?vcs_url=git%2Bhttps://github.com/anchore/stereoscope.git%400123456789abcdef0123456789abcdef01234567",
+			Type:      pkg.GitSubmodulePkg,
+			Metadata: pkg.GitSubmoduleEntry{
+				Path: "vendor/stereoscope",
+				URL:  "https://github.com/anchore/stereoscope.git",
+			},
+		},
+		{
+			Name:      "gitlab.com/example/unpinned",
+			FoundBy:   "git-submodule-cataloger",
+			Locations: file.NewLocationSet(gitmodules),
+			PURL:      "pkg:generic/unpinned?vcs_url=git%2Bhttps://gitlab.com/example/unpinned",
+			Type:      pkg.GitSubmodulePkg,
+			Metadata: pkg.GitSubmoduleEntry{
+				Path: "vendor/unpinned",
+				URL:  "https://gitlab.com/example/unpinned",
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, dir).
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewSubmoduleCataloger())
+}
+
+func Test_nameFromURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "https://github.com/anchore/syft.git", want: "github.com/anchore/syft"},
+		{url: "https://github.com/anchore/syft/", want: "github.com/anchore/syft"},
+		{url: "ssh://git@github.com/anchore/syft.git", want: "github.com/anchore/syft"},
+		{url: "git@github.com:anchore/syft.git", want: "github.com/anchore/syft"},
+		{url: "../syft.git", want: "../syft"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			require.Equal(t, tt.want, nameFromURL(tt.url))
+		})
+	}
+}
+
+func writeIndex(t *testing.T, path string, idx *index.Index) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	require.NoError(t, index.NewEncoder(f).Encode(idx))
+}
@@ -0,0 +1,121 @@
+package golang
+
+import (
+	"bytes"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const (
+	// elfGoBuildIDNoteType is the type of the note holding the build ID within the .note.go.buildid section
+	elfGoBuildIDNoteType = 4
+
+	// maxBuildIDTextSize is how much of the start of the text section is searched for the build ID (which the linker
+	// places first) of binaries without an ELF note, e.g. Mach-O and PE binaries.
+	maxBuildIDTextSize = 32 * 1024
+
+	// maxBuildIDSize bounds the size of a build ID (which is typically four slash separated hashes)
+	maxBuildIDSize = 1024
+)
+
+var (
+	elfGoBuildIDNoteName = []byte("Go\x00\x00")
+	goBuildIDTextPrefix  = []byte("\xff Go build ID: \"")
+	goBuildIDTextSuffix  = []byte("\"\n \xff")
+)
+
+// getBuildIDFromBin returns the build ID the go linker embedded within the binary (as reported by "go tool buildid"),
+// which can be used to correlate a binary with the build that produced it. An empty build ID is returned when the
+// binary does not have one (e.g. when built with -buildid=).
+func getBuildIDFromBin(r io.ReaderAt) (string, error) {
+	ident := make([]byte, 16)
+	if n, err := r.ReadAt(ident, 0); n < len(ident) || err != nil {
+		return "", fmt.Errorf("unrecognized file format: %w", err)
+	}
+
+	switch {
+	case bytes.HasPrefix(ident, []byte("\x7FELF")):
+		f, err := elf.NewFile(r)
+		if err != nil {
+			return "", fmt.Errorf("unrecognized file format: %w", err)
+		}
+		if s := f.Section(".note.go.buildid"); s != nil {
+			data, err := io.ReadAll(io.LimitReader(s.Open(), maxBuildIDSize+16))
+			if err != nil {
+				return "", fmt.Errorf("unable to read build ID note: %w", err)
+			}
+			return buildIDFromELFNote(data, f.ByteOrder)
+		}
+		if s := f.Section(".text"); s != nil {
+			return buildIDFromTextSection(s.Open())
+		}
+	case bytes.HasPrefix(ident, []byte("MZ")):
+		f, err := pe.NewFile(r)
+		if err != nil {
+			return "", fmt.Errorf("unrecognized file format: %w", err)
+		}
+		if s := f.Section(".text"); s != nil {
+			return buildIDFromTextSection(s.Open())
+		}
+	case bytes.HasPrefix(ident, []byte("\xFE\xED\xFA")) || bytes.HasPrefix(ident[1:], []byte("\xFA\xED\xFE")):
+		f, err := macho.NewFile(r)
+		if err != nil {
+			return "", fmt.Errorf("unrecognized file format: %w", err)
+		}
+		if s := f.Section("__text"); s != nil {
+			return buildIDFromTextSection(s.Open())
+		}
+	default:
+		return "", errUnrecognizedFormat
+	}
+	return "", nil
+}
+
+// buildIDFromELFNote returns the build ID held by the note of a .note.go.buildid section.
+func buildIDFromELFNote(data []byte, order binary.ByteOrder) (string, error) {
+	if len(data) < 12 {
+		return "", fmt.Errorf("build ID note is too short")
+	}
+	nameSize := order.Uint32(data[0:4])
+	descSize := order.Uint32(data[4:8])
+	noteType := order.Uint32(data[8:12])
+	if nameSize != uint32(len(elfGoBuildIDNoteName)) || noteType != elfGoBuildIDNoteType {
+		return "", fmt.Errorf("unexpected build ID note (name size=%d, type=%d)", nameSize, noteType)
+	}
+	data = data[12:]
+	if !bytes.HasPrefix(data, elfGoBuildIDNoteName) {
+		return "", fmt.Errorf("unexpected build ID note name")
+	}
+	data = data[len(elfGoBuildIDNoteName):]
+	if descSize > maxBuildIDSize || int(descSize) > len(data) {
+		return "", fmt.Errorf("invalid build ID note size=%d", descSize)
+	}
+	return string(data[:descSize]), nil
+}
+
+func buildIDFromTextSection(r io.Reader) (string, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxBuildIDTextSize))
+	if err != nil {
+		return "", fmt.Errorf("unable to read text section: %w", err)
+	}
+	return buildIDFromText(data), nil
+}
+
+// buildIDFromText returns the build ID the linker places at the start of the text section as a quoted string (e.g.
+// "\xff Go build ID: \"abc/def\"\n \xff"), which is how it is held by binaries without an ELF note.
+func buildIDFromText(data []byte) string {
+	i := bytes.Index(data, goBuildIDTextPrefix)
+	if i < 0 {
+		return ""
+	}
+	data = data[i+len(goBuildIDTextPrefix):]
+	j := bytes.Index(data, goBuildIDTextSuffix)
+	if j < 0 || j > maxBuildIDSize {
+		return ""
+	}
+	return string(data[:j])
+}
@@ -0,0 +1,117 @@
+package golang
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getBuildIDFromBin(t *testing.T) {
+	// the test binary is built by the go linker, so has a build ID (of the form "actionID/contentID")
+	f, err := os.Open(os.Args[0])
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+	buildID, err := getBuildIDFromBin(f)
+	require.NoError(t, err)
+	assert.Contains(t, buildID, "/")
+
+	_, err = getBuildIDFromBin(strings.NewReader("not a binary, but long enough"))
+	require.ErrorIs(t, err, errUnrecognizedFormat)
+}
+
+func Test_buildIDFromELFNote(t *testing.T) {
+	note := func(order binary.ByteOrder, name string, noteType uint32, desc string) []byte {
+		var buf bytes.Buffer
+		_ = binary.Write(&buf, order, []uint32{uint32(len(name)), uint32(len(desc)), noteType})
+		buf.WriteString(name)
+		buf.WriteString(desc)
+		return buf.Bytes()
+	}
+
+	tests := []struct {
+		name    string
+		data    []byte
+		order   binary.ByteOrder
+		want    string
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name:  "little endian",
+			data:  note(binary.LittleEndian, "Go\x00\x00", 4, "abc/def"),
+			order: binary.LittleEndian,
+			want:  "abc/def",
+		},
+		{
+			name:  "big endian",
+			data:  note(binary.BigEndian, "Go\x00\x00", 4, "abc/def"),
+			order: binary.BigEndian,
+			want:  "abc/def",
+		},
+		{
+			name:    "not a go note",
+			data:    note(binary.LittleEndian, "GNU\x00", 3, "abc/def"),
+			order:   binary.LittleEndian,
+			wantErr: require.Error,
+		},
+		{
+			name:    "description out of bounds",
+			data:    note(binary.LittleEndian, "Go\x00\x00", 4, "abc/def")[:20],
+			order:   binary.LittleEndian,
+			wantErr: require.Error,
+		},
+		{
+			name:    "too short",
+			data:    []byte{4, 0, 0, 0},
+			order:   binary.LittleEndian,
+			wantErr: require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			got, err := buildIDFromELFNote(tt.data, tt.order)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_buildIDFromText(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "start of the text section",
+			data: "\xff Go build ID: \"abc/def/ghi/jkl\"\n \xff\x00\x00\x00",
+			want: "abc/def/ghi/jkl",
+		},
+		{
+			name: "after padding",
+			data: "\x00\x00\x00\x00\xff Go build ID: \"abc/def\"\n \xff",
+			want: "abc/def",
+		},
+		{
+			name: "unterminated",
+			data: "\xff Go build ID: \"abc/def",
+		},
+		{
+			name: "no build ID",
+			data: "\x00\x00\x00\x00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildIDFromText([]byte(tt.data)))
+		})
+	}
+}
@@ -95,6 +95,7 @@ func newGoStdLib(version string, location file.LocationSet) *pkg.Package {
 		Type:      pkg.GoModulePkg,
 		Metadata: pkg.GolangBinaryBuildinfoEntry{
 			GoCompiledVersion: version,
+			BuildToolchain:    buildToolchain(version),
 		},
 	}
 	goCompilerPkg.SetID()
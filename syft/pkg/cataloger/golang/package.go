@@ -30,6 +30,7 @@ func (c *goBinaryCataloger) newGoBinaryPackage(resolver file.Resolver, dep *debu
 		Locations: file.NewLocationSet(locations...),
 		Metadata: pkg.GolangBinaryBuildinfoEntry{
 			GoCompiledVersion: goVersion,
+			BuildToolchain:    buildToolchain(goVersion),
 			H1Digest:          dep.Sum,
 			Architecture:      architecture,
 			BuildSettings:     buildSettings,
@@ -43,6 +44,16 @@ func (c *goBinaryCataloger) newGoBinaryPackage(resolver file.Resolver, dep *debu
 	return p
 }
 
+// buildToolchain returns the toolchain name and version of the go compiler from the go version recorded in the
+// buildinfo (e.g. "go1.22.1" or "go1.22.1 X:boringcrypto" -> "go 1.22.1").
+func buildToolchain(goVersion string) string {
+	fields := strings.Fields(goVersion)
+	if len(fields) == 0 || !strings.HasPrefix(fields[0], "go") {
+		return ""
+	}
+	return "go " + strings.TrimPrefix(fields[0], "go")
+}
+
 func packageURL(moduleName, moduleVersion string) string {
 	// source: https://github.com/package-url/purl-spec/blob/master/PURL-TYPES.rst#golang
 	// note: "The version is often empty when a commit is not specified and should be the commit in most cases when available."
@@ -54,3 +54,22 @@ func Test_packageURL(t *testing.T) {
 		})
 	}
 }
+
+func Test_buildToolchain(t *testing.T) {
+	tests := []struct {
+		goVersion string
+		expected  string
+	}{
+		{goVersion: "go1.22.1", expected: "go 1.22.1"},
+		{goVersion: "go1.21.4 X:boringcrypto", expected: "go 1.21.4"},
+		{goVersion: "go1.23rc1", expected: "go 1.23rc1"},
+		{goVersion: "devel go1.23-5c3a3b6c Mon Feb 5 14:24:24 2024 +0000"},
+		{goVersion: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.goVersion, func(t *testing.T) {
+			assert.Equal(t, test.expected, buildToolchain(test.goVersion))
+		})
+	}
+}
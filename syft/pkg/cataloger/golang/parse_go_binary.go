@@ -129,6 +129,15 @@ func (c *goBinaryCataloger) makeGoMainPackage(resolver file.Resolver, mod *exten
 		location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
 	)
 
+	if vcs := getVCS(gbs); vcs != nil || mod.buildID != "" {
+		if metadata, ok := main.Metadata.(pkg.GolangBinaryBuildinfoEntry); ok {
+			metadata.VCS = vcs
+			metadata.BuildID = mod.buildID
+			main.Metadata = metadata
+			main.SetID()
+		}
+	}
+
 	if main.Version != devel {
 		// found a full package with a non-development version... return it as is...
 		return main
@@ -316,6 +325,25 @@ func getBuildSettings(settings []debug.BuildSetting) pkg.KeyValues {
 	return m
 }
 
+// getVCS returns the version control information from the vcs.* build settings, which are only present for binaries
+// built (go 1.18+) from within a repository without -buildvcs=false.
+func getVCS(gbs pkg.KeyValues) *pkg.GolangBinaryVCS {
+	system, hasSystem := gbs.Get("vcs")
+	revision, hasRevision := gbs.Get("vcs.revision")
+	if !hasSystem && !hasRevision {
+		return nil
+	}
+	timestamp, _ := gbs.Get("vcs.time")
+	modified, _ := gbs.Get("vcs.modified")
+
+	return &pkg.GolangBinaryVCS{
+		System:   system,
+		Revision: revision,
+		Time:     timestamp,
+		Modified: modified == "true",
+	}
+}
+
 func createMainModuleFromPath(path string) (mod debug.Module) {
 	mod.Path = path
 	mod.Version = devel
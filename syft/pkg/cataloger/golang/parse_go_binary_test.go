@@ -210,7 +210,7 @@ func TestBuildGoPkgInfo(t *testing.T) {
 		},
 		{
 			name:     "buildGoPkgInfo parses a blank mod and returns no packages",
-			mod:      &extendedBuildInfo{&debug.BuildInfo{}, nil, ""},
+			mod:      &extendedBuildInfo{&debug.BuildInfo{}, nil, "", ""},
 			expected: []pkg.Package(nil),
 		},
 		{
@@ -328,6 +328,40 @@ func TestBuildGoPkgInfo(t *testing.T) {
 			},
 			expected: []pkg.Package{unmodifiedMain},
 		},
+		{
+			name: "parse a mod with a build ID",
+			mod: &extendedBuildInfo{
+				BuildInfo: &debug.BuildInfo{
+					GoVersion: goCompiledVersion,
+					Main:      debug.Module{Path: "github.com/anchore/syft", Version: "(devel)"},
+					Settings: []debug.BuildSetting{
+						{Key: "GOARCH", Value: archDetails},
+						{Key: "GOOS", Value: "darwin"},
+						{Key: "GOAMD64", Value: "v1"},
+					},
+				},
+				cryptoSettings: nil,
+				arch:           archDetails,
+				buildID:        "oS2jm8V0zT_Lf8n6Lj6w/Y4Zf6TjgJ1dAGGwFyBnv/oO4zqxMclMXqC3dgX8Vx/cga6eXXnwnwdBmvpjBkn",
+			},
+			expected: []pkg.Package{
+				{
+					Name:      unmodifiedMain.Name,
+					Language:  unmodifiedMain.Language,
+					Type:      unmodifiedMain.Type,
+					Version:   unmodifiedMain.Version,
+					PURL:      unmodifiedMain.PURL,
+					Locations: unmodifiedMain.Locations,
+					Metadata: pkg.GolangBinaryBuildinfoEntry{
+						GoCompiledVersion: goCompiledVersion,
+						Architecture:      archDetails,
+						BuildSettings:     defaultBuildSettings,
+						MainModule:        "github.com/anchore/syft",
+						BuildID:           "oS2jm8V0zT_Lf8n6Lj6w/Y4Zf6TjgJ1dAGGwFyBnv/oO4zqxMclMXqC3dgX8Vx/cga6eXXnwnwdBmvpjBkn",
+					},
+				},
+			},
+		},
 		{
 			name: "parse main mod and replace devel pseudo version and ldflags exists (but contains no version)",
 			mod: &extendedBuildInfo{
@@ -391,6 +425,10 @@ func TestBuildGoPkgInfo(t *testing.T) {
 							},
 						},
 						MainModule: "github.com/anchore/syft",
+						VCS: &pkg.GolangBinaryVCS{
+							Revision: "41bc6bb410352845f22766e27dd48ba93aa825a4",
+							Time:     "2022-10-14T19:54:57Z",
+						},
 					},
 				},
 			},
@@ -458,6 +496,10 @@ func TestBuildGoPkgInfo(t *testing.T) {
 							},
 						},
 						MainModule: "github.com/anchore/syft",
+						VCS: &pkg.GolangBinaryVCS{
+							Revision: "41bc6bb410352845f22766e27dd48ba93aa825a4",
+							Time:     "2022-10-14T19:54:57Z",
+						},
 					},
 				},
 			},
@@ -691,6 +733,10 @@ func TestBuildGoPkgInfo(t *testing.T) {
 							},
 						},
 						MainModule: "github.com/anchore/syft",
+						VCS: &pkg.GolangBinaryVCS{
+							Revision: "41bc6bb410352845f22766e27dd48ba93aa825a4",
+							Time:     "2022-10-14T19:54:57Z",
+						},
 					},
 				},
 			},
@@ -1142,3 +1188,52 @@ type alwaysErrorReader struct{}
 func (alwaysErrorReader) Read(_ []byte) (int, error) {
 	return 0, errors.New("read from always error reader")
 }
+
+func Test_getVCS(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings pkg.KeyValues
+		expected *pkg.GolangBinaryVCS
+	}{
+		{
+			name: "all vcs settings",
+			settings: pkg.KeyValues{
+				{Key: "GOOS", Value: "linux"},
+				{Key: "vcs", Value: "git"},
+				{Key: "vcs.revision", Value: "41bc6bb410352845f22766e27dd48ba93aa825a4"},
+				{Key: "vcs.time", Value: "2022-10-14T19:54:57Z"},
+				{Key: "vcs.modified", Value: "true"},
+			},
+			expected: &pkg.GolangBinaryVCS{
+				System:   "git",
+				Revision: "41bc6bb410352845f22766e27dd48ba93aa825a4",
+				Time:     "2022-10-14T19:54:57Z",
+				Modified: true,
+			},
+		},
+		{
+			name: "unmodified working tree",
+			settings: pkg.KeyValues{
+				{Key: "vcs", Value: "git"},
+				{Key: "vcs.revision", Value: "41bc6bb410352845f22766e27dd48ba93aa825a4"},
+				{Key: "vcs.modified", Value: "false"},
+			},
+			expected: &pkg.GolangBinaryVCS{
+				System:   "git",
+				Revision: "41bc6bb410352845f22766e27dd48ba93aa825a4",
+			},
+		},
+		{
+			name: "no vcs settings",
+			settings: pkg.KeyValues{
+				{Key: "GOOS", Value: "linux"},
+				{Key: "vcs.time", Value: "2022-10-14T19:54:57Z"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, getVCS(tt.settings))
+		})
+	}
+}
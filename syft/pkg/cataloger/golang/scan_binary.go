@@ -16,6 +16,7 @@ type extendedBuildInfo struct {
 	*debug.BuildInfo
 	cryptoSettings []string
 	arch           string
+	buildID        string
 }
 
 // scanFile scans file to try to report the Go and module versions.
@@ -56,7 +57,14 @@ func scanFile(reader unionreader.UnionReader, filename string) []*extendedBuildI
 			}
 		}
 
-		builds = append(builds, &extendedBuildInfo{bi, v, arch})
+		buildID, err := getBuildIDFromBin(r)
+		if err != nil {
+			log.WithFields("file", filename, "error", err).Trace("unable to read golang build ID")
+			// don't skip this build info.
+			// we can still catalog packages, even if we can't get the build ID
+		}
+
+		builds = append(builds, &extendedBuildInfo{bi, v, arch, buildID})
 	}
 	return builds
 }
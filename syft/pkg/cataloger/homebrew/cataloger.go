@@ -0,0 +1,16 @@
+/*
+Package homebrew provides a concrete Cataloger implementation for formulae installed by the Homebrew package manager.
+*/
+package homebrew
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewCellarCataloger returns a new cataloger object initialized for the install receipts of Homebrew formulae within
+// a Cellar directory (e.g. /opt/homebrew/Cellar or /home/linuxbrew/.linuxbrew/Cellar).
+func NewCellarCataloger() pkg.Cataloger {
+	return generic.NewCataloger("homebrew-cellar-cataloger").
+		WithParserByGlobs(parseInstallReceipt, "**/Cellar/*/*/INSTALL_RECEIPT.json")
+}
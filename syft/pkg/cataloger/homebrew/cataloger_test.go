@@ -0,0 +1,65 @@
+package homebrew
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestCellarCataloger(t *testing.T) {
+	wgetReceipt := file.NewLocation("opt/homebrew/Cellar/wget/1.21.4/INSTALL_RECEIPT.json")
+	wgetFormula := file.NewLocation("opt/homebrew/Cellar/wget/1.21.4/.brew/wget.rb")
+	libunistringReceipt := file.NewLocation("opt/homebrew/Cellar/libunistring/1.1/INSTALL_RECEIPT.json")
+
+	expected := []pkg.Package{
+		{
+			Name:      "libunistring",
+			Version:   "1.1",
+			Type:      pkg.HomebrewPkg,
+			FoundBy:   "homebrew-cellar-cataloger",
+			Locations: file.NewLocationSet(libunistringReceipt),
+			PURL:      "pkg:brew/libunistring@1.1",
+			Metadata: pkg.HomebrewFormula{
+				Tap: "homebrew/core",
+			},
+		},
+		{
+			Name:      "wget",
+			Version:   "1.21.4",
+			Type:      pkg.HomebrewPkg,
+			FoundBy:   "homebrew-cellar-cataloger",
+			Locations: file.NewLocationSet(wgetReceipt, wgetFormula),
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("GPL-3.0-or-later", wgetFormula)),
+			PURL:      "pkg:brew/wget@1.21.4",
+			Metadata: pkg.HomebrewFormula{
+				Tap:                "homebrew/core",
+				Homepage:           "https://www.gnu.org/software/wget/",
+				Description:        "Internet file retriever",
+				InstalledOnRequest: true,
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/cellar").
+		Expects(expected, nil).
+		TestCataloger(t, NewCellarCataloger())
+}
+
+func TestCellarCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"src/Cellar/jq/1.7.1/INSTALL_RECEIPT.json",
+		}).
+		TestCataloger(t, NewCellarCataloger())
+}
+
+func Test_corruptInstallReceipt(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromFile(t, "test-fixtures/glob-paths/src/Cellar/jq/1.7.1/INSTALL_RECEIPT.json").
+		WithError().
+		TestParser(t, parseInstallReceipt)
+}
@@ -0,0 +1,116 @@
+package homebrew
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var (
+	// match the (single line) string declarations of a formula, for example:
+	//   desc "Internet file retriever"
+	//   homepage "https://www.gnu.org/software/wget/"
+	//   license "GPL-3.0-or-later"
+	formulaDescPattern     = regexp.MustCompile(`^\s*desc\s+"((?:[^"\\]|\\.)*)"`)
+	formulaHomepagePattern = regexp.MustCompile(`^\s*homepage\s+"((?:[^"\\]|\\.)*)"`)
+	formulaLicensePattern  = regexp.MustCompile(`^\s*license\s+"((?:[^"\\]|\\.)*)"`)
+)
+
+// installReceipt represents the INSTALL_RECEIPT.json written by Homebrew into the keg of each installed formula.
+type installReceipt struct {
+	InstalledOnRequest bool `json:"installed_on_request"`
+	Source             struct {
+		Tap string `json:"tap"`
+	} `json:"source"`
+}
+
+// formula represents the declarations read from the Ruby source of a formula.
+type formula struct {
+	desc     string
+	homepage string
+	license  string
+}
+
+var _ generic.Parser = parseInstallReceipt
+
+// parseInstallReceipt is a parser function for the install receipt of a Homebrew formula, returning the installed
+// formula. The name and version are given by the path of the keg (Cellar/<formula>/<version>), while the description,
+// homepage, and license are read from the copy of the formula within the keg (.brew/<formula>.rb), when present.
+func parseInstallReceipt(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var receipt installReceipt
+	if err := json.NewDecoder(reader).Decode(&receipt); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse homebrew install receipt: %w", err)
+	}
+
+	kegPath := path.Dir(reader.RealPath)
+	name := path.Base(path.Dir(kegPath))
+	version := path.Base(kegPath)
+
+	m := pkg.HomebrewFormula{
+		Tap:                receipt.Source.Tap,
+		InstalledOnRequest: receipt.InstalledOnRequest,
+	}
+	locations := []file.Location{reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+
+	var licenses []pkg.License
+	if f, location := findFormula(resolver, reader.Location, path.Join(kegPath, ".brew", name+".rb")); location != nil {
+		m.Description = f.desc
+		m.Homepage = f.homepage
+		if f.license != "" {
+			licenses = pkg.NewLicensesFromLocation(*location, f.license)
+		}
+		locations = append(locations, location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+	}
+
+	return []pkg.Package{newPackage(name, version, m, licenses, locations...)}, nil, nil
+}
+
+// findFormula reads the declarations of the formula at the given path, returning a nil location if the formula
+// cannot be read.
+func findFormula(resolver file.Resolver, receiptLocation file.Location, formulaPath string) (formula, *file.Location) {
+	if resolver == nil {
+		return formula{}, nil
+	}
+
+	location := resolver.RelativeFileByPath(receiptLocation, formulaPath)
+	if location == nil {
+		return formula{}, nil
+	}
+
+	contents, err := resolver.FileContentsByLocation(*location)
+	if err != nil {
+		log.WithFields("path", location.RealPath, "error", err).Debug("unable to read homebrew formula")
+		return formula{}, nil
+	}
+	defer internal.CloseAndLogError(contents, location.RealPath)
+
+	var f formula
+	scanner := bufio.NewScanner(contents)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if match := formulaDescPattern.FindStringSubmatch(line); match != nil && f.desc == "" {
+			f.desc = match[1]
+		}
+		if match := formulaHomepagePattern.FindStringSubmatch(line); match != nil && f.homepage == "" {
+			f.homepage = match[1]
+		}
+		if match := formulaLicensePattern.FindStringSubmatch(line); match != nil && f.license == "" {
+			f.license = match[1]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.WithFields("path", location.RealPath, "error", err).Debug("unable to read homebrew formula")
+	}
+
+	return f, location
+}
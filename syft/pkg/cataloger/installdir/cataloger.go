@@ -0,0 +1,186 @@
+/*
+Package installdir provides a concrete Cataloger implementation for software installed within a directory of its own
+under a common prefix (such as /opt), which is not tracked by any package manager.
+*/
+package installdir
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+const catalogerName = "install-directory-cataloger"
+
+type CatalogerConfig struct {
+	// Prefixes are the directories that software is installed under, where each product is expected to be installed
+	// within <prefix>/<product>, <prefix>/<vendor>/<product>, or a version directory within either of these.
+	Prefixes []string `yaml:"prefixes" json:"prefixes" mapstructure:"prefixes"`
+
+	// VersionFiles are the basenames (which may be glob patterns) of the files within an install directory that
+	// declare the version of the software.
+	VersionFiles []string `yaml:"version-files" json:"version-files" mapstructure:"version-files"`
+}
+
+func DefaultCatalogerConfig() CatalogerConfig {
+	return CatalogerConfig{
+		Prefixes:     []string{"/opt"},
+		VersionFiles: []string{"VERSION", "RELEASE", "*.properties"},
+	}
+}
+
+func (c CatalogerConfig) WithPrefixes(prefixes ...string) CatalogerConfig {
+	c.Prefixes = prefixes
+	return c
+}
+
+func (c CatalogerConfig) WithVersionFiles(patterns ...string) CatalogerConfig {
+	c.VersionFiles = patterns
+	return c
+}
+
+// NewInstallDirectoryCataloger returns a new cataloger object for software installed within the configured prefixes,
+// where the name and version of each product are inferred from the directory structure and any version file.
+func NewInstallDirectoryCataloger(cfg CatalogerConfig) pkg.Cataloger {
+	return &cataloger{
+		cfg: cfg,
+	}
+}
+
+// cataloger is a heuristic cataloger, which surfaces commercial (or otherwise unpackaged) software that is commonly
+// installed under /opt/<vendor>/<product>/<version>. Since there is no package metadata to rely on, only directories
+// with a version (either in the directory name or within a version file) are reported.
+type cataloger struct {
+	cfg CatalogerConfig
+}
+
+// Name returns a string that uniquely describes the cataloger
+func (c cataloger) Name() string {
+	return catalogerName
+}
+
+// Catalog is given an object to resolve file references and content, this function returns any discovered Packages
+// after analyzing the catalog source.
+func (c cataloger) Catalog(_ context.Context, resolver file.Resolver) ([]pkg.Package, []artifact.Relationship, error) {
+	var packages []pkg.Package
+
+	for _, prefix := range c.cfg.Prefixes {
+		prefix = path.Join("/", prefix)
+
+		locations, err := resolver.FilesByGlob(path.Join("**", prefix, "**"))
+		if err != nil {
+			log.WithFields("error", err, "prefix", prefix).Debug("unable to search install prefix")
+			continue
+		}
+
+		dirs := c.installDirs(prefix, locations)
+
+		roots := make([]string, 0, len(dirs))
+		for root := range dirs {
+			roots = append(roots, root)
+		}
+		sort.Strings(roots)
+
+		for _, root := range roots {
+			if p, ok := c.newPackage(resolver, *dirs[root]); ok {
+				packages = append(packages, p)
+			}
+		}
+	}
+
+	return packages, nil, nil
+}
+
+// installDirs groups the given files within the prefix by the directory of the product they belong to.
+func (c cataloger) installDirs(prefix string, locations []file.Location) map[string]*installDir {
+	dirs := make(map[string]*installDir)
+	for _, location := range locations {
+		realPath := path.Join("/", location.RealPath)
+		idx := strings.Index(realPath, prefix+"/")
+		if idx < 0 {
+			continue
+		}
+
+		segments := strings.Split(realPath[idx+len(prefix)+1:], "/")
+		dir, ok := newInstallDir(realPath[:idx+len(prefix)], segments[:len(segments)-1])
+		if !ok {
+			continue
+		}
+
+		existing, ok := dirs[dir.path]
+		if !ok {
+			existing = &dir
+			dirs[dir.path] = existing
+		}
+
+		// only files directly within the install directory are considered to declare the version
+		if path.Dir(realPath) == existing.path && c.isVersionFile(path.Base(realPath)) {
+			existing.versionFiles = append(existing.versionFiles, location)
+		} else if existing.anyFile == nil {
+			l := location
+			existing.anyFile = &l
+		}
+	}
+	return dirs
+}
+
+func (c cataloger) isVersionFile(basename string) bool {
+	for _, pattern := range c.cfg.VersionFiles {
+		if matched, err := path.Match(pattern, basename); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (c cataloger) newPackage(resolver file.Resolver, dir installDir) (pkg.Package, bool) {
+	version := dir.version
+	var evidence *file.Location
+
+	// prefer the version declared by a file within the directory over the one inferred from the directory name
+	sort.Slice(dir.versionFiles, func(i, j int) bool {
+		return dir.versionFiles[i].RealPath < dir.versionFiles[j].RealPath
+	})
+	for i, location := range dir.versionFiles {
+		v, err := readVersionFile(resolver, location)
+		if err != nil {
+			log.WithFields("error", err, "path", location.RealPath).Trace("unable to read version file")
+			continue
+		}
+		if v != "" {
+			version = v
+			evidence = &dir.versionFiles[i]
+			break
+		}
+	}
+
+	if version == "" {
+		return pkg.Package{}, false
+	}
+
+	metadata := pkg.InstallDirectoryEntry{
+		Vendor: dir.vendor,
+		Path:   dir.path,
+	}
+
+	var location file.Location
+	switch {
+	case evidence != nil:
+		metadata.VersionFile = path.Join("/", evidence.RealPath)
+		location = *evidence
+	case len(dir.versionFiles) > 0:
+		location = dir.versionFiles[0]
+	case dir.anyFile != nil:
+		location = *dir.anyFile
+	default:
+		return pkg.Package{}, false
+	}
+
+	return newInstallDirPackage(dir.product, version, metadata, location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)), true
+}
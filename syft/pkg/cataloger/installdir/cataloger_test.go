@@ -0,0 +1,184 @@
+package installdir
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestInstallDirectoryCataloger(t *testing.T) {
+	evidence := func(path string) file.Location {
+		return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+	}
+
+	expected := []pkg.Package{
+		{
+			Name:      "gadget",
+			Version:   "2.0.3",
+			Type:      pkg.BinaryPkg,
+			FoundBy:   "install-directory-cataloger",
+			Locations: file.NewLocationSet(evidence("opt/acme/gadget/VERSION")),
+			PURL:      "pkg:generic/acme/gadget@2.0.3",
+			Metadata: pkg.InstallDirectoryEntry{
+				Vendor:      "acme",
+				Path:        "/opt/acme/gadget",
+				VersionFile: "/opt/acme/gadget/VERSION",
+			},
+		},
+		{
+			Name:      "widget",
+			Version:   "4.2.1",
+			Type:      pkg.BinaryPkg,
+			FoundBy:   "install-directory-cataloger",
+			Locations: file.NewLocationSet(evidence("opt/acme/widget/4.2.1/bin/widget")),
+			PURL:      "pkg:generic/acme/widget@4.2.1",
+			Metadata: pkg.InstallDirectoryEntry{
+				Vendor: "acme",
+				Path:   "/opt/acme/widget/4.2.1",
+			},
+		},
+		{
+			Name:      "apache-tomcat",
+			Version:   "9.0.80",
+			Type:      pkg.BinaryPkg,
+			FoundBy:   "install-directory-cataloger",
+			Locations: file.NewLocationSet(evidence("opt/apache-tomcat-9.0.80/bin/startup.sh")),
+			PURL:      "pkg:generic/apache-tomcat@9.0.80",
+			Metadata: pkg.InstallDirectoryEntry{
+				Path: "/opt/apache-tomcat-9.0.80",
+			},
+		},
+		{
+			Name:      "app",
+			Version:   "1.4.0-SNAPSHOT",
+			Type:      pkg.BinaryPkg,
+			FoundBy:   "install-directory-cataloger",
+			Locations: file.NewLocationSet(evidence("opt/app/build.properties")),
+			PURL:      "pkg:generic/app@1.4.0-SNAPSHOT",
+			Metadata: pkg.InstallDirectoryEntry{
+				Path:        "/opt/app",
+				VersionFile: "/opt/app/build.properties",
+			},
+		},
+		{
+			// the version within the version file is preferred over the version directory
+			Name:      "suite",
+			Version:   "8.1.3",
+			Type:      pkg.BinaryPkg,
+			FoundBy:   "install-directory-cataloger",
+			Locations: file.NewLocationSet(evidence("opt/globex/suite/8.1/RELEASE")),
+			PURL:      "pkg:generic/globex/suite@8.1.3",
+			Metadata: pkg.InstallDirectoryEntry{
+				Vendor:      "globex",
+				Path:        "/opt/globex/suite/8.1",
+				VersionFile: "/opt/globex/suite/8.1/RELEASE",
+			},
+		},
+		{
+			Name:      "jdk",
+			Version:   "17.0.2",
+			Type:      pkg.BinaryPkg,
+			FoundBy:   "install-directory-cataloger",
+			Locations: file.NewLocationSet(evidence("opt/jdk/RELEASE")),
+			PURL:      "pkg:generic/jdk@17.0.2",
+			Metadata: pkg.InstallDirectoryEntry{
+				Path:        "/opt/jdk",
+				VersionFile: "/opt/jdk/RELEASE",
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/install-prefixes").
+		Expects(expected, nil).
+		TestCataloger(t, NewInstallDirectoryCataloger(DefaultCatalogerConfig()))
+}
+
+func TestInstallDirectoryCataloger_customPrefix(t *testing.T) {
+	expected := []pkg.Package{
+		{
+			Name:    "lint",
+			Version: "2.3.0",
+			Type:    pkg.BinaryPkg,
+			FoundBy: "install-directory-cataloger",
+			Locations: file.NewLocationSet(
+				file.NewLocation("usr/local/tools/lint/2.3.0/lint").WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			),
+			PURL: "pkg:generic/tools/lint@2.3.0",
+			Metadata: pkg.InstallDirectoryEntry{
+				Vendor: "tools",
+				Path:   "/usr/local/tools/lint/2.3.0",
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/install-prefixes").
+		Expects(expected, nil).
+		TestCataloger(t, NewInstallDirectoryCataloger(DefaultCatalogerConfig().WithPrefixes("usr/local")))
+}
+
+func Test_newInstallDir(t *testing.T) {
+	tests := []struct {
+		name   string
+		dirs   []string
+		want   installDir
+		wantOK bool
+	}{
+		{
+			name:   "product directory",
+			dirs:   []string{"product"},
+			want:   installDir{path: "/opt/product", product: "product"},
+			wantOK: true,
+		},
+		{
+			name:   "vendor and product directories",
+			dirs:   []string{"vendor", "product"},
+			want:   installDir{path: "/opt/vendor/product", vendor: "vendor", product: "product"},
+			wantOK: true,
+		},
+		{
+			name:   "version directory",
+			dirs:   []string{"product", "v1.2.3", "lib", "ext"},
+			want:   installDir{path: "/opt/product/v1.2.3", product: "product", version: "v1.2.3"},
+			wantOK: true,
+		},
+		{
+			name:   "vendor, product, and version directories",
+			dirs:   []string{"vendor", "product", "12.2.0.1", "bin"},
+			want:   installDir{path: "/opt/vendor/product/12.2.0.1", vendor: "vendor", product: "product", version: "12.2.0.1"},
+			wantOK: true,
+		},
+		{
+			name:   "product and version within the directory name",
+			dirs:   []string{"vendor", "product-server_3.4.1-rc1", "bin"},
+			want:   installDir{path: "/opt/vendor/product-server_3.4.1-rc1", vendor: "vendor", product: "product-server", version: "3.4.1-rc1"},
+			wantOK: true,
+		},
+		{
+			name: "nested version directories",
+			dirs: []string{"product", "1.0", "2.0"},
+			want: installDir{path: "/opt/product/1.0", product: "product", version: "1.0"},
+			// the first version directory is the install directory
+			wantOK: true,
+		},
+		{
+			name: "too deep without a version directory",
+			dirs: []string{"vendor", "product", "lib"},
+		},
+		{
+			name: "file directly within the prefix",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := newInstallDir("/opt", tt.dirs)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
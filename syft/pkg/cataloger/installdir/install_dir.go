@@ -0,0 +1,83 @@
+package installdir
+
+import (
+	"path"
+	"regexp"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+var (
+	// versionPattern matches a directory named only by a version (e.g. "12.2.0.1" or "v3.4.1-rc1")
+	versionPattern = regexp.MustCompile(`^v?\d+(\.\d+)+([.+_-][0-9A-Za-z]+)*$`)
+
+	// nameVersionPattern matches a directory named by the product along with the version (e.g. "apache-tomcat-9.0.80")
+	nameVersionPattern = regexp.MustCompile(`^(?P<name>[A-Za-z][\w.-]*?)[-_](?P<version>v?\d+(\.\d+)+([.+_-][0-9A-Za-z]+)*)$`)
+)
+
+// installDir is the directory a single product is installed within.
+type installDir struct {
+	path    string
+	vendor  string
+	product string
+	// version is the version inferred from the directory structure (if any)
+	version string
+
+	versionFiles []file.Location
+	anyFile      *file.Location
+}
+
+// newInstallDir finds the directory of the product within the prefix from the directories (relative to the prefix) of
+// a file within it. Given the layouts:
+//
+//	<prefix>/<product>-<version>
+//	<prefix>/<product>/<version>
+//	<prefix>/<vendor>/<product>-<version>
+//	<prefix>/<vendor>/<product>/<version>
+//
+// the version is inferred from the directory structure. Otherwise the directory of the file is the install directory
+// (as <prefix>/<product> or <prefix>/<vendor>/<product>), which is only reported if it contains a version file.
+func newInstallDir(prefix string, dirs []string) (installDir, bool) {
+	if len(dirs) == 0 {
+		return installDir{}, false
+	}
+
+	for depth := 0; depth < len(dirs) && depth < 3; depth++ {
+		dir := installDir{
+			path: path.Join(append([]string{prefix}, dirs[:depth+1]...)...),
+		}
+
+		if depth > 0 && versionPattern.MatchString(dirs[depth]) {
+			dir.product = dirs[depth-1]
+			dir.version = dirs[depth]
+			if depth == 2 {
+				dir.vendor = dirs[0]
+			}
+			return dir, true
+		}
+
+		if depth < 2 {
+			if match := nameVersionPattern.FindStringSubmatch(dirs[depth]); match != nil {
+				dir.product = match[nameVersionPattern.SubexpIndex("name")]
+				dir.version = match[nameVersionPattern.SubexpIndex("version")]
+				if depth == 1 {
+					dir.vendor = dirs[0]
+				}
+				return dir, true
+			}
+		}
+	}
+
+	if len(dirs) > 2 {
+		return installDir{}, false
+	}
+
+	dir := installDir{
+		path:    path.Join(append([]string{prefix}, dirs...)...),
+		product: dirs[len(dirs)-1],
+	}
+	if len(dirs) == 2 {
+		dir.vendor = dirs[0]
+	}
+	return dir, true
+}
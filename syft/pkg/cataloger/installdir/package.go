@@ -0,0 +1,34 @@
+package installdir
+
+import (
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newInstallDirPackage(name, version string, m pkg.InstallDirectoryEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(m.Vendor, name, version),
+		Type:      pkg.BinaryPkg,
+		FoundBy:   catalogerName,
+		Metadata:  m,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func packageURL(vendor, name, version string) string {
+	return packageurl.NewPackageURL(
+		packageurl.TypeGeneric,
+		vendor,
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
@@ -0,0 +1,98 @@
+package installdir
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/syft/file"
+)
+
+// maxVersionFileSize is the most of a version file that is read, since files matched by broad patterns (such as
+// *.properties) may be large configuration files.
+const maxVersionFileSize = 64 * 1024
+
+var (
+	// versionTokenPattern matches a version within a line (e.g. "Product 4.5.1 (build 123)")
+	versionTokenPattern = regexp.MustCompile(`\d+(\.\d+)+([+_-][0-9A-Za-z]+(\.[0-9A-Za-z]+)*)?`)
+
+	propertyKeyPattern = regexp.MustCompile(`^[\w.-]+$`)
+)
+
+// readVersionFile returns the version declared by the given file, which is either given by a property (e.g.
+// "version=1.2.3" or JAVA_VERSION="17.0.2") or otherwise by the first line of the file (e.g. "1.2.3").
+func readVersionFile(resolver file.Resolver, location file.Location) (string, error) {
+	reader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return "", err
+	}
+	defer internal.CloseAndLogError(reader, location.RealPath)
+
+	var exact, suffixed, firstLine string
+	var hasProperties bool
+
+	scanner := bufio.NewScanner(io.LimitReader(reader, maxVersionFileSize))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+
+		key, value, ok := splitProperty(line)
+		if !ok {
+			if firstLine == "" {
+				firstLine = line
+			}
+			continue
+		}
+		hasProperties = true
+
+		key = strings.ToLower(key)
+		switch {
+		case key == "version" && exact == "":
+			exact = versionTokenPattern.FindString(value)
+		case isVersionKey(key) && suffixed == "":
+			suffixed = versionTokenPattern.FindString(value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("unable to read version file: %w", err)
+	}
+
+	switch {
+	case exact != "":
+		return exact, nil
+	case suffixed != "":
+		return suffixed, nil
+	case !hasProperties:
+		return versionTokenPattern.FindString(firstLine), nil
+	}
+	return "", nil
+}
+
+// splitProperty splits a "key=value" or "key: value" line, where the value may be quoted.
+func splitProperty(line string) (string, string, bool) {
+	idx := strings.IndexAny(line, "=:")
+	if idx <= 0 {
+		return "", "", false
+	}
+	key := strings.TrimSpace(line[:idx])
+	if !propertyKeyPattern.MatchString(key) {
+		return "", "", false
+	}
+	value := strings.Trim(strings.TrimSpace(line[idx+1:]), `"'`)
+	return key, value, true
+}
+
+// isVersionKey indicates the (lowercase) property key names a version, such as "app.version" or "java_version".
+func isVersionKey(key string) bool {
+	for _, suffix := range []string{".version", "_version", "-version"} {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+/*
+Package binutils provides helpers shared by the catalogers that identify packages from the contents of binaries.
+*/
+package binutils
+
+import (
+	"fmt"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/cpe"
+)
+
+// MustPURL parses the given package URL, panicking if it is invalid (for use with package URLs known at compile time).
+func MustPURL(purl string) packageurl.PackageURL {
+	p, err := packageurl.FromString(purl)
+	if err != nil {
+		panic(fmt.Sprintf("invalid PURL: %s", purl))
+	}
+	return p
+}
+
+// SingleCPE returns a []cpe.CPE with Source: Generated based on the cpe string or panics if the
+// cpe string cannot be parsed into valid CPE Attributes
+func SingleCPE(cpeString string) []cpe.CPE {
+	return []cpe.CPE{
+		cpe.Must(cpeString, cpe.GeneratedSource),
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Package embeddedlib finds libraries that are statically linked into binaries (e.g. OpenSSL or zlib) by the version
+banners these libraries embed, for use by catalogers that already read the contents of binaries.
+*/
+package embeddedlib
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/cpe"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+const (
+	// chunkSize is how much of a binary is searched at once
+	chunkSize = 1 << 20
+
+	// maxBannerSize bounds the length of a version banner, so that banners spanning two chunks are still found
+	maxBannerSize = 256
+)
+
+var emptyPURL = packageurl.PackageURL{}
+
+// Pattern describes a version banner of a library that may be statically linked into a binary.
+type Pattern struct {
+	// Class is the name of the pattern, used as the classifier of the package metadata (e.g. "openssl-embedded")
+	Class string
+
+	// Banner matches the version banner, with the version of the library captured in the "version" named group
+	Banner *regexp.Regexp
+
+	// Package is the name of the package reported for a match
+	Package string
+
+	// PURL is the package URL reported for a match, the version is set to the matched version
+	PURL packageurl.PackageURL
+
+	// CPEs are the CPEs reported for a match, the version is set to the matched version
+	CPEs []cpe.CPE
+}
+
+// Scan searches the contents of a binary for the version banners of the given patterns, returning a package for each
+// distinct library version found, in order of appearance. Packages are annotated as being embedded in the binary at
+// the given location; callers are responsible for setting FoundBy.
+func Scan(r io.ReaderAt, location file.Location, patterns ...Pattern) ([]pkg.Package, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+
+	var pkgs []pkg.Package
+	seen := make(map[string]struct{})
+
+	buf := make([]byte, maxBannerSize+chunkSize)
+	var carry int
+	var offset int64
+	for {
+		n, err := r.ReadAt(buf[carry:], offset)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("unable to read binary: %w", err)
+		}
+		last := err != nil || n == 0
+		window := buf[:carry+n]
+
+		for _, p := range patterns {
+			for _, version := range findVersions(p, window, last) {
+				key := p.Class + "@" + version
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+				pkgs = append(pkgs, newPackage(p, version, location))
+			}
+		}
+
+		if last {
+			return pkgs, nil
+		}
+
+		// keep the end of this window, which may hold the start of a banner
+		offset += int64(n)
+		carry = min(maxBannerSize, len(window))
+		copy(buf, window[len(window)-carry:])
+	}
+}
+
+// findVersions returns the versions matched by the pattern within the window. Unless the window is at the end of the
+// binary, matches reaching the end of the window may be incomplete and are skipped (these are found within the next
+// window instead).
+func findVersions(p Pattern, window []byte, last bool) []string {
+	group := p.Banner.SubexpIndex("version")
+	if group < 0 {
+		return nil
+	}
+
+	var versions []string
+	for _, m := range p.Banner.FindAllSubmatchIndex(window, -1) {
+		if !last && m[1] == len(window) {
+			continue
+		}
+		if m[2*group] < 0 {
+			continue
+		}
+		versions = append(versions, string(window[m[2*group]:m[2*group+1]]))
+	}
+	return versions
+}
+
+func newPackage(p Pattern, version string, location file.Location) pkg.Package {
+	var cpes []cpe.CPE
+	for _, c := range p.CPEs {
+		c.Attributes.Version = version
+		cpes = append(cpes, c)
+	}
+
+	result := pkg.Package{
+		Name:    p.Package,
+		Version: version,
+		Locations: file.NewLocationSet(
+			location.
+				WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation).
+				WithAnnotation(pkg.SourceAnnotationKey, pkg.BinaryEmbeddedSourceAnnotation),
+		),
+		Type: pkg.BinaryPkg,
+		CPEs: cpes,
+		Metadata: pkg.BinarySignature{
+			Matches: []pkg.ClassifierMatch{
+				{
+					Classifier: p.Class,
+					Location:   location,
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(p.PURL, emptyPURL) {
+		purl := p.PURL
+		purl.Version = version
+		result.PURL = purl.ToString()
+	}
+
+	result.SetID()
+
+	return result
+}
@@ -0,0 +1,110 @@
+package embeddedlib
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/cpe"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		expected map[string]string
+	}{
+		{
+			name:     "openssl 3 banner",
+			contents: "\x00\x7fELF\x00OpenSSL 3.0.12 24 Oct 2023\x00",
+			expected: map[string]string{"openssl": "3.0.12"},
+		},
+		{
+			name:     "openssl 1.1.1 banner",
+			contents: "\x00OpenSSL 1.1.1w  11 Sep 2023\x00",
+			expected: map[string]string{"openssl": "1.1.1w"},
+		},
+		{
+			name:     "zlib banners",
+			contents: "\x00 deflate 1.2.13 Copyright 1995-2022 Jean-loup Gailly and Mark Adler \x00 inflate 1.2.13 Copyright 1995-2022 Mark Adler \x00",
+			expected: map[string]string{"zlib": "1.2.13"},
+		},
+		{
+			name:     "openssl and zlib",
+			contents: "OpenSSL 3.1.4 24 Oct 2023\x00inflate 1.3.1 Copyright 1995-2024 Mark Adler",
+			expected: map[string]string{"openssl": "3.1.4", "zlib": "1.3.1"},
+		},
+		{
+			name:     "mentions without a banner",
+			contents: "\x00requires OpenSSL 1.1.0 or later\x00zlib 1.2.11\x00",
+			expected: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkgs, err := Scan(strings.NewReader(tt.contents), file.NewLocation("/app/bin/server"), DefaultPatterns()...)
+			require.NoError(t, err)
+
+			actual := make(map[string]string)
+			for _, p := range pkgs {
+				actual[p.Name] = p.Version
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestScan_package(t *testing.T) {
+	location := file.NewLocation("/app/bin/server")
+
+	pkgs, err := Scan(strings.NewReader("\x00OpenSSL 3.0.12 24 Oct 2023\x00"), location, DefaultPatterns()...)
+	require.NoError(t, err)
+	require.Len(t, pkgs, 1)
+
+	p := pkgs[0]
+	assert.Equal(t, pkg.BinaryPkg, p.Type)
+	assert.Equal(t, "pkg:generic/openssl@3.0.12", p.PURL)
+	assert.Equal(t, []cpe.CPE{cpe.Must("cpe:2.3:a:openssl:openssl:3.0.12:*:*:*:*:*:*:*", cpe.GeneratedSource)}, p.CPEs)
+	assert.Equal(t, "openssl-embedded", p.Metadata.(pkg.BinarySignature).Matches[0].Classifier)
+
+	locations := p.Locations.ToSlice()
+	require.Len(t, locations, 1)
+	assert.Equal(t, pkg.BinaryEmbeddedSourceAnnotation, locations[0].Annotations[pkg.SourceAnnotationKey])
+	assert.Equal(t, pkg.PrimaryEvidenceAnnotation, locations[0].Annotations[pkg.EvidenceAnnotationKey])
+}
+
+func TestScan_chunkBoundaries(t *testing.T) {
+	banner := "OpenSSL 3.0.12 24 Oct 2023"
+	for _, offset := range []int{0, chunkSize - 10, chunkSize - len(banner), chunkSize, 2*chunkSize - 3} {
+		contents := bytes.Repeat([]byte{0}, offset+len(banner)+chunkSize/2)
+		copy(contents[offset:], banner)
+
+		pkgs, err := Scan(bytes.NewReader(contents), file.NewLocation("/app/bin/server"), DefaultPatterns()...)
+		require.NoError(t, err)
+		require.Lenf(t, pkgs, 1, "banner at offset %d", offset)
+		assert.Equal(t, "3.0.12", pkgs[0].Version)
+	}
+}
+
+func TestScan_customPatterns(t *testing.T) {
+	patterns := []Pattern{
+		{
+			Class:   "sqlite-embedded",
+			Banner:  regexp.MustCompile(`SQLite version (?P<version>[0-9]+\.[0-9]+\.[0-9]+)\x00`),
+			Package: "sqlite",
+		},
+	}
+
+	pkgs, err := Scan(strings.NewReader("\x00SQLite version 3.45.1\x00SQLite version 3.45.1\x00"), file.NewLocation("/app/bin/server"), patterns...)
+	require.NoError(t, err)
+	require.Len(t, pkgs, 1)
+	assert.Equal(t, "sqlite", pkgs[0].Name)
+	assert.Equal(t, "3.45.1", pkgs[0].Version)
+	assert.Empty(t, pkgs[0].PURL)
+}
@@ -0,0 +1,32 @@
+package embeddedlib
+
+import (
+	"regexp"
+
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/binutils"
+)
+
+// DefaultPatterns returns the version banners of libraries that are commonly statically linked into binaries. Banners
+// are matched together with text that follows the version, so that a version cannot be matched in part.
+func DefaultPatterns() []Pattern {
+	return []Pattern{
+		{
+			Class: "openssl-embedded",
+			// OpenSSL 3.0.12 24 Oct 2023
+			// OpenSSL 1.1.1w  11 Sep 2023
+			Banner:  regexp.MustCompile(`OpenSSL (?P<version>[0-9]+\.[0-9]+\.[0-9]+([a-z]+|-alpha[0-9]+|-beta[0-9]+)?) +[0-9]{1,2} [A-Z][a-z]{2} [0-9]{4}`),
+			Package: "openssl",
+			PURL:    binutils.MustPURL("pkg:generic/openssl@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:openssl:openssl:*:*:*:*:*:*:*:*"),
+		},
+		{
+			Class: "zlib-embedded",
+			// deflate 1.2.13 Copyright 1995-2022 Jean-loup Gailly and Mark Adler
+			// inflate 1.3.1 Copyright 1995-2024 Mark Adler
+			Banner:  regexp.MustCompile(`(?:deflate|inflate) (?P<version>[0-9]+\.[0-9]+(\.[0-9]+)*) Copyright 1995-[0-9]{4}`),
+			Package: "zlib",
+			PURL:    binutils.MustPURL("pkg:generic/zlib@version"),
+			CPEs:    binutils.SingleCPE("cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*"),
+		},
+	}
+}
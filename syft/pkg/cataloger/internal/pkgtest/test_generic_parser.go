@@ -57,6 +57,7 @@ func NewCatalogTester() *CatalogTester {
 				"/etc/os-release",
 				"/usr/lib/os-release",
 				"/etc/system-release-cpe",
+				"/etc/lsb-release",
 				"/etc/redhat-release",
 				"/bin/busybox",
 			},
@@ -0,0 +1,256 @@
+package java
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode/utf16"
+)
+
+// chunk types used within the Android binary XML format (see ResourceTypes.h in the Android framework)
+const (
+	axmlStringPoolType   = 0x0001
+	axmlDocumentType     = 0x0003
+	axmlResourceMapType  = 0x0180
+	axmlStartElementType = 0x0102
+)
+
+// value types for attributes within the Android binary XML format
+const (
+	axmlValueString = 0x03
+	axmlValueIntDec = 0x10
+	axmlValueIntHex = 0x11
+)
+
+const (
+	axmlNoIndex      = 0xFFFFFFFF
+	axmlUTF8Flag     = 1 << 8
+	axmlChunkHdrSize = 8
+)
+
+// androidAttributeResourceIDs maps the well-known android attribute resource IDs to attribute names. These are used
+// when the attribute names have been stripped from the string pool (e.g. by an obfuscator).
+var androidAttributeResourceIDs = map[uint32]string{
+	0x0101020c: "minSdkVersion",
+	0x0101021b: "versionCode",
+	0x0101021c: "versionName",
+	0x01010270: "targetSdkVersion",
+}
+
+var errNotAndroidBinaryXML = errors.New("not an android binary XML document")
+
+// axmlElement is a start element within an android binary XML document, with all attribute values as strings.
+type axmlElement struct {
+	name  string
+	attrs map[string]string
+}
+
+// parseAndroidBinaryXML returns all start elements within the given android binary XML document (as found as the
+// AndroidManifest.xml within an APK). Attribute values that reference resources are not resolved and are omitted.
+func parseAndroidBinaryXML(data []byte) ([]axmlElement, error) {
+	if len(data) < axmlChunkHdrSize || binary.LittleEndian.Uint16(data) != axmlDocumentType {
+		return nil, errNotAndroidBinaryXML
+	}
+
+	end := int(binary.LittleEndian.Uint32(data[4:]))
+	if end > len(data) {
+		end = len(data)
+	}
+
+	var stringPool []string
+	var resourceIDs []uint32
+	var elements []axmlElement
+
+	offset := int(binary.LittleEndian.Uint16(data[2:]))
+	for offset+axmlChunkHdrSize <= end {
+		chunkType := binary.LittleEndian.Uint16(data[offset:])
+		headerSize := int(binary.LittleEndian.Uint16(data[offset+2:]))
+		size := int(binary.LittleEndian.Uint32(data[offset+4:]))
+		if size < axmlChunkHdrSize || headerSize > size || offset+size > end {
+			return nil, fmt.Errorf("invalid chunk at offset %d", offset)
+		}
+		chunk := data[offset : offset+size]
+
+		var err error
+		switch chunkType {
+		case axmlStringPoolType:
+			stringPool, err = parseAXMLStringPool(chunk)
+		case axmlResourceMapType:
+			resourceIDs = parseAXMLResourceMap(chunk[headerSize:])
+		case axmlStartElementType:
+			var el *axmlElement
+			el, err = parseAXMLStartElement(chunk, headerSize, stringPool, resourceIDs)
+			if el != nil {
+				elements = append(elements, *el)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		offset += size
+	}
+
+	return elements, nil
+}
+
+func parseAXMLStringPool(chunk []byte) ([]string, error) {
+	if len(chunk) < 28 {
+		return nil, errors.New("string pool header is truncated")
+	}
+	headerSize := int(binary.LittleEndian.Uint16(chunk[2:]))
+	count := int(binary.LittleEndian.Uint32(chunk[8:]))
+	flags := binary.LittleEndian.Uint32(chunk[16:])
+	stringsStart := int(binary.LittleEndian.Uint32(chunk[20:]))
+
+	if headerSize+count*4 > len(chunk) || stringsStart > len(chunk) {
+		return nil, errors.New("string pool is truncated")
+	}
+
+	pool := make([]string, count)
+	for i := range pool {
+		start := stringsStart + int(binary.LittleEndian.Uint32(chunk[headerSize+i*4:]))
+		if start >= len(chunk) {
+			return nil, fmt.Errorf("string %d is out of bounds", i)
+		}
+		var ok bool
+		if flags&axmlUTF8Flag != 0 {
+			pool[i], ok = decodeAXMLUTF8String(chunk[start:])
+		} else {
+			pool[i], ok = decodeAXMLUTF16String(chunk[start:])
+		}
+		if !ok {
+			return nil, fmt.Errorf("string %d is truncated", i)
+		}
+	}
+	return pool, nil
+}
+
+// decodeAXMLUTF8String decodes a string prefixed by its length in UTF-16 code units and by its length in bytes,
+// where each length is encoded in one or two bytes.
+func decodeAXMLUTF8String(b []byte) (string, bool) {
+	readLen := func(b []byte) (int, int, bool) {
+		if len(b) < 1 {
+			return 0, 0, false
+		}
+		if b[0]&0x80 == 0 {
+			return int(b[0]), 1, true
+		}
+		if len(b) < 2 {
+			return 0, 0, false
+		}
+		return int(b[0]&0x7f)<<8 | int(b[1]), 2, true
+	}
+
+	_, n1, ok := readLen(b)
+	if !ok {
+		return "", false
+	}
+	length, n2, ok := readLen(b[n1:])
+	if !ok {
+		return "", false
+	}
+	start := n1 + n2
+	if start+length > len(b) {
+		return "", false
+	}
+	return string(b[start : start+length]), true
+}
+
+// decodeAXMLUTF16String decodes a string prefixed by its length in UTF-16 code units, where the length is encoded
+// in one or two 16-bit values.
+func decodeAXMLUTF16String(b []byte) (string, bool) {
+	if len(b) < 2 {
+		return "", false
+	}
+	length := int(binary.LittleEndian.Uint16(b))
+	start := 2
+	if length&0x8000 != 0 {
+		if len(b) < 4 {
+			return "", false
+		}
+		length = (length&0x7fff)<<16 | int(binary.LittleEndian.Uint16(b[2:]))
+		start = 4
+	}
+	if start+length*2 > len(b) {
+		return "", false
+	}
+
+	units := make([]uint16, length)
+	for i := range units {
+		units[i] = binary.LittleEndian.Uint16(b[start+i*2:])
+	}
+	return string(utf16.Decode(units)), true
+}
+
+func parseAXMLResourceMap(body []byte) []uint32 {
+	ids := make([]uint32, len(body)/4)
+	for i := range ids {
+		ids[i] = binary.LittleEndian.Uint32(body[i*4:])
+	}
+	return ids
+}
+
+func parseAXMLStartElement(chunk []byte, headerSize int, stringPool []string, resourceIDs []uint32) (*axmlElement, error) {
+	// the attribute extension (namespace, name, attribute start, attribute size, attribute count) follows the node header
+	ext := headerSize
+	if ext+14 > len(chunk) {
+		return nil, errors.New("start element is truncated")
+	}
+
+	lookup := func(idx uint32) string {
+		if idx == axmlNoIndex || int(idx) >= len(stringPool) {
+			return ""
+		}
+		return stringPool[idx]
+	}
+
+	el := axmlElement{
+		name:  lookup(binary.LittleEndian.Uint32(chunk[ext+4:])),
+		attrs: make(map[string]string),
+	}
+
+	attrStart := ext + int(binary.LittleEndian.Uint16(chunk[ext+8:]))
+	attrSize := int(binary.LittleEndian.Uint16(chunk[ext+10:]))
+	attrCount := int(binary.LittleEndian.Uint16(chunk[ext+12:]))
+	if attrSize < 20 || attrStart+attrSize*attrCount > len(chunk) {
+		return nil, errors.New("start element attributes are truncated")
+	}
+
+	for i := 0; i < attrCount; i++ {
+		attr := chunk[attrStart+i*attrSize:]
+		nameIdx := binary.LittleEndian.Uint32(attr[4:])
+		rawValue := binary.LittleEndian.Uint32(attr[8:])
+		dataType := attr[15]
+		data := binary.LittleEndian.Uint32(attr[16:])
+
+		name := lookup(nameIdx)
+		if int(nameIdx) < len(resourceIDs) {
+			if n, ok := androidAttributeResourceIDs[resourceIDs[nameIdx]]; ok {
+				name = n
+			}
+		}
+		if name == "" {
+			continue
+		}
+
+		var value string
+		switch {
+		case rawValue != axmlNoIndex:
+			value = lookup(rawValue)
+		case dataType == axmlValueString:
+			value = lookup(data)
+		case dataType == axmlValueIntDec:
+			value = strconv.FormatInt(int64(int32(data)), 10)
+		case dataType == axmlValueIntHex:
+			value = fmt.Sprintf("0x%x", data)
+		default:
+			// references to resources (and other typed values) cannot be resolved without resources.arsc
+			continue
+		}
+		el.attrs[name] = value
+	}
+
+	return &el, nil
+}
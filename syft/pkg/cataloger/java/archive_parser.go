@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"sort"
+	"strconv"
 	"strings"
 
 	intFile "github.com/anchore/syft/internal/file"
@@ -90,7 +92,7 @@ func newJavaArchiveParser(reader file.LocationReadCloser, detectNested bool, cfg
 		return nil, cleanupFn, fmt.Errorf("unable to process java archive: %w", err)
 	}
 
-	fileManifest, err := intFile.NewZipFileManifest(archivePath)
+	fileManifest, err := newZipFileManifest(reader.Path(), archivePath, cfg)
 	if err != nil {
 		return nil, cleanupFn, fmt.Errorf("unable to read files from java archive: %w", err)
 	}
@@ -106,6 +108,16 @@ func newJavaArchiveParser(reader file.LocationReadCloser, detectNested bool, cfg
 	}, cleanupFn, nil
 }
 
+// newZipFileManifest returns the manifest of the given (zip) java archive, which only includes the first entries of the
+// archive when the configured maximum number of archive entries is exceeded.
+func newZipFileManifest(path, archivePath string, cfg ArchiveCatalogerConfig) (intFile.ZipFileManifest, error) {
+	fileManifest, truncated, err := intFile.NewZipFileManifestWithLimit(archivePath, cfg.MaxArchiveEntries)
+	if truncated {
+		log.WithFields("path", path, "limit", cfg.MaxArchiveEntries).Warn("java archive exceeds the maximum number of archive entries, skipping the remaining entries")
+	}
+	return fileManifest, err
+}
+
 // parse the loaded archive and return all packages found.
 func (j *archiveParser) parse(ctx context.Context) ([]pkg.Package, []artifact.Relationship, error) {
 	var pkgs []pkg.Package
@@ -210,13 +222,52 @@ func (j *archiveParser) discoverMainPackage(ctx context.Context) (*pkg.Package,
 		),
 		Type: j.fileInfo.pkgType(),
 		Metadata: pkg.JavaArchive{
-			VirtualPath:    j.location.Path(),
-			Manifest:       manifest,
-			ArchiveDigests: digests,
+			VirtualPath:          j.location.Path(),
+			Manifest:             manifest,
+			ArchiveDigests:       digests,
+			MultiReleaseVersions: multiReleaseVersions(manifest, j.fileManifest),
 		},
 	}, nil
 }
 
+// multiReleaseVersions returns the JDK feature versions that a multi-release jar provides versioned classes for
+// (from META-INF/versions/<n>/), or nil if the manifest does not declare the archive as multi-release.
+func multiReleaseVersions(manifest *pkg.JavaManifest, fileManifest intFile.ZipFileManifest) []int {
+	if manifest == nil {
+		return nil
+	}
+	if value, ok := manifest.Main.Get("Multi-Release"); !ok || !strings.EqualFold(strings.TrimSpace(value), "true") {
+		return nil
+	}
+
+	versions := make(map[int]struct{})
+	for entry := range fileManifest {
+		rest, ok := strings.CutPrefix(strings.TrimPrefix(entry, "/"), multiReleaseVersionsPath)
+		if !ok {
+			continue
+		}
+		dir, remainder, ok := strings.Cut(rest, "/")
+		if !ok || remainder == "" {
+			continue
+		}
+		// versioned directories are only consulted by the runtime for java 9 and later
+		if version, err := strconv.Atoi(dir); err == nil && version >= 9 {
+			versions[version] = struct{}{}
+		}
+	}
+
+	if len(versions) == 0 {
+		return nil
+	}
+
+	result := make([]int, 0, len(versions))
+	for version := range versions {
+		result = append(result, version)
+	}
+	sort.Ints(result)
+	return result
+}
+
 func (j *archiveParser) parseLicenses(ctx context.Context, manifest *pkg.JavaManifest) ([]pkg.License, string, string, error) {
 	// we use j.location because we want to associate the license declaration with where we discovered the contents in the manifest
 	// TODO: when we support locations of paths within archives we should start passing the specific manifest location object instead of the top jar
@@ -457,7 +508,7 @@ func (j *archiveParser) discoverPkgsFromNestedArchives(ctx context.Context, pare
 // associating each discovered package to the given parent package.
 func discoverPkgsFromZip(ctx context.Context, location file.Location, archivePath, contentPath string, fileManifest intFile.ZipFileManifest, parentPkg *pkg.Package, cfg ArchiveCatalogerConfig) ([]pkg.Package, []artifact.Relationship, error) {
 	// search and parse pom.properties files & fetch the contents
-	openers, err := intFile.ExtractFromZipToUniqueTempFile(archivePath, contentPath, fileManifest.GlobMatch(false, archiveFormatGlobs...)...)
+	openers, err := intFile.ExtractFromZipToUniqueTempFile(archivePath, contentPath, fileManifest.GlobMatch(false, cfg.ArchiveGlobs(archiveFormatGlobs...)...)...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to extract files from zip: %w", err)
 	}
@@ -1,6 +1,7 @@
 package java
 
 import (
+	"archive/zip"
 	"bufio"
 	"context"
 	"fmt"
@@ -21,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	intFile "github.com/anchore/syft/internal/file"
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/license"
@@ -1483,3 +1485,150 @@ func setup() (mux *http.ServeMux, serverURL string, teardown func()) {
 
 	return mux, server.URL, server.Close
 }
+
+func Test_multiReleaseVersions(t *testing.T) {
+	multiRelease := &pkg.JavaManifest{Main: pkg.KeyValues{{Key: "Multi-Release", Value: "true"}}}
+	fileManifest := intFile.ZipFileManifest{
+		"META-INF/MANIFEST.MF":                                nil,
+		"META-INF/versions/":                                  nil,
+		"META-INF/versions/11/":                               nil,
+		"META-INF/versions/11/com/example/Util.class":         nil,
+		"META-INF/versions/9/module-info.class":               nil,
+		"META-INF/versions/17/com/example/Util.class":         nil,
+		"META-INF/versions/17/com/example/Util$Inner.class":   nil,
+		"META-INF/versions/8/com/example/Ignored.class":       nil,
+		"META-INF/versions/not-a-version/com/example/X.class": nil,
+		"com/example/Util.class":                              nil,
+	}
+
+	tests := []struct {
+		name     string
+		manifest *pkg.JavaManifest
+		expected []int
+	}{
+		{
+			name:     "multi-release jar",
+			manifest: multiRelease,
+			expected: []int{9, 11, 17},
+		},
+		{
+			name:     "versioned directories are ignored without the manifest attribute",
+			manifest: &pkg.JavaManifest{Main: pkg.KeyValues{{Key: "Manifest-Version", Value: "1.0"}}},
+		},
+		{
+			name:     "multi-release disabled",
+			manifest: &pkg.JavaManifest{Main: pkg.KeyValues{{Key: "Multi-Release", Value: "false"}}},
+		},
+		{
+			name: "missing manifest",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, multiReleaseVersions(test.manifest, fileManifest))
+		})
+	}
+}
+
+func Test_newZipFileManifest_maxArchiveEntries(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "example.jar")
+	f, err := os.Create(archivePath)
+	require.NoError(t, err)
+	w := zip.NewWriter(f)
+	for _, name := range []string{"META-INF/MANIFEST.MF", "com/example/A.class", "com/example/B.class"} {
+		_, err := w.Create(name)
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+
+	tests := []struct {
+		name       string
+		maxEntries int
+		expected   []string
+	}{
+		{
+			name:     "no limit",
+			expected: []string{"META-INF/MANIFEST.MF", "com/example/A.class", "com/example/B.class"},
+		},
+		{
+			name:       "limit exceeded",
+			maxEntries: 2,
+			expected:   []string{"META-INF/MANIFEST.MF", "com/example/A.class"},
+		},
+		{
+			name:       "limit not exceeded",
+			maxEntries: 3,
+			expected:   []string{"META-INF/MANIFEST.MF", "com/example/A.class", "com/example/B.class"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := DefaultArchiveCatalogerConfig()
+			cfg.ArchiveSearchConfig = cfg.ArchiveSearchConfig.WithMaxArchiveEntries(test.maxEntries)
+
+			fileManifest, err := newZipFileManifest("example.jar", archivePath, cfg)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, test.expected, fileManifest.GlobMatch(false, "**"))
+		})
+	}
+}
+
+func Test_parseJavaArchive_shadedDependencies(t *testing.T) {
+	// an uber jar as built by the maven shade plugin: the classes of the dependencies are merged into the jar (and may
+	// be relocated), while the pom.properties of each dependency is kept under META-INF/maven
+	archivePath := filepath.Join(t.TempDir(), "app-1.0.jar")
+	f, err := os.Create(archivePath)
+	require.NoError(t, err)
+	w := zip.NewWriter(f)
+	for _, entry := range []struct{ name, contents string }{
+		{"META-INF/MANIFEST.MF", "Manifest-Version: 1.0\nCreated-By: Apache Maven 3.9.6\nMain-Class: com.example.App\n"},
+		{"META-INF/maven/com.example/app/pom.properties", "groupId=com.example\nartifactId=app\nversion=1.0\n"},
+		{"META-INF/maven/com.google.guava/guava/pom.properties", "groupId=com.google.guava\nartifactId=guava\nversion=32.1.2-jre\n"},
+		{"META-INF/maven/org.slf4j/slf4j-api/pom.properties", "groupId=org.slf4j\nartifactId=slf4j-api\nversion=2.0.9\n"},
+		{"com/example/App.class", ""},
+		{"com/example/shaded/com/google/common/base/Strings.class", ""},
+		{"org/slf4j/Logger.class", ""},
+	} {
+		fw, err := w.Create(entry.name)
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(entry.contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+
+	fh, err := os.Open(archivePath)
+	require.NoError(t, err)
+	parser, cleanup, err := newJavaArchiveParser(file.LocationReadCloser{Location: file.NewLocation(archivePath), ReadCloser: fh}, false, DefaultArchiveCatalogerConfig())
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	pkgs, _, err := parser.parse(context.Background())
+	require.NoError(t, err)
+
+	type identity struct {
+		name, version, purl, virtualPath string
+		hasParent                        bool
+	}
+	var got []identity
+	for _, p := range pkgs {
+		m, ok := p.Metadata.(pkg.JavaArchive)
+		require.True(t, ok)
+		got = append(got, identity{
+			name:        p.Name,
+			version:     p.Version,
+			purl:        p.PURL,
+			virtualPath: strings.TrimPrefix(m.VirtualPath, archivePath),
+			hasParent:   m.Parent != nil,
+		})
+	}
+
+	assert.ElementsMatch(t, []identity{
+		{name: "app", version: "1.0", purl: "pkg:maven/com.example/app@1.0"},
+		{name: "guava", version: "32.1.2-jre", purl: "pkg:maven/com.google.guava/guava@32.1.2-jre", virtualPath: ":com.google.guava:guava", hasParent: true},
+		{name: "slf4j-api", version: "2.0.9", purl: "pkg:maven/org.slf4j/slf4j-api@2.0.9", virtualPath: ":org.slf4j:slf4j-api", hasParent: true},
+	}, got)
+}
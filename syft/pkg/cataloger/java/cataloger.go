@@ -12,23 +12,35 @@ import (
 func NewArchiveCataloger(cfg ArchiveCatalogerConfig) pkg.Cataloger {
 	gap := newGenericArchiveParserAdapter(cfg)
 
-	c := generic.NewCataloger("java-archive-cataloger").
-		WithParserByGlobs(gap.parseJavaArchive, archiveFormatGlobs...)
+	c := generic.NewCataloger("java-archive-cataloger")
 
-	if cfg.IncludeIndexedArchives {
+	if globs := cfg.ArchiveGlobs(archiveFormatGlobs...); len(globs) > 0 {
+		c.WithParserByGlobs(gap.parseJavaArchive, globs...)
+	}
+
+	if globs := cfg.ArchiveGlobs(genericZipGlobs...); cfg.IncludeIndexedArchives && len(globs) > 0 {
 		// java archives wrapped within zip files
 		gzp := newGenericZipWrappedJavaArchiveParser(cfg)
-		c.WithParserByGlobs(gzp.parseZipWrappedJavaArchive, genericZipGlobs...)
+		c.WithParserByGlobs(gzp.parseZipWrappedJavaArchive, globs...)
 	}
 
-	if cfg.IncludeUnindexedArchives {
+	if globs := cfg.ArchiveGlobs(genericTarGlobs...); cfg.IncludeUnindexedArchives && len(globs) > 0 {
 		// java archives wrapped within tar files
 		gtp := newGenericTarWrappedJavaArchiveParser(cfg)
-		c.WithParserByGlobs(gtp.parseTarWrappedJavaArchive, genericTarGlobs...)
+		c.WithParserByGlobs(gtp.parseTarWrappedJavaArchive, globs...)
 	}
 	return c
 }
 
+// NewAndroidApkCataloger returns a cataloger capable of detecting Android applications (from the AndroidManifest.xml
+// within .apk files) and any java archives bundled within them.
+func NewAndroidApkCataloger(cfg ArchiveCatalogerConfig) pkg.Cataloger {
+	gap := newGenericAndroidApkParser(cfg)
+
+	return generic.NewCataloger("android-apk-cataloger").
+		WithParserByGlobs(gap.parseAndroidApk, androidApkGlobs...)
+}
+
 // NewPomCataloger returns a cataloger capable of parsing dependencies from a pom.xml file.
 // Pom files list dependencies that maybe not be locally installed yet.
 func NewPomCataloger(cfg ArchiveCatalogerConfig) pkg.Cataloger {
@@ -44,3 +56,17 @@ func NewGradleLockfileCataloger() pkg.Cataloger {
 	return generic.NewCataloger("java-gradle-lockfile-cataloger").
 		WithParserByGlobs(parseGradleLockfile, gradleLockfileGlob)
 }
+
+// NewGradleBuildCataloger returns a cataloger capable of parsing the dependencies declared in build.gradle and
+// build.gradle.kts files. Build files next to a gradle.lockfile are skipped in favor of the lockfile.
+func NewGradleBuildCataloger() pkg.Cataloger {
+	return generic.NewCataloger("java-gradle-build-cataloger").
+		WithParserByGlobs(parseGradleBuild, gradleBuildGlob, gradleKotlinBuildGlob)
+}
+
+// NewGradleCacheCataloger returns a cataloger capable of reporting the module versions resolved into the gradle
+// cache (~/.gradle/caches/modules-2), which reflect the dependencies a build actually pulled.
+func NewGradleCacheCataloger() pkg.Cataloger {
+	return generic.NewCataloger("java-gradle-cache-cataloger").
+		WithParserByGlobs(parseGradleCache, gradleCacheGlobs()...)
+}
@@ -69,6 +69,51 @@ func Test_ArchiveCataloger_Globs(t *testing.T) {
 	}
 }
 
+func Test_ArchiveCataloger_Globs_WithoutDefaultArchiveTypes(t *testing.T) {
+	tests := []struct {
+		name         string
+		archiveTypes []string
+		expected     []string
+	}{
+		{
+			name:         "only java archive types",
+			archiveTypes: []string{".jar", "war", ".EAR"},
+			expected: []string{
+				"java-archives/example.jar",
+				"java-archives/example.war",
+				"java-archives/example.ear",
+			},
+		},
+		{
+			name:         "java and wrapping archive types",
+			archiveTypes: []string{".jar", ".zip", ".tar.gz"},
+			expected: []string{
+				"java-archives/example.jar",
+				"archives/example.zip",
+				"archives/example.tar.gz",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, "test-fixtures/glob-paths").
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t,
+					NewArchiveCataloger(
+						ArchiveCatalogerConfig{
+							ArchiveSearchConfig: cataloging.ArchiveSearchConfig{
+								IncludeIndexedArchives:   true,
+								IncludeUnindexedArchives: true,
+							}.WithoutDefaultArchiveTypes(test.archiveTypes...),
+						},
+					),
+				)
+		})
+	}
+}
+
 func Test_POMCataloger_Globs(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -101,3 +146,55 @@ func Test_POMCataloger_Globs(t *testing.T) {
 		})
 	}
 }
+
+func Test_AndroidApkCataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain android apk files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"android/example.apk",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewAndroidApkCataloger(ArchiveCatalogerConfig{}))
+		})
+	}
+}
+
+func Test_GradleBuildCataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain gradle build files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"src/build.gradle",
+				"src/kotlin/build.gradle.kts",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				IgnoreUnfulfilledPathResponses("src/gradle.lockfile", "src/kotlin/gradle.lockfile").
+				TestCataloger(t, NewGradleBuildCataloger())
+		})
+	}
+}
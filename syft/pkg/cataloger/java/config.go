@@ -1,6 +1,11 @@
 package java
 
-import "github.com/anchore/syft/syft/cataloging"
+import (
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/cataloging"
+)
 
 const mavenBaseURL = "https://repo1.maven.org/maven2"
 
@@ -39,3 +44,53 @@ func (j ArchiveCatalogerConfig) WithArchiveTraversal(search cataloging.ArchiveSe
 	j.ArchiveSearchConfig = search
 	return j
 }
+
+type NativeImageCatalogerConfig struct {
+	// MaxSBOMSize is the maximum size in bytes of a decompressed SBOM embedded in a native image, larger SBOMs are not
+	// cataloged (0 means there is no limit).
+	MaxSBOMSize int64 `yaml:"max-sbom-size" json:"max-sbom-size" mapstructure:"max-sbom-size"`
+
+	// AllowedPaths are glob patterns (e.g. "**/bin/*") that restrict which executables are searched for an embedded
+	// SBOM (no patterns means all executables are searched).
+	AllowedPaths []string `yaml:"allowed-paths" json:"allowed-paths" mapstructure:"allowed-paths"`
+
+	// StrictFormatCheck rejects embedded SBOM documents that do not declare a supported specification version, and
+	// skips components without a name and version, instead of cataloging whatever can be read.
+	StrictFormatCheck bool `yaml:"strict-format-check" json:"strict-format-check" mapstructure:"strict-format-check"`
+}
+
+func DefaultNativeImageCatalogerConfig() NativeImageCatalogerConfig {
+	return NativeImageCatalogerConfig{}
+}
+
+func (n NativeImageCatalogerConfig) WithMaxSBOMSize(size int64) NativeImageCatalogerConfig {
+	n.MaxSBOMSize = size
+	return n
+}
+
+func (n NativeImageCatalogerConfig) WithAllowedPaths(patterns ...string) NativeImageCatalogerConfig {
+	n.AllowedPaths = patterns
+	return n
+}
+
+func (n NativeImageCatalogerConfig) WithStrictFormatCheck(strict bool) NativeImageCatalogerConfig {
+	n.StrictFormatCheck = strict
+	return n
+}
+
+func (n NativeImageCatalogerConfig) isAllowedPath(p string) bool {
+	if len(n.AllowedPaths) == 0 {
+		return true
+	}
+	for _, pattern := range n.AllowedPaths {
+		matches, err := doublestar.Match(pattern, p)
+		if err != nil {
+			log.WithFields("pattern", pattern, "error", err).Debug("invalid java native-image allowed path pattern")
+			continue
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto"
 	"debug/elf"
 	"debug/macho"
 	"debug/pe"
@@ -12,16 +13,25 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"regexp"
+	"strings"
+	"time"
 	"unsafe"
 
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/packageurl-go"
 	"github.com/anchore/syft/internal"
+	intFile "github.com/anchore/syft/internal/file"
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/internal/mimetype"
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/cpe"
+	"github.com/anchore/syft/syft/diagnostic"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/internal/unionreader"
 	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/embeddedlib"
 )
 
 type nativeImageCycloneDX struct {
@@ -44,8 +54,32 @@ type nativeImageCPE struct {
 	Value string `json:"value"`
 }
 
+// nativeImageSbomFormat holds the top-level fields used to tell the supported SBOM document formats apart.
+type nativeImageSbomFormat struct {
+	BomFormat   string `json:"bomFormat"`
+	SpecVersion string `json:"specVersion"`
+	SPDXVersion string `json:"spdxVersion"`
+}
+
+type nativeImageSPDX struct {
+	SPDXVersion string                   `json:"spdxVersion"`
+	Packages    []nativeImageSPDXPackage `json:"packages"`
+}
+
+type nativeImageSPDXPackage struct {
+	Name         string                       `json:"name"`
+	VersionInfo  string                       `json:"versionInfo"`
+	ExternalRefs []nativeImageSPDXExternalRef `json:"externalRefs"`
+}
+
+type nativeImageSPDXExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
 type nativeImage interface {
-	fetchPkgs() ([]pkg.Package, error)
+	fetchPkgs(cfg NativeImageCatalogerConfig) ([]pkg.Package, error)
 }
 
 type nativeImageElf struct {
@@ -73,14 +107,18 @@ type exportPrefixPE struct {
 
 type exportContentPE struct {
 	// Directory Entry Contents for finding SBOM symbols
-	numberOfFunctions  uint32
-	numberOfNames      uint32
-	addressOfFunctions uint32
-	addressOfNames     uint32
-	// Locations of SBOM symbols in the .data section
+	numberOfFunctions     uint32
+	numberOfNames         uint32
+	addressOfFunctions    uint32
+	addressOfNames        uint32
+	addressOfNameOrdinals uint32
+	// Indexes of the SBOM symbols within the export address table, which hold their locations in the .data section
 	addressOfSbom       uint32
 	addressOfSbomLength uint32
 	addressOfSvmVersion uint32
+	hasSbom             bool
+	hasSbomLength       bool
+	hasSvmVersion       bool
 }
 
 // A nativeImagePE must maintain the underlying reader to fetch information unavailable in the Golang API.
@@ -93,7 +131,9 @@ type nativeImagePE struct {
 	header        exportPrefixPE
 }
 
-type nativeImageCataloger struct{}
+type nativeImageCataloger struct {
+	cfg NativeImageCatalogerConfig
+}
 
 const nativeImageCatalogerName = "graalvm-native-image-cataloger"
 const nativeImageSbomSymbol = "sbom"
@@ -103,9 +143,33 @@ const nativeImageMissingSymbolsError = "one or more symbols are missing from the
 const nativeImageInvalidIndexError = "parsing the executable file generated an invalid index"
 const nativeImageMissingExportedDataDirectoryError = "exported data directory is missing"
 
+// graalVMPackageName is the name of the package representing the GraalVM release a native image was built with
+const graalVMPackageName = "graalvm"
+
+// svmVersionInfoMaxLength bounds how much is read for the (short) contents of the __svm_version_info symbol
+const svmVersionInfoMaxLength = 256
+
+// svmVersionPattern matches the GraalVM release within the contents of the __svm_version_info symbol, for example:
+//
+//	GraalVM 22.3.0 Java 17 CE
+//	GraalVM CE 22.3.0
+//	Oracle GraalVM 21.0.1+12.1
+var svmVersionPattern = regexp.MustCompile(`GraalVM(?:\s+(?:CE|EE))?\s+v?(\d+(?:\.\d+)*(?:[+-][\w.]+)?)`)
+
+// nativeImageHashes are the hash algorithms used to calculate the digests of native image executables
+var nativeImageHashes = []crypto.Hash{crypto.SHA256}
+
+const (
+	cpe22Prefix     = "cpe:/"
+	cpe23Prefix     = "cpe:2.3:"
+	cpe23Components = 13
+)
+
 // NewNativeImageCataloger returns a new Native Image cataloger object.
-func NewNativeImageCataloger() pkg.Cataloger {
-	return &nativeImageCataloger{}
+func NewNativeImageCataloger(cfg NativeImageCatalogerConfig) pkg.Cataloger {
+	return &nativeImageCataloger{
+		cfg: cfg,
+	}
 }
 
 // Name returns a string that uniquely describes a native image cataloger
@@ -116,12 +180,18 @@ func (c *nativeImageCataloger) Name() string {
 // getPackage returns the package given within a NativeImageComponent.
 func getPackage(component nativeImageComponent) pkg.Package {
 	var cpes []cpe.CPE
+	seen := strset.New()
 	for _, property := range component.Properties {
-		c, err := cpe.New(property.Value, cpe.DeclaredSource)
+		c, err := parseNativeImageCPE(property.Value)
 		if err != nil {
 			log.Debugf("unable to parse Attributes: %v", err)
 			continue
 		}
+		// the same CPE may be given in both the URI and formatted string bindings
+		if seen.Has(c.Attributes.String()) {
+			continue
+		}
+		seen.Add(c.Attributes.String())
 		cpes = append(cpes, c)
 	}
 	return pkg.Package{
@@ -139,10 +209,48 @@ func getPackage(component nativeImageComponent) pkg.Package {
 	}
 }
 
-// decompressSbom returns the packages given within a native image executable's SBOM.
-func decompressSbom(dataBuf []byte, sbomStart uint64, lengthStart uint64) ([]pkg.Package, error) {
-	var pkgs []pkg.Package
+// parseNativeImageCPE parses a CPE given in either the 2.2 URI binding (cpe:/a:vendor:product:version) or the 2.3
+// formatted string binding (cpe:2.3:a:vendor:product:version:*:*:*:*:*:*:*), normalizing either to the same attributes.
+func parseNativeImageCPE(value string) (cpe.CPE, error) {
+	value = strings.TrimSpace(value)
+	lower := strings.ToLower(value)
+
+	switch {
+	case strings.HasPrefix(lower, cpe23Prefix):
+		value = cpe23Prefix + value[len(cpe23Prefix):]
+		// a truncated formatted string would otherwise be accepted with the missing attributes treated as ANY
+		if n := cpeFormattedStringComponents(value); n != cpe23Components {
+			return cpe.CPE{}, fmt.Errorf("CPE formatted string %q has %d components, expected %d", value, n, cpe23Components)
+		}
+	case strings.HasPrefix(lower, cpe22Prefix):
+		// the URI binding is case-insensitive
+		value = lower
+	default:
+		return cpe.CPE{}, fmt.Errorf("unsupported CPE binding: %q", value)
+	}
 
+	return cpe.New(value, cpe.DeclaredSource)
+}
+
+// cpeFormattedStringComponents counts the colon separated components of a CPE formatted string, ignoring escaped colons.
+func cpeFormattedStringComponents(value string) int {
+	count := 1
+	escaped := false
+	for _, r := range value {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ':':
+			count++
+		}
+	}
+	return count
+}
+
+// decompressSbom returns the packages given within a native image executable's SBOM.
+func decompressSbom(cfg NativeImageCatalogerConfig, dataBuf []byte, sbomStart uint64, lengthStart uint64) ([]pkg.Package, error) {
 	lengthEnd := lengthStart + 8
 	bufLen := len(dataBuf)
 	if lengthEnd > uint64(bufLen) {
@@ -169,26 +277,168 @@ func decompressSbom(dataBuf []byte, sbomStart uint64, lengthStart uint64) ([]pkg
 	if err != nil {
 		return nil, fmt.Errorf("could not decompress the java native-image SBOM: %w", err)
 	}
+	// some toolchains write the SBOM as several concatenated gzip members, all of which must be read
+	gzreader.Multistream(true)
+
+	var sbomReader io.Reader = gzreader
+	if cfg.MaxSBOMSize > 0 {
+		// read one byte past the limit to tell an SBOM of exactly the maximum size apart from a larger one
+		sbomReader = io.LimitReader(gzreader, cfg.MaxSBOMSize+1)
+	}
 
-	output, err := io.ReadAll(gzreader)
+	output, err := io.ReadAll(sbomReader)
 	if err != nil {
 		return nil, fmt.Errorf("could not read the java native-image SBOM: %w", err)
 	}
+	if cfg.MaxSBOMSize > 0 && int64(len(output)) > cfg.MaxSBOMSize {
+		return nil, fmt.Errorf("the java native-image SBOM exceeds the maximum size of %d bytes", cfg.MaxSBOMSize)
+	}
 
-	var sbomContent nativeImageCycloneDX
-	err = json.Unmarshal(output, &sbomContent)
-	if err != nil {
-		return nil, fmt.Errorf("could not unmarshal the java native-image SBOM: %w", err)
+	return parseNativeImageSbom(cfg, output)
+}
+
+// parseNativeImageSbom returns the packages given within a decompressed native image SBOM. The SBOM may hold one or
+// more consecutive JSON documents, each of which may be either CycloneDX or SPDX; components found across all
+// documents are merged.
+func parseNativeImageSbom(cfg NativeImageCatalogerConfig, data []byte) ([]pkg.Package, error) {
+	var components []nativeImageComponent
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var document json.RawMessage
+		err := decoder.Decode(&document)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not unmarshal the java native-image SBOM: %w", err)
+		}
+
+		found, err := nativeImageSbomComponents(document, cfg.StrictFormatCheck)
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, found...)
 	}
 
-	for _, component := range sbomContent.Components {
-		p := getPackage(component)
-		pkgs = append(pkgs, p)
+	var pkgs []pkg.Package
+	for _, component := range mergeNativeImageComponents(components) {
+		if cfg.StrictFormatCheck && (component.Name == "" || component.Version == "") {
+			log.WithFields("name", component.Name, "version", component.Version).Trace("skipping incomplete java native-image SBOM component")
+			continue
+		}
+		pkgs = append(pkgs, getPackage(component))
 	}
 
 	return pkgs, nil
 }
 
+// nativeImageSbomComponents detects the format of a single SBOM document from its top-level fields and returns the
+// components it describes. When strict, the document must also declare a supported specification version.
+func nativeImageSbomComponents(document []byte, strict bool) ([]nativeImageComponent, error) {
+	var format nativeImageSbomFormat
+	if err := json.Unmarshal(document, &format); err != nil {
+		return nil, fmt.Errorf("could not unmarshal the java native-image SBOM: %w", err)
+	}
+	if strict {
+		if err := format.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case format.BomFormat == "CycloneDX":
+		var sbomContent nativeImageCycloneDX
+		if err := json.Unmarshal(document, &sbomContent); err != nil {
+			return nil, fmt.Errorf("could not unmarshal the java native-image CycloneDX SBOM: %w", err)
+		}
+		return sbomContent.Components, nil
+	case format.SPDXVersion != "":
+		var sbomContent nativeImageSPDX
+		if err := json.Unmarshal(document, &sbomContent); err != nil {
+			return nil, fmt.Errorf("could not unmarshal the java native-image SPDX SBOM: %w", err)
+		}
+		var components []nativeImageComponent
+		for _, p := range sbomContent.Packages {
+			components = append(components, p.toComponent())
+		}
+		return components, nil
+	}
+
+	return nil, errors.New("unknown java native-image SBOM format")
+}
+
+// validate checks that the document declares a specification version of a supported format.
+func (f nativeImageSbomFormat) validate() error {
+	switch {
+	case f.BomFormat == "CycloneDX":
+		if !strings.HasPrefix(f.SpecVersion, "1.") {
+			return fmt.Errorf("unsupported java native-image CycloneDX SBOM spec version %q", f.SpecVersion)
+		}
+	case f.SPDXVersion != "":
+		if !strings.HasPrefix(f.SPDXVersion, "SPDX-2.") {
+			return fmt.Errorf("unsupported java native-image SPDX SBOM version %q", f.SPDXVersion)
+		}
+	}
+	return nil
+}
+
+// toComponent converts an SPDX package into the same shape as a CycloneDX component. The group is taken from a maven
+// package URL, when present.
+func (p nativeImageSPDXPackage) toComponent() nativeImageComponent {
+	component := nativeImageComponent{
+		Name:    p.Name,
+		Version: p.VersionInfo,
+	}
+	for _, ref := range p.ExternalRefs {
+		switch ref.ReferenceType {
+		case "cpe23Type", "cpe22Type":
+			component.Properties = append(component.Properties, nativeImageCPE{Name: "syft:cpe23", Value: ref.ReferenceLocator})
+		case "purl":
+			purl, err := packageurl.FromString(ref.ReferenceLocator)
+			if err != nil {
+				log.Debugf("unable to parse package URL: %v", err)
+				continue
+			}
+			if purl.Type == packageurl.TypeMaven && component.Group == "" {
+				component.Group = purl.Namespace
+			}
+		}
+	}
+	return component
+}
+
+// mergeNativeImageComponents removes duplicate components (by group, name, and version), combining the CPEs of each
+// duplicate. The order in which components are first seen is preserved.
+func mergeNativeImageComponents(components []nativeImageComponent) []nativeImageComponent {
+	var merged []nativeImageComponent
+	index := make(map[string]int)
+	for _, component := range components {
+		key := component.Group + ":" + component.Name + "@" + component.Version
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(merged)
+			merged = append(merged, component)
+			continue
+		}
+		for _, property := range component.Properties {
+			if !containsNativeImageCPE(merged[i].Properties, property) {
+				merged[i].Properties = append(merged[i].Properties, property)
+			}
+		}
+	}
+	return merged
+}
+
+func containsNativeImageCPE(properties []nativeImageCPE, property nativeImageCPE) bool {
+	for _, p := range properties {
+		if p.Value == property.Value {
+			return true
+		}
+	}
+	return false
+}
+
 // fileError logs an error message when an executable cannot be read.
 func fileError(filename string, err error) (nativeImage, error) {
 	// We could not read the file as a binary for the desired platform, but it may still be a native-image executable.
@@ -282,19 +532,27 @@ func newPE(filename string, r io.ReaderAt) (nativeImage, error) {
 			namePointer:     0,
 			headerAttribute: 0,
 		},
-		header: exportPrefixPE{
-			characteristics: 0,
-			timeDateStamp:   0,
-			majorVersion:    0,
-			minorVersion:    0,
-			name:            0,
-			base:            0,
-		},
+		header: newExportPrefixPE(exports),
 	}, nil
 }
 
+// newExportPrefixPE reads the fixed fields at the start of the PE export directory table.
+func newExportPrefixPE(exports []byte) exportPrefixPE {
+	var prefix exportPrefixPE
+	if len(exports) < int(unsafe.Sizeof(prefix)) {
+		return prefix
+	}
+	prefix.characteristics = binary.LittleEndian.Uint32(exports[0:4])
+	prefix.timeDateStamp = binary.LittleEndian.Uint32(exports[4:8])
+	prefix.majorVersion = binary.LittleEndian.Uint16(exports[8:10])
+	prefix.minorVersion = binary.LittleEndian.Uint16(exports[10:12])
+	prefix.name = binary.LittleEndian.Uint32(exports[12:16])
+	prefix.base = binary.LittleEndian.Uint32(exports[16:20])
+	return prefix
+}
+
 // fetchPkgs obtains the packages given in the binary.
-func (ni nativeImageElf) fetchPkgs() (pkgs []pkg.Package, retErr error) {
+func (ni nativeImageElf) fetchPkgs(cfg NativeImageCatalogerConfig) (pkgs []pkg.Package, retErr error) {
 	defer func() {
 		if r := recover(); r != nil {
 			// this can happen in cases where a malformed binary is passed in can be initially parsed, but not
@@ -340,11 +598,26 @@ func (ni nativeImageElf) fetchPkgs() (pkgs []pkg.Package, retErr error) {
 	sbomLocation := sbom.Value - dataSectionBase
 	lengthLocation := sbomLength.Value - dataSectionBase
 
-	return decompressSbom(data, sbomLocation, lengthLocation)
+	pkgs, err = decompressSbom(cfg, data, sbomLocation, lengthLocation)
+	if err != nil {
+		return nil, err
+	}
+	return withGraalVMPackage(pkgs, ni.svmVersionInfo(svmVersion.Value), nil), nil
+}
+
+// svmVersionInfo returns the string given at the address of the __svm_version_info symbol.
+func (ni nativeImageElf) svmVersionInfo(addr uint64) string {
+	for _, s := range ni.file.Sections {
+		if s.Type == elf.SHT_NOBITS || addr < s.Addr || addr >= s.Addr+s.Size {
+			continue
+		}
+		return readCString(s, int64(addr-s.Addr))
+	}
+	return ""
 }
 
 // fetchPkgs obtains the packages from a Native Image given as a Mach O file.
-func (ni nativeImageMachO) fetchPkgs() (pkgs []pkg.Package, retErr error) {
+func (ni nativeImageMachO) fetchPkgs(cfg NativeImageCatalogerConfig) (pkgs []pkg.Package, retErr error) {
 	defer func() {
 		if r := recover(); r != nil {
 			// this can happen in cases where a malformed binary is passed in can be initially parsed, but not
@@ -387,7 +660,22 @@ func (ni nativeImageMachO) fetchPkgs() (pkgs []pkg.Package, retErr error) {
 	sbomLocation := sbom.Value - dataSegment.Addr
 	lengthLocation := sbomLength.Value - dataSegment.Addr
 
-	return decompressSbom(dataBuf, sbomLocation, lengthLocation)
+	pkgs, err = decompressSbom(cfg, dataBuf, sbomLocation, lengthLocation)
+	if err != nil {
+		return nil, err
+	}
+	return withGraalVMPackage(pkgs, ni.svmVersionInfo(svmVersion.Value), nil), nil
+}
+
+// svmVersionInfo returns the string given at the address of the __svm_version_info symbol.
+func (ni nativeImageMachO) svmVersionInfo(addr uint64) string {
+	for _, s := range ni.file.Sections {
+		if addr < s.Addr || addr >= s.Addr+s.Size {
+			continue
+		}
+		return readCString(s, int64(addr-s.Addr))
+	}
+	return ""
 }
 
 // fetchExportAttribute obtains an attribute from the exported symbols directory entry.
@@ -448,9 +736,34 @@ func (ni nativeImagePE) fetchExportContent() (*exportContentPE, error) {
 	if err != nil {
 		return nil, fmt.Errorf("could not find the exported 'address of names' attribute: %w", err)
 	}
+	content.addressOfNameOrdinals, err = ni.fetchExportAttribute(4)
+	if err != nil {
+		return nil, fmt.Errorf("could not find the exported 'address of name ordinals' attribute: %w", err)
+	}
 	return content, nil
 }
 
+// fetchExportOrdinal obtains the index into the export address table of the i-th exported name from the export
+// ordinal table. The names are sorted (so do not follow the order of the functions), and the indexes are unbiased: the
+// ordinal of an export is its index plus the ordinal base.
+func (ni nativeImagePE) fetchExportOrdinal(content *exportContentPE, i uint32) (uint32, error) {
+	var index uint16
+
+	n := uint32(len(ni.exports))
+	sz := uint32(unsafe.Sizeof(index))
+	j := content.addressOfNameOrdinals - ni.exportSymbols.VirtualAddress + i*sz
+	if j+sz > n {
+		log.Tracef("invalid index to exported name ordinal: %v", j)
+		return uint32(0), errors.New(nativeImageInvalidIndexError)
+	}
+	index = binary.LittleEndian.Uint16(ni.exports[j : j+sz])
+	if uint32(index) >= content.numberOfFunctions {
+		log.Tracef("exported name ordinal %v is outside of the export address table (ordinal base %v)", ni.header.base+uint32(index), ni.header.base)
+		return uint32(0), errors.New(nativeImageInvalidIndexError)
+	}
+	return uint32(index), nil
+}
+
 // fetchSbomSymbols enumerates the symbols exported by a binary to detect Native Image's SBOM symbols.
 func (ni nativeImagePE) fetchSbomSymbols(content *exportContentPE) {
 	// Appending NULL bytes to symbol names simplifies finding them in the export data directory
@@ -482,19 +795,29 @@ func (ni nativeImagePE) fetchSbomSymbols(content *exportContentPE) {
 			log.Tracef("invalid index to exported symbol: %v", symbolBase)
 			return
 		}
+		var address *uint32
+		var found *bool
 		switch {
 		case bytes.HasPrefix(ni.exports[symbolBase:], sbomBytes):
-			content.addressOfSbom = i
+			address, found = &content.addressOfSbom, &content.hasSbom
 		case bytes.HasPrefix(ni.exports[symbolBase:], sbomLengthBytes):
-			content.addressOfSbomLength = i
+			address, found = &content.addressOfSbomLength, &content.hasSbomLength
 		case bytes.HasPrefix(ni.exports[symbolBase:], svmVersionInfoBytes):
-			content.addressOfSvmVersion = i
+			address, found = &content.addressOfSvmVersion, &content.hasSvmVersion
+		default:
+			continue
+		}
+		// the position of a name does not give the position of the function it exports
+		index, err := ni.fetchExportOrdinal(content, i)
+		if err != nil {
+			continue
 		}
+		*address, *found = index, true
 	}
 }
 
 // fetchPkgs obtains the packages from a Native Image given as a PE file.
-func (ni nativeImagePE) fetchPkgs() (pkgs []pkg.Package, retErr error) {
+func (ni nativeImagePE) fetchPkgs(cfg NativeImageCatalogerConfig) (pkgs []pkg.Package, retErr error) {
 	defer func() {
 		if r := recover(); r != nil {
 			// this can happen in cases where a malformed binary is passed in can be initially parsed, but not
@@ -509,7 +832,7 @@ func (ni nativeImagePE) fetchPkgs() (pkgs []pkg.Package, retErr error) {
 		return nil, err
 	}
 	ni.fetchSbomSymbols(content)
-	if content.addressOfSbom == uint32(0) || content.addressOfSbomLength == uint32(0) || content.addressOfSvmVersion == uint32(0) {
+	if !content.hasSbom || !content.hasSbomLength || !content.hasSvmVersion {
 		return nil, errors.New(nativeImageMissingSymbolsError)
 	}
 	functionsBase := content.addressOfFunctions - ni.exportSymbols.VirtualAddress
@@ -536,11 +859,142 @@ func (ni nativeImagePE) fetchPkgs() (pkgs []pkg.Package, retErr error) {
 	sbomLocation := sbomAddress - dataSection.VirtualAddress
 	lengthLocation := sbomLengthAddress - dataSection.VirtualAddress
 
-	return decompressSbom(dataBuf, uint64(sbomLocation), uint64(lengthLocation))
+	pkgs, err = decompressSbom(cfg, dataBuf, uint64(sbomLocation), uint64(lengthLocation))
+	if err != nil {
+		return nil, err
+	}
+	metadata := ni.header.nativeImageMetadata()
+	pkgs = withNativeImageMetadata(pkgs, metadata)
+
+	var versionInfo string
+	if svmVersionAddress, err := ni.fetchExportFunctionPointer(functionsBase, content.addressOfSvmVersion); err == nil {
+		versionInfo = ni.svmVersionInfo(svmVersionAddress)
+	}
+	return withGraalVMPackage(pkgs, versionInfo, metadata), nil
+}
+
+// svmVersionInfo returns the string given at the (relative virtual) address of the __svm_version_info symbol.
+func (ni nativeImagePE) svmVersionInfo(addr uint32) string {
+	for _, s := range ni.file.Sections {
+		if addr < s.VirtualAddress || addr >= s.VirtualAddress+s.VirtualSize {
+			continue
+		}
+		return readCString(s, int64(addr-s.VirtualAddress))
+	}
+	return ""
+}
+
+// nativeImageMetadata describes the executable from the link timestamp in the export directory. Reproducible builds
+// zero the timestamp, which is flagged instead of being interpreted as the Unix epoch.
+func (h exportPrefixPE) nativeImageMetadata() *pkg.JavaNativeImage {
+	if h.timeDateStamp == 0 {
+		return &pkg.JavaNativeImage{LinkTimestampZeroed: true}
+	}
+	return &pkg.JavaNativeImage{
+		LinkTimestamp: time.Unix(int64(h.timeDateStamp), 0).UTC().Format(time.RFC3339),
+	}
+}
+
+// withNativeImageMetadata attaches the details of the native image executable to each package found within it.
+func withNativeImageMetadata(pkgs []pkg.Package, m *pkg.JavaNativeImage) []pkg.Package {
+	for i := range pkgs {
+		metadata, ok := pkgs[i].Metadata.(pkg.JavaArchive)
+		if !ok {
+			continue
+		}
+		metadata.NativeImage = m
+		pkgs[i].Metadata = metadata
+	}
+	return pkgs
+}
+
+// readCString reads the NUL-terminated string at the given offset, up to a maximum length.
+func readCString(r io.ReaderAt, offset int64) string {
+	buf := make([]byte, svmVersionInfoMaxLength)
+	n, err := r.ReadAt(buf, offset)
+	if err != nil && !errors.Is(err, io.EOF) {
+		log.Tracef("unable to read the java native-image version info: %v", err)
+		return ""
+	}
+	buf = buf[:n]
+	if i := bytes.IndexByte(buf, 0); i >= 0 {
+		buf = buf[:i]
+	}
+	return strings.TrimSpace(string(buf))
+}
+
+// graalVMVersion returns the GraalVM release given within the contents of the __svm_version_info symbol, which
+// describes the toolchain the executable was built with, for example "GraalVM 22.3.0 Java 17 CE".
+func graalVMVersion(versionInfo string) string {
+	match := svmVersionPattern.FindStringSubmatch(versionInfo)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// withBuildToolchain records the GraalVM release the native image executable was built with on each package found
+// within it.
+func withBuildToolchain(pkgs []pkg.Package, toolchain string) []pkg.Package {
+	for i := range pkgs {
+		metadata, ok := pkgs[i].Metadata.(pkg.JavaArchive)
+		if !ok {
+			continue
+		}
+		// note: the native image details may be shared between packages, so are copied before being modified
+		var nativeImage pkg.JavaNativeImage
+		if metadata.NativeImage != nil {
+			nativeImage = *metadata.NativeImage
+		}
+		nativeImage.BuildToolchain = toolchain
+		metadata.NativeImage = &nativeImage
+		pkgs[i].Metadata = metadata
+	}
+	return pkgs
+}
+
+// withGraalVMPackage adds a package for the GraalVM release a native image executable was built with (decoded from
+// the __svm_version_info symbol), as the Substrate VM runtime of that release is compiled into every native image.
+// The full version info is recorded once for the executable, while the toolchain is recorded on each package.
+func withGraalVMPackage(pkgs []pkg.Package, versionInfo string, m *pkg.JavaNativeImage) []pkg.Package {
+	version := graalVMVersion(versionInfo)
+	if version == "" {
+		if versionInfo != "" {
+			log.WithFields("versionInfo", versionInfo).Trace("unable to determine GraalVM version of java native-image")
+		}
+		return pkgs
+	}
+
+	toolchain := "graalvm " + version
+	pkgs = withBuildToolchain(pkgs, toolchain)
+
+	var nativeImage pkg.JavaNativeImage
+	if m != nil {
+		nativeImage = *m
+	}
+	nativeImage.SvmVersionInfo = versionInfo
+	nativeImage.BuildToolchain = toolchain
+
+	return append(pkgs, pkg.Package{
+		Name:     graalVMPackageName,
+		Version:  version,
+		Language: pkg.Java,
+		Type:     pkg.GraalVMNativeImagePkg,
+		FoundBy:  nativeImageCatalogerName,
+		Metadata: pkg.JavaArchive{
+			NativeImage: &nativeImage,
+		},
+		CPEs: []cpe.CPE{
+			{
+				Attributes: cpe.Attributes{Part: "a", Vendor: "oracle", Product: "graalvm", Version: version},
+				Source:     cpe.GeneratedSource,
+			},
+		},
+	})
 }
 
 // fetchPkgs provides the packages available in a UnionReader.
-func fetchPkgs(reader unionreader.UnionReader, filename string) []pkg.Package {
+func fetchPkgs(cfg NativeImageCatalogerConfig, reader unionreader.UnionReader, filename string) []pkg.Package {
 	var pkgs []pkg.Package
 	imageFormats := []func(string, io.ReaderAt) (nativeImage, error){newElf, newMachO, newPE}
 
@@ -560,7 +1014,7 @@ func fetchPkgs(reader unionreader.UnionReader, filename string) []pkg.Package {
 			if ni == nil {
 				continue
 			}
-			newPkgs, err := ni.fetchPkgs()
+			newPkgs, err := ni.fetchPkgs(cfg)
 			if err != nil {
 				log.Tracef("unable to extract SBOM from possible java native-image %s: %v", filename, err)
 				continue
@@ -571,8 +1025,54 @@ func fetchPkgs(reader unionreader.UnionReader, filename string) []pkg.Package {
 	return pkgs
 }
 
+// withExecutableDigests records the digests of the native image executable on each package found within it, so the
+// packages can be traced back to the exact binary they were read from.
+func withExecutableDigests(pkgs []pkg.Package, reader io.ReadSeeker, location file.Location) []pkg.Package {
+	if _, err := reader.Seek(0, io.SeekStart); err != nil {
+		log.WithFields("path", location.RealPath, "error", err).Debug("unable to digest java native-image")
+		return pkgs
+	}
+	digests, err := intFile.NewDigestsFromFile(io.NopCloser(reader), nativeImageHashes)
+	if err != nil {
+		log.WithFields("path", location.RealPath, "error", err).Debug("unable to digest java native-image")
+		return pkgs
+	}
+
+	for i := range pkgs {
+		metadata, ok := pkgs[i].Metadata.(pkg.JavaArchive)
+		if !ok {
+			continue
+		}
+		// the details of the executable may be shared between packages, so each package gets its own copy
+		var nativeImage pkg.JavaNativeImage
+		if metadata.NativeImage != nil {
+			nativeImage = *metadata.NativeImage
+		}
+		nativeImage.Digests = digests
+		metadata.NativeImage = &nativeImage
+		pkgs[i].Metadata = metadata
+	}
+	return pkgs
+}
+
+// fetchEmbeddedLibraryPkgs provides the packages for native libraries statically linked into a native image (e.g.
+// zlib), which are not listed in the embedded SBOM.
+func fetchEmbeddedLibraryPkgs(reader io.ReaderAt, location file.Location) []pkg.Package {
+	pkgs, err := embeddedlib.Scan(reader, location, embeddedlib.DefaultPatterns()...)
+	if err != nil {
+		log.WithFields("path", location.RealPath, "error", err).Debug("unable to search java native-image for embedded libraries")
+		return nil
+	}
+	for i := range pkgs {
+		pkgs[i].FoundBy = nativeImageCatalogerName
+	}
+	return pkgs
+}
+
 // Catalog attempts to find any native image executables reachable from a resolver.
-func (c *nativeImageCataloger) Catalog(_ context.Context, resolver file.Resolver) ([]pkg.Package, []artifact.Relationship, error) {
+func (c *nativeImageCataloger) Catalog(ctx context.Context, resolver file.Resolver) ([]pkg.Package, []artifact.Relationship, error) {
+	warnings := diagnostic.CollectionFromContext(ctx)
+
 	var pkgs []pkg.Package
 	fileMatches, err := resolver.FilesByMIMEType(mimetype.ExecutableMIMETypeSet.List()...)
 	if err != nil {
@@ -580,9 +1080,17 @@ func (c *nativeImageCataloger) Catalog(_ context.Context, resolver file.Resolver
 	}
 
 	for _, location := range fileMatches {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		if !c.cfg.isAllowedPath(location.RealPath) {
+			continue
+		}
+
 		readerCloser, err := resolver.FileContentsByLocation(location)
 		if err != nil {
 			log.Debugf("error opening file: %v", err)
+			warnings.Add(nativeImageCatalogerName, &location, fmt.Sprintf("unable to open file: %v", err))
 			continue
 		}
 
@@ -590,7 +1098,11 @@ func (c *nativeImageCataloger) Catalog(_ context.Context, resolver file.Resolver
 		if err != nil {
 			return nil, nil, err
 		}
-		newPkgs := fetchPkgs(reader, location.RealPath)
+		newPkgs := fetchPkgs(c.cfg, reader, location.RealPath)
+		if len(newPkgs) > 0 {
+			newPkgs = withExecutableDigests(newPkgs, reader, location)
+			newPkgs = append(newPkgs, fetchEmbeddedLibraryPkgs(reader, location)...)
+		}
 		pkgs = append(pkgs, newPkgs...)
 		internal.CloseAndLogError(readerCloser, location.RealPath)
 	}
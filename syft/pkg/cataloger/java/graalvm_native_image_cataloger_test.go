@@ -4,15 +4,20 @@ import (
 	"bufio"
 	"bytes"
 	"compress/gzip"
+	"crypto/sha256"
+	"debug/pe"
 	"encoding/binary"
+	"fmt"
 	"io"
 	"os"
 	"path"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/anchore/syft/syft/cpe"
+	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/internal/unionreader"
 	"github.com/anchore/syft/syft/pkg"
 )
@@ -43,7 +48,7 @@ func TestParseNativeImage(t *testing.T) {
 			for _, r := range readers {
 				ni, err := test.newFn(test.fixture, r)
 				assert.NoError(t, err)
-				_, err = ni.fetchPkgs()
+				_, err = ni.fetchPkgs(DefaultNativeImageCatalogerConfig())
 				if err == nil {
 					t.Fatalf("should have failed to extract SBOM.")
 				}
@@ -128,9 +133,443 @@ func TestParseNativeImageSbom(t *testing.T) {
 			_ = binary.Write(writebytes, binary.LittleEndian, sbomlength)
 			_ = writebytes.Flush()
 			compressedsbom = b.Bytes()
-			actual, err := decompressSbom(compressedsbom, 0, sbomlength)
+			actual, err := decompressSbom(DefaultNativeImageCatalogerConfig(), compressedsbom, 0, sbomlength)
 			assert.NoError(t, err)
 			assert.Equal(t, test.expected, actual)
 		})
 	}
 }
+
+func TestParseNativeImageSbom_multiMemberGzip(t *testing.T) {
+	expected, err := os.ReadFile("test-fixtures/graalvm-sbom/micronaut.json")
+	require.NoError(t, err)
+	expectedPkgs, err := parseNativeImageSbom(DefaultNativeImageCatalogerConfig(), expected)
+	require.NoError(t, err)
+
+	// the fixture is micronaut.json split across two concatenated gzip members
+	compressed, err := os.ReadFile("test-fixtures/graalvm-sbom/micronaut-multi-member.json.gz")
+	require.NoError(t, err)
+	sbomLength := uint64(len(compressed))
+	var b bytes.Buffer
+	b.Write(compressed)
+	require.NoError(t, binary.Write(&b, binary.LittleEndian, sbomLength))
+
+	actual, err := decompressSbom(DefaultNativeImageCatalogerConfig(), b.Bytes(), 0, sbomLength)
+	require.NoError(t, err)
+	assert.Equal(t, expectedPkgs, actual)
+}
+
+func TestDecompressSbom_spdx(t *testing.T) {
+	sbom, err := os.ReadFile("test-fixtures/graalvm-sbom/micronaut-spdx.json")
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	z := gzip.NewWriter(&b)
+	_, err = z.Write(sbom)
+	require.NoError(t, err)
+	require.NoError(t, z.Close())
+	sbomLength := uint64(b.Len())
+	require.NoError(t, binary.Write(&b, binary.LittleEndian, sbomLength))
+
+	actual, err := decompressSbom(DefaultNativeImageCatalogerConfig(), b.Bytes(), 0, sbomLength)
+	require.NoError(t, err)
+
+	var got []string
+	for _, p := range actual {
+		got = append(got, p.Metadata.(pkg.JavaArchive).PomProperties.GroupID+":"+p.Name+"@"+p.Version)
+	}
+	assert.Equal(t, []string{
+		"io.netty:netty-codec-http2@4.1.73.Final",
+		"io.micronaut:micronaut-http@3.2.7",
+	}, got)
+}
+
+func TestDecompressSbom_maxSize(t *testing.T) {
+	sbom, err := os.ReadFile("test-fixtures/graalvm-sbom/micronaut.json")
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	z := gzip.NewWriter(&b)
+	_, err = z.Write(sbom)
+	require.NoError(t, err)
+	require.NoError(t, z.Close())
+	sbomLength := uint64(b.Len())
+	require.NoError(t, binary.Write(&b, binary.LittleEndian, sbomLength))
+
+	_, err = decompressSbom(DefaultNativeImageCatalogerConfig().WithMaxSBOMSize(int64(len(sbom))), b.Bytes(), 0, sbomLength)
+	assert.NoError(t, err)
+
+	_, err = decompressSbom(DefaultNativeImageCatalogerConfig().WithMaxSBOMSize(int64(len(sbom))-1), b.Bytes(), 0, sbomLength)
+	assert.ErrorContains(t, err, "exceeds the maximum size")
+}
+
+func TestParseNativeImageSbom_strictFormatCheck(t *testing.T) {
+	tests := []struct {
+		name          string
+		sbom          string
+		expectedNames []string
+		wantErr       bool
+	}{
+		{
+			name:          "supported CycloneDX spec version",
+			sbom:          `{"bomFormat": "CycloneDX", "specVersion": "1.4", "components": [{"name": "netty", "version": "4.1.73.Final"}, {"name": "unversioned"}]}`,
+			expectedNames: []string{"netty"},
+		},
+		{
+			name:    "missing CycloneDX spec version",
+			sbom:    `{"bomFormat": "CycloneDX", "components": [{"name": "netty", "version": "4.1.73.Final"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "unsupported SPDX version",
+			sbom:    `{"spdxVersion": "SPDX-3.0", "packages": [{"name": "netty", "versionInfo": "4.1.73.Final"}]}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// without strict checks the whole document is cataloged
+			lenient, err := parseNativeImageSbom(DefaultNativeImageCatalogerConfig(), []byte(tt.sbom))
+			require.NoError(t, err)
+			assert.NotEmpty(t, lenient)
+
+			actual, err := parseNativeImageSbom(DefaultNativeImageCatalogerConfig().WithStrictFormatCheck(true), []byte(tt.sbom))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var names []string
+			for _, p := range actual {
+				names = append(names, p.Name)
+			}
+			assert.Equal(t, tt.expectedNames, names)
+		})
+	}
+}
+
+func TestNativeImageCatalogerConfig_isAllowedPath(t *testing.T) {
+	assert.True(t, DefaultNativeImageCatalogerConfig().isAllowedPath("/usr/local/bin/app"))
+
+	cfg := DefaultNativeImageCatalogerConfig().WithAllowedPaths("/app/**", "**/bin/server")
+	assert.True(t, cfg.isAllowedPath("/app/build/native/app"))
+	assert.True(t, cfg.isAllowedPath("/usr/local/bin/server"))
+	assert.False(t, cfg.isAllowedPath("/usr/local/bin/app"))
+}
+
+func Test_parseNativeImageCPE(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "formatted string binding",
+			value:    "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+			expected: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+		},
+		{
+			name:     "uri binding",
+			value:    "cpe:/a:apache:log4j:2.14.1",
+			expected: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+		},
+		{
+			name:     "uri binding with packed edition",
+			value:    "cpe:/a:apache:log4j:2.14.1::~~~java~~",
+			expected: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:java:*:*",
+		},
+		{
+			name:     "uri binding is case-insensitive",
+			value:    "CPE:/A:Apache:Log4j:2.14.1",
+			expected: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+		},
+		{
+			name:     "surrounding whitespace",
+			value:    " cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*\n",
+			expected: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+		},
+		{
+			name:     "escaped colon in formatted string",
+			value:    `cpe:2.3:a:vendor:product:1.0\:beta:*:*:*:*:*:*:*`,
+			expected: `cpe:2.3:a:vendor:product:1.0\:beta:*:*:*:*:*:*:*`,
+		},
+		{
+			name:    "truncated formatted string",
+			value:   "cpe:2.3:a:apache",
+			wantErr: require.Error,
+		},
+		{
+			name:    "not a cpe",
+			value:   "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1",
+			wantErr: require.Error,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.wantErr == nil {
+				test.wantErr = require.NoError
+			}
+			actual, err := parseNativeImageCPE(test.value)
+			test.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, test.expected, actual.Attributes.String())
+			assert.Equal(t, cpe.DeclaredSource, actual.Source)
+		})
+	}
+}
+
+func Test_getPackage_mixedCPEBindings(t *testing.T) {
+	p := getPackage(nativeImageComponent{
+		Group:   "org.apache.logging.log4j",
+		Name:    "log4j-core",
+		Version: "2.14.1",
+		Properties: []nativeImageCPE{
+			{Name: "syft:cpe23", Value: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*"},
+			{Name: "syft:cpe23", Value: "cpe:/a:apache:log4j:2.14.1"},
+			{Name: "syft:cpe23", Value: "cpe:/a:apache:log4j-core:2.14.1"},
+			{Name: "syft:cpe23", Value: "not-a-cpe"},
+		},
+	})
+
+	var actual []string
+	for _, c := range p.CPEs {
+		actual = append(actual, c.Attributes.String())
+	}
+	assert.Equal(t, []string{
+		"cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+		"cpe:2.3:a:apache:log4j-core:2.14.1:*:*:*:*:*:*:*",
+	}, actual)
+}
+
+func TestParseNativeImageSbom_mergesFormats(t *testing.T) {
+	cyclonedx, err := os.ReadFile("test-fixtures/graalvm-sbom/micronaut.json")
+	require.NoError(t, err)
+	spdx, err := os.ReadFile("test-fixtures/graalvm-sbom/micronaut-spdx.json")
+	require.NoError(t, err)
+
+	actual, err := parseNativeImageSbom(DefaultNativeImageCatalogerConfig(), append(cyclonedx, spdx...))
+	require.NoError(t, err)
+	require.Len(t, actual, 2)
+
+	netty := actual[0]
+	assert.Equal(t, "netty-codec-http2", netty.Name)
+	assert.Equal(t, "4.1.73.Final", netty.Version)
+	assert.Equal(t, "io.netty", netty.Metadata.(pkg.JavaArchive).PomProperties.GroupID)
+	var products []string
+	for _, c := range netty.CPEs {
+		products = append(products, c.Attributes.Vendor+":"+c.Attributes.Product)
+	}
+	assert.Equal(t, []string{"codec:codec", "codec:netty-codec-http2", "codec:netty_codec_http2", "netty:netty-codec-http2"}, products)
+
+	micronaut := actual[1]
+	assert.Equal(t, "micronaut-http", micronaut.Name)
+	assert.Equal(t, "3.2.7", micronaut.Version)
+	assert.Equal(t, "io.micronaut", micronaut.Metadata.(pkg.JavaArchive).PomProperties.GroupID)
+	assert.Empty(t, micronaut.CPEs)
+}
+
+func TestParseNativeImageSbom_unknownFormat(t *testing.T) {
+	_, err := parseNativeImageSbom(DefaultNativeImageCatalogerConfig(), []byte(`{"name": "not an sbom"}`))
+	assert.Error(t, err)
+}
+
+func TestNativeImagePELinkTimestamp(t *testing.T) {
+	tests := []struct {
+		name          string
+		timeDateStamp uint32
+		expected      *pkg.JavaNativeImage
+	}{
+		{
+			name:          "link timestamp",
+			timeDateStamp: 1700000000,
+			expected:      &pkg.JavaNativeImage{LinkTimestamp: "2023-11-14T22:13:20Z"},
+		},
+		{
+			name:          "zeroed by reproducible build",
+			timeDateStamp: 0,
+			expected:      &pkg.JavaNativeImage{LinkTimestampZeroed: true},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// the export directory table starts with the characteristics and time/date stamp fields
+			exports := make([]byte, 40)
+			binary.LittleEndian.PutUint32(exports[4:8], test.timeDateStamp)
+
+			header := newExportPrefixPE(exports)
+			assert.Equal(t, test.timeDateStamp, header.timeDateStamp)
+
+			pkgs := withNativeImageMetadata([]pkg.Package{{Name: "netty-codec-http2", Metadata: pkg.JavaArchive{}}}, header.nativeImageMetadata())
+			assert.Equal(t, test.expected, pkgs[0].Metadata.(pkg.JavaArchive).NativeImage)
+		})
+	}
+}
+
+func TestParseNativeImagePE_ordinalBase(t *testing.T) {
+	// the exports of the fixture have an ordinal base of 5, and the (sorted) names do not follow the order of the
+	// functions they export (see generate.py)
+	fixture := "test-fixtures/graalvm-native-image-pe/ordinal-base.exe"
+	f, err := os.Open(fixture)
+	require.NoError(t, err)
+	defer f.Close()
+
+	ni, err := newPE(fixture, f)
+	require.NoError(t, err)
+	require.NotNil(t, ni)
+	assert.Equal(t, uint32(5), ni.(nativeImagePE).header.base)
+
+	pkgs, err := ni.fetchPkgs(DefaultNativeImageCatalogerConfig())
+	require.NoError(t, err)
+
+	var names []string
+	for _, p := range pkgs {
+		names = append(names, p.Name+"@"+p.Version)
+	}
+	assert.Equal(t, []string{"netty-codec-http2@4.1.73.Final", "graalvm@22.3.0"}, names)
+}
+
+func Test_fetchExportOrdinal(t *testing.T) {
+	const exportsAddress = 0x1000
+	// an export directory table (40 bytes) followed by an export ordinal table for three names
+	exports := make([]byte, 46)
+	binary.LittleEndian.PutUint32(exports[16:20], 5) // ordinal base
+	for i, index := range []uint16{2, 0, 7} {
+		binary.LittleEndian.PutUint16(exports[40+2*i:], index)
+	}
+	ni := nativeImagePE{
+		exportSymbols: pe.DataDirectory{VirtualAddress: exportsAddress, Size: uint32(len(exports))},
+		exports:       exports,
+		header:        newExportPrefixPE(exports),
+	}
+	content := &exportContentPE{
+		numberOfFunctions:     3,
+		numberOfNames:         3,
+		addressOfNameOrdinals: exportsAddress + 40,
+	}
+
+	tests := []struct {
+		name    string
+		i       uint32
+		want    uint32
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "name index differs from the function index",
+			i:    0,
+			want: 2,
+		},
+		{
+			name: "indexes are not biased by the ordinal base",
+			i:    1,
+			want: 0,
+		},
+		{
+			name:    "index outside of the export address table",
+			i:       2,
+			wantErr: require.Error,
+		},
+		{
+			name:    "name outside of the export ordinal table",
+			i:       3,
+			wantErr: require.Error,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.wantErr == nil {
+				test.wantErr = require.NoError
+			}
+			index, err := ni.fetchExportOrdinal(content, test.i)
+			test.wantErr(t, err)
+			assert.Equal(t, test.want, index)
+		})
+	}
+}
+
+func TestWithExecutableDigests(t *testing.T) {
+	contents := []byte("\x7fELF native image contents")
+	reader := bytes.NewReader(contents)
+	// the reader position is left wherever the format-specific parsing stopped
+	_, err := reader.Seek(10, io.SeekStart)
+	require.NoError(t, err)
+
+	shared := &pkg.JavaNativeImage{LinkTimestamp: "2023-11-14T22:13:20Z"}
+	pkgs := withExecutableDigests([]pkg.Package{
+		{Name: "netty-codec-http2", Metadata: pkg.JavaArchive{NativeImage: shared}},
+		{Name: "netty-buffer", Metadata: pkg.JavaArchive{}},
+		{Name: "openssl", Metadata: pkg.BinarySignature{}},
+	}, reader, file.NewLocation("/app/bin/server"))
+
+	expected := []file.Digest{{Algorithm: "sha256", Value: fmt.Sprintf("%x", sha256.Sum256(contents))}}
+	assert.Equal(t, &pkg.JavaNativeImage{LinkTimestamp: "2023-11-14T22:13:20Z", Digests: expected}, pkgs[0].Metadata.(pkg.JavaArchive).NativeImage)
+	assert.Equal(t, &pkg.JavaNativeImage{Digests: expected}, pkgs[1].Metadata.(pkg.JavaArchive).NativeImage)
+	assert.Equal(t, pkg.BinarySignature{}, pkgs[2].Metadata)
+	// the executable details shared between packages are not modified
+	assert.Empty(t, shared.Digests)
+}
+
+func Test_graalVMVersion(t *testing.T) {
+	tests := []struct {
+		versionInfo string
+		expected    string
+	}{
+		{versionInfo: "GraalVM 22.3.0 Java 17 CE", expected: "22.3.0"},
+		{versionInfo: "GraalVM CE 22.3.0", expected: "22.3.0"},
+		{versionInfo: "GraalVM EE 21.3.8", expected: "21.3.8"},
+		{versionInfo: "Oracle GraalVM 21.0.1+12.1", expected: "21.0.1+12.1"},
+		{versionInfo: "Substrate VM", expected: ""},
+		{versionInfo: "", expected: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.versionInfo, func(t *testing.T) {
+			assert.Equal(t, test.expected, graalVMVersion(test.versionInfo))
+		})
+	}
+}
+
+func Test_readCString(t *testing.T) {
+	data := []byte("\x00\x01GraalVM 22.3.0 Java 17 CE\x00\x02trailing data")
+	assert.Equal(t, "GraalVM 22.3.0 Java 17 CE", readCString(bytes.NewReader(data), 2))
+	// the string may run until the end of the section
+	assert.Equal(t, "trailing data", readCString(bytes.NewReader(data), int64(bytes.IndexByte(data, 2)+1)))
+	assert.Empty(t, readCString(bytes.NewReader(data), int64(len(data)+1)))
+}
+
+func TestWithGraalVMPackage(t *testing.T) {
+	t.Run("version info", func(t *testing.T) {
+		shared := &pkg.JavaNativeImage{LinkTimestamp: "2023-11-14T22:13:20Z"}
+		sbomPkgs := []pkg.Package{{Name: "netty-codec-http2", Metadata: pkg.JavaArchive{NativeImage: shared}}}
+		pkgs := withGraalVMPackage(sbomPkgs, "GraalVM 22.3.0 Java 17 CE", shared)
+		require.Len(t, pkgs, 2)
+		// the toolchain is recorded on each package found within the executable
+		assert.Equal(t, &pkg.JavaNativeImage{
+			LinkTimestamp:  "2023-11-14T22:13:20Z",
+			BuildToolchain: "graalvm 22.3.0",
+		}, pkgs[0].Metadata.(pkg.JavaArchive).NativeImage)
+
+		graalVM := pkgs[1]
+		assert.Equal(t, "graalvm", graalVM.Name)
+		assert.Equal(t, "22.3.0", graalVM.Version)
+		assert.Equal(t, pkg.GraalVMNativeImagePkg, graalVM.Type)
+		assert.Equal(t, nativeImageCatalogerName, graalVM.FoundBy)
+		require.Len(t, graalVM.CPEs, 1)
+		assert.Equal(t, "cpe:2.3:a:oracle:graalvm:22.3.0:*:*:*:*:*:*:*", graalVM.CPEs[0].Attributes.String())
+		assert.Equal(t, &pkg.JavaNativeImage{
+			LinkTimestamp:  "2023-11-14T22:13:20Z",
+			SvmVersionInfo: "GraalVM 22.3.0 Java 17 CE",
+			BuildToolchain: "graalvm 22.3.0",
+		}, graalVM.Metadata.(pkg.JavaArchive).NativeImage)
+		// the executable details shared between packages are not modified
+		assert.Empty(t, shared.SvmVersionInfo)
+		assert.Empty(t, shared.BuildToolchain)
+	})
+
+	t.Run("no version info", func(t *testing.T) {
+		sbomPkgs := []pkg.Package{{Name: "netty-codec-http2", Metadata: pkg.JavaArchive{}}}
+		assert.Equal(t, sbomPkgs, withGraalVMPackage(sbomPkgs, "", nil))
+		assert.Equal(t, sbomPkgs, withGraalVMPackage(sbomPkgs, "Substrate VM", nil))
+	})
+}
@@ -0,0 +1,137 @@
+package java
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anchore/packageurl-go"
+	intFile "github.com/anchore/syft/internal/file"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+const androidManifestPath = "AndroidManifest.xml"
+
+var androidApkGlobs = []string{
+	"**/*.apk",
+}
+
+type genericAndroidApkParser struct {
+	cfg ArchiveCatalogerConfig
+}
+
+func newGenericAndroidApkParser(cfg ArchiveCatalogerConfig) genericAndroidApkParser {
+	return genericAndroidApkParser{
+		cfg: cfg,
+	}
+}
+
+// parseAndroidApk is a parser function for Android application packages, returning the application itself (as
+// declared by the binary AndroidManifest.xml) and any java archives bundled within the APK.
+func (gap genericAndroidApkParser) parseAndroidApk(ctx context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contentPath, archivePath, cleanupFn, err := saveArchiveToTmp(reader.Path(), reader)
+	// note: even on error, we should always run cleanup functions
+	defer cleanupFn()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fileManifest, err := newZipFileManifest(reader.Path(), archivePath, gap.cfg)
+	if err != nil {
+		// alpine packages share the same file extension, but are tar files
+		log.WithFields("path", reader.Path(), "error", err).Trace("unable to read .apk file as a zip archive")
+		return nil, nil, nil
+	}
+
+	contents, err := intFile.ContentsFromZip(archivePath, androidManifestPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to extract android manifest: %w", err)
+	}
+
+	rawManifest, ok := contents[androidManifestPath]
+	if !ok {
+		return nil, nil, nil
+	}
+
+	metadata, err := parseAndroidManifest([]byte(rawManifest))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse android manifest: %w", err)
+	}
+	if metadata.Package == "" {
+		return nil, nil, nil
+	}
+
+	metadata.NativeLibraries = fileManifest.GlobMatch(false, "/lib/*/*.so")
+
+	appPkg := newAndroidAppPackage(metadata, reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation))
+
+	// look for java archives bundled within the apk
+	nestedPkgs, relationships, err := discoverPkgsFromZip(ctx, reader.Location, archivePath, contentPath, fileManifest, &appPkg, gap.cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return append([]pkg.Package{appPkg}, nestedPkgs...), relationships, nil
+}
+
+// parseAndroidManifest extracts the application identity from the <manifest> and <uses-sdk> elements of a binary
+// AndroidManifest.xml.
+func parseAndroidManifest(data []byte) (pkg.AndroidApkManifest, error) {
+	elements, err := parseAndroidBinaryXML(data)
+	if err != nil {
+		return pkg.AndroidApkManifest{}, err
+	}
+
+	var m pkg.AndroidApkManifest
+	var foundManifest bool
+	for _, el := range elements {
+		switch el.name {
+		case "manifest":
+			if foundManifest {
+				continue
+			}
+			foundManifest = true
+			m.Package = el.attrs["package"]
+			m.VersionCode = el.attrs["versionCode"]
+			m.VersionName = el.attrs["versionName"]
+		case "uses-sdk":
+			m.MinSDKVersion = el.attrs["minSdkVersion"]
+			m.TargetSDKVersion = el.attrs["targetSdkVersion"]
+		}
+	}
+	return m, nil
+}
+
+func newAndroidAppPackage(m pkg.AndroidApkManifest, locations ...file.Location) pkg.Package {
+	version := m.VersionName
+	if version == "" {
+		version = m.VersionCode
+	}
+
+	p := pkg.Package{
+		Name:      m.Package,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      androidAppPackageURL(m.Package, version),
+		Type:      pkg.AndroidAppPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func androidAppPackageURL(name, version string) string {
+	return packageurl.NewPackageURL(
+		pkg.AndroidAppPkg.PackageURLType(),
+		"",
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
@@ -0,0 +1,75 @@
+package java
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func Test_parseAndroidApk(t *testing.T) {
+	fixture := "test-fixtures/android/example-app.apk"
+	fh, err := os.Open(fixture)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = fh.Close() })
+
+	gap := newGenericAndroidApkParser(ArchiveCatalogerConfig{})
+	actual, _, err := gap.parseAndroidApk(context.Background(), nil, nil, file.LocationReadCloser{
+		Location:   file.NewLocation(fixture),
+		ReadCloser: fh,
+	})
+	require.NoError(t, err)
+	require.Len(t, actual, 2)
+
+	app := actual[0]
+	assert.Equal(t, "com.example.app", app.Name)
+	assert.Equal(t, "1.2.3", app.Version)
+	assert.Equal(t, pkg.AndroidAppPkg, app.Type)
+	assert.Equal(t, "pkg:generic/com.example.app@1.2.3", app.PURL)
+	assert.Equal(t, pkg.AndroidApkManifest{
+		Package:          "com.example.app",
+		VersionCode:      "42",
+		VersionName:      "1.2.3",
+		MinSDKVersion:    "21",
+		TargetSDKVersion: "34",
+		NativeLibraries: []string{
+			"lib/arm64-v8a/libexample.so",
+			"lib/x86_64/libexample.so",
+		},
+	}, app.Metadata)
+
+	lib := actual[1]
+	assert.Equal(t, "example-lib", lib.Name)
+	assert.Equal(t, "1.2.3", lib.Version)
+	assert.Equal(t, pkg.JavaPkg, lib.Type)
+	assert.Equal(t, "pkg:maven/org.example/example-lib@1.2.3", lib.PURL)
+
+	metadata, ok := lib.Metadata.(pkg.JavaArchive)
+	require.True(t, ok)
+	require.NotNil(t, metadata.Parent)
+	assert.Equal(t, app.Name, metadata.Parent.Name)
+	assert.True(t, strings.HasSuffix(lib.Locations.ToSlice()[0].AccessPath, "example-app.apk:libs/example-lib-1.2.3.jar"))
+}
+
+func Test_parseAndroidApk_notZip(t *testing.T) {
+	// alpine packages share the .apk extension but are gzipped tar files
+	gap := newGenericAndroidApkParser(ArchiveCatalogerConfig{})
+	actual, _, err := gap.parseAndroidApk(context.Background(), nil, nil, file.LocationReadCloser{
+		Location:   file.NewLocation("musl-1.2.5-r0.apk"),
+		ReadCloser: io.NopCloser(strings.NewReader("\x1f\x8b\x08\x00 not a zip archive")),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, actual)
+}
+
+func Test_parseAndroidManifest_notBinaryXML(t *testing.T) {
+	_, err := parseAndroidManifest([]byte(`<manifest package="com.example.app"/>`))
+	assert.ErrorIs(t, err, errNotAndroidBinaryXML)
+}
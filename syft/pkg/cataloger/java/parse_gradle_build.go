@@ -0,0 +1,152 @@
+package java
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+const (
+	gradleBuildGlob       = "**/build.gradle"
+	gradleKotlinBuildGlob = "**/build.gradle.kts"
+	gradleLockfileName    = "gradle.lockfile"
+)
+
+// gradleConfigurations are the dependency configurations of the java, java-library, and kotlin plugins (and those
+// of older gradle versions) that declare external module dependencies.
+const gradleConfigurations = `(?:implementation|api|compileOnly|compileOnlyApi|runtimeOnly|testImplementation|testCompileOnly|testRuntimeOnly|annotationProcessor|testAnnotationProcessor|kapt|compile|runtime|testCompile|testRuntime|classpath)`
+
+var (
+	// matches string notation in both DSLs, for example:
+	//   implementation 'org.slf4j:slf4j-api:2.0.9'                (groovy)
+	//   implementation("org.slf4j:slf4j-api:2.0.9")               (kotlin)
+	//   implementation(platform("org.springframework:spring-bom:6.1.2"))
+	gradleStringNotationPattern = regexp.MustCompile(`\b` + gradleConfigurations + `\s*\(?\s*(?:(?:platform|enforcedPlatform)\s*\(\s*)?["']([^"'\s:]+):([^"'\s:]+):([^"'\s:@]+)[^"']*["']`)
+
+	// matches map notation in both DSLs, for example:
+	//   implementation group: 'org.slf4j', name: 'slf4j-api', version: '2.0.9'        (groovy)
+	//   implementation(group = "org.slf4j", name = "slf4j-api", version = "2.0.9")    (kotlin)
+	gradleMapNotationPattern = regexp.MustCompile(`\b` + gradleConfigurations + `\s*\(?\s*group\s*[:=]\s*["']([^"']+)["']\s*,\s*name\s*[:=]\s*["']([^"']+)["']\s*,\s*version\s*[:=]\s*["']([^"']+)["']`)
+
+	gradleBlockCommentPattern = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	gradleLineCommentPattern  = regexp.MustCompile(`(?m)(^|\s)//.*$`)
+)
+
+// parseGradleBuild is a tolerant parser for the external module dependencies declared in a build.gradle or
+// build.gradle.kts file. Only dependencies with a literal version are reported: versions from variables, version
+// catalogs, or platforms cannot be resolved without evaluating the build script.
+func parseGradleBuild(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	if hasGradleLockfile(resolver, reader.Location) {
+		// the lockfile holds the resolved versions of the declared dependencies (and is cataloged separately)
+		log.WithFields("path", reader.RealPath).Trace("skipping gradle build file with a gradle.lockfile")
+		return nil, nil, nil
+	}
+
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read gradle build file: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	seen := make(map[lockfileDependency]struct{})
+	for _, dep := range gradleBuildDependencies(string(contents)) {
+		if _, ok := seen[dep]; ok {
+			continue
+		}
+		seen[dep] = struct{}{}
+
+		archive := pkg.JavaArchive{
+			PomProject: &pkg.JavaPomProject{
+				GroupID:    dep.Group,
+				ArtifactID: dep.Name,
+				Version:    dep.Version,
+				Name:       dep.Name,
+			},
+		}
+
+		p := pkg.Package{
+			Name:    dep.Name,
+			Version: dep.Version,
+			Locations: file.NewLocationSet(
+				reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			),
+			Language: pkg.Java,
+			Type:     pkg.JavaPkg,
+			PURL:     packageURL(dep.Name, dep.Version, archive),
+			Metadata: archive,
+		}
+		p.SetID()
+		pkgs = append(pkgs, p)
+	}
+
+	return pkgs, nil, nil
+}
+
+// gradleBuildDependencies returns the dependencies declared in the given build script, in order of appearance.
+func gradleBuildDependencies(contents string) []lockfileDependency {
+	contents = gradleBlockCommentPattern.ReplaceAllString(contents, "")
+	contents = gradleLineCommentPattern.ReplaceAllString(contents, "$1")
+
+	type match struct {
+		index int
+		dep   lockfileDependency
+	}
+	var matches []match
+	for _, pattern := range []*regexp.Regexp{gradleStringNotationPattern, gradleMapNotationPattern} {
+		for _, m := range pattern.FindAllStringSubmatchIndex(contents, -1) {
+			version := normalizeGradleVersion(contents[m[6]:m[7]])
+			if version == "" {
+				continue
+			}
+			matches = append(matches, match{
+				index: m[0],
+				dep: lockfileDependency{
+					Group:   contents[m[2]:m[3]],
+					Name:    contents[m[4]:m[5]],
+					Version: version,
+				},
+			})
+		}
+	}
+
+	// keep the order of the declarations regardless of notation
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].index < matches[j].index
+	})
+
+	deps := make([]lockfileDependency, 0, len(matches))
+	for _, m := range matches {
+		deps = append(deps, m.dep)
+	}
+	return deps
+}
+
+// normalizeGradleVersion returns the declared version (dynamic versions and ranges are kept as-is), or an empty
+// string if the version cannot be determined without evaluating the build script.
+func normalizeGradleVersion(version string) string {
+	version = strings.TrimSpace(version)
+	if strings.Contains(version, "$") {
+		// string interpolation (e.g. "$slf4jVersion" or "${libs.versions.slf4j}")
+		return ""
+	}
+	// "1.0!!" is shorthand for a strict version constraint
+	return strings.TrimSuffix(version, "!!")
+}
+
+func hasGradleLockfile(resolver file.Resolver, location file.Location) bool {
+	if resolver == nil {
+		return false
+	}
+	locations, err := resolver.FilesByPath(path.Join(path.Dir(location.RealPath), gradleLockfileName))
+	return err == nil && len(locations) > 0
+}
@@ -0,0 +1,91 @@
+package java
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func gradleBuildPackage(group, name, version string) pkg.Package {
+	archive := pkg.JavaArchive{
+		PomProject: &pkg.JavaPomProject{GroupID: group, ArtifactID: name, Version: version, Name: name},
+	}
+	return pkg.Package{
+		Name:     name,
+		Version:  version,
+		Language: pkg.Java,
+		Type:     pkg.JavaPkg,
+		PURL:     packageURL(name, version, archive),
+		Metadata: archive,
+	}
+}
+
+func Test_parseGradleBuild(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []pkg.Package
+	}{
+		{
+			input: "test-fixtures/gradle-build/groovy/build.gradle",
+			expected: []pkg.Package{
+				gradleBuildPackage("org.apache.commons", "commons-lang3", "3.14.0"),
+				gradleBuildPackage("com.google.guava", "guava", "33.0.0-jre"),
+				gradleBuildPackage("org.slf4j", "slf4j-api", "2.0.9"),
+				gradleBuildPackage("io.netty", "netty-codec-http2", "4.1.100.Final"),
+				gradleBuildPackage("org.postgresql", "postgresql", "42.7.+"),
+				gradleBuildPackage("junit", "junit", "4.13.2"),
+			},
+		},
+		{
+			input: "test-fixtures/gradle-build/kotlin/build.gradle.kts",
+			expected: []pkg.Package{
+				gradleBuildPackage("org.springframework", "spring-framework-bom", "6.1.2"),
+				gradleBuildPackage("org.jetbrains.kotlinx", "kotlinx-coroutines-core", "1.7.3"),
+				gradleBuildPackage("org.slf4j", "slf4j-api", "2.0.9"),
+				gradleBuildPackage("org.projectlombok", "lombok", "1.18.30"),
+				gradleBuildPackage("org.junit.jupiter", "junit-jupiter", "[5.10,6.0)"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			for i := range test.expected {
+				test.expected[i].Locations = file.NewLocationSet(file.NewLocation(test.input))
+			}
+			pkgtest.TestFileParser(t, test.input, parseGradleBuild, test.expected, nil)
+		})
+	}
+}
+
+func Test_GradleBuildCataloger_skipsBuildFilesWithLockfile(t *testing.T) {
+	// the build.gradle next to the gradle.lockfile declares the same dependencies as the lockfile
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/gradle").
+		Expects(nil, nil).
+		TestCataloger(t, NewGradleBuildCataloger())
+}
+
+func Test_normalizeGradleVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{version: "1.0", want: "1.0"},
+		{version: " 1.0 ", want: "1.0"},
+		{version: "1.0!!", want: "1.0"},
+		{version: "1.+", want: "1.+"},
+		{version: "[1.0,2.0)", want: "[1.0,2.0)"},
+		{version: "$slf4jVersion", want: ""},
+		{version: "${libs.versions.slf4j.get()}", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeGradleVersion(tt.version))
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package java
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// gradleCacheDir is the directory of the gradle user home holding the files of resolved modules, laid out as
+// <group>/<module>/<version>/<sha1 of the file>/<file>.
+const gradleCacheDir = "caches/modules-2/files-2.1"
+
+// gradleCacheDescriptors are the globs of the module descriptors within the gradle cache, in order of precedence.
+// Every module version has at least one descriptor (unless resolved from an artifact-only repository), but may have
+// several (e.g. both a pom and gradle module metadata), so only the descriptor with the highest precedence is used.
+var gradleCacheDescriptors = []string{"*.pom", "*.module", "ivy-*.xml"}
+
+func gradleCacheGlobs() []string {
+	var globs []string
+	for _, descriptor := range gradleCacheDescriptors {
+		globs = append(globs, path.Join("**", gradleCacheDir, "*", "*", "*", "*", descriptor))
+	}
+	return globs
+}
+
+// parseGradleCache reports the module version a descriptor within the gradle cache was resolved for. The
+// coordinates are taken from the directory structure of the cache rather than the descriptor itself, as this is
+// what gradle resolved the module as (regardless of e.g. relocations within the descriptor).
+func parseGradleCache(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	dep, ok := gradleCacheDependency(reader.RealPath)
+	if !ok {
+		log.WithFields("path", reader.RealPath).Trace("unable to determine module coordinates from gradle cache path")
+		return nil, nil, nil
+	}
+
+	if hasPrecedingGradleCacheDescriptor(resolver, reader.Location) {
+		// the same module version is reported from the preferred descriptor
+		return nil, nil, nil
+	}
+
+	archive := pkg.JavaArchive{
+		PomProject: &pkg.JavaPomProject{
+			GroupID:    dep.Group,
+			ArtifactID: dep.Name,
+			Version:    dep.Version,
+			Name:       dep.Name,
+		},
+	}
+
+	p := pkg.Package{
+		Name:    dep.Name,
+		Version: dep.Version,
+		Locations: file.NewLocationSet(
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		),
+		Language: pkg.Java,
+		Type:     pkg.JavaPkg,
+		PURL:     packageURL(dep.Name, dep.Version, archive),
+		Metadata: archive,
+	}
+	p.SetID()
+
+	return []pkg.Package{p}, nil, nil
+}
+
+// gradleCacheDependency returns the module coordinates of a file within the gradle cache from its path.
+func gradleCacheDependency(realPath string) (lockfileDependency, bool) {
+	idx := strings.LastIndex(realPath, "/"+gradleCacheDir+"/")
+	if idx < 0 {
+		return lockfileDependency{}, false
+	}
+	fields := strings.Split(realPath[idx+len(gradleCacheDir)+2:], "/")
+	if len(fields) != 5 {
+		return lockfileDependency{}, false
+	}
+	for _, f := range fields[:3] {
+		if f == "" {
+			return lockfileDependency{}, false
+		}
+	}
+	return lockfileDependency{Group: fields[0], Name: fields[1], Version: fields[2]}, true
+}
+
+// hasPrecedingGradleCacheDescriptor indicates if the module version of the given descriptor has a descriptor with
+// a higher precedence. Each file of a module version is stored in a directory named by its checksum, so the
+// descriptors are searched for across all directories of the module version (by a glob, as paths may be relative to
+// the root of the source).
+func hasPrecedingGradleCacheDescriptor(resolver file.Resolver, location file.Location) bool {
+	if resolver == nil {
+		return false
+	}
+	versionDir := path.Dir(path.Dir(location.RealPath))
+	name := path.Base(location.RealPath)
+	for _, descriptor := range gradleCacheDescriptors {
+		if matched, _ := path.Match(descriptor, name); matched {
+			return false
+		}
+		locations, err := resolver.FilesByGlob(path.Join("**", versionDir, "*", descriptor))
+		if err == nil && len(locations) > 0 {
+			return true
+		}
+	}
+	return false
+}
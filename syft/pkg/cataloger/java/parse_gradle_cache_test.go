@@ -0,0 +1,62 @@
+package java
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_GradleCacheCataloger(t *testing.T) {
+	cache := ".gradle/caches/modules-2/files-2.1"
+
+	slf4j := gradleBuildPackage("org.slf4j", "slf4j-api", "2.0.9")
+	slf4j.Locations = file.NewLocationSet(file.NewLocation(cache + "/org.slf4j/slf4j-api/2.0.9/d8ac2a1d2bbd1a82e0c0e16e4aa3c2e3aa5ac2b0/slf4j-api-2.0.9.pom"))
+
+	// the gradle module metadata of guava is skipped in favor of the pom
+	guava := gradleBuildPackage("com.google.guava", "guava", "33.0.0-jre")
+	guava.Locations = file.NewLocationSet(file.NewLocation(cache + "/com.google.guava/guava/33.0.0-jre/3bc4e4b3b0d9b6e8c09a553bf0c8ab4b0b2b6e4c/guava-33.0.0-jre.pom"))
+
+	legacy := gradleBuildPackage("org.example", "legacy-util", "1.0")
+	legacy.Locations = file.NewLocationSet(file.NewLocation(cache + "/org.example/legacy-util/1.0/5d0f3e5b7a3c7e2f7a2b1c0e9d8f7a6b5c4d3e2f/ivy-1.0.xml"))
+
+	expected := []pkg.Package{guava, legacy, slf4j}
+	for i := range expected {
+		expected[i].FoundBy = "java-gradle-cache-cataloger"
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/gradle-cache").
+		Expects(expected, nil).
+		TestCataloger(t, NewGradleCacheCataloger())
+}
+
+func Test_gradleCacheDependency(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected lockfileDependency
+		ok       bool
+	}{
+		{
+			path:     "/root/.gradle/caches/modules-2/files-2.1/org.slf4j/slf4j-api/2.0.9/d8ac2a1d/slf4j-api-2.0.9.pom",
+			expected: lockfileDependency{Group: "org.slf4j", Name: "slf4j-api", Version: "2.0.9"},
+			ok:       true,
+		},
+		{
+			path: "/root/.gradle/caches/modules-2/files-2.1/org.slf4j/slf4j-api/2.0.9/slf4j-api-2.0.9.pom",
+		},
+		{
+			path: "/root/.m2/repository/org/slf4j/slf4j-api/2.0.9/slf4j-api-2.0.9.pom",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			actual, ok := gradleCacheDependency(tt.path)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -11,7 +11,10 @@ import (
 	"github.com/anchore/syft/syft/pkg"
 )
 
-const manifestGlob = "/META-INF/MANIFEST.MF"
+const (
+	manifestGlob             = "/META-INF/MANIFEST.MF"
+	multiReleaseVersionsPath = "META-INF/versions/"
+)
 
 // parseJavaManifest takes MANIFEST.MF file content and returns sections of parsed key/value pairs.
 // For more information: https://docs.oracle.com/en/java/javase/11/docs/specs/jar/jar.html#jar-manifest
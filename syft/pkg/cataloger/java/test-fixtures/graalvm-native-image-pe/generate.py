@@ -0,0 +1,160 @@
+#!/usr/bin/env python3
+"""
+Generates a minimal PE32+ executable resembling a Windows native image, which exports the SBOM symbols (sbom,
+sbom_length, and __svm_version_info) along with a decoy symbol.
+
+The exports use an ordinal base of 5 and the names (which are sorted) do not follow the order of the functions, so the
+location of each symbol can only be found through the export ordinal table.
+
+usage: ./generate.py  (writes ordinal-base.exe next to this script)
+"""
+import gzip
+import os
+import struct
+
+HERE = os.path.dirname(os.path.abspath(__file__))
+SBOM = os.path.join(HERE, "..", "graalvm-sbom", "micronaut.json")
+OUTPUT = os.path.join(HERE, "ordinal-base.exe")
+
+FILE_ALIGNMENT = 0x200
+SECTION_ALIGNMENT = 0x1000
+
+# the export directory is placed at the same file offset as its RVA
+EDATA_RVA = 0x1000
+EDATA_OFFSET = 0x1000
+DATA_RVA = 0x2000
+DATA_OFFSET = 0x1200
+
+ORDINAL_BASE = 5
+TIME_DATE_STAMP = 1700000000
+
+
+def align(n, alignment):
+    return (n + alignment - 1) // alignment * alignment
+
+
+def pad(b, alignment):
+    return b + b"\x00" * (align(len(b), alignment) - len(b))
+
+
+def data_section():
+    with open(SBOM, "rb") as f:
+        sbom = gzip.compress(f.read(), mtime=0)
+
+    data = bytearray(0x40)
+    struct.pack_into("<Q", data, 0x00, 0xDEADBEEFDEADBEEF)  # decoy
+    struct.pack_into("<Q", data, 0x08, len(sbom))  # sbom_length
+    version = b"GraalVM 22.3.0 Java 17 CE\x00"  # __svm_version_info
+    data[0x10 : 0x10 + len(version)] = version
+    data += sbom  # sbom
+
+    addresses = {
+        "decoy": DATA_RVA + 0x00,
+        "sbom_length": DATA_RVA + 0x08,
+        "__svm_version_info": DATA_RVA + 0x10,
+        "sbom": DATA_RVA + 0x40,
+    }
+    return bytes(data), addresses
+
+
+def export_section(addresses):
+    # the export address table, in ordinal order (an ordinal is the index plus the ordinal base)
+    functions = ["decoy", "sbom", "sbom_length", "__svm_version_info"]
+    names = sorted(functions)
+
+    directory_size = 40
+    functions_rva = EDATA_RVA + directory_size
+    names_rva = functions_rva + 4 * len(functions)
+    ordinals_rva = names_rva + 4 * len(names)
+    strings_rva = ordinals_rva + 2 * len(names)
+
+    strings = b""
+    string_rvas = {}
+    for s in ["native-image.exe"] + names:
+        string_rvas[s] = strings_rva + len(strings)
+        strings += s.encode() + b"\x00"
+
+    edata = struct.pack(
+        "<IIHHIIIIIII",
+        0,  # characteristics
+        TIME_DATE_STAMP,
+        0,  # major version
+        0,  # minor version
+        string_rvas["native-image.exe"],
+        ORDINAL_BASE,
+        len(functions),
+        len(names),
+        functions_rva,
+        names_rva,
+        ordinals_rva,
+    )
+    edata += b"".join(struct.pack("<I", addresses[f]) for f in functions)
+    edata += b"".join(struct.pack("<I", string_rvas[n]) for n in names)
+    edata += b"".join(struct.pack("<H", functions.index(n)) for n in names)
+    edata += strings
+    return edata
+
+
+def section_header(name, virtual_size, rva, raw_size, offset, characteristics):
+    return struct.pack(
+        "<8sIIIIIIHHI", name, virtual_size, rva, raw_size, offset, 0, 0, 0, 0, characteristics
+    )
+
+
+def main():
+    data, addresses = data_section()
+    edata = export_section(addresses)
+
+    size_of_headers = FILE_ALIGNMENT
+    size_of_image = align(DATA_RVA + len(data), SECTION_ALIGNMENT)
+
+    dos_header = bytearray(0x40)
+    dos_header[0:2] = b"MZ"
+    struct.pack_into("<I", dos_header, 0x3C, 0x40)
+
+    coff_header = struct.pack("<HHIIIHH", 0x8664, 2, TIME_DATE_STAMP, 0, 0, 240, 0x0022)
+
+    data_directories = [(0, 0)] * 16
+    data_directories[0] = (EDATA_RVA, len(edata))
+    optional_header = struct.pack(
+        "<HBBIIIIIQIIHHHHHHIIIIHHQQQQII",
+        0x20B,  # PE32+
+        14,
+        0,
+        0,  # size of code
+        len(edata) + len(data),
+        0,
+        0,  # entry point
+        0,  # base of code
+        0x140000000,  # image base
+        SECTION_ALIGNMENT,
+        FILE_ALIGNMENT,
+        6, 0, 0, 0, 6, 0,
+        0,
+        size_of_image,
+        size_of_headers,
+        0,  # checksum
+        3,  # console subsystem
+        0x8160,
+        0x100000, 0x1000, 0x100000, 0x1000,
+        0,
+        len(data_directories),
+    )
+    optional_header += b"".join(struct.pack("<II", rva, size) for rva, size in data_directories)
+    assert len(optional_header) == 240
+
+    sections = section_header(b".edata", len(edata), EDATA_RVA, align(len(edata), FILE_ALIGNMENT), EDATA_OFFSET, 0x40000040)
+    sections += section_header(b".data", len(data), DATA_RVA, align(len(data), FILE_ALIGNMENT), DATA_OFFSET, 0xC0000040)
+
+    headers = bytes(dos_header) + b"PE\x00\x00" + coff_header + optional_header + sections
+    out = headers + b"\x00" * (EDATA_OFFSET - len(headers))
+    out += pad(edata, FILE_ALIGNMENT)
+    assert len(out) == DATA_OFFSET
+    out += pad(data, FILE_ALIGNMENT)
+
+    with open(OUTPUT, "wb") as f:
+        f.write(out)
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,16 @@
+plugins {
+    kotlin("jvm") version "1.9.22"
+}
+
+val ktorVersion: String by project
+
+dependencies {
+    implementation(platform("org.springframework:spring-framework-bom:6.1.2"))
+    implementation("org.jetbrains.kotlinx:kotlinx-coroutines-core:1.7.3")
+    implementation("io.ktor:ktor-server-core:$ktorVersion")
+    implementation(group = "org.slf4j", name = "slf4j-api", version = "2.0.9")
+    implementation(libs.guava)
+    compileOnly("org.projectlombok:lombok:1.18.30!!")
+    testImplementation(kotlin("test"))
+    testImplementation("org.junit.jupiter:junit-jupiter:[5.10,6.0)")
+}
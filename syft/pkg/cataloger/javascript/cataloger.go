@@ -23,3 +23,10 @@ func NewLockCataloger(cfg CatalogerConfig) pkg.Cataloger {
 		WithParserByGlobs(yarnLockAdapter.parseYarnLock, "**/yarn.lock").
 		WithParserByGlobs(parsePnpmLock, "**/pnpm-lock.yaml")
 }
+
+// NewSourceMapCataloger returns a new cataloger object for npm packages bundled into javascript bundles, as listed
+// within the sources of the source maps of the bundles.
+func NewSourceMapCataloger() pkg.Cataloger {
+	return generic.NewCataloger("javascript-source-map-cataloger").
+		WithParserByGlobs(parseSourceMap, "**/*.js.map", "**/*.mjs.map", "**/*.cjs.map")
+}
@@ -5,6 +5,10 @@ const npmBaseURL = "https://registry.npmjs.org"
 type CatalogerConfig struct {
 	SearchRemoteLicenses bool   `json:"search-remote-licenses" yaml:"search-remote-licenses" mapstructure:"search-remote-licenses"`
 	NPMBaseURL           string `json:"npm-base-url" yaml:"npm-base-url" mapstructure:"npm-base-url"`
+
+	// ExcludeDevDependencies will skip packages that are only required for development (currently only for
+	// package-lock.json files, since yarn.lock and pnpm-lock.yaml files do not distinguish these).
+	ExcludeDevDependencies bool `json:"exclude-dev-dependencies" yaml:"exclude-dev-dependencies" mapstructure:"exclude-dev-dependencies"`
 }
 
 func DefaultCatalogerConfig() CatalogerConfig {
@@ -25,3 +29,8 @@ func (j CatalogerConfig) WithNpmBaseURL(input string) CatalogerConfig {
 	}
 	return j
 }
+
+func (j CatalogerConfig) WithExcludeDevDependencies(exclude bool) CatalogerConfig {
+	j.ExcludeDevDependencies = exclude
+	return j
+}
@@ -7,9 +7,12 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/scylladb/go-set/strset"
+
 	"github.com/anchore/packageurl-go"
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/file"
@@ -47,7 +50,7 @@ func newPackageJSONPackage(u packageJSON, indexLocation file.Location) pkg.Packa
 	return p
 }
 
-func newPackageLockV1Package(cfg CatalogerConfig, resolver file.Resolver, location file.Location, name string, u lockDependency) pkg.Package {
+func newPackageLockV1Package(cfg CatalogerConfig, resolver file.Resolver, location file.Location, name string, u lockDependency, kind pkg.DependencyKind) pkg.Package {
 	version := u.Version
 
 	const aliasPrefixPackageLockV1 = "npm:"
@@ -87,12 +90,12 @@ func newPackageLockV1Package(cfg CatalogerConfig, resolver file.Resolver, locati
 			PURL:      packageURL(name, version),
 			Language:  pkg.JavaScript,
 			Type:      pkg.NpmPkg,
-			Metadata:  pkg.NpmPackageLockEntry{Resolved: u.Resolved, Integrity: u.Integrity},
+			Metadata:  pkg.NpmPackageLockEntry{Resolved: u.Resolved, Integrity: u.Integrity, DependencyKind: kind, DevDependency: u.Dev, Registry: npmRegistryFromResolved(u.Resolved)},
 		},
 	)
 }
 
-func newPackageLockV2Package(cfg CatalogerConfig, resolver file.Resolver, location file.Location, name string, u lockPackage) pkg.Package {
+func newPackageLockV2Package(cfg CatalogerConfig, resolver file.Resolver, location file.Location, name string, u lockPackage, kind pkg.DependencyKind) pkg.Package {
 	var licenseSet pkg.LicenseSet
 
 	if u.License != nil {
@@ -119,7 +122,7 @@ func newPackageLockV2Package(cfg CatalogerConfig, resolver file.Resolver, locati
 			PURL:      packageURL(name, u.Version),
 			Language:  pkg.JavaScript,
 			Type:      pkg.NpmPkg,
-			Metadata:  pkg.NpmPackageLockEntry{Resolved: u.Resolved, Integrity: u.Integrity},
+			Metadata:  pkg.NpmPackageLockEntry{Resolved: u.Resolved, Integrity: u.Integrity, DependencyKind: kind, DevDependency: u.Dev, Registry: npmRegistryFromResolved(u.Resolved)},
 		},
 	)
 }
@@ -139,7 +142,29 @@ func newPnpmPackage(resolver file.Resolver, location file.Location, name, versio
 	)
 }
 
-func newYarnLockPackage(cfg CatalogerConfig, resolver file.Resolver, location file.Location, name, version string, resolved string, integrity string) pkg.Package {
+func newSourceMapPackage(location file.Location, name, version, bundle string, sources []string) pkg.Package {
+	sources = strset.New(sources...).List()
+	sort.Strings(sources)
+
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+		PURL:      packageURL(name, version),
+		Language:  pkg.JavaScript,
+		Type:      pkg.NpmPkg,
+		Metadata: pkg.NpmSourceMapEntry{
+			Bundle:  bundle,
+			Sources: sources,
+		},
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func newYarnLockPackage(cfg CatalogerConfig, resolver file.Resolver, location file.Location, name, version string, resolved string, integrity string, kind pkg.DependencyKind) pkg.Package {
 	var licenseSet pkg.LicenseSet
 
 	if cfg.SearchRemoteLicenses {
@@ -163,7 +188,7 @@ func newYarnLockPackage(cfg CatalogerConfig, resolver file.Resolver, location fi
 			PURL:      packageURL(name, version),
 			Language:  pkg.JavaScript,
 			Type:      pkg.NpmPkg,
-			Metadata:  pkg.YarnLockEntry{Resolved: resolved, Integrity: integrity},
+			Metadata:  pkg.YarnLockEntry{Resolved: resolved, Integrity: integrity, DependencyKind: kind},
 		},
 	)
 }
@@ -225,6 +250,38 @@ func getLicenseFromNpmRegistry(basURL, packageName, version string) (string, err
 	return license.License, nil
 }
 
+// npmRegistryFromResolved returns the base URL of the registry from the resolved URL of a package tarball, which has
+// the form "<registry>/<name>/-/<tarball>" (where the name may be scoped). Credentials within the URL are dropped, and
+// an empty string is returned for URLs that are not registry tarballs (e.g. git or file URLs).
+func npmRegistryFromResolved(resolved string) string {
+	u, err := url.Parse(resolved)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ""
+	}
+
+	idx := strings.LastIndex(u.Path, "/-/")
+	if idx < 0 {
+		return ""
+	}
+
+	// drop the package name (and scope) from the path, where the name of a scoped package may also be given as
+	// "@scope%2fname" (which is decoded within the path)
+	segments := strings.Split(strings.Trim(u.Path[:idx], "/"), "/")
+	if segments[len(segments)-1] == "" {
+		return ""
+	}
+	segments = segments[:len(segments)-1]
+	if len(segments) > 0 && strings.HasPrefix(segments[len(segments)-1], "@") {
+		segments = segments[:len(segments)-1]
+	}
+
+	registry := url.URL{Scheme: u.Scheme, Host: u.Host}
+	if len(segments) > 0 {
+		registry.Path = "/" + strings.Join(segments, "/")
+	}
+	return registry.String()
+}
+
 func finalizeLockPkg(resolver file.Resolver, location file.Location, p pkg.Package) pkg.Package {
 	licenseCandidate := addLicenses(p.Name, resolver, location)
 	p.Licenses.Add(pkg.NewLicensesFromLocation(location, licenseCandidate...)...)
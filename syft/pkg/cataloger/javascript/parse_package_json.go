@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path"
 	"regexp"
 
 	"github.com/mitchellh/mapstructure"
@@ -34,6 +35,12 @@ type packageJSON struct {
 	Dependencies map[string]string `json:"dependencies"`
 	Repository   repository        `json:"repository"`
 	Private      bool              `json:"private"`
+	Publisher    string            `json:"publisher"`
+	Engines      json.RawMessage   `json:"engines"`
+
+	DevDependencies      map[string]string `json:"devDependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+	PeerDependencies     map[string]string `json:"peerDependencies"`
 }
 
 type author struct {
@@ -64,6 +71,12 @@ func parsePackageJSON(_ context.Context, _ file.Resolver, _ *generic.Environment
 			return nil, nil, fmt.Errorf("failed to parse package.json file: %w", err)
 		}
 
+		if p.isVSCodeExtension(reader.RealPath) {
+			// installed VS Code extensions are cataloged as extensions (not npm packages) by the vscode cataloger
+			log.WithFields("path", reader.RealPath).Trace("ignoring VS Code extension manifest")
+			return nil, nil, nil
+		}
+
 		if !p.hasNameAndVersionValues() {
 			log.Debugf("encountered package.json file without a name and/or version field, ignoring (path=%q)", reader.Path())
 			return nil, nil, nil
@@ -80,6 +93,20 @@ func parsePackageJSON(_ context.Context, _ file.Resolver, _ *generic.Environment
 	return pkgs, nil, nil
 }
 
+// isVSCodeExtension indicates the package.json is the manifest of a VS Code extension installed within an extensions
+// directory (e.g. ~/.vscode/extensions/ms-python.python-2024.0.1/package.json).
+func (p packageJSON) isVSCodeExtension(manifestPath string) bool {
+	if p.Publisher == "" || path.Base(path.Dir(path.Dir(manifestPath))) != "extensions" {
+		return false
+	}
+	var engines map[string]interface{}
+	if err := json.Unmarshal(p.Engines, &engines); err != nil {
+		return false
+	}
+	vscode, ok := engines["vscode"].(string)
+	return ok && vscode != ""
+}
+
 func (a *author) UnmarshalJSON(b []byte) error {
 	var authorStr string
 	var fields map[string]string
@@ -241,3 +241,39 @@ func Test_pathContainsNodeModulesDirectory(t *testing.T) {
 		})
 	}
 }
+
+func Test_packageJSON_isVSCodeExtension(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		manifest packageJSON
+		expected bool
+	}{
+		{
+			name:     "installed extension",
+			path:     "/home/user/.vscode/extensions/ms-python.python-2024.0.1/package.json",
+			manifest: packageJSON{Publisher: "ms-python", Engines: []byte(`{"vscode": "^1.82.0"}`)},
+			expected: true,
+		},
+		{
+			name:     "extension outside of an extensions directory",
+			path:     "/src/vscode-python/package.json",
+			manifest: packageJSON{Publisher: "ms-python", Engines: []byte(`{"vscode": "^1.82.0"}`)},
+		},
+		{
+			name:     "npm package within an extensions directory",
+			path:     "/home/user/.vscode/extensions/semver/package.json",
+			manifest: packageJSON{Engines: []byte(`{"node": ">=10"}`)},
+		},
+		{
+			name:     "publisher without a vscode engine",
+			path:     "/home/user/.vscode/extensions/semver/package.json",
+			manifest: packageJSON{Publisher: "npm", Engines: []byte(`["node >=0.6"]`)},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.manifest.isVSCodeExtension(test.path))
+		})
+	}
+}
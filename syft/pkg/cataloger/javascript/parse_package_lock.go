@@ -28,6 +28,10 @@ type lockDependency struct {
 	Version   string `json:"version"`
 	Resolved  string `json:"resolved"`
 	Integrity string `json:"integrity"`
+	Dev       bool   `json:"dev"`
+
+	// Dependencies are the packages that could not be hoisted, so are installed within the node_modules of this package
+	Dependencies map[string]lockDependency `json:"dependencies"`
 }
 
 type lockPackage struct {
@@ -36,6 +40,13 @@ type lockPackage struct {
 	Resolved  string             `json:"resolved"`
 	Integrity string             `json:"integrity"`
 	License   packageLockLicense `json:"license"`
+	Dev       bool               `json:"dev"` // only required for development (not set for packages that are also optional, see "devOptional")
+
+	// the following are only considered for the root package entry (named ""), describing the direct dependencies
+	Dependencies         map[string]string `json:"dependencies"`
+	DevDependencies      map[string]string `json:"devDependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+	PeerDependencies     map[string]string `json:"peerDependencies"`
 }
 
 // packageLockLicense
@@ -72,18 +83,23 @@ func (a genericPackageLockAdapter) parsePackageLock(_ context.Context, resolver
 	}
 
 	if lock.LockfileVersion == 1 {
-		for name, pkgMeta := range lock.Dependencies {
-			pkgs = append(pkgs, newPackageLockV1Package(a.cfg, resolver, reader.Location, name, pkgMeta))
-		}
+		pkgs = append(pkgs, a.packageLockV1Packages(resolver, reader.Location, lock.Dependencies, "", 0)...)
 	}
 
 	if lock.LockfileVersion == 2 || lock.LockfileVersion == 3 {
+		root, hasRoot := lock.Packages[""]
 		for name, pkgMeta := range lock.Packages {
+			if pkgMeta.Dev && a.cfg.ExcludeDevDependencies {
+				continue
+			}
+			var kind pkg.DependencyKind
 			if name == "" {
 				if pkgMeta.Name == "" {
 					continue
 				}
 				name = pkgMeta.Name
+			} else if hasRoot {
+				kind = packageLockDependencyKind(root, name)
 			}
 
 			// handles alias names
@@ -93,7 +109,7 @@ func (a genericPackageLockAdapter) parsePackageLock(_ context.Context, resolver
 
 			pkgs = append(
 				pkgs,
-				newPackageLockV2Package(a.cfg, resolver, reader.Location, getNameFromPath(name), pkgMeta),
+				newPackageLockV2Package(a.cfg, resolver, reader.Location, getNameFromPath(name), pkgMeta, kind),
 			)
 		}
 	}
@@ -103,6 +119,47 @@ func (a genericPackageLockAdapter) parsePackageLock(_ context.Context, resolver
 	return pkgs, nil, nil
 }
 
+// maxPackageLockV1Depth bounds the nesting of the legacy dependencies tree (which mirrors the node_modules tree).
+const maxPackageLockV1Depth = 64
+
+// packageLockV1Packages returns the packages of the legacy (lockfile version 1) dependencies tree, including the
+// packages nested within the node_modules of other packages. Only nested packages are known to be transitive, since
+// the top level packages are a mix of direct dependencies and hoisted transitive dependencies.
+func (a genericPackageLockAdapter) packageLockV1Packages(resolver file.Resolver, location file.Location, deps map[string]lockDependency, kind pkg.DependencyKind, depth int) []pkg.Package {
+	if depth > maxPackageLockV1Depth {
+		log.WithFields("path", location.RealPath).Debug("package-lock.json dependencies are nested too deeply")
+		return nil
+	}
+	var pkgs []pkg.Package
+	for name, pkgMeta := range deps {
+		if pkgMeta.Dev && a.cfg.ExcludeDevDependencies {
+			continue
+		}
+		pkgs = append(pkgs, newPackageLockV1Package(a.cfg, resolver, location, name, pkgMeta, kind))
+		pkgs = append(pkgs, a.packageLockV1Packages(resolver, location, pkgMeta.Dependencies, pkg.TransitiveDependency, depth+1)...)
+	}
+	return pkgs
+}
+
+// packageLockDependencyKind determines if the package at the given path (e.g. "node_modules/a/node_modules/b") is a
+// direct dependency of the root package. Paths outside node_modules (e.g. workspace packages) are not classified.
+func packageLockDependencyKind(root lockPackage, path string) pkg.DependencyKind {
+	if !strings.HasPrefix(path, "node_modules/") {
+		return ""
+	}
+	if strings.Count(path, "node_modules/") > 1 {
+		// nested within another package, so this can only be required by that package
+		return pkg.TransitiveDependency
+	}
+	name := getNameFromPath(path)
+	for _, deps := range []map[string]string{root.Dependencies, root.DevDependencies, root.OptionalDependencies, root.PeerDependencies} {
+		if _, ok := deps[name]; ok {
+			return pkg.DirectDependency
+		}
+	}
+	return pkg.TransitiveDependency
+}
+
 func (licenses *packageLockLicense) UnmarshalJSON(data []byte) (err error) {
 	// The license field could be either a string or an array.
 
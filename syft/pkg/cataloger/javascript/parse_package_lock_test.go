@@ -18,7 +18,7 @@ func TestParsePackageLock(t *testing.T) {
 			PURL:     "pkg:npm/%40actions/core@1.6.0",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/@actions/core/-/core-1.6.0.tgz", Integrity: "sha512-NB1UAZomZlCV/LmJqkLhNTqtKfFXJZAUPcfl/zqG7EfsQdeUJtaWO98SGbuQ3pydJ3fHl2CvI/51OKYlCYYcaw=="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/@actions/core/-/core-1.6.0.tgz", Integrity: "sha512-NB1UAZomZlCV/LmJqkLhNTqtKfFXJZAUPcfl/zqG7EfsQdeUJtaWO98SGbuQ3pydJ3fHl2CvI/51OKYlCYYcaw==", Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "ansi-regex",
@@ -26,7 +26,7 @@ func TestParsePackageLock(t *testing.T) {
 			PURL:     "pkg:npm/ansi-regex@3.0.0",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/ansi-regex/-/ansi-regex-3.0.0.tgz", Integrity: "sha1-7QMXwyIGT3lGbAKWa922Bas32Zg="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/ansi-regex/-/ansi-regex-3.0.0.tgz", Integrity: "sha1-7QMXwyIGT3lGbAKWa922Bas32Zg=", Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "cowsay",
@@ -34,7 +34,7 @@ func TestParsePackageLock(t *testing.T) {
 			PURL:     "pkg:npm/cowsay@1.4.0",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/cowsay/-/cowsay-1.4.0.tgz", Integrity: "sha512-rdg5k5PsHFVJheO/pmE3aDg2rUDDTfPJau6yYkZYlHFktUz+UxbE+IgnUAEyyCyv4noL5ltxXD0gZzmHPCy/9g=="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/cowsay/-/cowsay-1.4.0.tgz", Integrity: "sha512-rdg5k5PsHFVJheO/pmE3aDg2rUDDTfPJau6yYkZYlHFktUz+UxbE+IgnUAEyyCyv4noL5ltxXD0gZzmHPCy/9g==", Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "get-stdin",
@@ -42,7 +42,7 @@ func TestParsePackageLock(t *testing.T) {
 			PURL:     "pkg:npm/get-stdin@5.0.1",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/get-stdin/-/get-stdin-5.0.1.tgz", Integrity: "sha1-Ei4WFZHiH/TFJTAwVpPyDmOTo5g="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/get-stdin/-/get-stdin-5.0.1.tgz", Integrity: "sha1-Ei4WFZHiH/TFJTAwVpPyDmOTo5g=", Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "is-fullwidth-code-point",
@@ -50,7 +50,7 @@ func TestParsePackageLock(t *testing.T) {
 			PURL:     "pkg:npm/is-fullwidth-code-point@2.0.0",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/is-fullwidth-code-point/-/is-fullwidth-code-point-2.0.0.tgz", Integrity: "sha1-o7MKXE8ZkYMWeqq5O+764937ZU8="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/is-fullwidth-code-point/-/is-fullwidth-code-point-2.0.0.tgz", Integrity: "sha1-o7MKXE8ZkYMWeqq5O+764937ZU8=", Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "minimist",
@@ -58,7 +58,7 @@ func TestParsePackageLock(t *testing.T) {
 			PURL:     "pkg:npm/minimist@0.0.10",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/minimist/-/minimist-0.0.10.tgz", Integrity: "sha1-3j+YVD2/lggr5IrRoMfNqDYwHc8="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/minimist/-/minimist-0.0.10.tgz", Integrity: "sha1-3j+YVD2/lggr5IrRoMfNqDYwHc8=", Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "optimist",
@@ -66,7 +66,7 @@ func TestParsePackageLock(t *testing.T) {
 			PURL:     "pkg:npm/optimist@0.6.1",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/optimist/-/optimist-0.6.1.tgz", Integrity: "sha1-2j6nRob6IaGaERwybpDrFaAZZoY="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/optimist/-/optimist-0.6.1.tgz", Integrity: "sha1-2j6nRob6IaGaERwybpDrFaAZZoY=", Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "string-width",
@@ -74,7 +74,7 @@ func TestParsePackageLock(t *testing.T) {
 			PURL:     "pkg:npm/string-width@2.1.1",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/string-width/-/string-width-2.1.1.tgz", Integrity: "sha512-nOqH59deCq9SRHlxq1Aw85Jnt4w6KvLKqWVik6oA9ZklXLNIOlqg4F2yrT1MVaTjAqvVwdfeZ7w7aCvJD7ugkw=="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/string-width/-/string-width-2.1.1.tgz", Integrity: "sha512-nOqH59deCq9SRHlxq1Aw85Jnt4w6KvLKqWVik6oA9ZklXLNIOlqg4F2yrT1MVaTjAqvVwdfeZ7w7aCvJD7ugkw==", Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "strip-ansi",
@@ -82,7 +82,7 @@ func TestParsePackageLock(t *testing.T) {
 			PURL:     "pkg:npm/strip-ansi@4.0.0",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/strip-ansi/-/strip-ansi-4.0.0.tgz", Integrity: "sha1-qEeQIusaw2iocTibY1JixQXuNo8="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/strip-ansi/-/strip-ansi-4.0.0.tgz", Integrity: "sha1-qEeQIusaw2iocTibY1JixQXuNo8=", Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "strip-eof",
@@ -90,7 +90,7 @@ func TestParsePackageLock(t *testing.T) {
 			PURL:     "pkg:npm/strip-eof@1.0.0",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/strip-eof/-/strip-eof-1.0.0.tgz", Integrity: "sha1-u0P/VZim6wXYm1n80SnJgzE2Br8="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/strip-eof/-/strip-eof-1.0.0.tgz", Integrity: "sha1-u0P/VZim6wXYm1n80SnJgzE2Br8=", Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "wordwrap",
@@ -98,7 +98,7 @@ func TestParsePackageLock(t *testing.T) {
 			PURL:     "pkg:npm/wordwrap@0.0.3",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/wordwrap/-/wordwrap-0.0.3.tgz", Integrity: "sha1-o9XabNXAvAAI03I0u68b7WMFkQc="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/wordwrap/-/wordwrap-0.0.3.tgz", Integrity: "sha1-o9XabNXAvAAI03I0u68b7WMFkQc=", Registry: "https://registry.npmjs.org"},
 		},
 	}
 	fixture := "test-fixtures/pkg-lock/package-lock.json"
@@ -131,7 +131,7 @@ func TestParsePackageLockV2(t *testing.T) {
 			Licenses: pkg.NewLicenseSet(
 				pkg.NewLicenseFromLocations("MIT", file.NewLocation(fixture)),
 			),
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/@types/prop-types/-/prop-types-15.7.5.tgz", Integrity: "sha1-XxnSuFqY6VWANvajysyIGUIPBc8="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/@types/prop-types/-/prop-types-15.7.5.tgz", Integrity: "sha1-XxnSuFqY6VWANvajysyIGUIPBc8=", DependencyKind: pkg.TransitiveDependency, Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "@types/react",
@@ -142,7 +142,7 @@ func TestParsePackageLockV2(t *testing.T) {
 			Licenses: pkg.NewLicenseSet(
 				pkg.NewLicenseFromLocations("MIT", file.NewLocation(fixture)),
 			),
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/@types/react/-/react-18.0.17.tgz", Integrity: "sha1-RYPZwyLWfv5LOak10iPtzHBQzPQ="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/@types/react/-/react-18.0.17.tgz", Integrity: "sha1-RYPZwyLWfv5LOak10iPtzHBQzPQ=", DependencyKind: pkg.DirectDependency, Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "@types/scheduler",
@@ -153,7 +153,7 @@ func TestParsePackageLockV2(t *testing.T) {
 			Licenses: pkg.NewLicenseSet(
 				pkg.NewLicenseFromLocations("MIT", file.NewLocation(fixture)),
 			),
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/@types/scheduler/-/scheduler-0.16.2.tgz", Integrity: "sha1-GmL4lSVyPd4kuhsBsJK/XfitTTk="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/@types/scheduler/-/scheduler-0.16.2.tgz", Integrity: "sha1-GmL4lSVyPd4kuhsBsJK/XfitTTk=", DependencyKind: pkg.TransitiveDependency, Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "csstype",
@@ -164,7 +164,7 @@ func TestParsePackageLockV2(t *testing.T) {
 			Licenses: pkg.NewLicenseSet(
 				pkg.NewLicenseFromLocations("MIT", file.NewLocation(fixture)),
 			),
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/csstype/-/csstype-3.1.0.tgz", Integrity: "sha1-TdysNxjXh8+d8NG30VAzklyPKfI="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/csstype/-/csstype-3.1.0.tgz", Integrity: "sha1-TdysNxjXh8+d8NG30VAzklyPKfI=", DependencyKind: pkg.TransitiveDependency, Registry: "https://registry.npmjs.org"},
 		},
 	}
 	for i := range expectedPkgs {
@@ -192,7 +192,7 @@ func TestParsePackageLockV3(t *testing.T) {
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
 			PURL:     "pkg:npm/%40types/prop-types@15.7.5",
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/@types/prop-types/-/prop-types-15.7.5.tgz", Integrity: "sha512-JCB8C6SnDoQf0cNycqd/35A7MjcnK+ZTqE7judS6o7utxUCg6imJg3QK2qzHKszlTjcj2cn+NwMB2i96ubpj7w=="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/@types/prop-types/-/prop-types-15.7.5.tgz", Integrity: "sha512-JCB8C6SnDoQf0cNycqd/35A7MjcnK+ZTqE7judS6o7utxUCg6imJg3QK2qzHKszlTjcj2cn+NwMB2i96ubpj7w==", DependencyKind: pkg.TransitiveDependency, Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "@types/react",
@@ -200,7 +200,7 @@ func TestParsePackageLockV3(t *testing.T) {
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
 			PURL:     "pkg:npm/%40types/react@18.0.20",
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/@types/react/-/react-18.0.20.tgz", Integrity: "sha512-MWul1teSPxujEHVwZl4a5HxQ9vVNsjTchVA+xRqv/VYGCuKGAU6UhfrTdF5aBefwD1BHUD8i/zq+O/vyCm/FrA=="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/@types/react/-/react-18.0.20.tgz", Integrity: "sha512-MWul1teSPxujEHVwZl4a5HxQ9vVNsjTchVA+xRqv/VYGCuKGAU6UhfrTdF5aBefwD1BHUD8i/zq+O/vyCm/FrA==", DependencyKind: pkg.DirectDependency, Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "@types/scheduler",
@@ -208,7 +208,7 @@ func TestParsePackageLockV3(t *testing.T) {
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
 			PURL:     "pkg:npm/%40types/scheduler@0.16.2",
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/@types/scheduler/-/scheduler-0.16.2.tgz", Integrity: "sha512-hppQEBDmlwhFAXKJX2KnWLYu5yMfi91yazPb2l+lbJiwW+wdo1gNeRA+3RgNSO39WYX2euey41KEwnqesU2Jew=="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/@types/scheduler/-/scheduler-0.16.2.tgz", Integrity: "sha512-hppQEBDmlwhFAXKJX2KnWLYu5yMfi91yazPb2l+lbJiwW+wdo1gNeRA+3RgNSO39WYX2euey41KEwnqesU2Jew==", DependencyKind: pkg.TransitiveDependency, Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "csstype",
@@ -216,7 +216,7 @@ func TestParsePackageLockV3(t *testing.T) {
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
 			PURL:     "pkg:npm/csstype@3.1.1",
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/csstype/-/csstype-3.1.1.tgz", Integrity: "sha512-DJR/VvkAvSZW9bTouZue2sSxDwdTN92uHjqeKVm+0dAqdfNykRzQ95tay8aXMBAAPpUiq4Qcug2L7neoRh2Egw=="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/csstype/-/csstype-3.1.1.tgz", Integrity: "sha512-DJR/VvkAvSZW9bTouZue2sSxDwdTN92uHjqeKVm+0dAqdfNykRzQ95tay8aXMBAAPpUiq4Qcug2L7neoRh2Egw==", DependencyKind: pkg.TransitiveDependency, Registry: "https://registry.npmjs.org"},
 		},
 	}
 	for i := range expectedPkgs {
@@ -235,7 +235,7 @@ func TestParsePackageLockAlias(t *testing.T) {
 			PURL:     "pkg:npm/case@1.6.2",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/case/-/case-1.6.2.tgz", Integrity: "sha512-ll380ZRoraT7mUK2G92UbH+FJVD5AwdVIAYk9xhV1tauh0carDgYByUD1HhjCWsWgxrfQvCeHvtfj7IYR6TKeg=="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/case/-/case-1.6.2.tgz", Integrity: "sha512-ll380ZRoraT7mUK2G92UbH+FJVD5AwdVIAYk9xhV1tauh0carDgYByUD1HhjCWsWgxrfQvCeHvtfj7IYR6TKeg==", Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "case",
@@ -243,7 +243,7 @@ func TestParsePackageLockAlias(t *testing.T) {
 			PURL:     "pkg:npm/case@1.6.3",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/case/-/case-1.6.3.tgz", Integrity: "sha512-mzDSXIPaFwVDvZAHqZ9VlbyF4yyXRuX6IvB06WvPYkqJVO24kX1PPhv9bfpKNFZyxYFmmgo03HUiD8iklmJYRQ=="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/case/-/case-1.6.3.tgz", Integrity: "sha512-mzDSXIPaFwVDvZAHqZ9VlbyF4yyXRuX6IvB06WvPYkqJVO24kX1PPhv9bfpKNFZyxYFmmgo03HUiD8iklmJYRQ==", Registry: "https://registry.npmjs.org"},
 		},
 		{
 			Name:     "@bundled-es-modules/chai",
@@ -251,7 +251,7 @@ func TestParsePackageLockAlias(t *testing.T) {
 			PURL:     "pkg:npm/%40bundled-es-modules/chai@4.2.2",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
-			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/@bundled-es-modules/chai/-/chai-4.2.2.tgz", Integrity: "sha512-iGmVYw2/zJCoqyKTtWEYCtFmMyi8WmACQKtky0lpNyEKWX0YIOpKWGD7saMXL+tPpllss0otilxV0SLwyi3Ytg=="},
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/@bundled-es-modules/chai/-/chai-4.2.2.tgz", Integrity: "sha512-iGmVYw2/zJCoqyKTtWEYCtFmMyi8WmACQKtky0lpNyEKWX0YIOpKWGD7saMXL+tPpllss0otilxV0SLwyi3Ytg==", Registry: "https://registry.npmjs.org"},
 		},
 	}
 
@@ -276,6 +276,12 @@ func TestParsePackageLockAlias(t *testing.T) {
 		copy(expected, commonPkgs)
 
 		if pl == packageLockV2 {
+			// only v2+ lockfiles describe the root package, which is needed to tell direct dependencies apart
+			for i := range expected {
+				metadata := expected[i].Metadata.(pkg.NpmPackageLockEntry)
+				metadata.DependencyKind = pkg.DirectDependency
+				expected[i].Metadata = metadata
+			}
 			expected = append(expected, v2Pkg)
 		}
 
@@ -287,6 +293,43 @@ func TestParsePackageLockAlias(t *testing.T) {
 	}
 }
 
+func TestParsePackageLockV1NestedDependencies(t *testing.T) {
+	fixture := "test-fixtures/pkg-lock/nested-package-lock-1.json"
+	var expectedRelationships []artifact.Relationship
+	expectedPkgs := []pkg.Package{
+		{
+			Name:     "debug",
+			Version:  "2.6.9",
+			PURL:     "pkg:npm/debug@2.6.9",
+			Language: pkg.JavaScript,
+			Type:     pkg.NpmPkg,
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/debug/-/debug-2.6.9.tgz", Integrity: "sha512-bC7ElrdJaJnPbAP+1EotYvqZsb3ecl5wi6Bfi6BJTUcNowp6cvspg0jXznRTKDjm/E7AdgFBVeAPVMNcKGsHMA==", Registry: "https://registry.npmjs.org"},
+		},
+		{
+			Name:     "ms",
+			Version:  "2.0.0",
+			PURL:     "pkg:npm/ms@2.0.0",
+			Language: pkg.JavaScript,
+			Type:     pkg.NpmPkg,
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/ms/-/ms-2.0.0.tgz", Integrity: "sha1-VgiurfwAvmwpAd9fmGF4jeDVl8g=", DependencyKind: pkg.TransitiveDependency, Registry: "https://registry.npmjs.org"},
+		},
+		{
+			Name:     "ms",
+			Version:  "2.1.3",
+			PURL:     "pkg:npm/ms@2.1.3",
+			Language: pkg.JavaScript,
+			Type:     pkg.NpmPkg,
+			Metadata: pkg.NpmPackageLockEntry{Resolved: "https://registry.npmjs.org/ms/-/ms-2.1.3.tgz", Integrity: "sha512-6FlzubTLZG3J2a/NVCAleEhjzq5oxgHyaCU9yYXvcLsvoVaHJq/s5xXI6/XXP6tz7R9xAOtHnSO/tXtF3WRTlA==", Registry: "https://registry.npmjs.org"},
+		},
+	}
+	for i := range expectedPkgs {
+		expectedPkgs[i].Locations.Add(file.NewLocation(fixture))
+	}
+
+	adapter := newGenericPackageLockAdapter(CatalogerConfig{})
+	pkgtest.TestFileParser(t, fixture, adapter.parsePackageLock, expectedPkgs, expectedRelationships)
+}
+
 func TestParsePackageLockLicenseWithArray(t *testing.T) {
 	fixture := "test-fixtures/pkg-lock/array-license-package-lock.json"
 	var expectedRelationships []artifact.Relationship
@@ -313,7 +356,7 @@ func TestParsePackageLockLicenseWithArray(t *testing.T) {
 				pkg.NewLicenseFromLocations("Apache2", file.NewLocation(fixture)),
 			),
 			PURL:     "pkg:npm/pause-stream@0.0.11",
-			Metadata: pkg.NpmPackageLockEntry{},
+			Metadata: pkg.NpmPackageLockEntry{DependencyKind: pkg.DirectDependency},
 		},
 		{
 			Name:     "through",
@@ -324,7 +367,7 @@ func TestParsePackageLockLicenseWithArray(t *testing.T) {
 				pkg.NewLicenseFromLocations("MIT", file.NewLocation(fixture)),
 			),
 			PURL:     "pkg:npm/through@2.3.8",
-			Metadata: pkg.NpmPackageLockEntry{},
+			Metadata: pkg.NpmPackageLockEntry{DependencyKind: pkg.TransitiveDependency},
 		},
 	}
 	for i := range expectedPkgs {
@@ -333,3 +376,72 @@ func TestParsePackageLockLicenseWithArray(t *testing.T) {
 	adapter := newGenericPackageLockAdapter(CatalogerConfig{})
 	pkgtest.TestFileParser(t, fixture, adapter.parsePackageLock, expectedPkgs, expectedRelationships)
 }
+
+func TestParsePackageLockDevDependencies(t *testing.T) {
+	fixture := "test-fixtures/pkg-lock/dev-package-lock.json"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	app := pkg.Package{
+		Name:      "app",
+		Version:   "1.0.0",
+		Language:  pkg.JavaScript,
+		Type:      pkg.NpmPkg,
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", file.NewLocation(fixture))),
+		PURL:      "pkg:npm/app@1.0.0",
+		Locations: locations,
+		Metadata:  pkg.NpmPackageLockEntry{},
+	}
+	ms := pkg.Package{
+		Name:      "ms",
+		Version:   "2.1.3",
+		Language:  pkg.JavaScript,
+		Type:      pkg.NpmPkg,
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", file.NewLocation(fixture))),
+		PURL:      "pkg:npm/ms@2.1.3",
+		Locations: locations,
+		Metadata: pkg.NpmPackageLockEntry{
+			Resolved:       "https://registry.npmjs.org/ms/-/ms-2.1.3.tgz",
+			Integrity:      "sha512-6FlzubTLZG3J2a/NVCAleEhjzq5oxgHyaCU9yYXvcLsvoVaHJq/s5xXI6/XXP6tz7R9xAOtHnSO/tXtF3WRTlA==",
+			DependencyKind: pkg.DirectDependency,
+			Registry:       "https://registry.npmjs.org",
+		},
+	}
+	typescript := pkg.Package{
+		Name:      "typescript",
+		Version:   "5.3.3",
+		Language:  pkg.JavaScript,
+		Type:      pkg.NpmPkg,
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("Apache-2.0", file.NewLocation(fixture))),
+		PURL:      "pkg:npm/typescript@5.3.3",
+		Locations: locations,
+		Metadata: pkg.NpmPackageLockEntry{
+			Resolved:       "https://registry.npmjs.org/typescript/-/typescript-5.3.3.tgz",
+			Integrity:      "sha512-pXWcraxM0uxAS+tITkklqADdmqvbz80B7Lk0G5ayDw2wGwEy7U5n3VyXBRBmo90yE3gww6l9Q0vFkaDaOyeXbA==",
+			DependencyKind: pkg.DirectDependency,
+			DevDependency:  true,
+			Registry:       "https://registry.npmjs.org",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		cfg      CatalogerConfig
+		expected []pkg.Package
+	}{
+		{
+			name:     "include dev dependencies",
+			cfg:      CatalogerConfig{},
+			expected: []pkg.Package{app, ms, typescript},
+		},
+		{
+			name:     "exclude dev dependencies",
+			cfg:      CatalogerConfig{}.WithExcludeDevDependencies(true),
+			expected: []pkg.Package{app, ms},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			adapter := newGenericPackageLockAdapter(test.cfg)
+			pkgtest.TestFileParser(t, fixture, adapter.parsePackageLock, test.expected, nil)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package javascript
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// integrity check
+var _ generic.Parser = parseSourceMap
+
+// sourceMap represents the contents of a (v3) source map, which may be an index map made of sections, each of which
+// holds a source map (see https://tc39.es/source-map/).
+type sourceMap struct {
+	File       string   `json:"file"`
+	SourceRoot string   `json:"sourceRoot"`
+	Sources    []string `json:"sources"`
+	Sections   []struct {
+		Map *sourceMap `json:"map"`
+	} `json:"sections"`
+}
+
+// yarnCacheArchivePattern matches the name of the archives within the yarn (berry) cache, capturing the version of the
+// package (e.g. lodash-npm-4.17.21-6382451519-eb835a2e51.zip)
+var yarnCacheArchivePattern = regexp.MustCompile(`-npm-(\d[^/]*)-[0-9a-f]{10}-[0-9a-f]+\.zip$`)
+
+// bundledSource is a single original source file of a bundle that belongs to an npm package.
+type bundledSource struct {
+	name    string
+	version string
+	path    string
+}
+
+// parseSourceMap parses the sources listed within a javascript source map, returning the npm packages that were
+// bundled (the sources found within a node_modules directory). The version of a package is only known when it can be
+// found from the path of the sources (e.g. when installed by pnpm or yarn berry).
+func parseSourceMap(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var m sourceMap
+	if err := json.NewDecoder(reader).Decode(&m); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse source map file: %w", err)
+	}
+
+	type packageKey struct{ name, version string }
+	sources := make(map[packageKey][]string)
+	var keys []packageKey
+	for _, source := range m.allSources() {
+		for _, s := range parseBundledSources(source) {
+			key := packageKey{name: s.name, version: s.version}
+			if _, ok := sources[key]; !ok {
+				keys = append(keys, key)
+			}
+			sources[key] = append(sources[key], s.path)
+		}
+	}
+
+	var pkgs []pkg.Package
+	for _, key := range keys {
+		pkgs = append(pkgs, newSourceMapPackage(reader.Location, key.name, key.version, m.File, sources[key]))
+	}
+
+	return pkgs, nil, nil
+}
+
+func (m sourceMap) allSources() []string {
+	var sources []string
+	for _, s := range m.Sources {
+		sources = append(sources, m.SourceRoot+s)
+	}
+	for _, section := range m.Sections {
+		if section.Map != nil {
+			sources = append(sources, section.Map.allSources()...)
+		}
+	}
+	return sources
+}
+
+// parseBundledSources returns the npm packages referenced by the given source, which may be a chain of (webpack)
+// loaders applied to a file (e.g. "webpack:///./node_modules/css-loader/dist/cjs.js!./src/style.css").
+func parseBundledSources(source string) []bundledSource {
+	var results []bundledSource
+	for _, part := range strings.Split(source, "!") {
+		if i := strings.IndexAny(part, "?#"); i >= 0 {
+			part = part[:i]
+		}
+		if s, ok := parseBundledSource(part); ok {
+			results = append(results, s)
+		}
+	}
+	return results
+}
+
+// parseBundledSource returns the npm package that the given source file belongs to, which is the package within the
+// last node_modules directory of the path.
+func parseBundledSource(source string) (bundledSource, bool) {
+	fields := strings.Split(strings.ReplaceAll(source, "\\", "/"), "/")
+
+	idx := -1
+	for i, f := range fields {
+		if f == "node_modules" {
+			idx = i
+		}
+	}
+	if idx < 0 || idx+1 >= len(fields) {
+		return bundledSource{}, false
+	}
+
+	name := fields[idx+1]
+	rest := fields[idx+2:]
+	if strings.HasPrefix(name, "@") {
+		if len(rest) == 0 {
+			return bundledSource{}, false
+		}
+		name += "/" + rest[0]
+		rest = rest[1:]
+	}
+	if !isValidBundledPackageName(name) || len(rest) == 0 {
+		return bundledSource{}, false
+	}
+
+	return bundledSource{
+		name:    name,
+		version: bundledPackageVersion(name, fields[:idx]),
+		path:    path.Join(rest...),
+	}, true
+}
+
+func isValidBundledPackageName(name string) bool {
+	if name == "" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+		// hidden directories (e.g. .bin, .pnpm, or .cache) are not packages
+		return false
+	}
+	for _, r := range name {
+		if r == ' ' || r == ':' || r == '*' {
+			return false
+		}
+	}
+	return true
+}
+
+// bundledPackageVersion returns the version of the given package from the directories that the node_modules directory
+// of the package is within, which are named after the package and version by pnpm (.pnpm/<name>@<version>) and yarn
+// berry (.yarn/cache/<name>-npm-<version>-<checksum>.zip).
+func bundledPackageVersion(name string, parents []string) string {
+	if len(parents) == 0 {
+		return ""
+	}
+	parent := parents[len(parents)-1]
+
+	if len(parents) > 1 && parents[len(parents)-2] == ".pnpm" {
+		// the scope separator is encoded as "+", and the peer dependencies follow the version ("_" or "(" prefixed)
+		prefix := strings.ReplaceAll(name, "/", "+") + "@"
+		if !strings.HasPrefix(parent, prefix) {
+			return ""
+		}
+		version := strings.TrimPrefix(parent, prefix)
+		if i := strings.IndexAny(version, "_("); i >= 0 {
+			version = version[:i]
+		}
+		return version
+	}
+
+	if match := yarnCacheArchivePattern.FindStringSubmatch(parent); match != nil {
+		prefix := strings.TrimPrefix(strings.ReplaceAll(name, "/", "-"), "@")
+		if strings.HasPrefix(strings.TrimPrefix(parent, "@"), prefix+"-npm-") {
+			return match[1]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,129 @@
+package javascript
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseSourceMap(t *testing.T) {
+	fixture := "test-fixtures/source-map/main.js.map"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	newPkg := func(name, version, purl string, sources ...string) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			PURL:      purl,
+			Locations: locations,
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.NpmSourceMapEntry{
+				Bundle:  "main.3f2a1c.js",
+				Sources: sources,
+			},
+		}
+	}
+
+	expected := []pkg.Package{
+		newPkg("react", "", "pkg:npm/react", "cjs/react.production.min.js", "index.js"),
+		newPkg("@babel/runtime", "", "pkg:npm/%40babel/runtime", "helpers/esm/extends.js"),
+		newPkg("css-loader", "", "pkg:npm/css-loader", "dist/cjs.js"),
+		newPkg("object-assign", "", "pkg:npm/object-assign", "index.js"),
+		newPkg("lodash", "4.17.21", "pkg:npm/lodash@4.17.21", "lodash.js"),
+		newPkg("@tanstack/query-core", "5.8.1", "pkg:npm/%40tanstack/query-core@5.8.1", "build/modern/index.js"),
+		newPkg("react-dom", "18.2.0", "pkg:npm/react-dom@18.2.0", "index.js"),
+		newPkg("axios", "1.6.2", "pkg:npm/axios@1.6.2", "lib/axios.js"),
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseSourceMap, expected, nil)
+}
+
+func TestParseSourceMap_IndexMap(t *testing.T) {
+	fixture := "test-fixtures/source-map/index.js.map"
+
+	expected := []pkg.Package{
+		{
+			Name:      "tslib",
+			PURL:      "pkg:npm/tslib",
+			Locations: file.NewLocationSet(file.NewLocation(fixture)),
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.NpmSourceMapEntry{
+				Bundle:  "bundle.js",
+				Sources: []string{"modules/index.js", "tslib.es6.mjs"},
+			},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseSourceMap, expected, nil)
+}
+
+func TestParseSourceMap_Malformed(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromFile(t, "test-fixtures/source-map/malformed.js.map").
+		WithError().
+		TestParser(t, parseSourceMap)
+}
+
+func Test_parseBundledSource(t *testing.T) {
+	tests := []struct {
+		source   string
+		expected bundledSource
+		ok       bool
+	}{
+		{
+			source:   "webpack:///./node_modules/lodash/lodash.js",
+			expected: bundledSource{name: "lodash", path: "lodash.js"},
+			ok:       true,
+		},
+		{
+			source:   `C:\app\node_modules\@scope\name\lib\index.js`,
+			expected: bundledSource{name: "@scope/name", path: "lib/index.js"},
+			ok:       true,
+		},
+		{
+			source:   "node_modules/a/node_modules/b/index.js",
+			expected: bundledSource{name: "b", path: "index.js"},
+			ok:       true,
+		},
+		{
+			source:   "node_modules/.pnpm/@scope+name@1.0.0-beta.1_peer@2.0.0/node_modules/@scope/name/index.js",
+			expected: bundledSource{name: "@scope/name", version: "1.0.0-beta.1", path: "index.js"},
+			ok:       true,
+		},
+		{
+			source:   "node_modules/.pnpm/other@1.0.0/node_modules/name/index.js",
+			expected: bundledSource{name: "name", path: "index.js"},
+			ok:       true,
+		},
+		{
+			source:   ".yarn/cache/@scope-name-npm-2.1.0-rc.1-0123456789-abcdef0123.zip/node_modules/@scope/name/index.js",
+			expected: bundledSource{name: "@scope/name", version: "2.1.0-rc.1", path: "index.js"},
+			ok:       true,
+		},
+		{
+			source: "webpack:///./src/index.js",
+		},
+		{
+			source: "node_modules/.bin/tool.js",
+		},
+		{
+			source: "node_modules/name",
+		},
+		{
+			source: "node_modules/@scope/name",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			actual, ok := parseBundledSource(test.source)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
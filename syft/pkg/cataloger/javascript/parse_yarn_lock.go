@@ -2,12 +2,20 @@ package javascript
 
 import (
 	"bufio"
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"path"
 	"regexp"
+	"strings"
 
 	"github.com/scylladb/go-set/strset"
+	"gopkg.in/yaml.v3"
 
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
@@ -46,8 +54,26 @@ var (
 	//		integrity sha512-tHq6qdbT9U1IRSGf14CL0pUlULksvY9OZ+5eEgl1N7t+OA3tGvNpxJCzuKQlsNgCVwbAs670L1vcVQi8j9HjnA==
 	// 			would return "sha512-tHq6qdbT9U1IRSGf14CL0pUlULksvY9OZ+5eEgl1N7t+OA3tGvNpxJCzuKQlsNgCVwbAs670L1vcVQi8j9HjnA==""
 	integrityExp = regexp.MustCompile(`^\s+integrity\s+([^\s]+)`)
+
+	// yarnBerryMetadataExp matches the "__metadata" entry that is only found within lock files written by yarn berry
+	// (v2+), which are YAML documents rather than the (YAML-like) format of yarn v1
+	yarnBerryMetadataExp = regexp.MustCompile(`(?m)^"?__metadata"?:\s*$`)
 )
 
+// yarnBerryLockEntry represents a single entry of a yarn berry (v2+) lock file, for example:
+//
+//	"lodash@npm:^4.17.20, lodash@npm:^4.17.21":
+//	  version: 4.17.21
+//	  resolution: "lodash@npm:4.17.21"
+//	  checksum: eb835a2e51d381e561e508ce932ea50a8e5a68f4ebdd771ea240d3048244a8d13658acbd502cd4829768c56f2e16bdd4340b9ea141297d472517b83868e677f7
+//	  languageName: node
+//	  linkType: hard
+type yarnBerryLockEntry struct {
+	Version    string `yaml:"version"`
+	Resolution string `yaml:"resolution"`
+	Checksum   string `yaml:"checksum"`
+}
+
 type genericYarnLockAdapter struct {
 	cfg CatalogerConfig
 }
@@ -65,10 +91,26 @@ func (a genericYarnLockAdapter) parseYarnLock(_ context.Context, resolver file.R
 		return nil, nil, nil
 	}
 
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read yarn.lock file: %w", err)
+	}
+
+	directDependencies := findYarnDirectDependencies(resolver, reader.Location)
+
+	if yarnBerryMetadataExp.Match(contents) {
+		pkgs, err := a.parseYarnBerryLock(resolver, reader.Location, contents, directDependencies)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse yarn.lock file: %w", err)
+		}
+		return pkgs, nil, nil
+	}
+
 	var pkgs []pkg.Package
 	var currentPackage, currentVersion, currentResolved, currentIntegrity string
+	var currentKind pkg.DependencyKind
 
-	scanner := bufio.NewScanner(reader)
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
 	parsedPackages := strset.New()
 
 	for scanner.Scan() {
@@ -77,11 +119,12 @@ func (a genericYarnLockAdapter) parseYarnLock(_ context.Context, resolver file.R
 		if packageName := findPackageName(line); packageName != "" {
 			// When we find a new package, check if we have unsaved identifiers
 			if currentPackage != "" && currentVersion != "" && !parsedPackages.Has(currentPackage+"@"+currentVersion) {
-				pkgs = append(pkgs, newYarnLockPackage(a.cfg, resolver, reader.Location, currentPackage, currentVersion, currentResolved, currentIntegrity))
+				pkgs = append(pkgs, newYarnLockPackage(a.cfg, resolver, reader.Location, currentPackage, currentVersion, currentResolved, currentIntegrity, currentKind))
 				parsedPackages.Add(currentPackage + "@" + currentVersion)
 			}
 
 			currentPackage = packageName
+			currentKind = yarnDependencyKind(directDependencies, line)
 		} else if version := findPackageVersion(line); version != "" {
 			currentVersion = version
 		} else if packageName, version, resolved := findResolvedPackageAndVersion(line); packageName != "" && version != "" && resolved != "" {
@@ -89,7 +132,7 @@ func (a genericYarnLockAdapter) parseYarnLock(_ context.Context, resolver file.R
 			currentPackage = packageName
 			currentVersion = version
 		} else if integrity := findIntegrity(line); integrity != "" && !parsedPackages.Has(currentPackage+"@"+currentVersion) {
-			pkgs = append(pkgs, newYarnLockPackage(a.cfg, resolver, reader.Location, currentPackage, currentVersion, currentResolved, integrity))
+			pkgs = append(pkgs, newYarnLockPackage(a.cfg, resolver, reader.Location, currentPackage, currentVersion, currentResolved, integrity, currentKind))
 			parsedPackages.Add(currentPackage + "@" + currentVersion)
 
 			// Cleanup to indicate no unsaved identifiers
@@ -97,12 +140,13 @@ func (a genericYarnLockAdapter) parseYarnLock(_ context.Context, resolver file.R
 			currentVersion = ""
 			currentResolved = ""
 			currentIntegrity = ""
+			currentKind = ""
 		}
 	}
 
 	// check if we have valid unsaved data after end-of-file has reached
 	if currentPackage != "" && currentVersion != "" && !parsedPackages.Has(currentPackage+"@"+currentVersion) {
-		pkgs = append(pkgs, newYarnLockPackage(a.cfg, resolver, reader.Location, currentPackage, currentVersion, currentResolved, currentIntegrity))
+		pkgs = append(pkgs, newYarnLockPackage(a.cfg, resolver, reader.Location, currentPackage, currentVersion, currentResolved, currentIntegrity, currentKind))
 		parsedPackages.Add(currentPackage + "@" + currentVersion)
 	}
 
@@ -115,6 +159,127 @@ func (a genericYarnLockAdapter) parseYarnLock(_ context.Context, resolver file.R
 	return pkgs, nil, nil
 }
 
+// parseYarnBerryLock parses the entries of a yarn berry (v2+) lock file, where each entry is keyed by the (comma
+// separated) descriptors resolved to the package, such as "lodash@npm:^4.17.21".
+func (a genericYarnLockAdapter) parseYarnBerryLock(resolver file.Resolver, location file.Location, contents []byte, directDependencies *strset.Set) ([]pkg.Package, error) {
+	// note: the document is decoded as a node (rather than a map) so that duplicate keys are tolerated
+	var doc yaml.Node
+	if err := yaml.Unmarshal(contents, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("unexpected yarn berry lock file structure")
+	}
+	root := doc.Content[0]
+
+	var pkgs []pkg.Package
+	parsedPackages := strset.New()
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key := root.Content[i].Value
+		if key == "__metadata" {
+			continue
+		}
+
+		var entry yarnBerryLockEntry
+		if err := root.Content[i+1].Decode(&entry); err != nil {
+			log.WithFields("path", location.RealPath, "entry", key, "error", err).Debug("unable to parse yarn berry lock entry")
+			continue
+		}
+
+		if strings.Contains(entry.Resolution, "@workspace:") {
+			// workspaces are the packages of the project itself (which yarn v1 does not record either)
+			continue
+		}
+
+		name := yarnBerryPackageName(key, entry.Resolution)
+		if name == "" || entry.Version == "" || parsedPackages.Has(name+"@"+entry.Version) {
+			continue
+		}
+		parsedPackages.Add(name + "@" + entry.Version)
+
+		pkgs = append(pkgs, newYarnLockPackage(a.cfg, resolver, location, name, entry.Version, entry.Resolution, entry.Checksum, yarnDependencyKind(directDependencies, key)))
+	}
+
+	pkg.Sort(pkgs)
+
+	return pkgs, nil
+}
+
+// yarnBerryPackageName returns the name of the package for a yarn berry lock entry. The name is taken from the
+// resolution for packages resolved from the registry, since the descriptors of aliased packages use the alias (e.g.
+// "string-width-cjs@npm:string-width@^4.2.0" resolves "string-width@npm:4.2.3"), otherwise from the first descriptor.
+func yarnBerryPackageName(key, resolution string) string {
+	if name, _, ok := cutYarnBerryDescriptor(resolution); ok && strings.HasPrefix(resolution[len(name)+1:], "npm:") {
+		return name
+	}
+	name, _, _ := cutYarnBerryDescriptor(strings.TrimSpace(strings.Split(key, ",")[0]))
+	return name
+}
+
+// cutYarnBerryDescriptor splits a descriptor (or resolution) such as "@babel/core@npm:7.23.0" into the name and the
+// range (or reference), where the "@" of a scope is not a separator.
+func cutYarnBerryDescriptor(descriptor string) (string, string, bool) {
+	descriptor = strings.Trim(descriptor, `"`)
+	i := strings.Index(strings.TrimPrefix(descriptor, "@"), "@")
+	if i < 0 {
+		return "", "", false
+	}
+	if strings.HasPrefix(descriptor, "@") {
+		i++
+	}
+	return descriptor[:i], descriptor[i+1:], true
+}
+
+// findYarnDirectDependencies returns the dependency specifiers (e.g. "lodash@^4.17.21") declared in the package.json
+// next to the given yarn.lock. A nil set is returned when there is no package.json, in which case packages cannot be
+// classified as direct or transitive.
+func findYarnDirectDependencies(resolver file.Resolver, location file.Location) *strset.Set {
+	if resolver == nil {
+		return nil
+	}
+
+	pkgJSONLocation := resolver.RelativeFileByPath(location, path.Join(path.Dir(location.RealPath), "package.json"))
+	if pkgJSONLocation == nil {
+		return nil
+	}
+
+	contents, err := resolver.FileContentsByLocation(*pkgJSONLocation)
+	if err != nil {
+		log.WithFields("path", pkgJSONLocation.RealPath, "error", err).Debug("unable to read package.json for yarn.lock")
+		return nil
+	}
+	defer internal.CloseAndLogError(contents, pkgJSONLocation.RealPath)
+
+	var p packageJSON
+	if err := json.NewDecoder(contents).Decode(&p); err != nil {
+		log.WithFields("path", pkgJSONLocation.RealPath, "error", err).Debug("unable to parse package.json for yarn.lock")
+		return nil
+	}
+
+	specs := strset.New()
+	for _, deps := range []map[string]string{p.Dependencies, p.DevDependencies, p.OptionalDependencies, p.PeerDependencies} {
+		for name, constraint := range deps {
+			// yarn berry records registry dependencies with an explicit protocol (e.g. "lodash@npm:^4.17.21")
+			specs.Add(name+"@"+constraint, name+"@npm:"+constraint)
+		}
+	}
+	return specs
+}
+
+// yarnDependencyKind classifies the yarn.lock entry with the given header line (e.g. `"lodash@^4.17.20", "lodash@^4.17.21":`)
+// as a direct dependency if any of the specifiers it resolves were declared in the package.json.
+func yarnDependencyKind(directDependencies *strset.Set, header string) pkg.DependencyKind {
+	if directDependencies == nil {
+		return ""
+	}
+	for _, spec := range strings.Split(strings.TrimSuffix(strings.TrimSpace(header), ":"), ",") {
+		if directDependencies.Has(strings.Trim(strings.TrimSpace(spec), `"`)) {
+			return pkg.DirectDependency
+		}
+	}
+	return pkg.TransitiveDependency
+}
+
 func findPackageName(line string) string {
 	if matches := packageNameExp.FindStringSubmatch(line); len(matches) >= 2 {
 		return matches[1]
@@ -28,7 +28,10 @@ func TestParseYarnBerry(t *testing.T) {
 			PURL:      "pkg:npm/%40babel/code-frame@7.10.4",
 			Language:  pkg.JavaScript,
 			Type:      pkg.NpmPkg,
-			Metadata:  pkg.YarnLockEntry{},
+			Metadata: pkg.YarnLockEntry{
+				Resolved:  "@babel/code-frame@npm:7.10.4",
+				Integrity: "feb4543c8a509fe30f0f6e8d7aa84f82b41148b963b826cd330e34986f649a85cb63b2f13dd4effdf434ac555d16f14940b8ea5f4433297c2f5ff85486ded019",
+			},
 		},
 		{
 			Name:      "@types/minimatch",
@@ -37,7 +40,10 @@ func TestParseYarnBerry(t *testing.T) {
 			PURL:      "pkg:npm/%40types/minimatch@3.0.3",
 			Language:  pkg.JavaScript,
 			Type:      pkg.NpmPkg,
-			Metadata:  pkg.YarnLockEntry{},
+			Metadata: pkg.YarnLockEntry{
+				Resolved:  "@types/minimatch@npm:3.0.3",
+				Integrity: "b80259d55b96ef24cb3bb961b6dc18b943f2bb8838b4d8e7bead204f3173e551a416ffa49f9aaf1dc431277fffe36214118628eacf4aea20119df8835229901b",
+			},
 		},
 		{
 			Name:      "@types/qs",
@@ -46,7 +52,10 @@ func TestParseYarnBerry(t *testing.T) {
 			PURL:      "pkg:npm/%40types/qs@6.9.4",
 			Language:  pkg.JavaScript,
 			Type:      pkg.NpmPkg,
-			Metadata:  pkg.YarnLockEntry{},
+			Metadata: pkg.YarnLockEntry{
+				Resolved:  "@types/qs@npm:6.9.4",
+				Integrity: "77e509ed213f7694ae35f84a58b88da8744aad019e93556af6aeab4289287abbe71836c051d00649dbac0289ea199e408442590cfb1785009de11c3c8d0cbbea",
+			},
 		},
 		{
 			Name:      "ajv",
@@ -55,7 +64,10 @@ func TestParseYarnBerry(t *testing.T) {
 			PURL:      "pkg:npm/ajv@6.12.3",
 			Language:  pkg.JavaScript,
 			Type:      pkg.NpmPkg,
-			Metadata:  pkg.YarnLockEntry{},
+			Metadata: pkg.YarnLockEntry{
+				Resolved:  "ajv@npm:6.12.3",
+				Integrity: "ca559d34710e6969d33bc1316282e1ece4d4d99ff5fdca4bfe31947740f8f90e7824238cdc2954e499cf75b2432e3e6c56b32814ebe04fccf8abcc3fbf36b348",
+			},
 		},
 		{
 			Name:      "asn1.js",
@@ -64,7 +76,10 @@ func TestParseYarnBerry(t *testing.T) {
 			PURL:      "pkg:npm/asn1.js@4.10.1",
 			Language:  pkg.JavaScript,
 			Type:      pkg.NpmPkg,
-			Metadata:  pkg.YarnLockEntry{},
+			Metadata: pkg.YarnLockEntry{
+				Resolved:  "asn1.js@npm:4.10.1",
+				Integrity: "9289a1a55401238755e3142511d7b8f6fc32f08c86ff68bd7100da8b6c186179dd6b14234fba2f7f6099afcd6758a816708485efe44bc5b2a6ec87d9ceeddbb5",
+			},
 		},
 		{
 			Name:      "atob",
@@ -73,7 +88,10 @@ func TestParseYarnBerry(t *testing.T) {
 			PURL:      "pkg:npm/atob@2.1.2",
 			Language:  pkg.JavaScript,
 			Type:      pkg.NpmPkg,
-			Metadata:  pkg.YarnLockEntry{},
+			Metadata: pkg.YarnLockEntry{
+				Resolved:  "atob@npm:2.1.2",
+				Integrity: "dfeeeb70090c5ebea7be4b9f787f866686c645d9f39a0d184c817252d0cf08455ed25267d79c03254d3be1f03ac399992a792edcd5ffb9c91e097ab5ef42833a",
+			},
 		},
 		{
 			Name:      "aws-sdk",
@@ -82,16 +100,10 @@ func TestParseYarnBerry(t *testing.T) {
 			Locations: locations,
 			Language:  pkg.JavaScript,
 			Type:      pkg.NpmPkg,
-			Metadata:  pkg.YarnLockEntry{},
-		},
-		{
-			Name:      "c0n-fab_u.laTION",
-			Version:   "7.7.7",
-			Locations: locations,
-			PURL:      "pkg:npm/c0n-fab_u.laTION@7.7.7",
-			Language:  pkg.JavaScript,
-			Type:      pkg.NpmPkg,
-			Metadata:  pkg.YarnLockEntry{},
+			Metadata: pkg.YarnLockEntry{
+				Resolved:  "aws-sdk@npm:2.706.0",
+				Integrity: "bf8ca2fc4f758bdebd04051ec15729affad3eb0e18eed4ae41db5b7d6ff2aed2cf3a12ae082c11b955df0125378c57b8406e1f91006e48f0c162fdbe4ee4e330",
+			},
 		},
 		{
 			Name:      "jhipster-core",
@@ -100,7 +112,10 @@ func TestParseYarnBerry(t *testing.T) {
 			PURL:      "pkg:npm/jhipster-core@7.3.4",
 			Language:  pkg.JavaScript,
 			Type:      pkg.NpmPkg,
-			Metadata:  pkg.YarnLockEntry{},
+			Metadata: pkg.YarnLockEntry{
+				Resolved:  "jhipster-core@npm:7.3.4",
+				Integrity: "6a97741d574a42a138f98596c668370b41ec8870335bcd758b6b890e279ba30d4d2be447f8cecbf416286f2c53636b406a63a773c7b00709c95af0a9a3f9b397",
+			},
 		},
 	}
 
@@ -108,6 +123,95 @@ func TestParseYarnBerry(t *testing.T) {
 	pkgtest.TestFileParser(t, fixture, adapter.parseYarnLock, expectedPkgs, expectedRelationships)
 }
 
+func TestParseYarnBerry_Protocols(t *testing.T) {
+	locations := file.NewLocationSet(file.NewLocation("yarn.lock"))
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "@types/node",
+			Version:   "20.10.4",
+			FoundBy:   "javascript-lock-cataloger",
+			Locations: locations,
+			PURL:      "pkg:npm/%40types/node@20.10.4",
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.YarnLockEntry{
+				Resolved:       "@types/node@npm:20.10.4",
+				Integrity:      "10c0/2c8b70cba731eb2ae3ae046daa74903bfcbb0e7b9196da767e5895054f6d252296ae7a04fb1dbbcb53bb004c4c658c05eaea2731bc9e2dd9e08f7e88d672f563",
+				DependencyKind: pkg.DirectDependency,
+			},
+		},
+		{
+			Name:      "lodash",
+			Version:   "4.17.21",
+			FoundBy:   "javascript-lock-cataloger",
+			Locations: locations,
+			PURL:      "pkg:npm/lodash@4.17.21",
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.YarnLockEntry{
+				Resolved:       "lodash@npm:4.17.21",
+				Integrity:      "10c0/d8cbea072bb08655bb4c989da418994b073a608dffa608b09ac04b43a791b12aeae7cd7ad919aa4c925f33b48490b5cfe6c1f71d827956071dae2e7bb3a6b74c",
+				DependencyKind: pkg.DirectDependency,
+			},
+		},
+		{
+			// note: the patched package (a builtin patch applied by yarn) is the same package
+			Name:      "resolve",
+			Version:   "1.22.8",
+			FoundBy:   "javascript-lock-cataloger",
+			Locations: locations,
+			PURL:      "pkg:npm/resolve@1.22.8",
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.YarnLockEntry{
+				Resolved:       "resolve@npm:1.22.8",
+				Integrity:      "10c0/07e179f4375e1fd072cfb72ad66d78547f86e6196c4014b31cb0b8bb1db5f7ca871f922d08da0fbc05b94e9fd42206f819648fa3b5b873ebbc8e1dc68fec433a",
+				DependencyKind: pkg.TransitiveDependency,
+			},
+		},
+		{
+			// note: aliased as string-width-cjs within the package.json
+			Name:      "string-width",
+			Version:   "4.2.3",
+			FoundBy:   "javascript-lock-cataloger",
+			Locations: locations,
+			PURL:      "pkg:npm/string-width@4.2.3",
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.YarnLockEntry{
+				Resolved:       "string-width@npm:4.2.3",
+				Integrity:      "10c0/1e525e92e5eae0afd7454086eed9c818ee84374bb80328fc41217ae72ff5f065ef1c9d7f72da41de40c75fa8bb3dee63d92373fd492c84260a552c636392a47b",
+				DependencyKind: pkg.DirectDependency,
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/yarn-berry-protocols").
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewLockCataloger(CatalogerConfig{}))
+}
+
+func Test_yarnBerryPackageName(t *testing.T) {
+	tests := []struct {
+		key        string
+		resolution string
+		expected   string
+	}{
+		{key: "lodash@npm:^4.17.21", resolution: "lodash@npm:4.17.21", expected: "lodash"},
+		{key: "@babel/core@npm:^7.23.0, @babel/core@npm:^7.23.5", resolution: "@babel/core@npm:7.23.5", expected: "@babel/core"},
+		{key: "string-width-cjs@npm:string-width@^4.2.0", resolution: "string-width@npm:4.2.3", expected: "string-width"},
+		{key: "resolve@patch:resolve@npm%3A^1.22.4#optional!builtin<compat/resolve>", resolution: "resolve@patch:resolve@npm%3A1.22.8#optional!builtin<compat/resolve>::version=1.22.8&hash=c3c19d", expected: "resolve"},
+		{key: "my-lib@https://github.com/owner/my-lib.git#commit=abc", resolution: "my-lib@https://github.com/owner/my-lib.git#commit=abc", expected: "my-lib"},
+		{key: "invalid", resolution: "", expected: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.key, func(t *testing.T) {
+			assert.Equal(t, test.expected, yarnBerryPackageName(test.key, test.resolution))
+		})
+	}
+}
+
 func TestParseYarnLock(t *testing.T) {
 	var expectedRelationships []artifact.Relationship
 	fixture := "test-fixtures/yarn/yarn.lock"
@@ -234,6 +338,59 @@ type handlerPath struct {
 	handler func(w http.ResponseWriter, r *http.Request)
 }
 
+func TestParseYarnLock_DependencyKind(t *testing.T) {
+	locations := file.NewLocationSet(file.NewLocation("yarn.lock"))
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "debug",
+			Version:   "4.3.4",
+			FoundBy:   "javascript-lock-cataloger",
+			Locations: locations,
+			PURL:      "pkg:npm/debug@4.3.4",
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.YarnLockEntry{
+				Resolved:       "https://registry.yarnpkg.com/debug/-/debug-4.3.4.tgz#1319f6579357f2338d3337d2cdd4914bb5dcc865",
+				Integrity:      "sha512-PRWFHuSU3eDtQJPvnNY7Jcket1j0t5OuOsFzPPzsekD52Zl8qUfFIPEiswXqIvHWGVHOgX+7G/vCNNhehwxfkQ==",
+				DependencyKind: pkg.DirectDependency,
+			},
+		},
+		{
+			Name:      "ms",
+			Version:   "2.1.2",
+			FoundBy:   "javascript-lock-cataloger",
+			Locations: locations,
+			PURL:      "pkg:npm/ms@2.1.2",
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.YarnLockEntry{
+				Resolved:       "https://registry.yarnpkg.com/ms/-/ms-2.1.2.tgz#d09d1f357b443f493382a8eb3ccd183872ae6009",
+				Integrity:      "sha512-6FlzubTLZG3J2a/NVCAleEhjzq5oxgHyaCU9yYXvcLsvoVaHJq/s5xXI6/XXP6tz7R9xAOtHnSO/tXtF3WRTlA==",
+				DependencyKind: pkg.TransitiveDependency,
+			},
+		},
+		{
+			Name:      "ms",
+			Version:   "2.1.3",
+			FoundBy:   "javascript-lock-cataloger",
+			Locations: locations,
+			PURL:      "pkg:npm/ms@2.1.3",
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.YarnLockEntry{
+				Resolved:       "https://registry.yarnpkg.com/ms/-/ms-2.1.3.tgz#574c8138ce1d2b5861f0b44579dbadd60c6615b2",
+				Integrity:      "sha512-6FlzubTLZG3J2a/NVCAleEhjzq5oxgHyaCU9yYXvcLsvoVaHJq/s5xXI6/XXP6tz7R9xAOtHnSO/tXtF3WRTlA==",
+				DependencyKind: pkg.DirectDependency,
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/yarn-dependency-kind").
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewLockCataloger(CatalogerConfig{}))
+}
+
 func TestSearchYarnForLicenses(t *testing.T) {
 	fixture := "test-fixtures/yarn-remote/yarn.lock"
 	locations := file.NewLocationSet(file.NewLocation(fixture))
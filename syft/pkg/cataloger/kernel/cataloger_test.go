@@ -3,6 +3,8 @@ package kernel
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp/cmpopts"
+
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
@@ -66,6 +68,12 @@ func Test_KernelCataloger(t *testing.T) {
 			KernelVersion: "6.0.7-301.fc37.x86_64",
 			VersionMagic:  "6.0.7-301.fc37.x86_64 SMP preempt mod_unload ",
 			Parameters:    map[string]pkg.LinuxKernelModuleParameter{},
+			Signed:        true,
+			Signature: &pkg.LinuxKernelModuleSignature{
+				Type:          "PKCS#7",
+				Signer:        "Fedora kernel signing key",
+				HashAlgorithm: "sha256",
+			},
 		},
 	}
 
@@ -84,6 +92,8 @@ func Test_KernelCataloger(t *testing.T) {
 	pkgtest.NewCatalogTester().
 		WithImageResolver(t, "image-kernel-and-modules").
 		IgnoreLocationLayer().
+		// the signing key is generated for each build of the kernel
+		WithCompareOptions(cmpopts.IgnoreFields(pkg.LinuxKernelModuleSignature{}, "KeyID")).
 		Expects(expectedPkgs, expectedRelationships).
 		TestCataloger(t,
 			NewLinuxKernelCataloger(
@@ -0,0 +1,178 @@
+package kernel
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/mholt/archiver/v3"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/internal/unionreader"
+)
+
+// linuxBannerPrefix starts the banner embedded within every kernel image (linux_banner within init/version.c), for
+// example: "Linux version 6.0.7-301.fc37.x86_64 (mockbuild@bkernel01.iad2.fedoraproject.org) (gcc (GCC) 12.2.1 ...) #1 SMP PREEMPT_DYNAMIC Fri Nov 4 18:35:48 UTC 2022"
+var linuxBannerPrefix = []byte("Linux version ")
+
+const (
+	// maxLinuxBannerLength bounds the length of the banner (after the prefix).
+	maxLinuxBannerLength = 512
+
+	// maxDecompressedKernelSize bounds how much of a compressed kernel is decompressed while looking for the banner.
+	maxDecompressedKernelSize = 512 * 1024 * 1024
+
+	// maxKernelPayloadCandidates bounds how many possible compressed payloads are tried, since the magic of a
+	// compression format may also appear by chance within the decompressor of a self-extracting kernel.
+	maxKernelPayloadCandidates = 16
+)
+
+// kernelPayloadFormats are the compression formats of the payload of self-extracting kernel images (vmlinuz), keyed
+// by the magic starting the compressed data.
+var kernelPayloadFormats = []struct {
+	magic        []byte
+	decompressor func() archiver.Decompressor
+}{
+	{magic: []byte{0x1f, 0x8b, 0x08}, decompressor: func() archiver.Decompressor { return &archiver.Gz{} }},
+	{magic: []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}, decompressor: func() archiver.Decompressor { return &archiver.Xz{} }},
+	{magic: []byte{0x28, 0xb5, 0x2f, 0xfd}, decompressor: func() archiver.Decompressor { return &archiver.Zstd{} }},
+	{magic: []byte{0x02, 0x21, 0x4c, 0x18}, decompressor: func() archiver.Decompressor { return &archiver.Lz4{} }},
+}
+
+var (
+	errLinuxBannerFound      = errors.New("linux banner found")
+	errKernelPayloadTooLarge = errors.New("kernel payload is too large")
+)
+
+// findLinuxBanner returns the version banner of the given kernel image (without the "Linux version " prefix), which is
+// searched for within the image itself (e.g. an uncompressed vmlinux or arm64 Image) and otherwise within the
+// compressed payload of a self-extracting image (gzip, xz, zstd, or lz4).
+func findLinuxBanner(r unionreader.UnionReader) string {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil || size <= 0 {
+		return ""
+	}
+
+	if banner := scanForLinuxBanner(io.NewSectionReader(r, 0, size)); banner != "" {
+		return banner
+	}
+
+	for _, offset := range kernelPayloadOffsets(io.NewSectionReader(r, 0, size)) {
+		for _, format := range kernelPayloadFormats {
+			magic := make([]byte, len(format.magic))
+			if _, err := r.ReadAt(magic, offset); err != nil || !bytes.Equal(magic, format.magic) {
+				continue
+			}
+
+			w := &linuxBannerWriter{}
+			err := format.decompressor().Decompress(io.NewSectionReader(r, offset, size-offset), w)
+			if w.banner != "" {
+				return w.banner
+			}
+			if err != nil && !errors.Is(err, errKernelPayloadTooLarge) {
+				log.WithFields("offset", offset, "error", err).Trace("unable to decompress possible kernel payload")
+			}
+		}
+	}
+	return ""
+}
+
+// kernelPayloadOffsets returns the offsets of the data that may be the compressed payload of a self-extracting kernel.
+func kernelPayloadOffsets(r io.Reader) []int64 {
+	var offsets []int64
+	w := &magicOffsetWriter{
+		onMatch: func(offset int64) bool {
+			offsets = append(offsets, offset)
+			return len(offsets) < maxKernelPayloadCandidates
+		},
+	}
+	_, _ = io.Copy(w, r)
+	return offsets
+}
+
+func scanForLinuxBanner(r io.Reader) string {
+	w := &linuxBannerWriter{}
+	_, _ = io.Copy(w, r)
+	return w.banner
+}
+
+// linuxBannerWriter looks for the linux banner within the data written, failing with errLinuxBannerFound once found
+// (to stop reading or decompressing any further).
+type linuxBannerWriter struct {
+	window  []byte
+	written int64
+	banner  string
+}
+
+func (w *linuxBannerWriter) Write(p []byte) (int, error) {
+	w.written += int64(len(p))
+	w.window = append(w.window, p...)
+
+	for start := 0; ; {
+		idx := bytes.Index(w.window[start:], linuxBannerPrefix)
+		if idx < 0 {
+			break
+		}
+		idx += start
+		rest := w.window[idx+len(linuxBannerPrefix):]
+		end := bytes.IndexAny(rest, "\n\x00")
+		if end < 0 && len(rest) < maxLinuxBannerLength {
+			// the banner may continue within the next write
+			w.window = w.window[idx:]
+			return len(p), nil
+		}
+		if end > 0 && end <= maxLinuxBannerLength && rest[0] >= '0' && rest[0] <= '9' {
+			w.banner = string(rest[:end])
+			return len(p), errLinuxBannerFound
+		}
+		start = idx + len(linuxBannerPrefix)
+	}
+
+	// keep enough of the data to find a prefix split across writes
+	if keep := len(linuxBannerPrefix) - 1; len(w.window) > keep {
+		w.window = append(w.window[:0], w.window[len(w.window)-keep:]...)
+	}
+
+	if w.written > maxDecompressedKernelSize {
+		return len(p), errKernelPayloadTooLarge
+	}
+	return len(p), nil
+}
+
+// magicOffsetWriter reports the offsets of the magic of the kernel payload formats within the data written, until
+// onMatch returns false.
+type magicOffsetWriter struct {
+	window  []byte
+	offset  int64 // the offset of the start of the window
+	onMatch func(offset int64) bool
+	done    bool
+}
+
+const maxPayloadMagicLength = 6
+
+func (w *magicOffsetWriter) Write(p []byte) (int, error) {
+	if w.done {
+		return 0, io.EOF
+	}
+	w.window = append(w.window, p...)
+
+	for i := 0; i+maxPayloadMagicLength <= len(w.window); i++ {
+		for _, format := range kernelPayloadFormats {
+			if !bytes.HasPrefix(w.window[i:], format.magic) {
+				continue
+			}
+			if !w.onMatch(w.offset + int64(i)) {
+				w.done = true
+				return len(p), io.EOF
+			}
+			break
+		}
+	}
+
+	// keep the bytes which have not yet been checked (since a magic may be split across writes)
+	if keep := maxPayloadMagicLength - 1; len(w.window) > keep {
+		w.offset += int64(len(w.window) - keep)
+		w.window = append(w.window[:0], w.window[len(w.window)-keep:]...)
+	}
+	return len(p), nil
+}
@@ -0,0 +1,181 @@
+package kernel
+
+import (
+	"bytes"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// moduleSignatureMagic is the marker at the very end of a signed kernel module, which follows the signature and the
+// module_signature trailer describing it (see scripts/sign-file.c within the kernel source).
+var moduleSignatureMagic = []byte("~Module signature appended~\n")
+
+const (
+	// moduleSignatureTrailerSize is the size of struct module_signature: algo, hash, id_type, signer_len, key_id_len,
+	// 3 bytes of padding, and the length of the signature (big endian).
+	moduleSignatureTrailerSize = 12
+
+	// moduleSignatureIDTypePKCS7 is the only signature type produced (and accepted) by recent kernels.
+	moduleSignatureIDTypePKCS7 = 2
+
+	// maxModuleSignatureSize bounds the signature read, which is typically well under a kilobyte.
+	maxModuleSignatureSize = 64 * 1024
+)
+
+var digestAlgorithmNames = map[string]string{
+	"1.3.14.3.2.26":           "sha1",
+	"2.16.840.1.101.3.4.2.4":  "sha224",
+	"2.16.840.1.101.3.4.2.1":  "sha256",
+	"2.16.840.1.101.3.4.2.2":  "sha384",
+	"2.16.840.1.101.3.4.2.3":  "sha512",
+	"2.16.840.1.101.3.4.2.8":  "sha3-256",
+	"2.16.840.1.101.3.4.2.9":  "sha3-384",
+	"2.16.840.1.101.3.4.2.10": "sha3-512",
+	"1.2.156.10197.1.401":     "sm3",
+}
+
+type pkcs7ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type pkcs7SignedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	ContentInfo      asn1.RawValue
+	Certificates     asn1.RawValue     `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue     `asn1:"optional,tag:1"`
+	SignerInfos      []pkcs7SignerInfo `asn1:"set"`
+}
+
+type pkcs7SignerInfo struct {
+	Version                   int
+	SignerIdentifier          asn1.RawValue
+	DigestAlgorithm           pkix.AlgorithmIdentifier
+	AuthenticatedAttributes   asn1.RawValue `asn1:"optional,tag:0"`
+	DigestEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedDigest           []byte
+}
+
+type pkcs7IssuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+// parseLinuxKernelModuleSignature returns the signature appended to the given kernel module and whether the module is
+// signed at all. A signature that cannot be parsed is reported as an error (while the module is reported as signed).
+func parseLinuxKernelModuleSignature(r unionreader.UnionReader) (*pkg.LinuxKernelModuleSignature, bool, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to determine the size of the module: %w", err)
+	}
+	footerSize := int64(moduleSignatureTrailerSize + len(moduleSignatureMagic))
+	if size < footerSize {
+		return nil, false, nil
+	}
+
+	footer := make([]byte, footerSize)
+	if _, err := r.ReadAt(footer, size-footerSize); err != nil {
+		return nil, false, fmt.Errorf("unable to read the module signature trailer: %w", err)
+	}
+	if !bytes.Equal(footer[moduleSignatureTrailerSize:], moduleSignatureMagic) {
+		return nil, false, nil
+	}
+
+	trailer := footer[:moduleSignatureTrailerSize]
+	if trailer[2] != moduleSignatureIDTypePKCS7 {
+		return nil, true, fmt.Errorf("unsupported module signature type: %d", trailer[2])
+	}
+	sigLen := int64(binary.BigEndian.Uint32(trailer[8:]))
+	if sigLen == 0 || sigLen > maxModuleSignatureSize || sigLen > size-footerSize {
+		return nil, true, fmt.Errorf("invalid module signature length: %d", sigLen)
+	}
+
+	sig := make([]byte, sigLen)
+	if _, err := r.ReadAt(sig, size-footerSize-sigLen); err != nil {
+		return nil, true, fmt.Errorf("unable to read the module signature: %w", err)
+	}
+
+	signature, err := parsePKCS7ModuleSignature(sig)
+	if err != nil {
+		return nil, true, err
+	}
+	return signature, true, nil
+}
+
+// parsePKCS7ModuleSignature returns the signer information of the (detached) PKCS#7 signature of a kernel module,
+// which does not include certificates: the signer is only identified by the certificate issuer and serial number
+// (or by the subject key identifier).
+func parsePKCS7ModuleSignature(der []byte) (*pkg.LinuxKernelModuleSignature, error) {
+	var ci pkcs7ContentInfo
+	if _, err := asn1.Unmarshal(der, &ci); err != nil {
+		return nil, fmt.Errorf("unable to parse module signature: %w", err)
+	}
+	var sd pkcs7SignedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &sd); err != nil {
+		return nil, fmt.Errorf("unable to parse module signature signed data: %w", err)
+	}
+	if len(sd.SignerInfos) == 0 {
+		return nil, fmt.Errorf("module signature has no signer")
+	}
+
+	si := sd.SignerInfos[0]
+	signature := &pkg.LinuxKernelModuleSignature{
+		Type:          "PKCS#7",
+		HashAlgorithm: digestAlgorithmName(si.DigestAlgorithm.Algorithm),
+	}
+
+	switch {
+	case si.SignerIdentifier.Class == asn1.ClassContextSpecific && si.SignerIdentifier.Tag == 0:
+		// subjectKeyIdentifier [0]
+		signature.KeyID = colonHex(si.SignerIdentifier.Bytes)
+	default:
+		var ias pkcs7IssuerAndSerialNumber
+		if _, err := asn1.Unmarshal(si.SignerIdentifier.FullBytes, &ias); err != nil {
+			return nil, fmt.Errorf("unable to parse module signature signer: %w", err)
+		}
+		signature.Signer = issuerName(ias.Issuer.FullBytes)
+		if ias.SerialNumber != nil {
+			signature.KeyID = colonHex(ias.SerialNumber.Bytes())
+		}
+	}
+
+	return signature, nil
+}
+
+// issuerName returns the common name of the given issuer (falling back to the organization), as shown by modinfo.
+func issuerName(der []byte) string {
+	var rdns pkix.RDNSequence
+	if _, err := asn1.Unmarshal(der, &rdns); err != nil {
+		return ""
+	}
+	var name pkix.Name
+	name.FillFromRDNSequence(&rdns)
+	if name.CommonName != "" {
+		return name.CommonName
+	}
+	return strings.Join(name.Organization, ", ")
+}
+
+func digestAlgorithmName(oid asn1.ObjectIdentifier) string {
+	if name, ok := digestAlgorithmNames[oid.String()]; ok {
+		return name
+	}
+	return oid.String()
+}
+
+func colonHex(b []byte) string {
+	parts := make([]string, len(b))
+	for i, v := range b {
+		parts[i] = fmt.Sprintf("%02X", v)
+	}
+	return strings.Join(parts, ":")
+}
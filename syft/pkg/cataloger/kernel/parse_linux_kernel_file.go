@@ -27,10 +27,18 @@ func parseLinuxKernelFile(_ context.Context, _ file.Resolver, _ *generic.Environ
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to get magic type for file: %w", err)
 	}
-	if len(magicType) < 1 || magicType[0] != linuxKernelMagicName {
-		return nil, nil, nil
+	var metadata pkg.LinuxKernel
+	if len(magicType) > 0 && magicType[0] == linuxKernelMagicName {
+		metadata = parseLinuxKernelMetadata(magicType)
+	}
+	if metadata.Version == "" {
+		// the version is not within the boot header for all kernel images (e.g. an uncompressed vmlinux, or most
+		// non-x86 images), so fall back to the version banner within the kernel itself
+		if banner := findLinuxBanner(unionReader); banner != "" {
+			metadata.ExtendedVersion = banner
+			metadata.Version = strings.Fields(banner)[0]
+		}
 	}
-	metadata := parseLinuxKernelMetadata(magicType)
 	if metadata.Version == "" {
 		return nil, nil, nil
 	}
@@ -0,0 +1,72 @@
+package kernel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_parseLinuxKernelFile_Banner(t *testing.T) {
+	const extendedVersion = "6.8.0-31-generic (buildd@lcy02-amd64-080) (x86_64-linux-gnu-gcc-13 (Ubuntu 13.2.0-23ubuntu4) 13.2.0, GNU ld (GNU Binutils for Ubuntu) 2.42) #31-Ubuntu SMP PREEMPT_DYNAMIC Sat Apr 20 00:40:06 UTC 2024"
+
+	tests := []struct {
+		name    string
+		fixture string
+		found   bool
+	}{
+		{
+			name:    "uncompressed kernel",
+			fixture: "test-fixtures/kernels/vmlinux",
+			found:   true,
+		},
+		{
+			name:    "gzip compressed kernel",
+			fixture: "test-fixtures/kernels/vmlinuz-gzip",
+			found:   true,
+		},
+		{
+			name:    "xz compressed kernel",
+			fixture: "test-fixtures/kernels/vmlinuz-xz",
+			found:   true,
+		},
+		{
+			name:    "no banner",
+			fixture: "test-fixtures/kernels/vmlinuz-none",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var expected []pkg.Package
+			if test.found {
+				expected = append(expected, pkg.Package{
+					Name:      "linux-kernel",
+					Version:   "6.8.0-31-generic",
+					Locations: file.NewLocationSet(file.NewLocation(test.fixture)),
+					PURL:      "pkg:generic/linux-kernel@6.8.0-31-generic",
+					Type:      pkg.LinuxKernelPkg,
+					Metadata: pkg.LinuxKernel{
+						Version:         "6.8.0-31-generic",
+						ExtendedVersion: extendedVersion,
+					},
+				})
+			}
+			pkgtest.TestFileParser(t, test.fixture, parseLinuxKernelFile, expected, nil)
+		})
+	}
+}
+
+func Test_linuxBannerWriter_SplitWrites(t *testing.T) {
+	data := []byte("junk Linux version %s\x00 more junk Linux version 6.8.0-31-generic (buildd@lcy02-amd64-080) #31-Ubuntu SMP\n trailing")
+
+	w := &linuxBannerWriter{}
+	for i := range data {
+		if _, err := w.Write(data[i : i+1]); err != nil {
+			break
+		}
+	}
+	assert.Equal(t, "6.8.0-31-generic (buildd@lcy02-amd64-080) #31-Ubuntu SMP", w.banner)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/internal/unionreader"
@@ -30,6 +31,11 @@ func parseLinuxKernelModuleFile(_ context.Context, _ file.Resolver, _ *generic.E
 
 	metadata.Path = reader.Location.RealPath
 
+	metadata.Signature, metadata.Signed, err = parseLinuxKernelModuleSignature(unionReader)
+	if err != nil {
+		log.WithFields("path", reader.RealPath, "error", err).Debug("unable to read kernel module signature")
+	}
+
 	return []pkg.Package{
 		newLinuxKernelModulePackage(
 			*metadata,
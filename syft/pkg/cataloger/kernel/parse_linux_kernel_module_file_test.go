@@ -0,0 +1,55 @@
+package kernel
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_parseLinuxKernelModuleFile_Signature(t *testing.T) {
+	tests := []struct {
+		fixture   string
+		signed    bool
+		signature *pkg.LinuxKernelModuleSignature
+	}{
+		{
+			fixture: "test-fixtures/modules/unsigned.ko",
+		},
+		{
+			fixture: "test-fixtures/modules/signed.ko",
+			signed:  true,
+			signature: &pkg.LinuxKernelModuleSignature{
+				Type:          "PKCS#7",
+				Signer:        "Build time autogenerated kernel key",
+				KeyID:         "49:A9:55:87:90:5B:33:41:AF:C0:A7:BE:2A:71:6C:D2:CA:34:E0:AE",
+				HashAlgorithm: "sha512",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			location := file.NewLocation(test.fixture)
+			expected := pkg.Package{
+				Name:      "example",
+				Locations: file.NewLocationSet(location),
+				Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("GPL", location)),
+				Type:      pkg.LinuxKernelModulePkg,
+				PURL:      "pkg:generic/example",
+				Metadata: pkg.LinuxKernelModule{
+					Name:          "example",
+					Path:          test.fixture,
+					Description:   "Example module",
+					License:       "GPL",
+					KernelVersion: "6.8.0-31-generic",
+					VersionMagic:  "6.8.0-31-generic SMP preempt mod_unload modversions ",
+					Parameters:    map[string]pkg.LinuxKernelModuleParameter{},
+					Signed:        test.signed,
+					Signature:     test.signature,
+				},
+			}
+			pkgtest.TestFileParser(t, test.fixture, parseLinuxKernelModuleFile, []pkg.Package{expected}, nil)
+		})
+	}
+}
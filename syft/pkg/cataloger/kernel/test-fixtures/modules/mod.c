@@ -0,0 +1,15 @@
+// the source of the unsigned.ko and signed.ko fixtures, which only have a .modinfo section:
+//
+//   gcc -c -Os -fno-asynchronous-unwind-tables mod.c -o unsigned.ko
+//   strip --strip-unneeded -R .comment -R .note.GNU-stack unsigned.ko
+//
+// signed.ko is unsigned.ko signed as done by scripts/sign-file (a detached PKCS#7 signature without certificates,
+// created with "openssl cms -sign -binary -noattr -nocerts -nosmimecap -outform DER -md sha512"), followed by the
+// module_signature trailer (id_type=2 and the signature length) and the "~Module signature appended~\n" marker. The
+// signing certificate has the subject "/O=example/CN=Build time autogenerated kernel key" and the serial number
+// 0x49A95587905B3341AFC0A7BE2A716CD2CA34E0AE.
+
+static const char license[] __attribute__((section(".modinfo"), used, aligned(1))) = "license=GPL";
+static const char description[] __attribute__((section(".modinfo"), used, aligned(1))) = "description=Example module";
+static const char name[] __attribute__((section(".modinfo"), used, aligned(1))) = "name=example";
+static const char vermagic[] __attribute__((section(".modinfo"), used, aligned(1))) = "vermagic=6.8.0-31-generic SMP preempt mod_unload modversions ";
@@ -0,0 +1,105 @@
+/*
+Package macos provides concrete Cataloger implementations for packages installed on macOS, either by the system
+installer or as application bundles.
+*/
+package macos
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+const (
+	appBundleGlob      = "**/*.app/Contents/Info.plist"
+	frameworkGlob      = "**/*.app/Contents/Frameworks/**/*.framework/Resources/Info.plist"
+	appBundleExtension = ".app"
+	appInfoPlistPath   = "/Contents/Info.plist"
+	appFrameworksPath  = "/Contents/Frameworks/"
+)
+
+// NewReceiptCataloger returns a new cataloger object initialized for macOS installer receipts.
+func NewReceiptCataloger() pkg.Cataloger {
+	return generic.NewCataloger("macos-receipt-cataloger").
+		WithParserByGlobs(parseReceipt, pkg.MacOSReceiptGlob)
+}
+
+// NewAppBundleCataloger returns a new cataloger object initialized for macOS application bundles (and the frameworks
+// embedded within them), which relates each embedded framework to the application as a dependency.
+func NewAppBundleCataloger() pkg.Cataloger {
+	return &appBundleCataloger{
+		cataloger: generic.NewCataloger("macos-app-bundle-cataloger").
+			WithParserByGlobs(parseAppBundle, appBundleGlob, frameworkGlob),
+	}
+}
+
+type appBundleCataloger struct {
+	cataloger *generic.Cataloger
+}
+
+func (c *appBundleCataloger) Name() string {
+	return c.cataloger.Name()
+}
+
+func (c *appBundleCataloger) Catalog(ctx context.Context, resolver file.Resolver) ([]pkg.Package, []artifact.Relationship, error) {
+	pkgs, relationships, err := c.cataloger.Catalog(ctx, resolver)
+	return pkgs, append(relationships, embeddedFrameworkRelationships(pkgs)...), err
+}
+
+// embeddedFrameworkRelationships relates each framework to the application bundle it is embedded within (the nearest
+// enclosing .app directory), since the application depends on the frameworks it ships with.
+func embeddedFrameworkRelationships(pkgs []pkg.Package) []artifact.Relationship {
+	apps := make(map[string]pkg.Package)
+	for _, p := range pkgs {
+		for _, l := range p.Locations.ToSlice() {
+			if dir, ok := appBundleDir(l.RealPath); ok {
+				apps[dir] = p
+			}
+		}
+	}
+
+	var relationships []artifact.Relationship
+	for _, p := range pkgs {
+		for _, l := range p.Locations.ToSlice() {
+			app, ok := apps[enclosingAppBundleDir(l.RealPath)]
+			if !ok {
+				continue
+			}
+			relationships = append(relationships, artifact.Relationship{
+				From: p,
+				To:   app,
+				Type: artifact.DependencyOfRelationship,
+			})
+			break
+		}
+	}
+
+	sort.SliceStable(relationships, func(i, j int) bool {
+		return relationships[i].From.(pkg.Package).Name < relationships[j].From.(pkg.Package).Name
+	})
+	return relationships
+}
+
+// appBundleDir returns the application bundle directory (e.g. /Applications/Example.app) for the path of the
+// Info.plist of the application.
+func appBundleDir(infoPlistPath string) (string, bool) {
+	if !strings.HasSuffix(infoPlistPath, appBundleExtension+appInfoPlistPath) {
+		return "", false
+	}
+	return strings.TrimSuffix(infoPlistPath, appInfoPlistPath), true
+}
+
+// enclosingAppBundleDir returns the nearest application bundle directory that embeds the framework holding the given
+// Info.plist (e.g. /Applications/Example.app for /Applications/Example.app/Contents/Frameworks/Lib.framework/...).
+func enclosingAppBundleDir(infoPlistPath string) string {
+	i := strings.LastIndex(infoPlistPath, appBundleExtension+appFrameworksPath)
+	if i < 0 {
+		return ""
+	}
+	return infoPlistPath[:i+len(appBundleExtension)]
+}
@@ -0,0 +1,163 @@
+package macos
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestReceiptCataloger(t *testing.T) {
+	binaryLocation := file.NewLocation("var/db/receipts/com.example.tool.plist")
+	xmlLocation := file.NewLocation("var/db/receipts/com.apple.pkg.CLTools_Executables.plist")
+
+	expected := []pkg.Package{
+		{
+			Name:      "com.apple.pkg.CLTools_Executables",
+			Version:   "15.3.0.0.1.1708646388",
+			Type:      pkg.MacOSPkg,
+			FoundBy:   "macos-receipt-cataloger",
+			Locations: file.NewLocationSet(xmlLocation),
+			PURL:      "pkg:generic/com.apple.pkg.CLTools_Executables@15.3.0.0.1.1708646388",
+			Metadata: pkg.MacOSInstallerReceipt{
+				PackageIdentifier:  "com.apple.pkg.CLTools_Executables",
+				PackageVersion:     "15.3.0.0.1.1708646388",
+				PackageFileName:    "CLTools_Executables.pkg",
+				InstallDate:        "2024-04-02T18:00:00Z",
+				InstallPrefixPath:  "/",
+				InstallProcessName: "softwareupdated",
+			},
+		},
+		{
+			Name:      "com.example.tool",
+			Version:   "2.4.1",
+			Type:      pkg.MacOSPkg,
+			FoundBy:   "macos-receipt-cataloger",
+			Locations: file.NewLocationSet(binaryLocation),
+			PURL:      "pkg:generic/com.example.tool@2.4.1",
+			Metadata: pkg.MacOSInstallerReceipt{
+				PackageIdentifier:  "com.example.tool",
+				PackageVersion:     "2.4.1",
+				PackageFileName:    "ExampleTool-2.4.1.pkg",
+				InstallDate:        "2024-03-14T09:26:53Z",
+				InstallPrefixPath:  "/",
+				InstallProcessName: "installer",
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/system").
+		Expects(expected, nil).
+		TestCataloger(t, NewReceiptCataloger())
+}
+
+func TestReceiptCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"var/db/receipts/com.example.tool.plist",
+		}).
+		TestCataloger(t, NewReceiptCataloger())
+}
+
+func TestAppBundleCataloger(t *testing.T) {
+	appLocation := file.NewLocation("Applications/Example.app/Contents/Info.plist")
+	frameworkLocation := file.NewVirtualLocation(
+		"Applications/Example.app/Contents/Frameworks/Sparkle.framework/Versions/B/Resources/Info.plist",
+		"Applications/Example.app/Contents/Frameworks/Sparkle.framework/Resources/Info.plist",
+	)
+	helperLocation := file.NewLocation("Applications/Example.app/Contents/Library/LoginItems/Helper.app/Contents/Info.plist")
+	helperFrameworkLocation := file.NewLocation("Applications/Example.app/Contents/Library/LoginItems/Helper.app/Contents/Frameworks/HelperKit.framework/Resources/Info.plist")
+
+	app := pkg.Package{
+		Name:      "com.example.App",
+		Version:   "3.2.1",
+		Type:      pkg.MacOSAppPkg,
+		FoundBy:   "macos-app-bundle-cataloger",
+		Locations: file.NewLocationSet(appLocation),
+		PURL:      "pkg:generic/com.example.App@3.2.1",
+		Metadata: pkg.MacOSAppBundle{
+			BundleIdentifier:     "com.example.App",
+			BundleName:           "Example",
+			DisplayName:          "Example App",
+			ShortVersion:         "3.2.1",
+			BundleVersion:        "3210",
+			PackageType:          "APPL",
+			Executable:           "Example",
+			MinimumSystemVersion: "11.0",
+		},
+	}
+	framework := pkg.Package{
+		Name:      "org.sparkle-project.Sparkle",
+		Version:   "2.5.2",
+		Type:      pkg.MacOSAppPkg,
+		FoundBy:   "macos-app-bundle-cataloger",
+		Locations: file.NewLocationSet(frameworkLocation),
+		PURL:      "pkg:generic/org.sparkle-project.Sparkle@2.5.2",
+		Metadata: pkg.MacOSAppBundle{
+			BundleIdentifier: "org.sparkle-project.Sparkle",
+			BundleName:       "Sparkle",
+			ShortVersion:     "2.5.2",
+			BundleVersion:    "2050",
+			PackageType:      "FMWK",
+			Executable:       "Sparkle",
+		},
+	}
+	helper := pkg.Package{
+		Name:      "com.example.App.Helper",
+		Version:   "42",
+		Type:      pkg.MacOSAppPkg,
+		FoundBy:   "macos-app-bundle-cataloger",
+		Locations: file.NewLocationSet(helperLocation),
+		PURL:      "pkg:generic/com.example.App.Helper@42",
+		Metadata: pkg.MacOSAppBundle{
+			BundleIdentifier: "com.example.App.Helper",
+			BundleVersion:    "42",
+			PackageType:      "APPL",
+		},
+	}
+	helperFramework := pkg.Package{
+		Name:      "com.example.HelperKit",
+		Version:   "1.0",
+		Type:      pkg.MacOSAppPkg,
+		FoundBy:   "macos-app-bundle-cataloger",
+		Locations: file.NewLocationSet(helperFrameworkLocation),
+		PURL:      "pkg:generic/com.example.HelperKit@1.0",
+		Metadata: pkg.MacOSAppBundle{
+			BundleIdentifier: "com.example.HelperKit",
+			ShortVersion:     "1.0",
+			PackageType:      "FMWK",
+		},
+	}
+
+	expectedRelationships := []artifact.Relationship{
+		{
+			From: helperFramework,
+			To:   helper,
+			Type: artifact.DependencyOfRelationship,
+		},
+		{
+			From: framework,
+			To:   app,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/apps").
+		Expects([]pkg.Package{app, framework, helper, helperFramework}, expectedRelationships).
+		TestCataloger(t, NewAppBundleCataloger())
+}
+
+func TestAppBundleCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"Applications/Tool.app/Contents/Info.plist",
+			"Applications/Tool.app/Contents/Frameworks/Kit.framework/Resources/Info.plist",
+		}).
+		TestCataloger(t, NewAppBundleCataloger())
+}
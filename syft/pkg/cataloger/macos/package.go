@@ -0,0 +1,64 @@
+package macos
+
+import (
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newPackage(r pkg.MacOSInstallerReceipt, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      r.PackageIdentifier,
+		Version:   r.PackageVersion,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(r),
+		Type:      pkg.MacOSPkg,
+		Metadata:  r,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func packageURL(r pkg.MacOSInstallerReceipt) string {
+	return packageurl.NewPackageURL(
+		pkg.MacOSPkg.PackageURLType(),
+		"",
+		r.PackageIdentifier,
+		r.PackageVersion,
+		nil,
+		"",
+	).ToString()
+}
+
+func newAppBundlePackage(b pkg.MacOSAppBundle, locations ...file.Location) pkg.Package {
+	version := b.ShortVersion
+	if version == "" {
+		version = b.BundleVersion
+	}
+
+	p := pkg.Package{
+		Name:      b.BundleIdentifier,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      appBundlePackageURL(b.BundleIdentifier, version),
+		Type:      pkg.MacOSAppPkg,
+		Metadata:  b,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func appBundlePackageURL(name, version string) string {
+	return packageurl.NewPackageURL(
+		pkg.MacOSAppPkg.PackageURLType(),
+		"",
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
@@ -0,0 +1,63 @@
+package macos
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// Info.plist files are small (typically a few KB), anything larger is not something we should be reading
+const maxInfoPlistSize = 4 * 1024 * 1024
+
+var _ generic.Parser = parseAppBundle
+
+// parseAppBundle is a parser function for the Info.plist of macOS application and framework bundles (either XML or
+// binary property lists), returning the bundle as a package.
+func parseAppBundle(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, maxInfoPlistSize+1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read Info.plist: %w", err)
+	}
+	if len(data) > maxInfoPlistSize {
+		return nil, nil, fmt.Errorf("Info.plist exceeds max size of %d bytes", maxInfoPlistSize)
+	}
+
+	value, err := parsePlist(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Info.plist: %w", err)
+	}
+
+	dict, ok := value.(map[string]any)
+	if !ok {
+		return nil, nil, fmt.Errorf("failed to parse Info.plist: %w: expected a dict", errMalformedPlist)
+	}
+
+	bundle := newAppBundle(dict)
+	if bundle.BundleIdentifier == "" {
+		log.WithFields("path", reader.RealPath).Trace("no bundle identifier found in Info.plist")
+		return nil, nil, nil
+	}
+
+	return []pkg.Package{
+		newAppBundlePackage(bundle, reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+	}, nil, nil
+}
+
+func newAppBundle(dict map[string]any) pkg.MacOSAppBundle {
+	return pkg.MacOSAppBundle{
+		BundleIdentifier:     stringValue(dict, "CFBundleIdentifier"),
+		BundleName:           stringValue(dict, "CFBundleName"),
+		DisplayName:          stringValue(dict, "CFBundleDisplayName"),
+		ShortVersion:         stringValue(dict, "CFBundleShortVersionString"),
+		BundleVersion:        stringValue(dict, "CFBundleVersion"),
+		PackageType:          stringValue(dict, "CFBundlePackageType"),
+		Executable:           stringValue(dict, "CFBundleExecutable"),
+		MinimumSystemVersion: stringValue(dict, "LSMinimumSystemVersion"),
+	}
+}
@@ -0,0 +1,70 @@
+package macos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// receipts are small (typically well under a few KB), anything larger is not something we should be reading
+const maxReceiptSize = 4 * 1024 * 1024
+
+var _ generic.Parser = parseReceipt
+
+// parseReceipt is a parser function for macOS installer receipt contents (either XML or binary property lists),
+// returning the package that was installed.
+func parseReceipt(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, maxReceiptSize+1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read macOS receipt: %w", err)
+	}
+	if len(data) > maxReceiptSize {
+		return nil, nil, fmt.Errorf("macOS receipt exceeds max size of %d bytes", maxReceiptSize)
+	}
+
+	value, err := parsePlist(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse macOS receipt: %w", err)
+	}
+
+	dict, ok := value.(map[string]any)
+	if !ok {
+		return nil, nil, fmt.Errorf("failed to parse macOS receipt: %w: expected a dict", errMalformedPlist)
+	}
+
+	receipt := newInstallerReceipt(dict)
+	if receipt.PackageIdentifier == "" {
+		log.WithFields("path", reader.RealPath).Trace("no package identifier found in macOS receipt")
+		return nil, nil, nil
+	}
+
+	return []pkg.Package{
+		newPackage(receipt, reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+	}, nil, nil
+}
+
+func newInstallerReceipt(dict map[string]any) pkg.MacOSInstallerReceipt {
+	receipt := pkg.MacOSInstallerReceipt{
+		PackageIdentifier:  stringValue(dict, "PackageIdentifier"),
+		PackageVersion:     stringValue(dict, "PackageVersion"),
+		PackageFileName:    stringValue(dict, "PackageFileName"),
+		InstallPrefixPath:  stringValue(dict, "InstallPrefixPath"),
+		InstallProcessName: stringValue(dict, "InstallProcessName"),
+	}
+	if installDate, ok := dict["InstallDate"].(time.Time); ok {
+		receipt.InstallDate = installDate.UTC().Format(time.RFC3339)
+	}
+	return receipt
+}
+
+func stringValue(dict map[string]any, key string) string {
+	s, _ := dict[key].(string)
+	return s
+}
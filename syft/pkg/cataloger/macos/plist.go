@@ -0,0 +1,428 @@
+package macos
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+const (
+	binaryPlistMagic       = "bplist00"
+	binaryPlistTrailerSize = 32
+
+	// guard against corrupt or hostile property lists that claim far more objects or nesting than a receipt would hold
+	maxBinaryPlistObjects = 1 << 20
+	maxPlistDepth         = 64
+)
+
+var errMalformedPlist = errors.New("malformed property list")
+
+// appleEpoch is the reference date used by property lists to store dates (2001-01-01T00:00:00Z).
+var appleEpoch = time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// parsePlist decodes an XML or binary property list. Dictionaries are returned as map[string]any, arrays as []any,
+// dates as time.Time, data as []byte, integers as int64, reals as float64, and booleans as bool.
+func parsePlist(data []byte) (any, error) {
+	if bytes.HasPrefix(data, []byte(binaryPlistMagic)) {
+		return parseBinaryPlist(data)
+	}
+	return parseXMLPlist(data)
+}
+
+// XML property lists ///////////////////////////////////////////////////////////////////////////////////////////////
+
+func parseXMLPlist(data []byte) (any, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	// the DOCTYPE references an external DTD, which should never be fetched or processed
+	decoder.Strict = false
+
+	for {
+		tok, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("%w: missing plist element", errMalformedPlist)
+			}
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Local != "plist" {
+			return nil, fmt.Errorf("%w: unexpected root element %q", errMalformedPlist, start.Name.Local)
+		}
+		next, err := nextXMLElement(decoder)
+		if err != nil {
+			return nil, err
+		}
+		if next == nil {
+			// an empty property list
+			return nil, nil
+		}
+		return decodeXMLValue(decoder, *next, 0)
+	}
+}
+
+// nextXMLElement returns the next start element, or nil if the enclosing element ends first.
+func nextXMLElement(decoder *xml.Decoder) (*xml.StartElement, error) {
+	for {
+		tok, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			return &t, nil
+		case xml.EndElement:
+			return nil, nil
+		}
+	}
+}
+
+func decodeXMLValue(decoder *xml.Decoder, start xml.StartElement, depth int) (any, error) {
+	if depth > maxPlistDepth {
+		return nil, fmt.Errorf("%w: exceeded max nesting depth", errMalformedPlist)
+	}
+
+	switch start.Name.Local {
+	case "dict":
+		result := make(map[string]any)
+		for {
+			keyElement, err := nextXMLElement(decoder)
+			if err != nil {
+				return nil, err
+			}
+			if keyElement == nil {
+				return result, nil
+			}
+			if keyElement.Name.Local != "key" {
+				return nil, fmt.Errorf("%w: expected dict key, found %q", errMalformedPlist, keyElement.Name.Local)
+			}
+			var key string
+			if err := decoder.DecodeElement(&key, keyElement); err != nil {
+				return nil, err
+			}
+			valueElement, err := nextXMLElement(decoder)
+			if err != nil {
+				return nil, err
+			}
+			if valueElement == nil {
+				return nil, fmt.Errorf("%w: missing value for dict key %q", errMalformedPlist, key)
+			}
+			value, err := decodeXMLValue(decoder, *valueElement, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = value
+		}
+	case "array":
+		var result []any
+		for {
+			element, err := nextXMLElement(decoder)
+			if err != nil {
+				return nil, err
+			}
+			if element == nil {
+				return result, nil
+			}
+			value, err := decodeXMLValue(decoder, *element, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, value)
+		}
+	case "true", "false":
+		if err := decoder.Skip(); err != nil {
+			return nil, err
+		}
+		return start.Name.Local == "true", nil
+	}
+
+	var text string
+	if err := decoder.DecodeElement(&text, &start); err != nil {
+		return nil, err
+	}
+
+	switch start.Name.Local {
+	case "string":
+		return text, nil
+	case "integer":
+		return strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+	case "real":
+		return strconv.ParseFloat(strings.TrimSpace(text), 64)
+	case "date":
+		return time.Parse(time.RFC3339, strings.TrimSpace(text))
+	case "data":
+		return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(text), ""))
+	}
+
+	return nil, fmt.Errorf("%w: unsupported element %q", errMalformedPlist, start.Name.Local)
+}
+
+// binary property lists ////////////////////////////////////////////////////////////////////////////////////////////
+
+type binaryPlist struct {
+	data          []byte
+	offsets       []uint64
+	objectRefSize int
+
+	// decoded is the number of objects visited so far, since shared references could otherwise expand exponentially
+	decoded int
+}
+
+func parseBinaryPlist(data []byte) (any, error) {
+	if len(data) < len(binaryPlistMagic)+binaryPlistTrailerSize {
+		return nil, fmt.Errorf("%w: binary property list is too small", errMalformedPlist)
+	}
+
+	trailer := data[len(data)-binaryPlistTrailerSize:]
+	offsetIntSize := int(trailer[6])
+	objectRefSize := int(trailer[7])
+	numObjects := binary.BigEndian.Uint64(trailer[8:16])
+	topObject := binary.BigEndian.Uint64(trailer[16:24])
+	offsetTableOffset := binary.BigEndian.Uint64(trailer[24:32])
+
+	if !validIntSize(offsetIntSize) || !validIntSize(objectRefSize) {
+		return nil, fmt.Errorf("%w: invalid integer sizes in trailer", errMalformedPlist)
+	}
+	if numObjects == 0 || numObjects > maxBinaryPlistObjects || topObject >= numObjects {
+		return nil, fmt.Errorf("%w: invalid object count", errMalformedPlist)
+	}
+	tableEnd := offsetTableOffset + numObjects*uint64(offsetIntSize)
+	if offsetTableOffset < uint64(len(binaryPlistMagic)) || tableEnd > uint64(len(data)-binaryPlistTrailerSize) {
+		return nil, fmt.Errorf("%w: offset table out of bounds", errMalformedPlist)
+	}
+
+	p := &binaryPlist{
+		data:          data,
+		offsets:       make([]uint64, numObjects),
+		objectRefSize: objectRefSize,
+	}
+	for i := range p.offsets {
+		start := offsetTableOffset + uint64(i*offsetIntSize)
+		p.offsets[i] = readUint(data[start : start+uint64(offsetIntSize)])
+	}
+
+	return p.object(topObject, 0)
+}
+
+func validIntSize(size int) bool {
+	switch size {
+	case 1, 2, 4, 8:
+		return true
+	}
+	return false
+}
+
+func readUint(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
+
+// slice returns n bytes starting at the given offset, ensuring they fall within the object area of the plist.
+func (p *binaryPlist) slice(offset, n uint64) ([]byte, error) {
+	end := offset + n
+	if end < offset || end > uint64(len(p.data)-binaryPlistTrailerSize) {
+		return nil, fmt.Errorf("%w: object out of bounds", errMalformedPlist)
+	}
+	return p.data[offset:end], nil
+}
+
+// count returns the number of elements (or bytes) held by an object and the offset of its contents. Counts of 15 or
+// more are stored as a separate integer object following the marker.
+func (p *binaryPlist) count(offset uint64, marker byte) (uint64, uint64, error) {
+	n := uint64(marker & 0x0f)
+	if n != 0x0f {
+		return n, offset + 1, nil
+	}
+	intMarker, err := p.slice(offset+1, 1)
+	if err != nil {
+		return 0, 0, err
+	}
+	if intMarker[0]&0xf0 != 0x10 {
+		return 0, 0, fmt.Errorf("%w: invalid object length", errMalformedPlist)
+	}
+	size := uint64(1) << (intMarker[0] & 0x0f)
+	if size > 8 {
+		return 0, 0, fmt.Errorf("%w: invalid object length", errMalformedPlist)
+	}
+	b, err := p.slice(offset+2, size)
+	if err != nil {
+		return 0, 0, err
+	}
+	n = readUint(b)
+	if n > uint64(len(p.data)) {
+		// the contents of every object must fit within the plist, so this could never be satisfied
+		return 0, 0, fmt.Errorf("%w: invalid object length", errMalformedPlist)
+	}
+	return n, offset + 2 + size, nil
+}
+
+func (p *binaryPlist) refs(offset, n uint64) ([]uint64, error) {
+	b, err := p.slice(offset, n*uint64(p.objectRefSize))
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]uint64, n)
+	for i := range refs {
+		start := i * p.objectRefSize
+		refs[i] = readUint(b[start : start+p.objectRefSize])
+	}
+	return refs, nil
+}
+
+//nolint:funlen,gocognit
+func (p *binaryPlist) object(ref uint64, depth int) (any, error) {
+	if depth > maxPlistDepth {
+		return nil, fmt.Errorf("%w: exceeded max nesting depth", errMalformedPlist)
+	}
+	if ref >= uint64(len(p.offsets)) {
+		return nil, fmt.Errorf("%w: invalid object reference", errMalformedPlist)
+	}
+	p.decoded++
+	if p.decoded > maxBinaryPlistObjects {
+		return nil, fmt.Errorf("%w: exceeded max object count", errMalformedPlist)
+	}
+
+	offset := p.offsets[ref]
+	m, err := p.slice(offset, 1)
+	if err != nil {
+		return nil, err
+	}
+	marker := m[0]
+
+	switch marker & 0xf0 {
+	case 0x00:
+		switch marker {
+		case 0x08:
+			return false, nil
+		case 0x09:
+			return true, nil
+		}
+		return nil, nil
+	case 0x10:
+		size := uint64(1) << (marker & 0x0f)
+		if size > 8 {
+			return nil, fmt.Errorf("%w: unsupported integer size", errMalformedPlist)
+		}
+		b, err := p.slice(offset+1, size)
+		if err != nil {
+			return nil, err
+		}
+		return int64(readUint(b)), nil
+	case 0x20:
+		return p.real(offset+1, uint64(1)<<(marker&0x0f))
+	case 0x30:
+		if marker != 0x33 {
+			return nil, fmt.Errorf("%w: unsupported date marker", errMalformedPlist)
+		}
+		seconds, err := p.real(offset+1, 8)
+		if err != nil {
+			return nil, err
+		}
+		return appleEpoch.Add(time.Duration(seconds * float64(time.Second))), nil
+	case 0x40:
+		n, start, err := p.count(offset, marker)
+		if err != nil {
+			return nil, err
+		}
+		return p.slice(start, n)
+	case 0x50:
+		n, start, err := p.count(offset, marker)
+		if err != nil {
+			return nil, err
+		}
+		b, err := p.slice(start, n)
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case 0x60:
+		n, start, err := p.count(offset, marker)
+		if err != nil {
+			return nil, err
+		}
+		b, err := p.slice(start, n*2)
+		if err != nil {
+			return nil, err
+		}
+		units := make([]uint16, n)
+		for i := range units {
+			units[i] = binary.BigEndian.Uint16(b[i*2:])
+		}
+		return string(utf16.Decode(units)), nil
+	case 0xa0:
+		n, start, err := p.count(offset, marker)
+		if err != nil {
+			return nil, err
+		}
+		refs, err := p.refs(start, n)
+		if err != nil {
+			return nil, err
+		}
+		result := make([]any, 0, len(refs))
+		for _, r := range refs {
+			value, err := p.object(r, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, value)
+		}
+		return result, nil
+	case 0xd0:
+		n, start, err := p.count(offset, marker)
+		if err != nil {
+			return nil, err
+		}
+		// keys are stored first, followed by the values in the same order
+		refs, err := p.refs(start, n*2)
+		if err != nil {
+			return nil, err
+		}
+		result := make(map[string]any, n)
+		for i := uint64(0); i < n; i++ {
+			key, err := p.object(refs[i], depth+1)
+			if err != nil {
+				return nil, err
+			}
+			keyString, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("%w: dict key is not a string", errMalformedPlist)
+			}
+			value, err := p.object(refs[n+i], depth+1)
+			if err != nil {
+				return nil, err
+			}
+			result[keyString] = value
+		}
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("%w: unsupported object marker 0x%02x", errMalformedPlist, marker)
+}
+
+func (p *binaryPlist) real(offset, size uint64) (float64, error) {
+	b, err := p.slice(offset, size)
+	if err != nil {
+		return 0, err
+	}
+	switch size {
+	case 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), nil
+	case 8:
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), nil
+	}
+	return 0, fmt.Errorf("%w: unsupported real size", errMalformedPlist)
+}
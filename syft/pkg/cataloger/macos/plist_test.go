@@ -0,0 +1,132 @@
+package macos
+
+import (
+	"encoding/binary"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parsePlist(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+	}{
+		{
+			name:    "binary",
+			fixture: "test-fixtures/system/var/db/receipts/com.example.tool.plist",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := os.ReadFile(test.fixture)
+			require.NoError(t, err)
+
+			value, err := parsePlist(data)
+			require.NoError(t, err)
+
+			assert.Equal(t, map[string]any{
+				"PackageIdentifier":        "com.example.tool",
+				"PackageVersion":           "2.4.1",
+				"PackageFileName":          "ExampleTool-2.4.1.pkg",
+				"InstallDate":              time.Date(2024, time.March, 14, 9, 26, 53, 0, time.UTC),
+				"InstallPrefixPath":        "/",
+				"InstallProcessName":       "installer",
+				"IncludeParentIdentifiers": []any{"com.example.suite"},
+				"InstallProcessVersion":    int64(42),
+			}, value)
+		})
+	}
+}
+
+func Test_parsePlist_xmlTypes(t *testing.T) {
+	value, err := parsePlist([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>string</key>
+	<string>value</string>
+	<key>integer</key>
+	<integer>-7</integer>
+	<key>real</key>
+	<real>1.5</real>
+	<key>true</key>
+	<true/>
+	<key>false</key>
+	<false/>
+	<key>data</key>
+	<data>
+	aGVsbG8=
+	</data>
+	<key>array</key>
+	<array>
+		<string>a</string>
+		<dict/>
+	</array>
+</dict>
+</plist>`))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"string":  "value",
+		"integer": int64(-7),
+		"real":    1.5,
+		"true":    true,
+		"false":   false,
+		"data":    []byte("hello"),
+		"array":   []any{"a", map[string]any{}},
+	}, value)
+}
+
+func Test_parsePlist_malformed(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{
+			name: "truncated binary plist",
+			data: []byte("bplist00\x00\x01"),
+		},
+		{
+			name: "binary plist with offset table out of bounds",
+			data: append([]byte("bplist00\x50"), binaryTrailer(1, 1, 1, 0, 0xffff)...),
+		},
+		{
+			name: "binary plist string longer than the file",
+			data: append([]byte("bplist00\x5f\x10\xff"), binaryTrailer(1, 1, 1, 0, 11)...),
+		},
+		{
+			name: "xml without a plist element",
+			data: []byte(`<dict><key>a</key><string>b</string></dict>`),
+		},
+		{
+			name: "xml dict missing a value",
+			data: []byte(`<plist><dict><key>a</key></dict></plist>`),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parsePlist(test.data)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// binaryTrailer creates the 32 byte trailer of a binary property list, followed by the offset table it describes
+// (a single entry pointing just after the magic) when the table offset is in bounds.
+func binaryTrailer(offsetIntSize, objectRefSize byte, numObjects, topObject, offsetTableOffset uint64) []byte {
+	var table []byte
+	if offsetTableOffset < 0xffff {
+		table = []byte{8}
+	}
+	trailer := make([]byte, 32)
+	trailer[6] = offsetIntSize
+	trailer[7] = objectRefSize
+	binary.BigEndian.PutUint64(trailer[8:], numObjects)
+	binary.BigEndian.PutUint64(trailer[16:], topObject)
+	binary.BigEndian.PutUint64(trailer[24:], offsetTableOffset)
+	return append(table, trailer...)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
 )
 
 const catalogerName = "nix-store-cataloger"
@@ -20,10 +21,17 @@ const catalogerName = "nix-store-cataloger"
 // storeCataloger finds package outputs installed in the Nix store location (/nix/store/*).
 type storeCataloger struct{}
 
+// NewStoreCataloger returns a new cataloger object for nix packages installed in the nix store.
 func NewStoreCataloger() pkg.Cataloger {
 	return &storeCataloger{}
 }
 
+// NewFlakeLockCataloger returns a new cataloger object for the inputs pinned within nix flake.lock files.
+func NewFlakeLockCataloger() pkg.Cataloger {
+	return generic.NewCataloger("nix-flake-lock-cataloger").
+		WithParserByGlobs(parseFlakeLock, "**/flake.lock")
+}
+
 func (c *storeCataloger) Name() string {
 	return catalogerName
 }
@@ -1,6 +1,8 @@
 package nix
 
 import (
+	"strings"
+
 	"github.com/anchore/packageurl-go"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
@@ -25,6 +27,65 @@ func newNixStorePackage(storePath nixStorePath, locations ...file.Location) pkg.
 	return p
 }
 
+func newFlakeLockPackage(m pkg.NixFlakeLockEntry, locations ...file.Location) pkg.Package {
+	name := m.Repo
+	if name == "" {
+		name = m.Input
+	}
+
+	p := pkg.Package{
+		Name:      name,
+		Version:   m.Rev,
+		Locations: file.NewLocationSet(locations...),
+		Type:      pkg.NixPkg,
+		PURL:      flakeLockPackageURL(name, m),
+		Metadata:  m,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func flakeLockPackageURL(name string, m pkg.NixFlakeLockEntry) string {
+	var qualifiers packageurl.Qualifiers
+	if vcs := flakeInputVCSURL(m); vcs != "" {
+		if m.Rev != "" {
+			vcs += "@" + m.Rev
+		}
+		qualifiers = append(qualifiers,
+			packageurl.Qualifier{
+				Key:   pkg.PURLQualifierVCSURL,
+				Value: "git+" + vcs,
+			},
+		)
+	}
+	pURL := packageurl.NewPackageURL(
+		// TODO: nix pURL type has not been accepted yet (only proposed at this time)
+		"nix",
+		"",
+		name,
+		m.Rev,
+		qualifiers,
+		"")
+	return pURL.ToString()
+}
+
+// flakeInputVCSURL returns the repository URL for inputs locked to a git commit.
+func flakeInputVCSURL(m pkg.NixFlakeLockEntry) string {
+	switch m.Type {
+	case "github":
+		return "https://github.com/" + m.Owner + "/" + m.Repo
+	case "gitlab":
+		return "https://gitlab.com/" + m.Owner + "/" + m.Repo
+	case "sourcehut":
+		return "https://git.sr.ht/" + m.Owner + "/" + m.Repo
+	case "git":
+		return strings.TrimPrefix(m.URL, "git+")
+	}
+	return ""
+}
+
 func packageURL(storePath nixStorePath) string {
 	var qualifiers packageurl.Qualifiers
 	if storePath.output != "" {
@@ -0,0 +1,99 @@
+package nix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseFlakeLock
+
+type flakeLock struct {
+	Nodes map[string]flakeLockNode `json:"nodes"`
+	Root  string                   `json:"root"`
+}
+
+type flakeLockNode struct {
+	Locked   *flakeRef `json:"locked"`
+	Original *flakeRef `json:"original"`
+}
+
+type flakeRef struct {
+	Type         string `json:"type"`
+	Owner        string `json:"owner"`
+	Repo         string `json:"repo"`
+	URL          string `json:"url"`
+	Path         string `json:"path"`
+	Ref          string `json:"ref"`
+	Rev          string `json:"rev"`
+	NarHash      string `json:"narHash"`
+	LastModified int64  `json:"lastModified"`
+}
+
+// parseFlakeLock is a parser function for nix flake.lock contents, returning all locked inputs of the flake. An
+// example flake.lock:
+//
+//	{
+//	  "nodes": {
+//	    "nixpkgs": {
+//	      "locked": {"owner": "NixOS", "repo": "nixpkgs", "rev": "5672bc9d...", "type": "github", ...},
+//	      "original": {"owner": "NixOS", "ref": "nixos-unstable", "repo": "nixpkgs", "type": "github"}
+//	    },
+//	    "root": {"inputs": {"nixpkgs": "nixpkgs"}}
+//	  },
+//	  "root": "root",
+//	  "version": 7
+//	}
+func parseFlakeLock(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var lock flakeLock
+	if err := json.NewDecoder(reader).Decode(&lock); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse flake.lock file: %w", err)
+	}
+
+	// sort for stable results, since the nodes are keyed by name
+	names := make([]string, 0, len(lock.Nodes))
+	for name := range lock.Nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var pkgs []pkg.Package
+	for _, name := range names {
+		node := lock.Nodes[name]
+		if name == lock.Root || node.Locked == nil {
+			// the root node is the flake itself, which has no locked reference
+			continue
+		}
+
+		locked := node.Locked
+		m := pkg.NixFlakeLockEntry{
+			Input:        name,
+			Type:         locked.Type,
+			Owner:        locked.Owner,
+			Repo:         locked.Repo,
+			URL:          locked.URL,
+			Rev:          locked.Rev,
+			NarHash:      locked.NarHash,
+			LastModified: locked.LastModified,
+		}
+		if m.URL == "" {
+			m.URL = locked.Path
+		}
+		if node.Original != nil {
+			m.Ref = node.Original.Ref
+		}
+		if m.Ref == "" {
+			m.Ref = locked.Ref
+		}
+
+		pkgs = append(pkgs, newFlakeLockPackage(m, reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)))
+	}
+
+	return pkgs, nil, nil
+}
@@ -0,0 +1,84 @@
+package nix
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseFlakeLock(t *testing.T) {
+	fixture := "test-fixtures/flake-lock/flake.lock"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	expected := []pkg.Package{
+		{
+			Name:      "flake-utils",
+			Version:   "b1d9ab70662946ef0850d488da1c9019f3a9752a",
+			PURL:      "pkg:nix/flake-utils@b1d9ab70662946ef0850d488da1c9019f3a9752a?vcs_url=git%2Bhttps://github.com/numtide/flake-utils%40b1d9ab70662946ef0850d488da1c9019f3a9752a",
+			Locations: locations,
+			Type:      pkg.NixPkg,
+			Metadata: pkg.NixFlakeLockEntry{
+				Input:        "flake-utils",
+				Type:         "github",
+				Owner:        "numtide",
+				Repo:         "flake-utils",
+				Rev:          "b1d9ab70662946ef0850d488da1c9019f3a9752a",
+				NarHash:      "sha256-SZ5L6eA7HJ/nmkzGG7/ISclqe6oZdOZTNoesiInkXPQ=",
+				LastModified: 1710146030,
+			},
+		},
+		{
+			Name:      "nixpkgs",
+			Version:   "cfd6b5fc90b15709b780a5a1619695a88505a176",
+			PURL:      "pkg:nix/nixpkgs@cfd6b5fc90b15709b780a5a1619695a88505a176?vcs_url=git%2Bhttps://github.com/NixOS/nixpkgs%40cfd6b5fc90b15709b780a5a1619695a88505a176",
+			Locations: locations,
+			Type:      pkg.NixPkg,
+			Metadata: pkg.NixFlakeLockEntry{
+				Input:        "nixpkgs",
+				Type:         "github",
+				Owner:        "NixOS",
+				Repo:         "nixpkgs",
+				Ref:          "nixos-unstable",
+				Rev:          "cfd6b5fc90b15709b780a5a1619695a88505a176",
+				NarHash:      "sha256-WKm9CvgCldeIVvRz87iOMi8CFVB1apJlkUT4GGvA0iM=",
+				LastModified: 1712963716,
+			},
+		},
+		{
+			Name:      "default",
+			Version:   "da67096a3b9bf56a91d16901293e51ba5b49a27e",
+			PURL:      "pkg:nix/default@da67096a3b9bf56a91d16901293e51ba5b49a27e?vcs_url=git%2Bhttps://github.com/nix-systems/default%40da67096a3b9bf56a91d16901293e51ba5b49a27e",
+			Locations: locations,
+			Type:      pkg.NixPkg,
+			Metadata: pkg.NixFlakeLockEntry{
+				Input:        "systems",
+				Type:         "github",
+				Owner:        "nix-systems",
+				Repo:         "default",
+				Rev:          "da67096a3b9bf56a91d16901293e51ba5b49a27e",
+				NarHash:      "sha256-Vy1rq5AaRuLzOxct8nz4T6wlgyUR7zLU309k9mBC768=",
+				LastModified: 1681028828,
+			},
+		},
+		{
+			Name:      "tools",
+			Version:   "0123456789abcdef0123456789abcdef01234567",
+			PURL:      "pkg:nix/tools@0123456789abcdef0123456789abcdef01234567?vcs_url=git%2Bhttps://example.com/tools.git%400123456789abcdef0123456789abcdef01234567",
+			Locations: locations,
+			Type:      pkg.NixPkg,
+			Metadata: pkg.NixFlakeLockEntry{
+				Input:        "tools",
+				Type:         "git",
+				URL:          "https://example.com/tools.git",
+				Ref:          "refs/heads/main",
+				Rev:          "0123456789abcdef0123456789abcdef01234567",
+				NarHash:      "sha256-47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
+				LastModified: 1700000000,
+			},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseFlakeLock, expected, nil)
+}
@@ -0,0 +1,38 @@
+/*
+Package perl provides a concrete Cataloger implementation relating to packages within the Perl language ecosystem.
+*/
+package perl
+
+import (
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewCpanCataloger returns a new cataloger object for Perl dependencies declared in cpanfile files and pinned in
+// cpanfile.snapshot files (written by Carton).
+func NewCpanCataloger() pkg.Cataloger {
+	return generic.NewCataloger("perl-cpan-cataloger").
+		WithParserByGlobs(parseCpanfile, "**/cpanfile").
+		WithParserByGlobs(parseCpanfileSnapshot, "**/cpanfile.snapshot").
+		WithProcessors(preferSnapshotPackages)
+}
+
+// preferSnapshotPackages drops modules declared in a cpanfile when a distribution pinned in a cpanfile.snapshot
+// within the same directory provides the same module, since the snapshot records the exact version installed.
+var preferSnapshotPackages = generic.PreferLockedPackages(
+	func(p pkg.Package) []string {
+		m, ok := p.Metadata.(pkg.PerlCpanfileSnapshotEntry)
+		if !ok {
+			return nil
+		}
+		return append([]string{strings.ReplaceAll(p.Name, "-", "::")}, m.Provides...)
+	},
+	func(p pkg.Package) string {
+		if _, ok := p.Metadata.(pkg.PerlCpanfileEntry); ok {
+			return p.Name
+		}
+		return ""
+	},
+)
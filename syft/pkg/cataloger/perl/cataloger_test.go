@@ -0,0 +1,64 @@
+package perl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestCataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain cpanfile files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"src/cpanfile",
+				"src/cpanfile.snapshot",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewCpanCataloger())
+		})
+	}
+}
+
+func Test_preferSnapshotPackages(t *testing.T) {
+	snapshot := newCpanfileSnapshotPackage("Plack", "1.0047", pkg.PerlCpanfileSnapshotEntry{
+		Pathname: "M/MI/MIYAGAWA/Plack-1.0047.tar.gz",
+		Provides: []string{"Plack", "Plack::Request"},
+	}, file.NewLocation("cpanfile.snapshot"))
+
+	declared := func(name, location string) pkg.Package {
+		return newCpanfilePackage(name, "", pkg.PerlCpanfileEntry{Phase: "runtime", Relationship: "requires"}, file.NewLocation(location))
+	}
+
+	got, _, _ := preferSnapshotPackages([]pkg.Package{
+		declared("Plack", "cpanfile"),
+		declared("Plack::Request", "cpanfile"),
+		declared("DBI", "cpanfile"),
+		// a project without a snapshot of its own keeps the modules it declares
+		declared("Plack", "other/cpanfile"),
+		snapshot,
+	}, nil, nil)
+
+	var names []string
+	for _, p := range got {
+		names = append(names, fmt.Sprintf("%s@%s", p.Name, p.Version))
+	}
+	assert.Equal(t, []string{"DBI@", "Plack@", "Plack@1.0047"}, names)
+}
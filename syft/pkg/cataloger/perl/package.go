@@ -0,0 +1,50 @@
+package perl
+
+import (
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newCpanfilePackage(name, version string, m pkg.PerlCpanfileEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL("", name, version),
+		Language:  pkg.Perl,
+		Type:      pkg.PerlCpanPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func newCpanfileSnapshotPackage(name, version string, m pkg.PerlCpanfileSnapshotEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(authorFromPathname(m.Pathname), name, version),
+		Language:  pkg.Perl,
+		Type:      pkg.PerlCpanPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func packageURL(author, name, version string) string {
+	return packageurl.NewPackageURL(
+		pkg.PerlCpanPkg.PackageURLType(),
+		author,
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
@@ -0,0 +1,125 @@
+package perl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseCpanfile
+
+const runtimePhase = "runtime"
+
+// cpanfileTokenPattern matches the parts of a cpanfile (which is perl code) needed to find requirements: the
+// start of "on <phase> => sub {" blocks, other brace-delimited blocks (e.g. "feature ... => sub {"), and
+// requirement statements such as "requires 'Plack', '1.0';".
+var cpanfileTokenPattern = regexp.MustCompile(
+	`(?P<on>\bon\s*\(?\s*['"]?(?P<phase>\w+)['"]?\s*(?:,|=>)\s*sub\s*\{)` +
+		`|(?P<open>\{)` +
+		`|(?P<close>\})` +
+		`|(?P<statement>\b(?P<relationship>requires|recommends|suggests|conflicts|test_requires|build_requires|configure_requires|author_requires)\s*\(?\s*['"](?P<module>[^'"]+)['"]\s*(?:(?:,|=>)\s*['"]?(?P<version>[^'";)]*?)['"]?)?\s*\)?\s*;)`,
+)
+
+// shorthandPhases maps the phase-specific requirement functions to the phase they apply to
+var shorthandPhases = map[string]string{
+	"test_requires":      "test",
+	"build_requires":     "build",
+	"configure_requires": "configure",
+	"author_requires":    "develop",
+}
+
+// parseCpanfile is a parser function for cpanfile contents, returning all modules declared within the file.
+func parseCpanfile(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read cpanfile: %w", err)
+	}
+
+	content := stripComments(string(contents))
+	names := cpanfileTokenPattern.SubexpNames()
+
+	phases := []string{runtimePhase}
+	var pkgs []pkg.Package
+	for _, match := range cpanfileTokenPattern.FindAllStringSubmatch(content, -1) {
+		groups := make(map[string]string)
+		for i, n := range names {
+			if n != "" {
+				groups[n] = match[i]
+			}
+		}
+
+		current := phases[len(phases)-1]
+		switch {
+		case groups["on"] != "":
+			phases = append(phases, groups["phase"])
+		case groups["open"] != "":
+			// other blocks (such as features) inherit the phase of the enclosing block
+			phases = append(phases, current)
+		case groups["close"] != "":
+			if len(phases) > 1 {
+				phases = phases[:len(phases)-1]
+			}
+		case groups["statement"] != "":
+			module := groups["module"]
+			if module == "perl" {
+				// the minimum perl version is not a module dependency
+				continue
+			}
+
+			relationship := groups["relationship"]
+			phase := current
+			if p, ok := shorthandPhases[relationship]; ok {
+				phase = p
+				relationship = "requires"
+			}
+
+			constraint := normalizeConstraint(groups["version"])
+			pkgs = append(pkgs, newCpanfilePackage(module, exactVersion(constraint), pkg.PerlCpanfileEntry{
+				Phase:             phase,
+				Relationship:      relationship,
+				VersionConstraint: constraint,
+			}, reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)))
+		}
+	}
+
+	return pkgs, nil, nil
+}
+
+func stripComments(content string) string {
+	var lines []string
+	for _, line := range strings.Split(content, "\n") {
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// normalizeConstraint turns a cpanfile version requirement into an explicit constraint. A bare version means
+// "at least this version", and a version of "0" means any version.
+func normalizeConstraint(version string) string {
+	version = strings.TrimSpace(version)
+	if version == "" || version == "0" {
+		return ""
+	}
+	if strings.ContainsAny(version[:1], "<>=!") {
+		return version
+	}
+	return ">= " + version
+}
+
+// exactVersion returns the version from a constraint that pins a single version (e.g. "== 1.0").
+func exactVersion(constraint string) string {
+	if !strings.HasPrefix(constraint, "==") || strings.Contains(constraint, ",") {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(constraint, "=="))
+}
@@ -0,0 +1,105 @@
+package perl
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseCpanfileSnapshot
+
+type snapshotDistribution struct {
+	name string
+	pkg.PerlCpanfileSnapshotEntry
+}
+
+// parseCpanfileSnapshot is a parser function for cpanfile.snapshot contents, returning all distributions pinned
+// within the snapshot. An example snapshot:
+//
+//	# carton snapshot format: version 1.0
+//	DISTRIBUTIONS
+//	  Plack-1.0047
+//	    pathname: M/MI/MIYAGAWA/Plack-1.0047.tar.gz
+//	    provides:
+//	      Plack 1.0047
+//	    requirements:
+//	      ExtUtils::MakeMaker 0
+func parseCpanfileSnapshot(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var dists []*snapshotDistribution
+	var current *snapshotDistribution
+	var section string
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		switch indent := len(line) - len(strings.TrimLeft(line, " ")); {
+		case indent == 0:
+			// section headers (e.g. "DISTRIBUTIONS")
+			current = nil
+		case indent <= 2:
+			current = &snapshotDistribution{name: trimmed}
+			dists = append(dists, current)
+			section = ""
+		case current == nil:
+			continue
+		case indent <= 4:
+			key, value, _ := strings.Cut(trimmed, ":")
+			section = key
+			if key == "pathname" {
+				current.Pathname = strings.TrimSpace(value)
+			}
+		default:
+			module, _, _ := strings.Cut(trimmed, " ")
+			switch section {
+			case "provides":
+				current.Provides = append(current.Provides, module)
+			case "requirements":
+				current.Requirements = append(current.Requirements, module)
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse cpanfile.snapshot file: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	for _, d := range dists {
+		name, version := splitDistribution(d.name)
+		if name == "" {
+			continue
+		}
+		pkgs = append(pkgs, newCpanfileSnapshotPackage(name, version, d.PerlCpanfileSnapshotEntry, reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)))
+	}
+
+	return pkgs, nil, nil
+}
+
+// splitDistribution splits a distribution name (e.g. "Plack-1.0047") into the name and version components.
+func splitDistribution(dist string) (string, string) {
+	idx := strings.LastIndex(dist, "-")
+	if idx <= 0 {
+		return dist, ""
+	}
+	return dist[:idx], dist[idx+1:]
+}
+
+// authorFromPathname returns the CPAN author ID from a distribution pathname (e.g. "M/MI/MIYAGAWA/Plack-1.0047.tar.gz").
+func authorFromPathname(pathname string) string {
+	fields := strings.Split(pathname, "/")
+	if len(fields) < 4 {
+		return ""
+	}
+	return fields[2]
+}
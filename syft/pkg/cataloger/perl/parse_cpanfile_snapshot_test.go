@@ -0,0 +1,68 @@
+package perl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseCpanfileSnapshot(t *testing.T) {
+	fixture := "test-fixtures/cpanfile.snapshot"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	expected := []pkg.Package{
+		{
+			Name:      "DBI",
+			Version:   "1.643",
+			Language:  pkg.Perl,
+			Type:      pkg.PerlCpanPkg,
+			Locations: locations,
+			PURL:      "pkg:cpan/TIMB/DBI@1.643",
+			Metadata: pkg.PerlCpanfileSnapshotEntry{
+				Pathname:     "T/TI/TIMB/DBI-1.643.tar.gz",
+				Provides:     []string{"Bundle::DBI", "DBD::DBM", "DBI"},
+				Requirements: []string{"ExtUtils::MakeMaker", "Test::Simple", "perl"},
+			},
+		},
+		{
+			Name:      "Plack",
+			Version:   "1.0047",
+			Language:  pkg.Perl,
+			Type:      pkg.PerlCpanPkg,
+			Locations: locations,
+			PURL:      "pkg:cpan/MIYAGAWA/Plack@1.0047",
+			Metadata: pkg.PerlCpanfileSnapshotEntry{
+				Pathname:     "M/MI/MIYAGAWA/Plack-1.0047.tar.gz",
+				Provides:     []string{"HTTP::Message::PSGI", "Plack", "Plack::Request"},
+				Requirements: []string{"Apache::LogFormat::Compiler", "ExtUtils::MakeMaker", "HTTP::Entity::Parser"},
+			},
+		},
+	}
+
+	var expectedRelationships []artifact.Relationship
+
+	pkgtest.TestFileParser(t, fixture, parseCpanfileSnapshot, expected, expectedRelationships)
+}
+
+func Test_splitDistribution(t *testing.T) {
+	tests := []struct {
+		dist        string
+		wantName    string
+		wantVersion string
+	}{
+		{dist: "Plack-1.0047", wantName: "Plack", wantVersion: "1.0047"},
+		{dist: "HTTP-Entity-Parser-0.25", wantName: "HTTP-Entity-Parser", wantVersion: "0.25"},
+		{dist: "Moose", wantName: "Moose"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dist, func(t *testing.T) {
+			name, version := splitDistribution(tt.dist)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantVersion, version)
+		})
+	}
+}
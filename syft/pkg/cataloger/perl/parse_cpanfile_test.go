@@ -0,0 +1,47 @@
+package perl
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseCpanfile(t *testing.T) {
+	fixture := "test-fixtures/cpanfile"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	newPkg := func(name, version string, m pkg.PerlCpanfileEntry) pkg.Package {
+		purl := "pkg:cpan/" + name
+		if version != "" {
+			purl += "@" + version
+		}
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			Language:  pkg.Perl,
+			Type:      pkg.PerlCpanPkg,
+			Locations: locations,
+			PURL:      purl,
+			Metadata:  m,
+		}
+	}
+
+	expected := []pkg.Package{
+		newPkg("Plack", "", pkg.PerlCpanfileEntry{Phase: "runtime", Relationship: "requires", VersionConstraint: ">= 1.0"}),
+		newPkg("DBI", "", pkg.PerlCpanfileEntry{Phase: "runtime", Relationship: "requires", VersionConstraint: ">= 1.600, < 2.0"}),
+		newPkg("JSON::PP", "4.16", pkg.PerlCpanfileEntry{Phase: "runtime", Relationship: "requires", VersionConstraint: "== 4.16"}),
+		newPkg("JSON::XS", "", pkg.PerlCpanfileEntry{Phase: "runtime", Relationship: "recommends"}),
+		newPkg("Test::More", "", pkg.PerlCpanfileEntry{Phase: "test", Relationship: "requires", VersionConstraint: ">= 0.98"}),
+		newPkg("Test::Pod", "", pkg.PerlCpanfileEntry{Phase: "test", Relationship: "suggests"}),
+		newPkg("Perl::Tidy", "", pkg.PerlCpanfileEntry{Phase: "develop", Relationship: "requires", VersionConstraint: ">= 20230309"}),
+		newPkg("DBD::SQLite", "", pkg.PerlCpanfileEntry{Phase: "runtime", Relationship: "recommends"}),
+		newPkg("Test::Deep", "", pkg.PerlCpanfileEntry{Phase: "test", Relationship: "requires"}),
+	}
+
+	var expectedRelationships []artifact.Relationship
+
+	pkgtest.TestFileParser(t, fixture, parseCpanfile, expected, expectedRelationships)
+}
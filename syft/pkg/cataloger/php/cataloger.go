@@ -17,10 +17,26 @@ func NewComposerInstalledCataloger() pkg.Cataloger {
 		WithParserByGlobs(parseInstalledJSON, "**/installed.json")
 }
 
+// CatalogerConfig contains the settings for the PHP composer.lock cataloger.
+type CatalogerConfig struct {
+	// IncludeDevDependencies will report the packages listed within the "packages-dev" section of composer.lock files,
+	// which are not reported by default.
+	IncludeDevDependencies bool `yaml:"include-dev-dependencies" json:"include-dev-dependencies" mapstructure:"include-dev-dependencies"`
+}
+
+func DefaultCatalogerConfig() CatalogerConfig {
+	return CatalogerConfig{}
+}
+
+func (c CatalogerConfig) WithIncludeDevDependencies(include bool) CatalogerConfig {
+	c.IncludeDevDependencies = include
+	return c
+}
+
 // NewComposerLockCataloger returns a new cataloger for PHP composer.lock files.
-func NewComposerLockCataloger() pkg.Cataloger {
+func NewComposerLockCataloger(cfg CatalogerConfig) pkg.Cataloger {
 	return generic.NewCataloger("php-composer-lock-cataloger").
-		WithParserByGlobs(parseComposerLock, "**/composer.lock")
+		WithParserByGlobs(newComposerLockParser(cfg).parseComposerLock, "**/composer.lock")
 }
 
 // NewPeclCataloger returns a new cataloger for PHP PECL metadata“.
@@ -51,7 +51,7 @@ func Test_ComposerLockCataloger_Globs(t *testing.T) {
 			pkgtest.NewCatalogTester().
 				FromDirectory(t, test.fixture).
 				ExpectsResolverContentQueries(test.expected).
-				TestCataloger(t, NewComposerLockCataloger())
+				TestCataloger(t, NewComposerLockCataloger(DefaultCatalogerConfig()))
 		})
 	}
 }
@@ -10,7 +10,7 @@ import (
 
 func newComposerLockPackage(pd parsedLockData, indexLocation file.Location) pkg.Package {
 	p := pkg.Package{
-		Name:      pd.Name,
+		Name:      pkg.NormalizeName(pkg.PhpComposerPkg, pd.Name),
 		Version:   pd.Version,
 		Locations: file.NewLocationSet(indexLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
 		Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromLocation(indexLocation, pd.License...)...),
@@ -24,9 +24,25 @@ func newComposerLockPackage(pd parsedLockData, indexLocation file.Location) pkg.
 	return p
 }
 
+// newComposerPlatformPackage returns a package for a platform package of a composer.lock file. There is no package URL,
+// since platform packages are provided by the environment (not by a composer repository).
+func newComposerPlatformPackage(entry pkg.PhpComposerPlatformEntry, indexLocation file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      entry.Name,
+		Version:   entry.Version,
+		Locations: file.NewLocationSet(indexLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+		Language:  pkg.PHP,
+		Type:      pkg.PhpComposerPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+	return p
+}
+
 func newComposerInstalledPackage(pd parsedInstalledData, indexLocation file.Location) pkg.Package {
 	p := pkg.Package{
-		Name:      pd.Name,
+		Name:      pkg.NormalizeName(pkg.PhpComposerPkg, pd.Name),
 		Version:   pd.Version,
 		Locations: file.NewLocationSet(indexLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
 		Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromLocation(indexLocation, pd.License...)...),
@@ -58,6 +74,7 @@ func newPeclPackage(pd pkg.PhpPeclEntry, indexLocation file.Location) pkg.Packag
 
 func packageURL(name, version string) string {
 	var pkgName, vendor string
+	name = pkg.NormalizeName(pkg.PhpComposerPkg, name)
 	fields := strings.Split(name, "/")
 	switch len(fields) {
 	case 0:
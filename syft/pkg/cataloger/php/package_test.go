@@ -19,6 +19,12 @@ func Test_packageURL(t *testing.T) {
 			packageVersion: "1.0.1",
 			expected:       "pkg:composer/ven/name@1.0.1",
 		},
+		{
+			name:           "name is normalized",
+			packageName:    "Ven/Name",
+			packageVersion: "1.0.1",
+			expected:       "pkg:composer/ven/name@1.0.1",
+		},
 		{
 			name:           "name with slashes (invalid)",
 			packageName:    "ven/name/component",
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/file"
@@ -13,20 +14,39 @@ import (
 	"github.com/anchore/syft/syft/pkg/cataloger/generic"
 )
 
-var _ generic.Parser = parseComposerLock
-
 type parsedLockData struct {
 	License []string `json:"license"`
 	pkg.PhpComposerLockEntry
 }
 
 type composerLock struct {
-	Packages   []parsedLockData `json:"packages"`
-	PackageDev []parsedLockData `json:"packages-dev"` // TODO: these are not currently included as packages in the SBOM... should they be?
+	Packages          []parsedLockData `json:"packages"`
+	PackageDev        []parsedLockData `json:"packages-dev"`
+	Platform          json.RawMessage  `json:"platform"`
+	PlatformDev       json.RawMessage  `json:"platform-dev"`
+	PlatformOverrides json.RawMessage  `json:"platform-overrides"`
+}
+
+type composerLockParser struct {
+	includeDevDependencies bool
+}
+
+func newComposerLockParser(cfg CatalogerConfig) composerLockParser {
+	return composerLockParser{
+		includeDevDependencies: cfg.IncludeDevDependencies,
+	}
 }
 
-// parseComposerLock is a parser function for Composer.lock contents, returning "Default" php packages discovered.
-func parseComposerLock(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+// parseComposerLock is a parser function for Composer.lock contents using the default settings, returning the
+// "Default" php packages discovered and the platform packages that the lock was resolved against.
+func parseComposerLock(ctx context.Context, resolver file.Resolver, env *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	return newComposerLockParser(DefaultCatalogerConfig()).parseComposerLock(ctx, resolver, env, reader)
+}
+
+// parseComposerLock is a parser function for Composer.lock contents, returning the php packages discovered (along
+// with the packages only required for development, when these are included) and the platform packages that the lock
+// was resolved against.
+func (cp composerLockParser) parseComposerLock(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
 	pkgs := make([]pkg.Package, 0)
 	dec := json.NewDecoder(reader)
 
@@ -46,7 +66,74 @@ func parseComposerLock(_ context.Context, _ file.Resolver, _ *generic.Environmen
 				),
 			)
 		}
+		if cp.includeDevDependencies {
+			for _, pd := range lock.PackageDev {
+				pd.DevDependency = true
+				pkgs = append(
+					pkgs,
+					newComposerLockPackage(
+						pd,
+						reader.Location,
+					),
+				)
+			}
+		}
+		pkgs = append(pkgs, cp.platformPackages(lock, reader.Location)...)
 	}
 
 	return pkgs, nil, nil
 }
+
+// platformPackages returns the platform packages given by the "platform-overrides" section, which are the versions
+// of PHP (and of the extensions and libraries) that composer resolved the lock against. The "platform" and
+// "platform-dev" sections only hold the requirements of the project, so do not give the version of a platform package
+// on their own.
+func (cp composerLockParser) platformPackages(lock composerLock, location file.Location) []pkg.Package {
+	overrides := composerPlatformVersions(lock.PlatformOverrides)
+	if len(overrides) == 0 {
+		return nil
+	}
+	requirements := composerPlatformVersions(lock.Platform)
+	devRequirements := composerPlatformVersions(lock.PlatformDev)
+
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var pkgs []pkg.Package
+	for _, name := range names {
+		entry := pkg.PhpComposerPlatformEntry{
+			Name:    name,
+			Version: overrides[name],
+		}
+		if constraint, ok := requirements[name]; ok {
+			entry.Constraint = constraint
+		} else if constraint, ok := devRequirements[name]; ok {
+			entry.Constraint = constraint
+			entry.DevDependency = true
+		}
+		if entry.DevDependency && !cp.includeDevDependencies {
+			continue
+		}
+		pkgs = append(pkgs, newComposerPlatformPackage(entry, location))
+	}
+	return pkgs
+}
+
+// composerPlatformVersions returns the versions (or constraints) of a platform section of a composer.lock file. Note
+// that empty sections are written as an array (not an object), and an override of false disables a platform package.
+func composerPlatformVersions(raw json.RawMessage) map[string]string {
+	var section map[string]interface{}
+	if err := json.Unmarshal(raw, &section); err != nil {
+		return nil
+	}
+	versions := make(map[string]string)
+	for name, value := range section {
+		if v, ok := value.(string); ok && v != "" {
+			versions[name] = v
+		}
+	}
+	return versions
+}
@@ -1,8 +1,11 @@
 package php
 
 import (
+	"encoding/json"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
@@ -113,3 +116,185 @@ func TestParseComposerFileLock(t *testing.T) {
 	}
 	pkgtest.TestFileParser(t, fixture, parseComposerLock, expectedPkgs, expectedRelationships)
 }
+
+func TestParseComposerFileLock_DevDependencies(t *testing.T) {
+	fixture := "test-fixtures/composer-dev/composer.lock"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	monolog := pkg.Package{
+		Name:      "monolog/monolog",
+		Version:   "3.5.0",
+		PURL:      "pkg:composer/monolog/monolog@3.5.0",
+		Locations: locations,
+		Licenses: pkg.NewLicenseSet(
+			pkg.NewLicenseFromLocations("MIT", file.NewLocation(fixture)),
+		),
+		Language: pkg.PHP,
+		Type:     pkg.PhpComposerPkg,
+		Metadata: pkg.PhpComposerLockEntry{
+			Name:    "monolog/monolog",
+			Version: "3.5.0",
+			Type:    "library",
+		},
+	}
+	phpunit := pkg.Package{
+		Name:      "phpunit/phpunit",
+		Version:   "10.5.3",
+		PURL:      "pkg:composer/phpunit/phpunit@10.5.3",
+		Locations: locations,
+		Licenses: pkg.NewLicenseSet(
+			pkg.NewLicenseFromLocations("BSD-3-Clause", file.NewLocation(fixture)),
+		),
+		Language: pkg.PHP,
+		Type:     pkg.PhpComposerPkg,
+		Metadata: pkg.PhpComposerLockEntry{
+			Name:          "phpunit/phpunit",
+			Version:       "10.5.3",
+			Type:          "library",
+			DevDependency: true,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		cfg      CatalogerConfig
+		expected []pkg.Package
+	}{
+		{
+			name:     "dev dependencies are not reported by default",
+			cfg:      DefaultCatalogerConfig(),
+			expected: []pkg.Package{monolog},
+		},
+		{
+			name:     "include dev dependencies",
+			cfg:      DefaultCatalogerConfig().WithIncludeDevDependencies(true),
+			expected: []pkg.Package{monolog, phpunit},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.TestFileParser(t, fixture, newComposerLockParser(test.cfg).parseComposerLock, test.expected, nil)
+		})
+	}
+}
+
+func TestParseComposerFileLock_PlatformOverrides(t *testing.T) {
+	fixture := "test-fixtures/composer-platform/composer.lock"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	guzzle := pkg.Package{
+		Name:      "guzzlehttp/guzzle",
+		Version:   "7.8.1",
+		PURL:      "pkg:composer/guzzlehttp/guzzle@7.8.1",
+		Locations: locations,
+		Licenses: pkg.NewLicenseSet(
+			pkg.NewLicenseFromLocations("MIT", file.NewLocation(fixture)),
+		),
+		Language: pkg.PHP,
+		Type:     pkg.PhpComposerPkg,
+		Metadata: pkg.PhpComposerLockEntry{
+			Name:    "guzzlehttp/guzzle",
+			Version: "7.8.1",
+			Require: map[string]string{
+				"ext-json": "*",
+				"php":      "^7.2.5 || ^8.0",
+			},
+			Type: "library",
+		},
+	}
+	phpunit := pkg.Package{
+		Name:      "phpunit/phpunit",
+		Version:   "9.6.15",
+		PURL:      "pkg:composer/phpunit/phpunit@9.6.15",
+		Locations: locations,
+		Licenses: pkg.NewLicenseSet(
+			pkg.NewLicenseFromLocations("BSD-3-Clause", file.NewLocation(fixture)),
+		),
+		Language: pkg.PHP,
+		Type:     pkg.PhpComposerPkg,
+		Metadata: pkg.PhpComposerLockEntry{
+			Name:    "phpunit/phpunit",
+			Version: "9.6.15",
+			Require: map[string]string{
+				"ext-dom": "*",
+				"php":     ">=7.3",
+			},
+			Type:          "library",
+			DevDependency: true,
+		},
+	}
+	platform := func(name, version, constraint string, dev bool) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			Locations: locations,
+			Language:  pkg.PHP,
+			Type:      pkg.PhpComposerPkg,
+			Metadata: pkg.PhpComposerPlatformEntry{
+				Name:          name,
+				Version:       version,
+				Constraint:    constraint,
+				DevDependency: dev,
+			},
+		}
+	}
+	extJSON := platform("ext-json", "8.1.27", "*", false)
+	extXdebug := platform("ext-xdebug", "3.3.1", "^3.2", true)
+	php := platform("php", "8.1.27", "^8.1", false)
+
+	tests := []struct {
+		name     string
+		cfg      CatalogerConfig
+		expected []pkg.Package
+	}{
+		{
+			name:     "dev dependencies are not reported by default",
+			cfg:      DefaultCatalogerConfig(),
+			expected: []pkg.Package{guzzle, extJSON, php},
+		},
+		{
+			name:     "include dev dependencies",
+			cfg:      DefaultCatalogerConfig().WithIncludeDevDependencies(true),
+			expected: []pkg.Package{guzzle, phpunit, extJSON, extXdebug, php},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.TestFileParser(t, fixture, newComposerLockParser(test.cfg).parseComposerLock, test.expected, nil)
+		})
+	}
+}
+
+func Test_composerPlatformVersions(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want map[string]string
+	}{
+		{
+			name: "object",
+			raw:  `{"php": "8.1.27", "ext-json": "*"}`,
+			want: map[string]string{"php": "8.1.27", "ext-json": "*"},
+		},
+		{
+			name: "empty section is written as an array",
+			raw:  `[]`,
+		},
+		{
+			name: "disabled platform packages are skipped",
+			raw:  `{"php": "8.1.27", "ext-redis": false}`,
+			want: map[string]string{"php": "8.1.27"},
+		},
+		{
+			name: "missing section",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := composerPlatformVersions(json.RawMessage(tt.raw))
+			if len(tt.want) == 0 {
+				assert.Empty(t, got)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+/*
+Package protobuf provides a concrete Cataloger implementation for the proto packages (and the services they expose)
+declared by protocol buffers definitions.
+*/
+package protobuf
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewProtoFileCataloger returns a new cataloger object for the package, import and service declarations of .proto
+// files. These are not dependencies in the traditional sense, but describe the API surface of a project.
+func NewProtoFileCataloger() pkg.Cataloger {
+	return generic.NewCataloger("proto-file-cataloger").
+		WithParserByGlobs(parseProtoFile, "**/*.proto")
+}
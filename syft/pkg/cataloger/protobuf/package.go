@@ -0,0 +1,19 @@
+package protobuf
+
+import (
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newPackage(name string, m pkg.ProtobufFile, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Locations: file.NewLocationSet(locations...),
+		Type:      pkg.ProtobufPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+
+	return p
+}
@@ -0,0 +1,160 @@
+package protobuf
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+const defaultSyntax = "proto2"
+
+var (
+	// matches the syntax declaration, for example:
+	//   syntax = "proto3";
+	syntaxPattern = regexp.MustCompile(`\bsyntax\s*=\s*["'](proto[23])["']\s*;`)
+
+	// matches the package declaration, for example:
+	//   package google.api.expr.v1alpha1;
+	packagePattern = regexp.MustCompile(`\bpackage\s+([A-Za-z_][\w.]*)\s*;`)
+
+	// matches an import statement, for example:
+	//   import public "google/protobuf/timestamp.proto";
+	importPattern = regexp.MustCompile(`\bimport\s+(?:(public|weak)\s+)?["']([^"']+)["']\s*;`)
+
+	// matches the start of a service definition, for example:
+	//   service Greeter {
+	servicePattern = regexp.MustCompile(`\bservice\s+([A-Za-z_]\w*)\s*\{`)
+
+	// matches the start of a method definition within a service, for example:
+	//   rpc SayHello (HelloRequest) returns (HelloReply) {}
+	rpcPattern = regexp.MustCompile(`\brpc\s+([A-Za-z_]\w*)\s*\(`)
+)
+
+var _ generic.Parser = parseProtoFile
+
+// parseProtoFile parses the package, import and service declarations of a .proto file (without a full parse of the
+// protobuf language), returning a package for the proto package declared by the file. Files without a package
+// declaration are not cataloged, since there is nothing to identify the definitions by.
+func parseProtoFile(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read .proto file: %w", err)
+	}
+	src := stripComments(string(contents))
+
+	match := packagePattern.FindStringSubmatch(src)
+	if match == nil {
+		log.WithFields("path", reader.RealPath).Trace("skipping .proto file without a package declaration")
+		return nil, nil, nil
+	}
+
+	m := pkg.ProtobufFile{
+		Syntax:   defaultSyntax,
+		Imports:  parseImports(src),
+		Services: parseServices(src),
+	}
+	if match := syntaxPattern.FindStringSubmatch(src); match != nil {
+		m.Syntax = match[1]
+	}
+
+	return []pkg.Package{
+		newPackage(match[1], m, reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+	}, nil, nil
+}
+
+func parseImports(src string) []pkg.ProtobufImport {
+	var imports []pkg.ProtobufImport
+	for _, match := range importPattern.FindAllStringSubmatch(src, -1) {
+		imports = append(imports, pkg.ProtobufImport{
+			Path:   match[2],
+			Public: match[1] == "public",
+			Weak:   match[1] == "weak",
+		})
+	}
+	return imports
+}
+
+func parseServices(src string) []pkg.ProtobufService {
+	var services []pkg.ProtobufService
+	for _, loc := range servicePattern.FindAllStringSubmatchIndex(src, -1) {
+		service := pkg.ProtobufService{
+			Name: src[loc[2]:loc[3]],
+		}
+		// the opening brace of the service body is the last character of the match
+		body := blockBody(src[loc[1]:])
+		for _, match := range rpcPattern.FindAllStringSubmatch(body, -1) {
+			service.Methods = append(service.Methods, match[1])
+		}
+		services = append(services, service)
+	}
+	return services
+}
+
+// blockBody returns the contents of a block up to the closing brace matching an (already consumed) opening brace,
+// accounting for nested blocks (e.g. the options of a method).
+func blockBody(s string) string {
+	depth := 1
+	for i, c := range s {
+		switch c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return s[:i]
+			}
+		}
+	}
+	return s
+}
+
+// stripComments replaces the line (//) and block (/* */) comments of the given protobuf source with whitespace,
+// leaving comment markers within string literals (e.g. URLs within options) as-is.
+func stripComments(src string) string {
+	var sb strings.Builder
+	sb.Grow(len(src))
+
+	var quote byte
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case quote != 0:
+			sb.WriteByte(c)
+			if c == '\\' && i+1 < len(src) {
+				i++
+				sb.WriteByte(src[i])
+			} else if c == quote || c == '\n' {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+			sb.WriteByte(c)
+		case strings.HasPrefix(src[i:], "//"):
+			end := strings.IndexByte(src[i:], '\n')
+			if end < 0 {
+				return sb.String()
+			}
+			i += end - 1
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return sb.String()
+			}
+			// retain the line breaks of the comment to keep statements separated
+			sb.WriteString(strings.Repeat("\n", strings.Count(src[i:i+2+end], "\n")))
+			sb.WriteByte(' ')
+			i += end + 3
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,105 @@
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseProtoFile(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		expected []pkg.Package
+	}{
+		{
+			fixture: "test-fixtures/greeter.proto",
+			expected: []pkg.Package{
+				{
+					Name:      "helloworld.v1",
+					Type:      pkg.ProtobufPkg,
+					Locations: file.NewLocationSet(file.NewLocation("test-fixtures/greeter.proto").WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+					Metadata: pkg.ProtobufFile{
+						Syntax: "proto3",
+						Imports: []pkg.ProtobufImport{
+							{Path: "google/api/annotations.proto"},
+							{Path: "helloworld/v1/messages.proto", Public: true},
+							{Path: "helloworld/v1/legacy.proto", Weak: true},
+						},
+						Services: []pkg.ProtobufService{
+							{Name: "Greeter", Methods: []string{"SayHello", "SayHelloStream"}},
+							{Name: "Health", Methods: []string{"Check"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/legacy.proto",
+			expected: []pkg.Package{
+				{
+					Name:      "tutorial",
+					Type:      pkg.ProtobufPkg,
+					Locations: file.NewLocationSet(file.NewLocation("test-fixtures/legacy.proto").WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+					Metadata: pkg.ProtobufFile{
+						Syntax: "proto2",
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/no-package.proto",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			for i := range test.expected {
+				test.expected[i].SetID()
+			}
+			pkgtest.TestFileParser(t, test.fixture, parseProtoFile, test.expected, nil)
+		})
+	}
+}
+
+func Test_stripComments(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "line comment",
+			input:    "package a; // package b;\nimport \"c.proto\";",
+			expected: "package a; \nimport \"c.proto\";",
+		},
+		{
+			name:     "block comment spanning lines",
+			input:    "package a;/* first\nsecond */import \"c.proto\";",
+			expected: "package a;\n import \"c.proto\";",
+		},
+		{
+			name:     "comment markers within strings",
+			input:    `option go_package = "example.com/a//b/*c*/";`,
+			expected: `option go_package = "example.com/a//b/*c*/";`,
+		},
+		{
+			name:     "escaped quote within string",
+			input:    `option x = "a\"b // c"; // comment`,
+			expected: `option x = "a\"b // c"; `,
+		},
+		{
+			name:     "unterminated block comment",
+			input:    "package a; /* never ends",
+			expected: "package a; ",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, stripComments(test.input))
+		})
+	}
+}
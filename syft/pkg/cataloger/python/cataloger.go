@@ -4,6 +4,12 @@ Package python provides a concrete Cataloger implementation relating to packages
 package python
 
 import (
+	"path"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/syft/internal/mimetype"
+	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/pkg/cataloger/generic"
 )
@@ -12,6 +18,11 @@ const eggInfoGlob = "**/*.egg-info"
 
 type CatalogerConfig struct {
 	GuessUnpinnedRequirements bool `yaml:"guess-unpinned-requirements" json:"guess-unpinned-requirements" mapstructure:"guess-unpinned-requirements"`
+
+	// ExcludeDevDependencies will skip packages that are only required for development (currently the "dev" category of
+	// poetry.lock files and the [dev-packages] of Pipfile files). Note that Pipfile.lock files only ever report their
+	// default packages, so never include the packages of the "develop" section.
+	ExcludeDevDependencies bool `yaml:"exclude-dev-dependencies" json:"exclude-dev-dependencies" mapstructure:"exclude-dev-dependencies"`
 }
 
 func DefaultCatalogerConfig() CatalogerConfig {
@@ -20,14 +31,93 @@ func DefaultCatalogerConfig() CatalogerConfig {
 	}
 }
 
-// NewPackageCataloger returns a new cataloger for python packages referenced from poetry lock files, requirements.txt files, and setup.py files.
+func (c CatalogerConfig) WithExcludeDevDependencies(exclude bool) CatalogerConfig {
+	c.ExcludeDevDependencies = exclude
+	return c
+}
+
+// NewPackageCataloger returns a new cataloger for python packages referenced from poetry lock files, Pipfile.lock files,
+// Pipfile files, requirements.txt files, setup.py files, pyproject.toml files, and setup.cfg files.
 func NewPackageCataloger(cfg CatalogerConfig) pkg.Cataloger {
 	rqp := newRequirementsParser(cfg)
+	plp := newPoetryLockParser(cfg)
 	return generic.NewCataloger("python-package-cataloger").
 		WithParserByGlobs(rqp.parseRequirementsTxt, "**/*requirements*.txt").
-		WithParserByGlobs(parsePoetryLock, "**/poetry.lock").
+		WithParserByGlobs(plp.parsePoetryLock, "**/poetry.lock").
 		WithParserByGlobs(parsePipfileLock, "**/Pipfile.lock").
-		WithParserByGlobs(parseSetup, "**/setup.py")
+		WithParserByGlobs(rqp.parsePipfile, "**/Pipfile").
+		WithParserByGlobs(rqp.parseSetup, "**/setup.py").
+		WithParserByGlobs(rqp.parsePyprojectToml, "**/pyproject.toml").
+		WithParserByGlobs(rqp.parseSetupCfg, "**/setup.cfg").
+		WithProcessors(preferLockedPackages, dropLockedPipfilePackages)
+}
+
+// preferLockedPackages drops packages declared by pyproject.toml and setup.cfg files when the same package is pinned
+// by a poetry.lock or Pipfile.lock file within the same directory, since the lockfile records the exact version used.
+// Packages are matched by the normalized package name (see PEP 503).
+var preferLockedPackages = generic.PreferLockedPackages(
+	func(p pkg.Package) []string {
+		switch p.Metadata.(type) {
+		case pkg.PythonPoetryLockEntry, pkg.PythonPipfileLockEntry:
+			return []string{pkg.NormalizeName(pkg.PythonPkg, p.Name)}
+		}
+		return nil
+	},
+	func(p pkg.Package) string {
+		if isDeclaredByProject(p) {
+			return pkg.NormalizeName(pkg.PythonPkg, p.Name)
+		}
+		return ""
+	},
+)
+
+// dropLockedPipfilePackages drops the packages declared by a Pipfile altogether when a Pipfile.lock is found within
+// the same directory, since the lockfile resolves every package of the Pipfile (only the default packages of the
+// lockfile are reported).
+func dropLockedPipfilePackages(pkgs []pkg.Package, relationships []artifact.Relationship, err error) ([]pkg.Package, []artifact.Relationship, error) {
+	pipfileLocked := strset.New()
+	for _, p := range pkgs {
+		if _, ok := p.Metadata.(pkg.PythonPipfileLockEntry); !ok {
+			continue
+		}
+		for _, l := range p.Locations.ToSlice() {
+			if path.Base(l.RealPath) == "Pipfile.lock" {
+				pipfileLocked.Add(path.Dir(l.RealPath))
+			}
+		}
+	}
+
+	if pipfileLocked.IsEmpty() {
+		return pkgs, relationships, err
+	}
+
+	var result []pkg.Package
+	for _, p := range pkgs {
+		if isDeclaredByLockedPipfile(pipfileLocked, p) {
+			continue
+		}
+		result = append(result, p)
+	}
+	return result, relationships, err
+}
+
+func isDeclaredByProject(p pkg.Package) bool {
+	for _, l := range p.Locations.ToSlice() {
+		switch path.Base(l.RealPath) {
+		case "pyproject.toml", "setup.cfg":
+			return true
+		}
+	}
+	return false
+}
+
+func isDeclaredByLockedPipfile(pipfileLocked *strset.Set, p pkg.Package) bool {
+	for _, l := range p.Locations.ToSlice() {
+		if path.Base(l.RealPath) == "Pipfile" && pipfileLocked.Has(path.Dir(l.RealPath)) {
+			return true
+		}
+	}
+	return false
 }
 
 // NewInstalledPackageCataloger returns a new cataloger for python packages within egg or wheel installation directories.
@@ -42,3 +132,11 @@ func NewInstalledPackageCataloger() pkg.Cataloger {
 			"**/*EGG-INFO/PKG-INFO",
 		)
 }
+
+// NewPyInstallerBinaryCataloger returns a new cataloger for python packages bundled within PyInstaller executables
+// (one-file bundles), which embed a python interpreter and the bundled packages within an archive appended to the
+// executable.
+func NewPyInstallerBinaryCataloger() pkg.Cataloger {
+	return generic.NewCataloger("python-pyinstaller-binary-cataloger").
+		WithParserByMimeTypes(parsePyInstallerBinary, mimetype.ExecutableMIMETypeSet.List()...)
+}
@@ -113,9 +113,9 @@ func Test_PackageCataloger(t *testing.T) {
 				"test-fixtures/dist-info/direct_url.json",
 			},
 			expectedPackage: pkg.Package{
-				Name:     "Pygments",
+				Name:     "pygments",
 				Version:  "2.6.1",
-				PURL:     "pkg:pypi/Pygments@2.6.1?vcs_url=git%2Bhttps://github.com/python-test/test.git%40aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+				PURL:     "pkg:pypi/pygments@2.6.1?vcs_url=git%2Bhttps://github.com/python-test/test.git%40aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 				Type:     pkg.PythonPkg,
 				Language: pkg.Python,
 				Licenses: pkg.NewLicenseSet(
@@ -152,9 +152,9 @@ func Test_PackageCataloger(t *testing.T) {
 				"test-fixtures/casesensitive/DIST-INFO/direct_url.json",
 			},
 			expectedPackage: pkg.Package{
-				Name:     "Pygments",
+				Name:     "pygments",
 				Version:  "2.6.1",
-				PURL:     "pkg:pypi/Pygments@2.6.1?vcs_url=git%2Bhttps://github.com/python-test/test.git%40aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+				PURL:     "pkg:pypi/pygments@2.6.1?vcs_url=git%2Bhttps://github.com/python-test/test.git%40aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 				Type:     pkg.PythonPkg,
 				Language: pkg.Python,
 				Licenses: pkg.NewLicenseSet(
@@ -189,9 +189,9 @@ func Test_PackageCataloger(t *testing.T) {
 				"test-fixtures/malformed-record/dist-info/RECORD",
 			},
 			expectedPackage: pkg.Package{
-				Name:     "Pygments",
+				Name:     "pygments",
 				Version:  "2.6.1",
-				PURL:     "pkg:pypi/Pygments@2.6.1",
+				PURL:     "pkg:pypi/pygments@2.6.1",
 				Type:     pkg.PythonPkg,
 				Language: pkg.Python,
 				Licenses: pkg.NewLicenseSet(
@@ -220,9 +220,9 @@ func Test_PackageCataloger(t *testing.T) {
 			name:     "partial dist-info directory",
 			fixtures: []string{"test-fixtures/partial.dist-info/METADATA"},
 			expectedPackage: pkg.Package{
-				Name:     "Pygments",
+				Name:     "pygments",
 				Version:  "2.6.1",
-				PURL:     "pkg:pypi/Pygments@2.6.1",
+				PURL:     "pkg:pypi/pygments@2.6.1",
 				Type:     pkg.PythonPkg,
 				Language: pkg.Python,
 				Licenses: pkg.NewLicenseSet(
@@ -324,6 +324,9 @@ func Test_IndexCataloger_Globs(t *testing.T) {
 				"src/setup.py",
 				"src/poetry.lock",
 				"src/Pipfile.lock",
+				"src/Pipfile",
+				"src/pyproject.toml",
+				"src/setup.cfg",
 			},
 		},
 	}
@@ -338,6 +341,69 @@ func Test_IndexCataloger_Globs(t *testing.T) {
 	}
 }
 
+func Test_IndexCataloger_PrefersLockedPackages(t *testing.T) {
+	pyproject := file.NewLocation("pyproject.toml")
+	lockfile := file.NewLocation("poetry.lock")
+
+	expected := []pkg.Package{
+		{
+			Name:      "added-value",
+			Version:   "0.14.2",
+			PURL:      "pkg:pypi/added-value@0.14.2",
+			Locations: file.NewLocationSet(lockfile),
+			Language:  pkg.Python,
+			Type:      pkg.PythonPkg,
+			FoundBy:   "python-package-cataloger",
+			Metadata:  pkg.PythonPoetryLockEntry{Index: "https://pypi.org/simple"},
+		},
+		{
+			// not found in the lockfile, so the declared package is kept
+			Name:      "six",
+			Version:   "1.16.0",
+			PURL:      "pkg:pypi/six@1.16.0",
+			Locations: file.NewLocationSet(pyproject),
+			Language:  pkg.Python,
+			Type:      pkg.PythonPkg,
+			FoundBy:   "python-package-cataloger",
+			Metadata: pkg.PythonRequirementsEntry{
+				Name:              "six",
+				VersionConstraint: "1.16.0",
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/pyproject/locked").
+		Expects(expected, nil).
+		TestCataloger(t, NewPackageCataloger(DefaultCatalogerConfig()))
+}
+
+func Test_IndexCataloger_PrefersPipfileLock(t *testing.T) {
+	lockfile := file.NewLocation("Pipfile.lock")
+
+	expected := []pkg.Package{
+		{
+			// the dev packages of the Pipfile are not reported, as with the dev packages of the lockfile
+			Name:      "requests",
+			Version:   "2.31.0",
+			PURL:      "pkg:pypi/requests@2.31.0",
+			Locations: file.NewLocationSet(lockfile),
+			Language:  pkg.Python,
+			Type:      pkg.PythonPkg,
+			FoundBy:   "python-package-cataloger",
+			Metadata: pkg.PythonPipfileLockEntry{
+				Index:  "https://pypi.org/simple",
+				Hashes: []string{"sha256:64299f4909223da747622c030b781c0d7811e359c37124b4bd368fb8c6518baa"},
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/pipfile/locked").
+		Expects(expected, nil).
+		TestCataloger(t, NewPackageCataloger(DefaultCatalogerConfig()))
+}
+
 func Test_PackageCataloger_Globs(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -10,24 +10,9 @@ import (
 	"github.com/anchore/syft/syft/pkg"
 )
 
-func newPackageForIndex(name, version string, locations ...file.Location) pkg.Package {
-	p := pkg.Package{
-		Name:      name,
-		Version:   version,
-		Locations: file.NewLocationSet(locations...),
-		PURL:      packageURL(name, version, nil),
-		Language:  pkg.Python,
-		Type:      pkg.PythonPkg,
-	}
-
-	p.SetID()
-
-	return p
-}
-
 func newPackageForIndexWithMetadata(name, version string, metadata interface{}, locations ...file.Location) pkg.Package {
 	p := pkg.Package{
-		Name:      name,
+		Name:      pkg.NormalizeName(pkg.PythonPkg, name),
 		Version:   version,
 		Locations: file.NewLocationSet(locations...),
 		PURL:      packageURL(name, version, nil),
@@ -43,7 +28,7 @@ func newPackageForIndexWithMetadata(name, version string, metadata interface{},
 
 func newPackageForRequirementsWithMetadata(name, version string, metadata pkg.PythonRequirementsEntry, locations ...file.Location) pkg.Package {
 	p := pkg.Package{
-		Name:      name,
+		Name:      pkg.NormalizeName(pkg.PythonPkg, name),
 		Version:   version,
 		Locations: file.NewLocationSet(locations...),
 		PURL:      packageURL(name, version, nil),
@@ -88,7 +73,7 @@ func newPackageForPackage(resolver file.Resolver, m parsedData, sources ...file.
 	}
 
 	p := pkg.Package{
-		Name:      m.Name,
+		Name:      pkg.NormalizeName(pkg.PythonPkg, m.Name),
 		Version:   m.Version,
 		PURL:      packageURL(m.Name, m.Version, &m.PythonPackage),
 		Locations: file.NewLocationSet(sources...),
@@ -108,7 +93,7 @@ func packageURL(name, version string, m *pkg.PythonPackage) string {
 	pURL := packageurl.NewPackageURL(
 		packageurl.TypePyPi,
 		"",
-		name,
+		pkg.NormalizeName(pkg.PythonPkg, name),
 		version,
 		purlQualifiersForPackage(m),
 		"")
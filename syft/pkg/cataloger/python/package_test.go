@@ -22,6 +22,12 @@ func Test_packageURL(t *testing.T) {
 			version:  "v0.1.0",
 			want:     "pkg:pypi/name@v0.1.0",
 		},
+		{
+			testName: "name is normalized",
+			name:     "Zope.Interface_Extras",
+			version:  "v0.1.0",
+			want:     "pkg:pypi/zope-interface-extras@v0.1.0",
+		},
 		{
 			testName: "with vcs info",
 			name:     "name",
@@ -0,0 +1,129 @@
+package python
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// the version control systems supported by pipenv, as keys of a Pipfile dependency table
+var pipfileVCSKeys = []string{"git", "hg", "svn", "bzr"}
+
+type pipfile struct {
+	Packages    map[string]interface{} `toml:"packages"`
+	DevPackages map[string]interface{} `toml:"dev-packages"`
+}
+
+// parsePipfile is a parser function for Pipfile contents, returning all dependencies declared within the [packages]
+// and [dev-packages] tables (unless dev dependencies are excluded). Projects with a Pipfile.lock report the resolved
+// packages of the lock instead (see preferLockedPackages).
+func (rp requirementsParser) parsePipfile(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	tree, err := toml.LoadReader(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to load Pipfile for parsing: %w", err)
+	}
+
+	var project pipfile
+	if err := tree.Unmarshal(&project); err != nil {
+		return nil, nil, fmt.Errorf("unable to parse Pipfile: %w", err)
+	}
+
+	tables := []map[string]interface{}{project.Packages}
+	if !rp.excludeDevDependencies {
+		tables = append(tables, project.DevPackages)
+	}
+
+	var pkgs []pkg.Package
+	for _, deps := range tables {
+		// sort for stable results, since the dependencies are keyed by name
+		names := make([]string, 0, len(deps))
+		for name := range deps {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			entry, ok := newPipfileDependency(name, deps[name])
+			if !ok {
+				log.WithFields("path", reader.RealPath).Tracef("skipping Pipfile dependency: %q", name)
+				continue
+			}
+			pkgs = append(pkgs, newPackageForRequirementsWithMetadata(
+				entry.Name,
+				parseVersion(entry.VersionConstraint, rp.guessUnpinnedRequirements),
+				entry,
+				reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			))
+		}
+	}
+
+	return pkgs, nil, nil
+}
+
+// newPipfileDependency creates a requirements entry from a Pipfile dependency value, which is either a version
+// constraint (e.g. ">=2.28") or a table, for example:
+//
+//	requests = { version = "==2.28.1", extras = ["socks"] }
+//	django = { git = "https://github.com/django/django.git", ref = "4.2" }
+//
+// Local path dependencies (e.g. { path = ".", editable = true }) are not packages from an index, so are skipped.
+func newPipfileDependency(name string, value interface{}) (pkg.PythonRequirementsEntry, bool) {
+	entry := pkg.PythonRequirementsEntry{Name: name}
+
+	switch v := value.(type) {
+	case string:
+		entry.VersionConstraint = v
+	case map[string]interface{}:
+		if _, ok := v["path"]; ok {
+			return entry, false
+		}
+		entry.VersionConstraint, _ = v["version"].(string)
+		entry.Markers, _ = v["markers"].(string)
+		entry.URL = pipfileURL(v)
+		if extras, ok := v["extras"].([]interface{}); ok {
+			for _, e := range extras {
+				if s, ok := e.(string); ok {
+					entry.Extras = append(entry.Extras, s)
+				}
+			}
+		}
+	default:
+		return entry, false
+	}
+
+	entry.VersionConstraint = strings.TrimSpace(entry.VersionConstraint)
+	if entry.VersionConstraint == "*" {
+		entry.VersionConstraint = ""
+	}
+
+	return entry, true
+}
+
+// pipfileURL returns the URL of a VCS (e.g. "git+https://github.com/django/django.git@4.2") or file dependency table,
+// or an empty string for dependencies from an index.
+func pipfileURL(v map[string]interface{}) string {
+	for _, vcs := range pipfileVCSKeys {
+		url, ok := v[vcs].(string)
+		if !ok || url == "" {
+			continue
+		}
+		if !strings.HasPrefix(url, vcs+"+") {
+			url = vcs + "+" + url
+		}
+		if ref, ok := v["ref"].(string); ok && ref != "" {
+			url += "@" + ref
+		}
+		return url
+	}
+	url, _ := v["file"].(string)
+	return url
+}
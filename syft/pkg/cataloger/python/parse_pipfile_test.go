@@ -0,0 +1,122 @@
+package python
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParsePipfile(t *testing.T) {
+	fixture := "test-fixtures/pipfile/Pipfile"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "django",
+			PURL:      "pkg:pypi/django",
+			Locations: locations,
+			Language:  pkg.Python,
+			Type:      pkg.PythonPkg,
+			Metadata: pkg.PythonRequirementsEntry{
+				Name: "django",
+				URL:  "git+https://github.com/django/django.git@4.2",
+			},
+		},
+		{
+			Name:      "flask",
+			PURL:      "pkg:pypi/flask",
+			Locations: locations,
+			Language:  pkg.Python,
+			Type:      pkg.PythonPkg,
+			Metadata: pkg.PythonRequirementsEntry{
+				Name:              "flask",
+				VersionConstraint: ">=2.0",
+			},
+		},
+		{
+			Name:      "pywin32",
+			PURL:      "pkg:pypi/pywin32",
+			Locations: locations,
+			Language:  pkg.Python,
+			Type:      pkg.PythonPkg,
+			Metadata: pkg.PythonRequirementsEntry{
+				Name:    "pywin32",
+				Markers: "sys_platform == 'win32'",
+			},
+		},
+		{
+			Name:      "requests",
+			Version:   "2.28.1",
+			PURL:      "pkg:pypi/requests@2.28.1",
+			Locations: locations,
+			Language:  pkg.Python,
+			Type:      pkg.PythonPkg,
+			Metadata: pkg.PythonRequirementsEntry{
+				Name:              "requests",
+				Extras:            []string{"socks"},
+				VersionConstraint: "==2.28.1",
+			},
+		},
+		{
+			Name:      "six",
+			PURL:      "pkg:pypi/six",
+			Locations: locations,
+			Language:  pkg.Python,
+			Type:      pkg.PythonPkg,
+			Metadata: pkg.PythonRequirementsEntry{
+				Name: "six",
+			},
+		},
+		{
+			Name:      "pytest",
+			Version:   "7.4.0",
+			PURL:      "pkg:pypi/pytest@7.4.0",
+			Locations: locations,
+			Language:  pkg.Python,
+			Type:      pkg.PythonPkg,
+			Metadata: pkg.PythonRequirementsEntry{
+				Name:              "pytest",
+				VersionConstraint: "==7.4.0",
+			},
+		},
+	}
+
+	var expectedWithoutDevPkgs []pkg.Package
+	for _, p := range expectedPkgs {
+		if p.Name != "pytest" {
+			expectedWithoutDevPkgs = append(expectedWithoutDevPkgs, p)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		cfg      CatalogerConfig
+		expected []pkg.Package
+	}{
+		{
+			name:     "packages and dev packages",
+			cfg:      DefaultCatalogerConfig(),
+			expected: expectedPkgs,
+		},
+		{
+			name:     "exclude dev packages",
+			cfg:      DefaultCatalogerConfig().WithExcludeDevDependencies(true),
+			expected: expectedWithoutDevPkgs,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parser := newRequirementsParser(test.cfg)
+			pkgtest.TestFileParser(t, fixture, parser.parsePipfile, test.expected, nil)
+		})
+	}
+}
+
+func Test_corruptPipfile(t *testing.T) {
+	parser := newRequirementsParser(DefaultCatalogerConfig())
+	pkgtest.NewCatalogTester().
+		FromFile(t, "test-fixtures/glob-paths/src/Pipfile").
+		WithError().
+		TestParser(t, parser.parsePipfile)
+}
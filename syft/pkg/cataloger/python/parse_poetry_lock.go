@@ -13,8 +13,6 @@ import (
 )
 
 // integrity check
-var _ generic.Parser = parsePoetryLock
-
 type poetryPackageSource struct {
 	URL string `toml:"url"`
 }
@@ -30,8 +28,18 @@ type poetryPackages struct {
 	} `toml:"package"`
 }
 
+type poetryLockParser struct {
+	excludeDevDependencies bool
+}
+
+func newPoetryLockParser(cfg CatalogerConfig) poetryLockParser {
+	return poetryLockParser{
+		excludeDevDependencies: cfg.ExcludeDevDependencies,
+	}
+}
+
 // parsePoetryLock is a parser function for poetry.lock contents, returning all python packages discovered.
-func parsePoetryLock(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+func (pp poetryLockParser) parsePoetryLock(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
 	tree, err := toml.LoadReader(reader)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to load poetry.lock for parsing: %w", err)
@@ -45,6 +53,11 @@ func parsePoetryLock(_ context.Context, _ file.Resolver, _ *generic.Environment,
 
 	var pkgs []pkg.Package
 	for _, p := range metadata.Packages {
+		dev := p.Category == "dev"
+		if dev && pp.excludeDevDependencies {
+			continue
+		}
+
 		var index string
 		if p.Source.URL != "" {
 			index = p.Source.URL
@@ -57,7 +70,7 @@ func parsePoetryLock(_ context.Context, _ file.Resolver, _ *generic.Environment,
 			newPackageForIndexWithMetadata(
 				p.Name,
 				p.Version,
-				pkg.PythonPoetryLockEntry{Index: index},
+				pkg.PythonPoetryLockEntry{Index: index, DevDependency: dev},
 				reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
 			),
 		)
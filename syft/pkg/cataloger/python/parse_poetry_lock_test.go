@@ -20,7 +20,7 @@ func TestParsePoetryLock(t *testing.T) {
 			Locations: locations,
 			Language:  pkg.Python,
 			Type:      pkg.PythonPkg,
-			Metadata:  pkg.PythonPoetryLockEntry{Index: "https://test.pypi.org/simple"},
+			Metadata:  pkg.PythonPoetryLockEntry{Index: "https://test.pypi.org/simple", DevDependency: true},
 		},
 		{
 			Name:      "alabaster",
@@ -29,7 +29,7 @@ func TestParsePoetryLock(t *testing.T) {
 			Locations: locations,
 			Language:  pkg.Python,
 			Type:      pkg.PythonPkg,
-			Metadata:  pkg.PythonPoetryLockEntry{Index: "https://pypi.org/simple"},
+			Metadata:  pkg.PythonPoetryLockEntry{Index: "https://pypi.org/simple", DevDependency: true},
 		},
 		{
 			Name:      "appnope",
@@ -38,7 +38,7 @@ func TestParsePoetryLock(t *testing.T) {
 			Locations: locations,
 			Language:  pkg.Python,
 			Type:      pkg.PythonPkg,
-			Metadata:  pkg.PythonPoetryLockEntry{Index: "https://pypi.org/simple"},
+			Metadata:  pkg.PythonPoetryLockEntry{Index: "https://pypi.org/simple", DevDependency: true},
 		},
 		{
 			Name:      "asciitree",
@@ -47,12 +47,57 @@ func TestParsePoetryLock(t *testing.T) {
 			Locations: locations,
 			Language:  pkg.Python,
 			Type:      pkg.PythonPkg,
-			Metadata:  pkg.PythonPoetryLockEntry{Index: "https://pypi.org/simple"},
+			Metadata:  pkg.PythonPoetryLockEntry{Index: "https://pypi.org/simple", DevDependency: true},
 		},
 	}
 
 	// TODO: relationships are not under test
 	var expectedRelationships []artifact.Relationship
 
-	pkgtest.TestFileParser(t, fixture, parsePoetryLock, expectedPkgs, expectedRelationships)
+	pkgtest.TestFileParser(t, fixture, newPoetryLockParser(DefaultCatalogerConfig()).parsePoetryLock, expectedPkgs, expectedRelationships)
+}
+
+func TestParsePoetryLockDevDependencies(t *testing.T) {
+	fixture := "test-fixtures/poetry-dev/poetry.lock"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	requests := pkg.Package{
+		Name:      "requests",
+		Version:   "2.31.0",
+		PURL:      "pkg:pypi/requests@2.31.0",
+		Locations: locations,
+		Language:  pkg.Python,
+		Type:      pkg.PythonPkg,
+		Metadata:  pkg.PythonPoetryLockEntry{Index: "https://pypi.org/simple"},
+	}
+	pytest := pkg.Package{
+		Name:      "pytest",
+		Version:   "7.4.3",
+		PURL:      "pkg:pypi/pytest@7.4.3",
+		Locations: locations,
+		Language:  pkg.Python,
+		Type:      pkg.PythonPkg,
+		Metadata:  pkg.PythonPoetryLockEntry{Index: "https://pypi.org/simple", DevDependency: true},
+	}
+
+	tests := []struct {
+		name     string
+		cfg      CatalogerConfig
+		expected []pkg.Package
+	}{
+		{
+			name:     "include dev dependencies",
+			cfg:      DefaultCatalogerConfig(),
+			expected: []pkg.Package{requests, pytest},
+		},
+		{
+			name:     "exclude dev dependencies",
+			cfg:      DefaultCatalogerConfig().WithExcludeDevDependencies(true),
+			expected: []pkg.Package{requests},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.TestFileParser(t, fixture, newPoetryLockParser(test.cfg).parsePoetryLock, test.expected, nil)
+		})
+	}
 }
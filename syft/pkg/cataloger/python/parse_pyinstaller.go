@@ -0,0 +1,264 @@
+package python
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// the structures of the archive (CArchive) appended to PyInstaller executables, which holds the embedded Python
+// interpreter, the PYZ archive of modules, and the data files (including the metadata of the bundled packages)
+// (see https://pyinstaller.org/en/stable/advanced-topics.html#the-table-of-contents-toc-lists-and-the-archive-class)
+const (
+	// pyInstallerCookieSize is the size of the cookie (the trailer) of the archive for PyInstaller >= 2.1, which is
+	// the magic followed by the length of the archive, the offset and length of the table of contents, the version of
+	// the embedded python interpreter, and the name of the python library
+	pyInstallerCookieSize = 88
+
+	// pyInstallerTOCEntrySize is the size of a table of contents entry, without the (variable length) name
+	pyInstallerTOCEntrySize = 18
+
+	// pyInstallerMaxCookieSearch bounds how far from the end of the executable the cookie is searched for, allowing
+	// for data appended after the archive (e.g. code signatures)
+	pyInstallerMaxCookieSearch = 1 << 20
+
+	// pyInstallerMaxTOCSize bounds the size of the table of contents that is read
+	pyInstallerMaxTOCSize = 16 << 20
+
+	// pyInstallerMaxMetadataSize bounds the (uncompressed) size of the package metadata that is read
+	pyInstallerMaxMetadataSize = 1 << 20
+
+	// pyInstallerDataEntry is the kind of the entries holding data files (rather than binaries, scripts, or modules)
+	pyInstallerDataEntry = 'x'
+)
+
+var pyInstallerMagic = []byte("MEI\014\013\012\013\016")
+
+var errNotPyInstaller = errors.New("not a PyInstaller executable")
+
+// pyInstallerArchive is the archive appended to a PyInstaller executable.
+type pyInstallerArchive struct {
+	reader io.ReaderAt
+	start  int64
+	end    int64
+	// pythonVersion is the version of the embedded python interpreter (major.minor)
+	pythonVersion string
+	// pythonLibrary is the name of the embedded python library (e.g. libpython3.11.so.1.0 or python311.dll)
+	pythonLibrary string
+	entries       []pyInstallerTOCEntry
+}
+
+type pyInstallerTOCEntry struct {
+	name             string
+	offset           int64
+	compressedSize   int64
+	uncompressedSize int64
+	compressed       bool
+	kind             byte
+}
+
+var _ generic.Parser = parsePyInstallerBinary
+
+// parsePyInstallerBinary parses the archive appended to PyInstaller executables (one-file bundles), returning the
+// python packages whose metadata (dist-info or egg-info) was bundled. Executables without an archive are skipped.
+func parsePyInstallerBinary(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	unionReader, err := unionreader.GetUnionReader(reader.ReadCloser)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get union reader for binary: %w", err)
+	}
+	size, err := unionReader.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to determine size of binary: %w", err)
+	}
+
+	archive, err := readPyInstallerArchive(unionReader, size)
+	if errors.Is(err, errNotPyInstaller) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read PyInstaller archive: %w", err)
+	}
+	log.WithFields("path", reader.RealPath, "python", archive.pythonVersion, "library", archive.pythonLibrary).Trace("found PyInstaller archive")
+
+	location := reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	var pkgs []pkg.Package
+	for _, entry := range archive.entries {
+		if entry.kind != pyInstallerDataEntry || !isBundledPackageMetadata(entry.name) {
+			continue
+		}
+		contents, err := archive.read(entry, pyInstallerMaxMetadataSize)
+		if err != nil {
+			log.WithFields("path", reader.RealPath, "entry", entry.name, "error", err).Debug("unable to read bundled python package metadata")
+			continue
+		}
+		pd, err := parseWheelOrEggMetadata(entry.name, bytes.NewReader(contents))
+		if err != nil {
+			log.WithFields("path", reader.RealPath, "entry", entry.name, "error", err).Debug("unable to parse bundled python package metadata")
+			continue
+		}
+		if pd.Name == "" {
+			continue
+		}
+
+		// the metadata is not found on the filesystem, but within the executable
+		pd.SitePackagesRootPath = ""
+		pd.LicenseLocation = file.Location{}
+		if pd.Licenses != "" || pd.LicenseExpression != "" {
+			pd.LicenseLocation = reader.Location
+		}
+
+		pkgs = append(pkgs, newPackageForPackage(resolver, pd, location))
+	}
+
+	return pkgs, nil, nil
+}
+
+// isBundledPackageMetadata indicates if the given archive entry is the metadata of a bundled python package.
+func isBundledPackageMetadata(name string) bool {
+	dir, base := path.Split(strings.ReplaceAll(name, "\\", "/"))
+	dir = strings.ToLower(strings.TrimSuffix(dir, "/"))
+	switch {
+	case strings.HasSuffix(dir, ".dist-info"):
+		return base == "METADATA"
+	case strings.HasSuffix(dir, ".egg-info"):
+		return base == "PKG-INFO"
+	}
+	return false
+}
+
+// readPyInstallerArchive reads the table of contents of the archive appended to a PyInstaller executable, returning
+// errNotPyInstaller if there is no archive.
+func readPyInstallerArchive(reader io.ReaderAt, size int64) (*pyInstallerArchive, error) {
+	cookieOffset, err := findPyInstallerCookie(reader, size)
+	if err != nil {
+		return nil, err
+	}
+
+	cookie := make([]byte, pyInstallerCookieSize)
+	if _, err := reader.ReadAt(cookie, cookieOffset); err != nil {
+		return nil, fmt.Errorf("unable to read cookie: %w", err)
+	}
+
+	length := int64(binary.BigEndian.Uint32(cookie[8:]))
+	tocOffset := int64(binary.BigEndian.Uint32(cookie[12:]))
+	tocLength := int64(binary.BigEndian.Uint32(cookie[16:]))
+	pyver := binary.BigEndian.Uint32(cookie[20:])
+
+	end := cookieOffset + pyInstallerCookieSize
+	start := end - length
+	if start < 0 || tocOffset+tocLength > length {
+		return nil, fmt.Errorf("invalid archive bounds (length=%d, toc offset=%d, toc length=%d)", length, tocOffset, tocLength)
+	}
+	if tocLength > pyInstallerMaxTOCSize {
+		return nil, fmt.Errorf("table of contents is too large (%d bytes)", tocLength)
+	}
+
+	toc := make([]byte, tocLength)
+	if _, err := reader.ReadAt(toc, start+tocOffset); err != nil {
+		return nil, fmt.Errorf("unable to read table of contents: %w", err)
+	}
+	entries, err := parsePyInstallerTOC(toc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pyInstallerArchive{
+		reader:        reader,
+		start:         start,
+		end:           end,
+		pythonVersion: pyInstallerPythonVersion(pyver),
+		pythonLibrary: string(bytes.TrimRight(cookie[24:], "\x00")),
+		entries:       entries,
+	}, nil
+}
+
+// findPyInstallerCookie returns the offset of the last cookie within the end of the given executable.
+func findPyInstallerCookie(reader io.ReaderAt, size int64) (int64, error) {
+	searchStart := size - pyInstallerMaxCookieSearch
+	if searchStart < 0 {
+		searchStart = 0
+	}
+	if size-searchStart < pyInstallerCookieSize {
+		return 0, errNotPyInstaller
+	}
+
+	tail := make([]byte, size-searchStart)
+	if _, err := reader.ReadAt(tail, searchStart); err != nil && !errors.Is(err, io.EOF) {
+		return 0, fmt.Errorf("unable to read binary: %w", err)
+	}
+
+	// the cookie must be complete, so it cannot start within the last bytes of the file
+	i := bytes.LastIndex(tail[:len(tail)-pyInstallerCookieSize+len(pyInstallerMagic)], pyInstallerMagic)
+	if i < 0 {
+		return 0, errNotPyInstaller
+	}
+	return searchStart + int64(i), nil
+}
+
+func parsePyInstallerTOC(toc []byte) ([]pyInstallerTOCEntry, error) {
+	var entries []pyInstallerTOCEntry
+	for pos := 0; pos < len(toc); {
+		if pos+pyInstallerTOCEntrySize > len(toc) {
+			return nil, fmt.Errorf("table of contents entry at %d is truncated", pos)
+		}
+		entrySize := int(binary.BigEndian.Uint32(toc[pos:]))
+		if entrySize < pyInstallerTOCEntrySize || pos+entrySize > len(toc) {
+			return nil, fmt.Errorf("table of contents entry at %d has an invalid size of %d bytes", pos, entrySize)
+		}
+		entries = append(entries, pyInstallerTOCEntry{
+			offset:           int64(binary.BigEndian.Uint32(toc[pos+4:])),
+			compressedSize:   int64(binary.BigEndian.Uint32(toc[pos+8:])),
+			uncompressedSize: int64(binary.BigEndian.Uint32(toc[pos+12:])),
+			compressed:       toc[pos+16] == 1,
+			kind:             toc[pos+17],
+			name:             string(bytes.TrimRight(toc[pos+pyInstallerTOCEntrySize:pos+entrySize], "\x00")),
+		})
+		pos += entrySize
+	}
+	return entries, nil
+}
+
+// pyInstallerPythonVersion returns the version of the embedded python interpreter, which is given as major*100+minor
+// (or major*10+minor by older versions of PyInstaller).
+func pyInstallerPythonVersion(pyver uint32) string {
+	if pyver >= 100 {
+		return fmt.Sprintf("%d.%d", pyver/100, pyver%100)
+	}
+	return fmt.Sprintf("%d.%d", pyver/10, pyver%10)
+}
+
+// read returns the (decompressed) contents of the given entry, which may not be larger than the given size.
+func (a *pyInstallerArchive) read(entry pyInstallerTOCEntry, maxSize int64) ([]byte, error) {
+	if entry.uncompressedSize > maxSize {
+		return nil, fmt.Errorf("entry is too large (%d bytes)", entry.uncompressedSize)
+	}
+	offset := a.start + entry.offset
+	if offset < a.start || offset+entry.compressedSize > a.end {
+		return nil, fmt.Errorf("entry is beyond the end of the archive")
+	}
+
+	var r io.Reader = io.NewSectionReader(a.reader, offset, entry.compressedSize)
+	if entry.compressed {
+		zr, err := zlib.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		r = zr
+	}
+	return io.ReadAll(io.LimitReader(r, maxSize))
+}
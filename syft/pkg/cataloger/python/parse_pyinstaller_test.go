@@ -0,0 +1,221 @@
+package python
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+const testRequestsMetadata = `Metadata-Version: 2.1
+Name: requests
+Version: 2.31.0
+Summary: Python HTTP for Humans.
+Author: Kenneth Reitz
+Author-email: me@kennethreitz.org
+License: Apache 2.0
+Requires-Python: >=3.7
+
+Requests is an elegant and simple HTTP library for Python.
+`
+
+const testSixMetadata = `Metadata-Version: 1.2
+Name: six
+Version: 1.16.0
+Summary: Python 2 and 3 compatibility utilities
+`
+
+type testPyInstallerEntry struct {
+	name       string
+	kind       byte
+	compressed bool
+	contents   string
+}
+
+func TestParsePyInstallerBinary(t *testing.T) {
+	entries := []testPyInstallerEntry{
+		{name: "pyiboot01_bootstrap", kind: 's', compressed: true, contents: "bootstrap"},
+		{name: "libpython3.11.so.1.0", kind: 'b', compressed: true, contents: "\x7fELF"},
+		{name: "PYZ-00.pyz", kind: 'z', contents: "PYZ\x00"},
+		{name: "requests-2.31.0.dist-info/METADATA", kind: 'x', compressed: true, contents: testRequestsMetadata},
+		{name: "requests-2.31.0.dist-info/RECORD", kind: 'x', compressed: true, contents: "requests/__init__.py,,"},
+		{name: "six-1.16.0-py3.11.egg-info/PKG-INFO", kind: 'x', contents: testSixMetadata},
+		{name: "certifi/cacert.pem", kind: 'x', compressed: true, contents: "-----BEGIN CERTIFICATE-----"},
+	}
+
+	tests := []struct {
+		name     string
+		fixture  func(t *testing.T) string
+		expected bool
+	}{
+		{
+			name: "one-file bundle",
+			fixture: func(t *testing.T) string {
+				return writeTestPyInstaller(t, entries, nil)
+			},
+			expected: true,
+		},
+		{
+			name: "one-file bundle with a code signature appended",
+			fixture: func(t *testing.T) string {
+				return writeTestPyInstaller(t, entries, bytes.Repeat([]byte{0xAB}, 4096))
+			},
+			expected: true,
+		},
+		{
+			name: "executable without an archive",
+			fixture: func(t *testing.T) string {
+				fixture := filepath.Join(t.TempDir(), "app")
+				require.NoError(t, os.WriteFile(fixture, []byte("\x7fELF not a bundle"), 0o755))
+				return fixture
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fixture := test.fixture(t)
+			location := file.NewLocation(fixture)
+
+			var expected []pkg.Package
+			if test.expected {
+				expected = []pkg.Package{
+					{
+						Name:      "requests",
+						Version:   "2.31.0",
+						PURL:      "pkg:pypi/requests@2.31.0",
+						Locations: file.NewLocationSet(location),
+						Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("Apache 2.0", location)),
+						Language:  pkg.Python,
+						Type:      pkg.PythonPkg,
+						Metadata: pkg.PythonPackage{
+							Name:        "requests",
+							Version:     "2.31.0",
+							Author:      "Kenneth Reitz",
+							AuthorEmail: "me@kennethreitz.org",
+						},
+					},
+					{
+						Name:      "six",
+						Version:   "1.16.0",
+						PURL:      "pkg:pypi/six@1.16.0",
+						Locations: file.NewLocationSet(location),
+						Language:  pkg.Python,
+						Type:      pkg.PythonPkg,
+						Metadata: pkg.PythonPackage{
+							Name:    "six",
+							Version: "1.16.0",
+						},
+					},
+				}
+			}
+
+			pkgtest.TestFileParser(t, fixture, parsePyInstallerBinary, expected, nil)
+		})
+	}
+}
+
+func Test_corruptPyInstallerBinary(t *testing.T) {
+	fixture := writeTestPyInstaller(t, []testPyInstallerEntry{
+		{name: "six-1.16.0.dist-info/METADATA", kind: 'x', contents: testSixMetadata},
+	}, nil)
+
+	// corrupt the size of the only table of contents entry
+	contents, err := os.ReadFile(fixture)
+	require.NoError(t, err)
+	cookie := bytes.LastIndex(contents, pyInstallerMagic)
+	archiveStart := cookie + pyInstallerCookieSize - int(binary.BigEndian.Uint32(contents[cookie+8:]))
+	tocOffset := int(binary.BigEndian.Uint32(contents[cookie+12:]))
+	binary.BigEndian.PutUint32(contents[archiveStart+tocOffset:], 0xFFFF)
+	require.NoError(t, os.WriteFile(fixture, contents, 0o755))
+
+	pkgtest.NewCatalogTester().
+		FromFile(t, fixture).
+		WithError().
+		TestParser(t, parsePyInstallerBinary)
+}
+
+func Test_pyInstallerPythonVersion(t *testing.T) {
+	assert.Equal(t, "3.11", pyInstallerPythonVersion(311))
+	assert.Equal(t, "3.8", pyInstallerPythonVersion(308))
+	assert.Equal(t, "2.7", pyInstallerPythonVersion(27))
+}
+
+func Test_isBundledPackageMetadata(t *testing.T) {
+	assert.True(t, isBundledPackageMetadata("requests-2.31.0.dist-info/METADATA"))
+	assert.True(t, isBundledPackageMetadata(`six-1.16.0.egg-info\PKG-INFO`))
+	assert.True(t, isBundledPackageMetadata("Flask-3.0.0.DIST-INFO/METADATA"))
+	assert.False(t, isBundledPackageMetadata("requests-2.31.0.dist-info/RECORD"))
+	assert.False(t, isBundledPackageMetadata("METADATA"))
+	assert.False(t, isBundledPackageMetadata("docs/METADATA"))
+}
+
+// writeTestPyInstaller writes an executable with a PyInstaller archive holding the given entries appended, followed
+// by the given trailing data.
+func writeTestPyInstaller(t *testing.T, entries []testPyInstallerEntry, trailer []byte) string {
+	t.Helper()
+
+	var archive, toc bytes.Buffer
+	for _, e := range entries {
+		data := []byte(e.contents)
+		if e.compressed {
+			var buf bytes.Buffer
+			zw := zlib.NewWriter(&buf)
+			_, err := zw.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, zw.Close())
+			data = buf.Bytes()
+		}
+		offset := archive.Len()
+		archive.Write(data)
+
+		name := append([]byte(e.name), 0)
+		for (pyInstallerTOCEntrySize+len(name))%16 != 0 {
+			name = append(name, 0)
+		}
+		var compressed byte
+		if e.compressed {
+			compressed = 1
+		}
+		require.NoError(t, binary.Write(&toc, binary.BigEndian, []uint32{
+			uint32(pyInstallerTOCEntrySize + len(name)),
+			uint32(offset),
+			uint32(len(data)),
+			uint32(len(e.contents)),
+		}))
+		toc.Write([]byte{compressed, e.kind})
+		toc.Write(name)
+	}
+
+	tocOffset := archive.Len()
+	archive.Write(toc.Bytes())
+
+	archive.Write(pyInstallerMagic)
+	require.NoError(t, binary.Write(&archive, binary.BigEndian, []uint32{
+		uint32(archive.Len() + pyInstallerCookieSize - len(pyInstallerMagic)),
+		uint32(tocOffset),
+		uint32(toc.Len()),
+		311,
+	}))
+	pylib := make([]byte, 64)
+	copy(pylib, "libpython3.11.so.1.0")
+	archive.Write(pylib)
+
+	var contents bytes.Buffer
+	contents.WriteString("\x7fELF")
+	contents.Write(bytes.Repeat([]byte{0}, 1024))
+	contents.Write(archive.Bytes())
+	contents.Write(trailer)
+
+	fixture := filepath.Join(t.TempDir(), "app")
+	require.NoError(t, os.WriteFile(fixture, contents.Bytes(), 0o755))
+	return fixture
+}
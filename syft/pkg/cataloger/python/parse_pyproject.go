@@ -0,0 +1,133 @@
+package python
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+type pyprojectToml struct {
+	Project struct {
+		Dependencies []string `toml:"dependencies"`
+	} `toml:"project"`
+	Tool struct {
+		Poetry struct {
+			Dependencies map[string]interface{} `toml:"dependencies"`
+		} `toml:"poetry"`
+	} `toml:"tool"`
+}
+
+// parsePyprojectToml is a parser function for pyproject.toml contents, returning all runtime dependencies declared
+// within the PEP 621 [project] table or the [tool.poetry.dependencies] table that are pinned to a specific version
+// (or that have a version guessed from the constraint, when configured).
+func (rp requirementsParser) parsePyprojectToml(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	tree, err := toml.LoadReader(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to load pyproject.toml for parsing: %w", err)
+	}
+
+	var project pyprojectToml
+	if err := tree.Unmarshal(&project); err != nil {
+		return nil, nil, fmt.Errorf("unable to parse pyproject.toml: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	for _, raw := range project.Project.Dependencies {
+		req := newRequirement(raw)
+		if req == nil {
+			log.WithFields("path", reader.RealPath).Debugf("unable to parse pyproject.toml dependency: %q", raw)
+			continue
+		}
+
+		p := rp.newDeclaredPackage(req, reader.Location)
+		if p.Version == "" {
+			log.WithFields("path", reader.RealPath).Tracef("unable to determine package version in pyproject.toml dependency: %q", raw)
+			continue
+		}
+		pkgs = append(pkgs, p)
+	}
+
+	// sort for stable results, since the poetry dependencies are keyed by name
+	names := make([]string, 0, len(project.Tool.Poetry.Dependencies))
+	for name := range project.Tool.Poetry.Dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if strings.EqualFold(name, "python") {
+			// the supported python version is not a package dependency
+			continue
+		}
+		entry, ok := newPoetryDependency(name, project.Tool.Poetry.Dependencies[name])
+		if !ok {
+			log.WithFields("path", reader.RealPath).Debugf("unable to parse poetry dependency: %q", name)
+			continue
+		}
+
+		version := rp.poetryVersion(entry.VersionConstraint)
+		if version == "" {
+			log.WithFields("path", reader.RealPath).Tracef("unable to determine package version in poetry dependency: %q", name)
+			continue
+		}
+		pkgs = append(pkgs, newPackageForRequirementsWithMetadata(
+			entry.Name,
+			version,
+			entry,
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		))
+	}
+
+	return pkgs, nil, nil
+}
+
+// newPoetryDependency creates a requirements entry from a [tool.poetry.dependencies] value, which is either a
+// version constraint (e.g. "^2.28") or a table (e.g. { version = "^2.28", extras = ["socks"] }).
+func newPoetryDependency(name string, value interface{}) (pkg.PythonRequirementsEntry, bool) {
+	entry := pkg.PythonRequirementsEntry{Name: name}
+
+	switch v := value.(type) {
+	case string:
+		entry.VersionConstraint = v
+	case map[string]interface{}:
+		entry.VersionConstraint, _ = v["version"].(string)
+		entry.Markers, _ = v["markers"].(string)
+		if git, ok := v["git"].(string); ok {
+			entry.URL = git
+		}
+		if extras, ok := v["extras"].([]interface{}); ok {
+			for _, e := range extras {
+				if s, ok := e.(string); ok {
+					entry.Extras = append(entry.Extras, s)
+				}
+			}
+		}
+	default:
+		return entry, false
+	}
+
+	if entry.VersionConstraint == "*" {
+		entry.VersionConstraint = ""
+	}
+
+	return entry, true
+}
+
+// poetryVersion returns the version for a poetry constraint. Unlike PEP 440, a bare version (e.g. "2.28.1") pins
+// the dependency to exactly that version.
+func (rp requirementsParser) poetryVersion(constraint string) string {
+	constraint = strings.TrimSpace(constraint)
+	if constraint != "" && !strings.ContainsAny(constraint, "^~*,<>=!| ") {
+		return constraint
+	}
+	return parseVersion(constraint, rp.guessUnpinnedRequirements)
+}
@@ -0,0 +1,97 @@
+package python
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParsePyprojectToml(t *testing.T) {
+	tests := []struct {
+		name         string
+		fixture      string
+		cfg          CatalogerConfig
+		expectedPkgs []pkg.Package
+	}{
+		{
+			// dependencies without a pinned version are not reported (as with requirements.txt files)
+			name:    "PEP 621 project dependencies",
+			fixture: "test-fixtures/pyproject/pep621/pyproject.toml",
+			expectedPkgs: []pkg.Package{
+				{
+					Name:     "click",
+					Version:  "8.1.3",
+					PURL:     "pkg:pypi/click@8.1.3",
+					Language: pkg.Python,
+					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "click",
+						VersionConstraint: "==8.1.3",
+					},
+				},
+			},
+		},
+		{
+			name:    "PEP 621 project dependencies with guessed versions",
+			fixture: "test-fixtures/pyproject/pep621/pyproject.toml",
+			cfg: CatalogerConfig{
+				GuessUnpinnedRequirements: true,
+			},
+			expectedPkgs: []pkg.Package{
+				{
+					Name:     "requests",
+					Version:  "2.8.1",
+					PURL:     "pkg:pypi/requests@2.8.1",
+					Language: pkg.Python,
+					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "requests",
+						Extras:            []string{"security"},
+						VersionConstraint: ">= 2.8.1",
+					},
+				},
+				{
+					Name:     "click",
+					Version:  "8.1.3",
+					PURL:     "pkg:pypi/click@8.1.3",
+					Language: pkg.Python,
+					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "click",
+						VersionConstraint: "==8.1.3",
+					},
+				},
+			},
+		},
+		{
+			// dependencies constrained to a range of versions (e.g. "^2.28" and "*") are not reported
+			name:    "poetry dependencies",
+			fixture: "test-fixtures/pyproject/poetry/pyproject.toml",
+			expectedPkgs: []pkg.Package{
+				{
+					Name:     "click",
+					Version:  "8.1.3",
+					PURL:     "pkg:pypi/click@8.1.3",
+					Language: pkg.Python,
+					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "click",
+						VersionConstraint: "8.1.3",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := range tc.expectedPkgs {
+				tc.expectedPkgs[i].Locations = file.NewLocationSet(file.NewLocation(tc.fixture))
+			}
+			parser := newRequirementsParser(tc.cfg)
+			pkgtest.TestFileParser(t, tc.fixture, parser.parsePyprojectToml, tc.expectedPkgs, nil)
+		})
+	}
+}
@@ -83,11 +83,13 @@ func newRequirement(raw string) *unprocessedRequirement {
 
 type requirementsParser struct {
 	guessUnpinnedRequirements bool
+	excludeDevDependencies    bool
 }
 
 func newRequirementsParser(cfg CatalogerConfig) requirementsParser {
 	return requirementsParser{
 		guessUnpinnedRequirements: cfg.GuessUnpinnedRequirements,
+		excludeDevDependencies:    cfg.ExcludeDevDependencies,
 	}
 }
 
@@ -129,29 +131,13 @@ func (rp requirementsParser) parseRequirementsTxt(_ context.Context, _ file.Reso
 			continue
 		}
 
-		name := removeExtras(req.Name)
-		version := parseVersion(req.VersionConstraint, rp.guessUnpinnedRequirements)
-
-		if version == "" {
+		p := rp.newDeclaredPackage(req, reader.Location)
+		if p.Version == "" {
 			log.WithFields("path", reader.RealPath).Tracef("unable to determine package version in requirements.txt line: %q", line)
 			continue
 		}
 
-		packages = append(
-			packages,
-			newPackageForRequirementsWithMetadata(
-				name,
-				version,
-				pkg.PythonRequirementsEntry{
-					Name:              name,
-					Extras:            parseExtras(req.Name),
-					VersionConstraint: req.VersionConstraint,
-					URL:               parseURL(req.URL),
-					Markers:           req.Markers,
-				},
-				reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
-			),
-		)
+		packages = append(packages, p)
 	}
 
 	if err := scanner.Err(); err != nil {
@@ -161,6 +147,24 @@ func (rp requirementsParser) parseRequirementsTxt(_ context.Context, _ file.Reso
 	return packages, nil, nil
 }
 
+// newDeclaredPackage creates a package from a PEP 508 requirement (as found in requirements.txt, pyproject.toml, and
+// setup.cfg files). The version is only set when the requirement is pinned (or when guessing unpinned versions).
+func (rp requirementsParser) newDeclaredPackage(req *unprocessedRequirement, location file.Location) pkg.Package {
+	name := removeExtras(req.Name)
+	return newPackageForRequirementsWithMetadata(
+		name,
+		parseVersion(req.VersionConstraint, rp.guessUnpinnedRequirements),
+		pkg.PythonRequirementsEntry{
+			Name:              name,
+			Extras:            parseExtras(req.Name),
+			VersionConstraint: req.VersionConstraint,
+			URL:               parseURL(req.URL),
+			Markers:           req.Markers,
+		},
+		location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+	)
+}
+
 func parseVersion(version string, guessFromConstraint bool) string {
 	if isPinnedConstraint(version) {
 		return strings.TrimSpace(strings.ReplaceAll(version, "==", ""))
@@ -1,10 +1,13 @@
 package python
 
 import (
-	"bufio"
 	"context"
-	"regexp"
+	"fmt"
+	"io"
 	"strings"
+	"unicode"
+
+	"github.com/scylladb/go-set/strset"
 
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/artifact"
@@ -13,61 +16,566 @@ import (
 	"github.com/anchore/syft/syft/pkg/cataloger/generic"
 )
 
-// integrity check
-var _ generic.Parser = parseSetup
-
-// match examples:
+// parseSetup is a parser function for setup.py contents, returning the requirements given to the install_requires and
+// extras_require arguments of the setup() call that have a version (see newDeclaredPackage). Rather than matching
+// lines, the python source is tokenized and the arguments are evaluated as literals, which handles lists spanning
+// many lines, arguments referencing variables (including those extended with +=, append(), or extend() within
+// conditionals), and lists combined with "+". Expressions that cannot be evaluated without running the code (e.g.
+// function calls or string formatting) are skipped. An example setup.py:
+//
+//	INSTALL_REQUIRES = ["requests[security]==2.28.1", "click>=8"]
+//	if sys.version_info < (3, 8):
+//	    INSTALL_REQUIRES.append("importlib-metadata==4.13.0")
 //
-//	'pathlib3==2.2.0;python_version<"3.6"'  --> match(name=pathlib3 version=2.2.0)
-//	 "mypy==v0.770",                        --> match(name=mypy version=v0.770)
-//	" mypy2 == v0.770", ' mypy3== v0.770',  --> match(name=mypy2 version=v0.770), match(name=mypy3, version=v0.770)
-var pinnedDependency = regexp.MustCompile(`['"]\W?(\w+\W?==\W?[\w.]*)`)
+//	setup(
+//	    install_requires=INSTALL_REQUIRES,
+//	    extras_require={"test": ["pytest==7.2.0"]},
+//	)
+func (rp requirementsParser) parseSetup(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read setup.py file: %w", err)
+	}
+
+	tokens, err := tokenizePython(string(contents))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse setup.py file: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	seen := strset.New()
+	for _, raw := range newSetupEvaluator(tokens).requirements() {
+		if seen.Has(raw) {
+			// the same requirement is commonly shared by several extras
+			continue
+		}
+		seen.Add(raw)
+
+		if hasTemplateDirective(raw) {
+			// this can happen in more dynamic setup.py where there is templating
+			continue
+		}
 
-func parseSetup(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
-	var packages []pkg.Package
+		req := newRequirement(raw)
+		if req == nil {
+			log.WithFields("path", reader.RealPath).Debugf("unable to parse setup.py requirement: %q", raw)
+			continue
+		}
 
-	scanner := bufio.NewScanner(reader)
+		p := rp.newDeclaredPackage(req, reader.Location)
+		if p.Version == "" {
+			log.WithFields("path", reader.RealPath).Tracef("unable to determine package version in setup.py requirement: %q", raw)
+			continue
+		}
+		pkgs = append(pkgs, p)
+	}
 
-	for scanner.Scan() {
-		line := scanner.Text()
-		line = strings.TrimRight(line, "\n")
+	return pkgs, nil, nil
+}
+
+func hasTemplateDirective(s string) bool {
+	return strings.Contains(s, `%s`) || strings.Contains(s, `{`) || strings.Contains(s, `}`)
+}
 
-		for _, match := range pinnedDependency.FindAllString(line, -1) {
-			parts := strings.Split(match, "==")
-			if len(parts) != 2 {
-				continue
+type pythonTokenKind int
+
+const (
+	pythonName pythonTokenKind = iota
+	pythonString
+	pythonNumber
+	pythonOperator
+	pythonNewline
+)
+
+type pythonToken struct {
+	kind pythonTokenKind
+	// value is the name, the operator, or the decoded contents of a string
+	value string
+	// formatted indicates that a string is an f-string, the contents of which cannot be known without running the code
+	formatted bool
+}
+
+// tokenizePython splits python source into names, strings, numbers, and operators. Comments are dropped, and newlines
+// are only kept where they end a statement (i.e. outside of brackets and line continuations).
+func tokenizePython(src string) ([]pythonToken, error) {
+	var tokens []pythonToken
+	depth := 0
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			if depth == 0 && len(tokens) > 0 && tokens[len(tokens)-1].kind != pythonNewline {
+				tokens = append(tokens, pythonToken{kind: pythonNewline})
+			}
+			i++
+		case c == '\\' && i+1 < len(src) && (src[i+1] == '\n' || src[i+1] == '\r'):
+			// line continuation
+			i += 2
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == ';' && depth > 0:
+			i++
+		case c == ';':
+			tokens = append(tokens, pythonToken{kind: pythonNewline})
+			i++
+		case c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case c == '"' || c == '\'' || isStringPrefix(src[i:]):
+			tok, n, err := readPythonString(src[i:])
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, tok)
+			i += n
+		case c == '_' || c >= 0x80 || unicode.IsLetter(rune(c)):
+			start := i
+			for i < len(src) && (src[i] == '_' || src[i] >= 0x80 || unicode.IsLetter(rune(src[i])) || unicode.IsDigit(rune(src[i]))) {
+				i++
+			}
+			tokens = append(tokens, pythonToken{kind: pythonName, value: src[start:i]})
+		case unicode.IsDigit(rune(c)):
+			start := i
+			for i < len(src) && (src[i] == '.' || src[i] == '_' || unicode.IsLetter(rune(src[i])) || unicode.IsDigit(rune(src[i]))) {
+				i++
 			}
-			name := strings.Trim(parts[0], "'\"")
-			name = strings.TrimSpace(name)
-			name = strings.Trim(name, "'\"")
+			tokens = append(tokens, pythonToken{kind: pythonNumber, value: src[start:i]})
+		default:
+			op := readPythonOperator(src[i:])
+			switch op {
+			case "(", "[", "{":
+				depth++
+			case ")", "]", "}":
+				if depth > 0 {
+					depth--
+				}
+			}
+			tokens = append(tokens, pythonToken{kind: pythonOperator, value: op})
+			i += len(op)
+		}
+	}
+	return tokens, nil
+}
+
+func readPythonOperator(s string) string {
+	for _, op := range []string{"**=", "//=", ">>=", "<<=", "->", ":=", "==", "!=", "<=", ">=", "+=", "-=", "*=", "/=", "%=", "&=", "|=", "^=", "@=", "**", "//", "<<", ">>"} {
+		if strings.HasPrefix(s, op) {
+			return op
+		}
+	}
+	return s[:1]
+}
 
-			version := strings.TrimSpace(parts[len(parts)-1])
-			version = strings.Trim(version, "'\"")
+// isStringPrefix indicates whether the source begins with a string literal prefix (e.g. r"..." or f'...').
+func isStringPrefix(s string) bool {
+	for n := 1; n <= 2 && n < len(s); n++ {
+		if !strings.ContainsRune("rRbBuUfF", rune(s[n-1])) {
+			return false
+		}
+		if s[n] == '"' || s[n] == '\'' {
+			return true
+		}
+	}
+	return false
+}
+
+// readPythonString reads the string literal at the start of the source, returning the token and its length.
+func readPythonString(s string) (pythonToken, int, error) {
+	i := 0
+	var raw, formatted bool
+	for s[i] != '"' && s[i] != '\'' {
+		switch s[i] {
+		case 'r', 'R':
+			raw = true
+		case 'f', 'F':
+			formatted = true
+		}
+		i++
+	}
+
+	quote := s[i : i+1]
+	if strings.HasPrefix(s[i:], strings.Repeat(quote, 3)) {
+		quote = strings.Repeat(quote, 3)
+	}
+	i += len(quote)
 
-			if hasTemplateDirective(name) || hasTemplateDirective(version) {
-				// this can happen in more dynamic setup.py where there is templating
-				continue
+	var value strings.Builder
+	for {
+		if i >= len(s) || (len(quote) == 1 && s[i] == '\n') {
+			return pythonToken{}, 0, fmt.Errorf("unterminated string literal")
+		}
+		if strings.HasPrefix(s[i:], quote) {
+			i += len(quote)
+			break
+		}
+		if s[i] == '\\' && i+1 < len(s) {
+			if raw {
+				value.WriteString(s[i : i+2])
+			} else {
+				value.WriteString(unescapePython(s[i+1]))
 			}
+			i += 2
+			continue
+		}
+		value.WriteByte(s[i])
+		i++
+	}
+
+	return pythonToken{kind: pythonString, value: value.String(), formatted: formatted}, i, nil
+}
+
+func unescapePython(c byte) string {
+	switch c {
+	case 'n':
+		return "\n"
+	case 't':
+		return "\t"
+	case '\n':
+		// an escaped newline continues the string on the next line
+		return ""
+	}
+	return string(c)
+}
 
-			if name == "" || version == "" {
-				log.WithFields("path", reader.RealPath).Debugf("unable to parse package in setup.py line: %q", line)
-				continue
+type pythonValueKind int
+
+const (
+	unknownValue pythonValueKind = iota
+	stringValue
+	listValue
+	dictValue
+)
+
+// pythonValue is the result of evaluating a python literal expression.
+type pythonValue struct {
+	kind pythonValueKind
+	str  string
+	// list holds the strings of a list or tuple (elements that are not strings are dropped)
+	list []string
+	// dict holds the values of a dictionary with string keys, in the order they are written
+	dict []pythonDictEntry
+}
+
+type pythonDictEntry struct {
+	key   string
+	value pythonValue
+}
+
+// strings returns the requirement strings held by the value (setuptools accepts both a list of requirements and a
+// string of newline separated requirements).
+func (v pythonValue) strings() []string {
+	switch v.kind {
+	case listValue:
+		return v.list
+	case stringValue:
+		var results []string
+		for _, line := range strings.Split(v.str, "\n") {
+			if line = strings.TrimSpace(removeTrailingComment(line)); line != "" {
+				results = append(results, line)
 			}
+		}
+		return results
+	}
+	return nil
+}
+
+// setupEvaluator evaluates the literal expressions of a setup.py, tracking the values assigned to variables at the
+// statement level so that the arguments to setup() may reference them.
+type setupEvaluator struct {
+	tokens    []pythonToken
+	pos       int
+	variables map[string]pythonValue
+}
+
+func newSetupEvaluator(tokens []pythonToken) *setupEvaluator {
+	return &setupEvaluator{
+		tokens:    tokens,
+		variables: make(map[string]pythonValue),
+	}
+}
 
-			packages = append(
-				packages,
-				newPackageForIndex(
-					name,
-					version,
-					reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
-				),
-			)
+// requirements returns the requirements given to install_requires followed by those for each extra of extras_require,
+// for every setup() call found.
+func (e *setupEvaluator) requirements() []string {
+	var results []string
+	depth := 0
+	for e.pos < len(e.tokens) {
+		tok := e.tokens[e.pos]
+		switch {
+		case tok.kind == pythonOperator && strings.ContainsAny(tok.value, "([{"):
+			depth++
+			e.pos++
+		case tok.kind == pythonOperator && strings.ContainsAny(tok.value, ")]}"):
+			if depth > 0 {
+				depth--
+			}
+			e.pos++
+		case tok.kind == pythonName && tok.value == "setup" && e.isOperator(e.pos+1, "(") && !e.isName(e.pos-1, "def"):
+			e.pos += 2
+			results = append(results, e.setupArguments()...)
+		case tok.kind == pythonName && depth == 0 && e.isStatementStart(e.pos):
+			e.statement()
+		default:
+			e.pos++
 		}
 	}
+	return results
+}
 
-	return packages, nil, nil
+// statement evaluates an assignment to (or the extension of) a variable, e.g. "X = [...]", "X += [...]",
+// "X.append(...)", or "X.extend([...])".
+func (e *setupEvaluator) statement() {
+	name := e.tokens[e.pos].value
+	switch {
+	case e.isOperator(e.pos+1, "="):
+		e.pos += 2
+		e.variables[name] = e.expression()
+	case e.isOperator(e.pos+1, "+="):
+		e.pos += 2
+		e.variables[name] = concatenate(e.variables[name], e.expression())
+	case e.isOperator(e.pos+1, ".") && (e.isName(e.pos+2, "append") || e.isName(e.pos+2, "extend")) && e.isOperator(e.pos+3, "("):
+		method := e.tokens[e.pos+2].value
+		e.pos += 4
+		arg := e.expression()
+		if method == "append" && arg.kind == stringValue {
+			arg = pythonValue{kind: listValue, list: []string{arg.str}}
+		}
+		if arg.kind == listValue {
+			e.variables[name] = concatenate(e.variables[name], arg)
+		}
+	default:
+		e.pos++
+	}
 }
 
-func hasTemplateDirective(s string) bool {
-	return strings.Contains(s, `%s`) || strings.Contains(s, `{`) || strings.Contains(s, `}`)
+// setupArguments evaluates the keyword arguments of a setup() call, returning the requirements found.
+func (e *setupEvaluator) setupArguments() []string {
+	var installRequires, extrasRequire pythonValue
+	for e.pos < len(e.tokens) && !e.isOperator(e.pos, ")") {
+		if e.isOperator(e.pos, ",") {
+			e.pos++
+			continue
+		}
+		if e.tokens[e.pos].kind == pythonName && e.isOperator(e.pos+1, "=") {
+			name := e.tokens[e.pos].value
+			e.pos += 2
+			value := e.expression()
+			switch name {
+			case "install_requires":
+				installRequires = value
+			case "extras_require":
+				extrasRequire = value
+			}
+			continue
+		}
+		// positional arguments and **kwargs are not evaluated
+		e.advance(e.expression)
+	}
+	e.pos++
+
+	results := installRequires.strings()
+	if extrasRequire.kind == dictValue {
+		for _, extra := range extrasRequire.dict {
+			results = append(results, extra.value.strings()...)
+		}
+	}
+	return results
+}
+
+// expression evaluates the expression at the current position, consuming tokens through the end of the expression
+// (the next comma, closing bracket, or newline outside any brackets of the expression itself).
+func (e *setupEvaluator) expression() pythonValue {
+	value := e.term()
+	for e.isOperator(e.pos, "+") {
+		e.pos++
+		value = concatenate(value, e.term())
+	}
+	if !e.atExpressionEnd() {
+		// the expression continues with something that cannot be evaluated (e.g. a call, attribute, or conditional)
+		e.skipExpression()
+		return pythonValue{}
+	}
+	return value
+}
+
+func (e *setupEvaluator) term() pythonValue {
+	if e.pos >= len(e.tokens) {
+		return pythonValue{}
+	}
+
+	tok := e.tokens[e.pos]
+	switch {
+	case tok.kind == pythonString:
+		// adjacent strings are concatenated
+		var value strings.Builder
+		known := true
+		for e.pos < len(e.tokens) && e.tokens[e.pos].kind == pythonString {
+			known = known && !e.tokens[e.pos].formatted
+			value.WriteString(e.tokens[e.pos].value)
+			e.pos++
+		}
+		if !known {
+			return pythonValue{}
+		}
+		return pythonValue{kind: stringValue, str: value.String()}
+	case tok.kind == pythonName:
+		e.pos++
+		if e.isOperator(e.pos, "(") || e.isOperator(e.pos, ".") || e.isOperator(e.pos, "[") {
+			return pythonValue{}
+		}
+		return e.variables[tok.value]
+	case e.isOperator(e.pos, "["), e.isOperator(e.pos, "("):
+		closing := "]"
+		if tok.value == "(" {
+			closing = ")"
+		}
+		e.pos++
+		return e.sequence(closing)
+	case e.isOperator(e.pos, "{"):
+		e.pos++
+		return e.dictionary()
+	}
+	return pythonValue{}
+}
+
+// sequence evaluates the elements of a list or tuple through the closing bracket. A parenthesized expression that is
+// not a tuple (e.g. "(["a"] + ["b"])") evaluates to the expression itself.
+func (e *setupEvaluator) sequence(closing string) pythonValue {
+	var elements []pythonValue
+	trailingComma := false
+	for e.pos < len(e.tokens) && !e.isOperator(e.pos, closing) {
+		if e.isOperator(e.pos, ",") {
+			trailingComma = true
+			e.pos++
+			continue
+		}
+		trailingComma = false
+		if e.isName(e.pos, "for") {
+			// comprehensions cannot be evaluated
+			e.skipUntil(closing)
+			return pythonValue{}
+		}
+		elements = append(elements, e.advance(e.expression))
+	}
+	e.pos++
+
+	if closing == ")" && len(elements) == 1 && !trailingComma {
+		return elements[0]
+	}
+
+	result := pythonValue{kind: listValue}
+	for _, element := range elements {
+		if element.kind == stringValue {
+			result.list = append(result.list, element.str)
+		}
+	}
+	return result
+}
+
+func (e *setupEvaluator) dictionary() pythonValue {
+	result := pythonValue{kind: dictValue}
+	for e.pos < len(e.tokens) && !e.isOperator(e.pos, "}") {
+		if e.isOperator(e.pos, ",") {
+			e.pos++
+			continue
+		}
+		key := e.advance(e.expression)
+		if !e.isOperator(e.pos, ":") {
+			// a set or a comprehension, neither of which hold requirements
+			e.skipUntil("}")
+			return pythonValue{}
+		}
+		e.pos++
+		value := e.expression()
+		if key.kind == stringValue {
+			result.dict = append(result.dict, pythonDictEntry{key: key.str, value: value})
+		}
+	}
+	e.pos++
+	return result
+}
+
+// advance evaluates an expression, skipping a token when the expression is empty (e.g. a stray ":") so that
+// evaluation always makes progress.
+func (e *setupEvaluator) advance(expression func() pythonValue) pythonValue {
+	start := e.pos
+	value := expression()
+	if e.pos == start {
+		e.pos++
+	}
+	return value
+}
+
+func (e *setupEvaluator) atExpressionEnd() bool {
+	if e.pos >= len(e.tokens) {
+		return true
+	}
+	tok := e.tokens[e.pos]
+	if tok.kind == pythonNewline {
+		return true
+	}
+	if tok.kind == pythonOperator {
+		switch tok.value {
+		case ",", ")", "]", "}", ":":
+			return true
+		}
+	}
+	return false
+}
+
+// skipExpression skips tokens through the end of the current expression, accounting for nested brackets.
+func (e *setupEvaluator) skipExpression() {
+	depth := 0
+	for e.pos < len(e.tokens) {
+		tok := e.tokens[e.pos]
+		if depth == 0 && e.atExpressionEnd() {
+			return
+		}
+		if tok.kind == pythonOperator {
+			switch tok.value {
+			case "(", "[", "{":
+				depth++
+			case ")", "]", "}":
+				depth--
+			}
+		}
+		e.pos++
+	}
+}
+
+// skipUntil skips tokens through the given closing bracket of the current nesting level.
+func (e *setupEvaluator) skipUntil(closing string) {
+	for e.pos < len(e.tokens) && !e.isOperator(e.pos, closing) {
+		e.skipExpression()
+		if e.pos < len(e.tokens) && !e.isOperator(e.pos, closing) {
+			e.pos++
+		}
+	}
+	e.pos++
+}
+
+func (e *setupEvaluator) isOperator(pos int, op string) bool {
+	return pos >= 0 && pos < len(e.tokens) && e.tokens[pos].kind == pythonOperator && e.tokens[pos].value == op
+}
+
+func (e *setupEvaluator) isName(pos int, name string) bool {
+	return pos >= 0 && pos < len(e.tokens) && e.tokens[pos].kind == pythonName && e.tokens[pos].value == name
+}
+
+// isStatementStart indicates whether the token begins a statement (at the start of a line, or after the colon of a
+// single line conditional such as "if x: X.append(...)").
+func (e *setupEvaluator) isStatementStart(pos int) bool {
+	return pos == 0 || e.tokens[pos-1].kind == pythonNewline || e.isOperator(pos-1, ":")
+}
+
+func concatenate(a, b pythonValue) pythonValue {
+	switch {
+	case a.kind == listValue && b.kind == listValue:
+		return pythonValue{kind: listValue, list: append(append([]string{}, a.list...), b.list...)}
+	case a.kind == stringValue && b.kind == stringValue:
+		return pythonValue{kind: stringValue, str: a.str + b.str}
+	case a.kind == unknownValue && b.kind == listValue:
+		// extending a variable that could not be evaluated still records the known requirements
+		return b
+	}
+	return pythonValue{}
 }
@@ -0,0 +1,84 @@
+package python
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// parseSetupCfg is a parser function for setup.cfg contents, returning all requirements listed by the
+// install_requires option that are pinned to a specific version (as with requirements.txt files). An example setup.cfg:
+//
+//	[options]
+//	install_requires =
+//	    requests[security] >= 2.8.1
+//	    click==8.1.3
+func (rp requirementsParser) parseSetupCfg(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var pkgs []pkg.Package
+	var section string
+	var inInstallRequires bool
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, ";") {
+			continue
+		}
+
+		continuation := line[0] == ' ' || line[0] == '\t'
+		if !continuation {
+			inInstallRequires = false
+		}
+
+		var value string
+		switch {
+		case continuation:
+			if !inInstallRequires {
+				continue
+			}
+			value = trimmed
+		case strings.HasPrefix(trimmed, "["):
+			section = strings.TrimSpace(strings.Trim(trimmed, "[]"))
+			continue
+		default:
+			key, v, ok := strings.Cut(trimmed, "=")
+			if !ok || section != "options" || strings.TrimSpace(key) != "install_requires" {
+				continue
+			}
+			inInstallRequires = true
+			value = strings.TrimSpace(v)
+		}
+
+		value = strings.TrimSpace(removeTrailingComment(value))
+		if value == "" {
+			continue
+		}
+
+		req := newRequirement(value)
+		if req == nil {
+			log.WithFields("path", reader.RealPath).Debugf("unable to parse setup.cfg requirement: %q", value)
+			continue
+		}
+
+		p := rp.newDeclaredPackage(req, reader.Location)
+		if p.Version == "" {
+			log.WithFields("path", reader.RealPath).Tracef("unable to determine package version in setup.cfg requirement: %q", value)
+			continue
+		}
+		pkgs = append(pkgs, p)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse setup.cfg file: %w", err)
+	}
+
+	return pkgs, nil, nil
+}
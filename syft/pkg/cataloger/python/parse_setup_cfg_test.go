@@ -0,0 +1,33 @@
+package python
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseSetupCfg(t *testing.T) {
+	fixture := "test-fixtures/setup-cfg/setup.cfg"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	// requirements without a pinned version are not reported (as with requirements.txt files)
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "click",
+			Version:   "8.1.3",
+			PURL:      "pkg:pypi/click@8.1.3",
+			Locations: locations,
+			Language:  pkg.Python,
+			Type:      pkg.PythonPkg,
+			Metadata: pkg.PythonRequirementsEntry{
+				Name:              "click",
+				VersionConstraint: "==8.1.3",
+			},
+		},
+	}
+
+	parser := newRequirementsParser(DefaultCatalogerConfig())
+	pkgtest.TestFileParser(t, fixture, parser.parseSetupCfg, expectedPkgs, nil)
+}
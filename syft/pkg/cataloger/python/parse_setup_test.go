@@ -25,6 +25,11 @@ func TestParseSetup(t *testing.T) {
 					PURL:     "pkg:pypi/pathlib3@2.2.0",
 					Language: pkg.Python,
 					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "pathlib3",
+						VersionConstraint: "==2.2.0",
+						Markers:           `python_version<"3.6"`,
+					},
 				},
 				{
 					Name:     "mypy",
@@ -32,6 +37,10 @@ func TestParseSetup(t *testing.T) {
 					PURL:     "pkg:pypi/mypy@v0.770",
 					Language: pkg.Python,
 					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "mypy",
+						VersionConstraint: "==v0.770",
+					},
 				},
 				{
 					Name:     "mypy1",
@@ -39,6 +48,10 @@ func TestParseSetup(t *testing.T) {
 					PURL:     "pkg:pypi/mypy1@v0.770",
 					Language: pkg.Python,
 					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "mypy1",
+						VersionConstraint: "==v0.770",
+					},
 				},
 				{
 					Name:     "mypy2",
@@ -46,6 +59,10 @@ func TestParseSetup(t *testing.T) {
 					PURL:     "pkg:pypi/mypy2@v0.770",
 					Language: pkg.Python,
 					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "mypy2",
+						VersionConstraint: "== v0.770",
+					},
 				},
 				{
 					Name:     "mypy3",
@@ -53,6 +70,10 @@ func TestParseSetup(t *testing.T) {
 					PURL:     "pkg:pypi/mypy3@v0.770",
 					Language: pkg.Python,
 					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "mypy3",
+						VersionConstraint: "== v0.770",
+					},
 				},
 			},
 		},
@@ -61,6 +82,141 @@ func TestParseSetup(t *testing.T) {
 			fixture:  "test-fixtures/setup/dynamic-setup.py",
 			expected: nil,
 		},
+		{
+			// requirements referenced by variables, extended conditionally, and combined with "+"
+			fixture: "test-fixtures/setup/literal-setup.py",
+			expected: []pkg.Package{
+				{
+					Name:     "click",
+					Version:  "8.1.3",
+					PURL:     "pkg:pypi/click@8.1.3",
+					Language: pkg.Python,
+					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "click",
+						VersionConstraint: "==8.1.3",
+					},
+				},
+				{
+					Name:     "rich",
+					Version:  "13.3.1",
+					PURL:     "pkg:pypi/rich@13.3.1",
+					Language: pkg.Python,
+					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "rich",
+						VersionConstraint: "==13.3.1",
+					},
+				},
+				{
+					Name:     "importlib-metadata",
+					Version:  "4.13.0",
+					PURL:     "pkg:pypi/importlib-metadata@4.13.0",
+					Language: pkg.Python,
+					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "importlib-metadata",
+						VersionConstraint: "==4.13.0",
+						Markers:           "python_version < '3.8'",
+					},
+				},
+				{
+					Name:     "colorama",
+					Version:  "0.4.6",
+					PURL:     "pkg:pypi/colorama@0.4.6",
+					Language: pkg.Python,
+					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "colorama",
+						VersionConstraint: "==0.4.6",
+					},
+				},
+				{
+					Name:     "requests",
+					Version:  "2.28.1",
+					PURL:     "pkg:pypi/requests@2.28.1",
+					Language: pkg.Python,
+					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "requests",
+						Extras:            []string{"security", "socks"},
+						VersionConstraint: "== 2.28.1",
+					},
+				},
+				{
+					Name:     "six",
+					Version:  "1.16.0",
+					PURL:     "pkg:pypi/six@1.16.0",
+					Language: pkg.Python,
+					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "six",
+						VersionConstraint: "==1.16.0",
+					},
+				},
+				{
+					Name:     "pytest",
+					Version:  "7.2.0",
+					PURL:     "pkg:pypi/pytest@7.2.0",
+					Language: pkg.Python,
+					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "pytest",
+						VersionConstraint: "==7.2.0",
+					},
+				},
+				{
+					Name:     "sphinx",
+					Version:  "6.1.3",
+					PURL:     "pkg:pypi/sphinx@6.1.3",
+					Language: pkg.Python,
+					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "sphinx",
+						VersionConstraint: "==6.1.3",
+					},
+				},
+				{
+					Name:     "mkdocs",
+					Version:  "1.4.2",
+					PURL:     "pkg:pypi/mkdocs@1.4.2",
+					Language: pkg.Python,
+					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "mkdocs",
+						VersionConstraint: "==1.4.2",
+					},
+				},
+			},
+		},
+		{
+			// requirements given as a string, and requirements that cannot be evaluated (function calls)
+			fixture: "test-fixtures/setup/string-setup.py",
+			expected: []pkg.Package{
+				{
+					Name:     "attrs",
+					Version:  "22.2.0",
+					PURL:     "pkg:pypi/attrs@22.2.0",
+					Language: pkg.Python,
+					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "attrs",
+						VersionConstraint: "==22.2.0",
+					},
+				},
+				{
+					Name:     "idna",
+					Version:  "3.4",
+					PURL:     "pkg:pypi/idna@3.4",
+					Language: pkg.Python,
+					Type:     pkg.PythonPkg,
+					Metadata: pkg.PythonRequirementsEntry{
+						Name:              "idna",
+						VersionConstraint: "==3.4",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -71,7 +227,7 @@ func TestParseSetup(t *testing.T) {
 			}
 			var expectedRelationships []artifact.Relationship
 
-			pkgtest.TestFileParser(t, tt.fixture, parseSetup, tt.expected, expectedRelationships)
+			pkgtest.TestFileParser(t, tt.fixture, newRequirementsParser(DefaultCatalogerConfig()).parseSetup, tt.expected, expectedRelationships)
 		})
 	}
 
@@ -110,3 +266,59 @@ func Test_hasTemplateDirective(t *testing.T) {
 		})
 	}
 }
+
+func Test_setupEvaluator_requirements(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []string
+	}{
+		{
+			name:   "literal list",
+			source: `setup(install_requires=["a==1", 'b>=2'])`,
+			want:   []string{"a==1", "b>=2"},
+		},
+		{
+			name:   "tuple and parenthesized concatenation",
+			source: "setup(install_requires=(\"a==1\",), extras_require={'x': ([\"b==2\"] + [\"c==3\"])})",
+			want:   []string{"a==1", "b==2", "c==3"},
+		},
+		{
+			name:   "setuptools attribute call within a function",
+			source: "import setuptools\nREQS = ['a==1']\ndef main():\n    setuptools.setup(install_requires=REQS)\n",
+			want:   []string{"a==1"},
+		},
+		{
+			name:   "variables assigned after the call are not resolved",
+			source: "setup(install_requires=REQS)\nREQS = ['a==1']\n",
+			want:   nil,
+		},
+		{
+			name:   "comprehensions, unpacking, and lambdas are skipped",
+			source: `setup(install_requires=[r for r in reqs], extras_require={**base, "a": ["b==1"]}, cmdclass={"x": lambda: 1}, *args)`,
+			want:   nil,
+		},
+		{
+			name:   "keyword arguments of other calls are not variables",
+			source: "X = ['a==1']\nfoo(X=['b==2'])\nsetup(install_requires=X)",
+			want:   []string{"a==1"},
+		},
+		{
+			name:   "defining a setup function is not a call",
+			source: "def setup(install_requires=['a==1']):\n    pass\n",
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := tokenizePython(tt.source)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, newSetupEvaluator(tokens).requirements())
+		})
+	}
+}
+
+func Test_tokenizePython_unterminatedString(t *testing.T) {
+	_, err := tokenizePython("setup(install_requires=['a==1)\n")
+	assert.Error(t, err)
+}
@@ -0,0 +1,37 @@
+import sys
+
+from setuptools import find_packages, setup
+
+# requirements shared by extras, referenced by name below
+TEST_REQUIRES = [
+    "pytest==7.2.0",
+    "pytest-cov>=4.0",  # not pinned, so this is not caught
+]
+
+BASE_REQUIRES = ["click==8.1.3"]
+BASE_REQUIRES += ["rich==13.3.1"]
+
+if sys.version_info < (3, 8):
+    BASE_REQUIRES.append("importlib-metadata==4.13.0; python_version < '3.8'")
+
+if sys.platform == "win32": BASE_REQUIRES.extend(["colorama==0.4.6"])
+
+setup(
+    name="example",
+    version="1.0.0",
+    packages=find_packages(exclude=["tests"]),
+    install_requires=BASE_REQUIRES + [
+        "requests[security,socks] == 2.28.1",
+        'six'
+        '==1.16.0',
+        "urllib3>=1.26,<2",
+        f"templated=={sys.version_info[0]}",
+        "jinja2==%s" % "3.1.2",
+    ],
+    setup_requires=["setuptools-scm==7.1.0"],  # build requirements are not caught
+    extras_require={
+        "test": TEST_REQUIRES,
+        "docs": ["""sphinx==6.1.3"""],
+        "all": [r"mkdocs==1.4.2"] + TEST_REQUIRES,
+    },
+)
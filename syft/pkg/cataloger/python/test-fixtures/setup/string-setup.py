@@ -0,0 +1,16 @@
+from setuptools import setup
+
+def requirements():
+    with open("requirements.txt") as f:
+        return f.read().splitlines()
+
+setup(
+    name="example",
+    install_requires="""
+        attrs==22.2.0
+        # comments are ignored
+        idna==3.4  # trailing comments are ignored
+    """,
+    extras_require=dict(test=requirements()),
+    tests_require=requirements(),
+)
@@ -8,8 +8,30 @@ import (
 	"github.com/anchore/syft/syft/pkg/cataloger/generic"
 )
 
-// NewPackageCataloger returns a new R cataloger object based on detection of R package DESCRIPTION files.
+// NewPackageCataloger returns a new R cataloger object based on detection of R package DESCRIPTION files and
+// renv.lock files.
 func NewPackageCataloger() pkg.Cataloger {
 	return generic.NewCataloger("r-package-cataloger").
-		WithParserByGlobs(parseDescriptionFile, "**/DESCRIPTION")
+		WithParserByGlobs(parseDescriptionFile, "**/DESCRIPTION").
+		WithParserByGlobs(parseRenvLock, "**/renv.lock").
+		WithProcessors(preferRenvLockPackages)
 }
+
+// preferRenvLockPackages drops packages found from DESCRIPTION files when the same package is pinned by an
+// renv.lock file within the same directory, since the lockfile records the exact version that the project was
+// snapshot with. Packages installed within a library (in their own directory) are always kept, since they describe
+// what is actually installed.
+var preferRenvLockPackages = generic.PreferLockedPackages(
+	func(p pkg.Package) []string {
+		if _, ok := p.Metadata.(pkg.RRenvLockEntry); ok {
+			return []string{p.Name}
+		}
+		return nil
+	},
+	func(p pkg.Package) string {
+		if _, ok := p.Metadata.(pkg.RDescription); ok {
+			return p.Name
+		}
+		return ""
+	},
+)
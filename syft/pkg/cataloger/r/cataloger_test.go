@@ -1,8 +1,11 @@
 package r
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
@@ -56,3 +59,72 @@ func TestRPackageCataloger(t *testing.T) {
 
 	pkgtest.NewCatalogTester().FromDirectory(t, "test-fixtures/installed").Expects(expectedPkgs, expectedRelationships).TestCataloger(t, NewPackageCataloger())
 }
+
+func TestRPackageCataloger_RenvLockProject(t *testing.T) {
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "stringr",
+			Version:   "1.5.1",
+			FoundBy:   "r-package-cataloger",
+			Locations: file.NewLocationSet(file.NewLocation("renv.lock")),
+			Language:  pkg.R,
+			Type:      pkg.Rpkg,
+			PURL:      "pkg:cran/stringr@1.5.1",
+			Metadata: pkg.RRenvLockEntry{
+				Source:     "Repository",
+				Repository: "CRAN",
+				Hash:       "960e2ae9e09656611e0b8214ad543207",
+			},
+		},
+		{
+			// the installed package is kept, since it describes what is installed (not what the project was snapshot with)
+			Name:      "stringr",
+			Version:   "1.5.0.9000",
+			FoundBy:   "r-package-cataloger",
+			Locations: file.NewLocationSet(file.NewLocation("stringr/DESCRIPTION")),
+			Licenses:  pkg.NewLicenseSet([]pkg.License{pkg.NewLicense("MIT")}...),
+			Language:  pkg.R,
+			Type:      pkg.Rpkg,
+			PURL:      "pkg:cran/stringr@1.5.0.9000",
+			Metadata: pkg.RDescription{
+				Title:       "Simple, Consistent Wrappers for Common String Operations",
+				Description: "A consistent, simple and easy to use set of wrappers around the fantastic 'stringi' package. All function and argument names (and positions) are consistent, all functions deal with \"NA\"'s and zero length vectors in the same way, and the output from one function is easy to feed into the input of another.",
+				URL:         []string{"https://stringr.tidyverse.org", "https://github.com/tidyverse/stringr"},
+				Imports: []string{
+					"cli", "glue (>= 1.6.1)", "lifecycle (>= 1.0.3)", "magrittr",
+					"rlang (>= 1.0.0)", "stringi (>= 1.5.3)", "vctrs (>= 0.4.0)",
+				},
+				Depends:  []string{"R (>= 3.3)"},
+				Suggests: []string{"covr", "dplyr", "gt", "htmltools", "htmlwidgets", "knitr", "rmarkdown", "testthat (>= 3.0.0)", "tibble"},
+			},
+		},
+	}
+	var expectedRelationships []artifact.Relationship
+
+	pkgtest.NewCatalogTester().FromDirectory(t, "test-fixtures/renv-project").Expects(expectedPkgs, expectedRelationships).TestCataloger(t, NewPackageCataloger())
+}
+
+func Test_preferRenvLockPackages(t *testing.T) {
+	pinned := newRenvLockPackage("mypkg", "1.2.0", pkg.RRenvLockEntry{Source: "Repository", Repository: "CRAN"}, file.NewLocation("project/renv.lock"))
+
+	described := func(location string) pkg.Package {
+		return newPackage(parseData{Package: "mypkg", Version: "1.1.0"}, file.NewLocation(location))
+	}
+
+	got, _, _ := preferRenvLockPackages([]pkg.Package{
+		described("project/DESCRIPTION"),
+		described("project/renv/library/R-4.3/x86_64-pc-linux-gnu/mypkg/DESCRIPTION"),
+		described("other/DESCRIPTION"),
+		pinned,
+	}, nil, nil)
+
+	var found []string
+	for _, p := range got {
+		found = append(found, fmt.Sprintf("%s@%s", p.Locations.ToSlice()[0].RealPath, p.Version))
+	}
+	assert.Equal(t, []string{
+		"project/renv/library/R-4.3/x86_64-pc-linux-gnu/mypkg/DESCRIPTION@1.1.0",
+		"other/DESCRIPTION@1.1.0",
+		"project/renv.lock@1.2.0",
+	}, found)
+}
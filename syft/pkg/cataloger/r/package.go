@@ -31,6 +31,39 @@ func newPackage(pd parseData, locations ...file.Location) pkg.Package {
 	return result
 }
 
+func newRenvLockPackage(name, version string, m pkg.RRenvLockEntry, locations ...file.Location) pkg.Package {
+	locationSet := file.NewLocationSet()
+	for _, loc := range locations {
+		locationSet.Add(loc.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation))
+	}
+
+	result := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: locationSet,
+		Language:  pkg.R,
+		Type:      pkg.Rpkg,
+		PURL:      renvLockPackageURL(name, version, m),
+		Metadata:  m,
+	}
+
+	result.SetID()
+	return result
+}
+
+// renvLockPackageURL returns the PURL of an renv.lock entry, where packages installed from a GitHub repository are
+// referenced by the repository (and commit) they were installed from rather than as CRAN packages.
+func renvLockPackageURL(name, version string, m pkg.RRenvLockEntry) string {
+	if strings.EqualFold(m.RemoteType, "github") && m.RemoteUsername != "" && m.RemoteRepo != "" {
+		ref := m.RemoteSha
+		if ref == "" {
+			ref = version
+		}
+		return packageurl.NewPackageURL(packageurl.TypeGithub, m.RemoteUsername, m.RemoteRepo, ref, nil, "").ToString()
+	}
+	return packageurl.NewPackageURL("cran", "", name, version, nil, "").ToString()
+}
+
 func packageURL(m parseData) string {
 	return packageurl.NewPackageURL("cran", "", m.Package, m.Version, nil, "").ToString()
 }
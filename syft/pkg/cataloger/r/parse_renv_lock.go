@@ -0,0 +1,72 @@
+package r
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseRenvLock
+
+// renvLock represents the renv.lock file written by renv::snapshot(), see https://rstudio.github.io/renv/articles/lockfile.html
+type renvLock struct {
+	Packages map[string]renvLockPackage `json:"Packages"`
+}
+
+type renvLockPackage struct {
+	Package        string   `json:"Package"`
+	Version        string   `json:"Version"`
+	Source         string   `json:"Source"`
+	Repository     string   `json:"Repository"`
+	Hash           string   `json:"Hash"`
+	Requirements   []string `json:"Requirements"`
+	RemoteType     string   `json:"RemoteType"`
+	RemoteHost     string   `json:"RemoteHost"`
+	RemoteUsername string   `json:"RemoteUsername"`
+	RemoteRepo     string   `json:"RemoteRepo"`
+	RemoteRef      string   `json:"RemoteRef"`
+	RemoteSha      string   `json:"RemoteSha"`
+}
+
+// parseRenvLock is a parser function for renv.lock contents, returning all R packages pinned within the lockfile.
+func parseRenvLock(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var lock renvLock
+	if err := json.NewDecoder(reader).Decode(&lock); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse renv.lock file: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	for key, entry := range lock.Packages {
+		name := entry.Package
+		if name == "" {
+			name = key
+		}
+		if name == "" || entry.Version == "" {
+			continue
+		}
+		pkgs = append(pkgs, newRenvLockPackage(name, entry.Version, pkg.RRenvLockEntry{
+			Source:         entry.Source,
+			Repository:     entry.Repository,
+			Hash:           entry.Hash,
+			Requirements:   entry.Requirements,
+			RemoteType:     entry.RemoteType,
+			RemoteHost:     entry.RemoteHost,
+			RemoteUsername: entry.RemoteUsername,
+			RemoteRepo:     entry.RemoteRepo,
+			RemoteRef:      entry.RemoteRef,
+			RemoteSha:      entry.RemoteSha,
+		}, reader.Location))
+	}
+
+	sort.Slice(pkgs, func(i, j int) bool {
+		return pkgs[i].Name < pkgs[j].Name
+	})
+
+	return pkgs, nil, nil
+}
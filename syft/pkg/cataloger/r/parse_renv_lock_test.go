@@ -0,0 +1,68 @@
+package r
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseRenvLock(t *testing.T) {
+	fixture := "test-fixtures/renv/renv.lock"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	expected := []pkg.Package{
+		{
+			Name:      "R6",
+			Version:   "2.5.1",
+			Language:  pkg.R,
+			Type:      pkg.Rpkg,
+			Locations: locations,
+			PURL:      "pkg:cran/R6@2.5.1",
+			Metadata: pkg.RRenvLockEntry{
+				Source:       "Repository",
+				Repository:   "CRAN",
+				Hash:         "470851b6d5d0ac559e9d01bb352b4021",
+				Requirements: []string{"R"},
+			},
+		},
+		{
+			Name:      "gh",
+			Version:   "1.4.0.9000",
+			Language:  pkg.R,
+			Type:      pkg.Rpkg,
+			Locations: locations,
+			PURL:      "pkg:github/r-lib/gh@ed0b1a4b0ef95de7a5ab4c5a6feb7e0ff4ab7dca",
+			Metadata: pkg.RRenvLockEntry{
+				Source:         "GitHub",
+				Hash:           "03533b1c875028233598f848fda44c4c",
+				Requirements:   []string{"R6", "cli"},
+				RemoteType:     "github",
+				RemoteHost:     "api.github.com",
+				RemoteUsername: "r-lib",
+				RemoteRepo:     "gh",
+				RemoteRef:      "main",
+				RemoteSha:      "ed0b1a4b0ef95de7a5ab4c5a6feb7e0ff4ab7dca",
+			},
+		},
+		{
+			Name:      "renv",
+			Version:   "1.0.3",
+			Language:  pkg.R,
+			Type:      pkg.Rpkg,
+			Locations: locations,
+			PURL:      "pkg:cran/renv@1.0.3",
+			Metadata: pkg.RRenvLockEntry{
+				Source:       "Repository",
+				Repository:   "CRAN",
+				Hash:         "41b847654f567341725473431dd0d5ab",
+				Requirements: []string{"utils"},
+			},
+		},
+	}
+
+	var expectedRelationships []artifact.Relationship
+
+	pkgtest.TestFileParser(t, fixture, parseRenvLock, expected, expectedRelationships)
+}
@@ -40,6 +40,11 @@ var patterns = map[string]*regexp.Regexp{
 	// homepage = "https://github.com/anchore/syft".freeze   --->   https://github.com/anchore/syft
 	"homepage": regexp.MustCompile(`.*\.homepage\s*=\s*["']{1}(?P<homepage>.*)["']{1} *`),
 
+	// match example:
+	// metadata = { "source_code_uri" => "https://github.com/anchore/syft" }   --->   https://github.com/anchore/syft
+	// metadata["source_code_uri"] = "https://github.com/anchore/syft"       --->   https://github.com/anchore/syft
+	"sourceCodeURI": regexp.MustCompile(`.*\.metadata(?:\s*=.*|\[)["']source_code_uri["'](?:\s*=>|]\s*=)\s*["'](?P<sourceCodeURI>[^"']*)["']`),
+
 	// match example:       files = ["exe/bundle".freeze, "exe/bundler".freeze]    --->    "exe/bundle".freeze, "exe/bundler".freeze
 	"files": regexp.MustCompile(`.*\.files\s*=\s*\[(?P<files>.*)] *`),
 
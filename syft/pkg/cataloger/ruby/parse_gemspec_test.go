@@ -3,6 +3,9 @@ package ruby
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/internal"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
@@ -24,13 +27,43 @@ func TestParseGemspec(t *testing.T) {
 		),
 		Language: pkg.Ruby,
 		Metadata: pkg.RubyGemspec{
-			Name:     "bundler",
-			Version:  "2.1.4",
-			Files:    []string{"exe/bundle", "exe/bundler"},
-			Authors:  []string{"André Arko", "Samuel Giddins", "Colby Swandale", "Hiroshi Shibata", "David Rodríguez", "Grey Baker", "Stephanie Morillo", "Chris Morris", "James Wen", "Tim Moore", "André Medeiros", "Jessica Lynn Suttles", "Terence Lee", "Carl Lerche", "Yehuda Katz"},
-			Homepage: "https://bundler.io",
+			Name:          "bundler",
+			Version:       "2.1.4",
+			Files:         []string{"exe/bundle", "exe/bundler"},
+			Authors:       []string{"André Arko", "Samuel Giddins", "Colby Swandale", "Hiroshi Shibata", "David Rodríguez", "Grey Baker", "Stephanie Morillo", "Chris Morris", "James Wen", "Tim Moore", "André Medeiros", "Jessica Lynn Suttles", "Terence Lee", "Carl Lerche", "Yehuda Katz"},
+			Homepage:      "https://bundler.io",
+			SourceCodeURI: "https://github.com/bundler/bundler/",
 		},
 	}
 
 	pkgtest.TestFileParser(t, fixture, parseGemSpecEntries, []pkg.Package{expectedPkg}, nil)
 }
+
+func TestSourceCodeURIPattern(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected string
+	}{
+		{
+			line:     `s.metadata = { "changelog_uri" => "https://example.com/CHANGELOG.md", "source_code_uri" => "https://github.com/example/gem" } if s.respond_to? :metadata=`,
+			expected: "https://github.com/example/gem",
+		},
+		{
+			line:     `spec.metadata["source_code_uri"] = "https://github.com/example/gem"`,
+			expected: "https://github.com/example/gem",
+		},
+		{
+			line:     `spec.metadata['source_code_uri'] = 'https://github.com/example/gem'`,
+			expected: "https://github.com/example/gem",
+		},
+		{
+			line: `spec.metadata["homepage_uri"] = "https://example.com"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			actual := internal.MatchNamedCaptureGroups(patterns["sourceCodeURI"], tt.line)
+			assert.Equal(t, tt.expected, actual["sourceCodeURI"])
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package rust
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/zlib"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	rustaudit "github.com/microsoft/go-rustaudit"
+)
+
+// the names of the section holding the dependency list embedded by cargo-auditable (.dep-v0), as well as the names
+// used by the (older) rust-audit 0.1.0 for each binary format
+const (
+	auditSectionName          = ".dep-v0"
+	rustAuditELFSectionName   = ".rust-deps-v0"
+	rustAuditPESectionName    = "rdep-v0"
+	rustAuditMachOSectionName = "rust-deps-v0"
+)
+
+// maxAuditDataSize bounds the (decompressed) size of the dependency list, which is far smaller for any real binary
+// (cargo-auditable applies the same limit when reading the list).
+const maxAuditDataSize = 8 << 20
+
+// rustcCommentPattern matches the version of rustc within the .comment section of an ELF binary, for example:
+//
+//	rustc version 1.79.0 (129f3b996 2024-06-10)
+var rustcCommentPattern = regexp.MustCompile(`rustc version (\d+\.\d+\.\d+(?:-[\w.]+)?)`)
+
+var (
+	elfMagic   = []byte("\x7FELF")
+	peMagic    = []byte("MZ")
+	machoMagic = [][]byte{
+		{0xFE, 0xED, 0xFA, 0xCE}, {0xFE, 0xED, 0xFA, 0xCF}, // big endian (32 and 64 bit)
+		{0xCE, 0xFA, 0xED, 0xFE}, {0xCF, 0xFA, 0xED, 0xFE}, // little endian (32 and 64 bit)
+	}
+)
+
+// readAuditDependencyInfo returns the dependency list embedded within the given executable by cargo-auditable (or the
+// older rust-audit). rustaudit.ErrNoRustDepInfo is returned if there is no dependency list, and
+// rustaudit.ErrUnknownFileFormat if the file is not an ELF, PE, or Mach-O executable.
+func readAuditDependencyInfo(r io.ReaderAt) (rustaudit.VersionInfo, error) {
+	data, err := readAuditSection(r)
+	if err != nil {
+		return rustaudit.VersionInfo{}, err
+	}
+
+	contents, err := decodeAuditData(data)
+	if err != nil {
+		return rustaudit.VersionInfo{}, err
+	}
+
+	var versionInfo rustaudit.VersionInfo
+	if err := json.Unmarshal(contents, &versionInfo); err != nil {
+		return rustaudit.VersionInfo{}, fmt.Errorf("unable to parse dependency list: %w", err)
+	}
+	return versionInfo, nil
+}
+
+// readRustcToolchain returns the toolchain name and version of the rustc compiler that built the given executable
+// (e.g. "rustc 1.79.0"), which is only recorded for ELF binaries (within the .comment section).
+func readRustcToolchain(r io.ReaderAt) string {
+	f, err := elf.NewFile(r)
+	if err != nil {
+		return ""
+	}
+	s := f.Section(".comment")
+	if s == nil || s.Type == elf.SHT_NOBITS {
+		return ""
+	}
+	data, err := s.Data()
+	if err != nil {
+		return ""
+	}
+	for _, comment := range strings.Split(string(data), "\x00") {
+		if match := rustcCommentPattern.FindStringSubmatch(comment); match != nil {
+			return "rustc " + match[1]
+		}
+	}
+	return ""
+}
+
+func readAuditSection(r io.ReaderAt) ([]byte, error) {
+	header := make([]byte, 16)
+	if n, err := r.ReadAt(header, 0); n < len(header) || (err != nil && !errors.Is(err, io.EOF)) {
+		return nil, rustaudit.ErrUnknownFileFormat
+	}
+
+	switch {
+	case bytes.HasPrefix(header, elfMagic):
+		f, err := elf.NewFile(r)
+		if err != nil {
+			return nil, rustaudit.ErrUnknownFileFormat
+		}
+		for _, name := range []string{auditSectionName, rustAuditELFSectionName} {
+			if s := f.Section(name); s != nil && s.Type != elf.SHT_NOBITS {
+				return sectionData(s.Open(), s.Size)
+			}
+		}
+	case bytes.HasPrefix(header, peMagic):
+		f, err := pe.NewFile(r)
+		if err != nil {
+			return nil, rustaudit.ErrUnknownFileFormat
+		}
+		for _, name := range []string{auditSectionName, rustAuditPESectionName} {
+			if s := f.Section(name); s != nil {
+				// the raw data of a section is padded to the file alignment, where the virtual size is the
+				// size of the section itself (when smaller)
+				size := uint64(s.Size)
+				if s.VirtualSize != 0 && uint64(s.VirtualSize) < size {
+					size = uint64(s.VirtualSize)
+				}
+				return sectionData(s.Open(), size)
+			}
+		}
+	case isMachO(header):
+		f, err := macho.NewFile(r)
+		if err != nil {
+			return nil, rustaudit.ErrUnknownFileFormat
+		}
+		for _, name := range []string{auditSectionName, rustAuditMachOSectionName} {
+			if s := f.Section(name); s != nil {
+				return sectionData(s.Open(), s.Size)
+			}
+		}
+	default:
+		return nil, rustaudit.ErrUnknownFileFormat
+	}
+
+	return nil, rustaudit.ErrNoRustDepInfo
+}
+
+func isMachO(header []byte) bool {
+	for _, magic := range machoMagic {
+		if bytes.HasPrefix(header, magic) {
+			return true
+		}
+	}
+	return false
+}
+
+func sectionData(r io.Reader, size uint64) ([]byte, error) {
+	if size > maxAuditDataSize {
+		return nil, fmt.Errorf("dependency list section is too large (%d bytes)", size)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, fmt.Errorf("unable to read dependency list section: %w", err)
+	}
+	return data, nil
+}
+
+// decodeAuditData returns the JSON dependency list held by the given section contents, which is compressed with zlib
+// by cargo-auditable. Uncompressed lists and raw DEFLATE streams (without the zlib header) are accepted as well, and
+// any padding following the list is ignored.
+func decodeAuditData(data []byte) ([]byte, error) {
+	// note: only trailing padding is trimmed, since compressed data may end with zero bytes (e.g. the checksum)
+	trimmed := bytes.TrimRight(data, "\x00")
+	if len(trimmed) == 0 {
+		return nil, rustaudit.ErrNoRustDepInfo
+	}
+
+	if trimmed[0] == '{' {
+		return trimmed, nil
+	}
+
+	var errs []error
+	if isZlibHeader(data) {
+		zr, err := zlib.NewReader(bytes.NewReader(data))
+		if err == nil {
+			defer zr.Close()
+			var contents []byte
+			if contents, err = readDecompressed(zr); err == nil {
+				return contents, nil
+			}
+		}
+		errs = append(errs, err)
+	}
+
+	fr := flate.NewReader(bytes.NewReader(data))
+	defer fr.Close()
+	contents, err := readDecompressed(fr)
+	if err == nil {
+		return contents, nil
+	}
+	errs = append(errs, err)
+
+	return nil, fmt.Errorf("unable to decompress dependency list: %w", errors.Join(errs...))
+}
+
+// isZlibHeader indicates if the given data starts with a zlib header (using DEFLATE, with a valid check value).
+func isZlibHeader(data []byte) bool {
+	if len(data) < 2 {
+		return false
+	}
+	return data[0]&0x0F == 8 && (uint16(data[0])<<8|uint16(data[1]))%31 == 0
+}
+
+func readDecompressed(r io.Reader) ([]byte, error) {
+	contents, err := io.ReadAll(io.LimitReader(r, maxAuditDataSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(contents) > maxAuditDataSize {
+		return nil, fmt.Errorf("dependency list is larger than %d bytes", maxAuditDataSize)
+	}
+	return contents, nil
+}
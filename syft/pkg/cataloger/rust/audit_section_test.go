@@ -0,0 +1,254 @@
+package rust
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/zlib"
+	"debug/elf"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	rustaudit "github.com/microsoft/go-rustaudit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+// testAuditJSON is a dependency list as embedded by recent versions of cargo-auditable (where the kind of runtime
+// dependencies is omitted, and the root package is marked)
+const testAuditJSON = `{"packages":[{"name":"hello-auditable","version":"0.1.0","source":"local","dependencies":[1,2],"root":true},{"name":"itoa","version":"1.0.11","source":"crates.io"},{"name":"cc","version":"1.0.98","source":"crates.io","kind":"build"}]}`
+
+func TestParseAuditBinary_Encodings(t *testing.T) {
+	tests := []struct {
+		name     string
+		section  string
+		contents []byte
+	}{
+		{
+			name:     "zlib compressed (cargo-auditable)",
+			section:  auditSectionName,
+			contents: zlibCompress(t, testAuditJSON),
+		},
+		{
+			name:     "zlib compressed with padding",
+			section:  auditSectionName,
+			contents: append(zlibCompress(t, testAuditJSON), make([]byte, 64)...),
+		},
+		{
+			name:     "raw deflate stream",
+			section:  auditSectionName,
+			contents: flateCompress(t, testAuditJSON),
+		},
+		{
+			name:     "uncompressed",
+			section:  auditSectionName,
+			contents: []byte(testAuditJSON),
+		},
+		{
+			name:     "older rust-audit section",
+			section:  rustAuditELFSectionName,
+			contents: zlibCompress(t, testAuditJSON),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fixture := writeTestElf(t, test.section, test.contents)
+			location := file.NewLocation(fixture)
+
+			expected := []pkg.Package{
+				{
+					Name:      "hello-auditable",
+					Version:   "0.1.0",
+					PURL:      "pkg:cargo/hello-auditable@0.1.0",
+					Locations: file.NewLocationSet(location),
+					Language:  pkg.Rust,
+					Type:      pkg.RustPkg,
+					Metadata: pkg.RustBinaryAuditEntry{
+						Name:    "hello-auditable",
+						Version: "0.1.0",
+						Source:  "local",
+					},
+				},
+				{
+					Name:      "itoa",
+					Version:   "1.0.11",
+					PURL:      "pkg:cargo/itoa@1.0.11",
+					Locations: file.NewLocationSet(location),
+					Language:  pkg.Rust,
+					Type:      pkg.RustPkg,
+					Metadata: pkg.RustBinaryAuditEntry{
+						Name:    "itoa",
+						Version: "1.0.11",
+						Source:  "crates.io",
+					},
+				},
+			}
+
+			pkgtest.TestFileParser(t, fixture, parseAuditBinary, expected, nil)
+		})
+	}
+}
+
+func Test_readAuditDependencyInfo(t *testing.T) {
+	t.Run("no dependency list", func(t *testing.T) {
+		fixture := writeTestElf(t, ".data", []byte("not an audit section"))
+		f, err := os.Open(fixture)
+		require.NoError(t, err)
+		defer f.Close()
+
+		_, err = readAuditDependencyInfo(f)
+		assert.ErrorIs(t, err, rustaudit.ErrNoRustDepInfo)
+	})
+
+	t.Run("not an executable", func(t *testing.T) {
+		_, err := readAuditDependencyInfo(bytes.NewReader([]byte("just some text, not a binary")))
+		assert.ErrorIs(t, err, rustaudit.ErrUnknownFileFormat)
+	})
+
+	t.Run("corrupt dependency list", func(t *testing.T) {
+		fixture := writeTestElf(t, auditSectionName, []byte{0x78, 0x9c, 0xde, 0xad, 0xbe, 0xef})
+		f, err := os.Open(fixture)
+		require.NoError(t, err)
+		defer f.Close()
+
+		_, err = readAuditDependencyInfo(f)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, rustaudit.ErrNoRustDepInfo)
+	})
+}
+
+func Test_decodeAuditData_TooLarge(t *testing.T) {
+	_, err := decodeAuditData(zlibCompress(t, string(make([]byte, maxAuditDataSize+1))))
+	require.ErrorContains(t, err, "larger than")
+}
+
+func Test_readRustcToolchain(t *testing.T) {
+	tests := []struct {
+		name     string
+		comment  string
+		expected string
+	}{
+		{
+			name:     "rustc with linker comments",
+			comment:  "GCC: (Debian 12.2.0-14) 12.2.0\x00rustc version 1.79.0 (129f3b996 2024-06-10)\x00",
+			expected: "rustc 1.79.0",
+		},
+		{
+			name:     "nightly rustc",
+			comment:  "rustc version 1.81.0-nightly (8337ba918 2024-06-12)\x00",
+			expected: "rustc 1.81.0-nightly",
+		},
+		{
+			name:    "no rustc comment",
+			comment: "GCC: (Debian 12.2.0-14) 12.2.0\x00",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fixture := writeTestElfSections(t,
+				testElfSection{name: auditSectionName, contents: zlibCompress(t, testAuditJSON)},
+				testElfSection{name: ".comment", contents: []byte(test.comment)},
+			)
+			f, err := os.Open(fixture)
+			require.NoError(t, err)
+			defer f.Close()
+
+			assert.Equal(t, test.expected, readRustcToolchain(f))
+		})
+	}
+
+	t.Run("not an ELF binary", func(t *testing.T) {
+		assert.Empty(t, readRustcToolchain(bytes.NewReader([]byte("just some text, not a binary"))))
+	})
+}
+
+func zlibCompress(t *testing.T, s string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	_, err := zw.Write([]byte(s))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func flateCompress(t *testing.T, s string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	fw, err := flate.NewWriter(&buf, flate.BestCompression)
+	require.NoError(t, err)
+	_, err = fw.Write([]byte(s))
+	require.NoError(t, err)
+	require.NoError(t, fw.Close())
+	return buf.Bytes()
+}
+
+// writeTestElf writes a minimal ELF executable with a single section of the given name and contents.
+func writeTestElf(t *testing.T, section string, contents []byte) string {
+	t.Helper()
+	return writeTestElfSections(t, testElfSection{name: section, contents: contents})
+}
+
+type testElfSection struct {
+	name     string
+	contents []byte
+}
+
+// writeTestElfSections writes a minimal ELF executable with the given sections.
+func writeTestElfSections(t *testing.T, sections ...testElfSection) string {
+	t.Helper()
+
+	headerSize := binary.Size(elf.Header64{})
+
+	var data bytes.Buffer
+	shstrtab := []byte("\x00")
+	headers := []elf.Section64{{}}
+	for _, s := range sections {
+		headers = append(headers, elf.Section64{
+			Name:  uint32(len(shstrtab)),
+			Type:  uint32(elf.SHT_PROGBITS),
+			Flags: uint64(elf.SHF_ALLOC),
+			Off:   uint64(headerSize + data.Len()),
+			Size:  uint64(len(s.contents)),
+		})
+		shstrtab = append(shstrtab, s.name+"\x00"...)
+		data.Write(s.contents)
+	}
+	headers = append(headers, elf.Section64{
+		Name: uint32(len(shstrtab)),
+		Type: uint32(elf.SHT_STRTAB),
+		Off:  uint64(headerSize + data.Len()),
+		Size: uint64(len(shstrtab) + len(".shstrtab\x00")),
+	})
+	shstrtab = append(shstrtab, ".shstrtab\x00"...)
+	data.Write(shstrtab)
+
+	header := elf.Header64{
+		Type:      uint16(elf.ET_EXEC),
+		Machine:   uint16(elf.EM_X86_64),
+		Version:   uint32(elf.EV_CURRENT),
+		Shoff:     uint64(headerSize + data.Len()),
+		Ehsize:    uint16(headerSize),
+		Shentsize: uint16(binary.Size(elf.Section64{})),
+		Shnum:     uint16(len(headers)),
+		Shstrndx:  uint16(len(headers) - 1),
+	}
+	copy(header.Ident[:], elf.ELFMAG)
+	header.Ident[elf.EI_CLASS] = byte(elf.ELFCLASS64)
+	header.Ident[elf.EI_DATA] = byte(elf.ELFDATA2LSB)
+	header.Ident[elf.EI_VERSION] = byte(elf.EV_CURRENT)
+
+	var buf bytes.Buffer
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, header))
+	buf.Write(data.Bytes())
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, headers))
+
+	fixture := filepath.Join(t.TempDir(), "app")
+	require.NoError(t, os.WriteFile(fixture, buf.Bytes(), 0o755))
+	return fixture
+}
@@ -20,11 +20,39 @@ func TestNewAuditBinaryCataloger(t *testing.T) {
 			Language:  pkg.Rust,
 			Type:      pkg.RustPkg,
 			Metadata: pkg.RustBinaryAuditEntry{
-				Name:    "auditable",
-				Version: "0.1.0",
-				Source:  "local",
+				Name:           "auditable",
+				Version:        "0.1.0",
+				Source:         "local",
+				BuildToolchain: "rustc 1.79.0",
+			},
+		},
+		{
+			Name:      "hello-auditable",
+			Version:   "0.1.0",
+			PURL:      "pkg:cargo/hello-auditable@0.1.0",
+			FoundBy:   "cargo-auditable-binary-cataloger",
+			Locations: file.NewLocationSet(file.NewVirtualLocation("/hello-auditable", "/hello-auditable")),
+			Language:  pkg.Rust,
+			Type:      pkg.RustPkg,
+			Metadata: pkg.RustBinaryAuditEntry{
+				Name:           "hello-auditable",
+				Version:        "0.1.0",
+				Source:         "local",
+				BuildToolchain: "rustc 1.79.0",
 			},
 		},
+	}
+
+	pkgtest.NewCatalogTester().
+		WithImageResolver(t, "image-audit").
+		IgnoreLocationLayer(). // this fixture can be rebuilt, thus the layer ID will change
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewAuditBinaryCataloger())
+}
+
+func TestNewAuditBinaryCataloger_RecentCargoAuditable(t *testing.T) {
+	// note: recent versions of cargo-auditable omit the kind of runtime dependencies and mark the root package
+	expectedPkgs := []pkg.Package{
 		{
 			Name:      "hello-auditable",
 			Version:   "0.1.0",
@@ -42,7 +70,7 @@ func TestNewAuditBinaryCataloger(t *testing.T) {
 	}
 
 	pkgtest.NewCatalogTester().
-		WithImageResolver(t, "image-audit").
+		WithImageResolver(t, "image-audit-recent").
 		IgnoreLocationLayer(). // this fixture can be rebuilt, thus the layer ID will change
 		Expects(expectedPkgs, nil).
 		TestCataloger(t, NewAuditBinaryCataloger())
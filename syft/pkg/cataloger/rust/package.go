@@ -1,6 +1,8 @@
 package rust
 
 import (
+	"strings"
+
 	"github.com/microsoft/go-rustaudit"
 
 	"github.com/anchore/packageurl-go"
@@ -10,6 +12,8 @@ import (
 
 // Pkg returns the standard `pkg.Package` representation of the package referenced within the Cargo.lock metadata.
 func newPackageFromCargoMetadata(m pkg.RustCargoLockEntry, locations ...file.Location) pkg.Package {
+	m.Registry = registryFromSource(m.Source)
+
 	p := pkg.Package{
 		Name:      m.Name,
 		Version:   m.Version,
@@ -25,12 +29,12 @@ func newPackageFromCargoMetadata(m pkg.RustCargoLockEntry, locations ...file.Loc
 	return p
 }
 
-func newPackagesFromAudit(location file.Location, versionInfo rustaudit.VersionInfo) []pkg.Package {
+func newPackagesFromAudit(location file.Location, versionInfo rustaudit.VersionInfo, toolchain string) []pkg.Package {
 	var pkgs []pkg.Package
 
 	for _, dep := range versionInfo.Packages {
 		dep := dep
-		p := newPackageFromAudit(&dep, location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation))
+		p := newPackageFromAudit(&dep, toolchain, location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation))
 		if pkg.IsValid(&p) && dep.Kind == rustaudit.Runtime {
 			pkgs = append(pkgs, p)
 		}
@@ -39,7 +43,7 @@ func newPackagesFromAudit(location file.Location, versionInfo rustaudit.VersionI
 	return pkgs
 }
 
-func newPackageFromAudit(dep *rustaudit.Package, locations ...file.Location) pkg.Package {
+func newPackageFromAudit(dep *rustaudit.Package, toolchain string, locations ...file.Location) pkg.Package {
 	p := pkg.Package{
 		Name:      dep.Name,
 		Version:   dep.Version,
@@ -48,9 +52,10 @@ func newPackageFromAudit(dep *rustaudit.Package, locations ...file.Location) pkg
 		Type:      pkg.RustPkg,
 		Locations: file.NewLocationSet(locations...),
 		Metadata: pkg.RustBinaryAuditEntry{
-			Name:    dep.Name,
-			Version: dep.Version,
-			Source:  dep.Source,
+			Name:           dep.Name,
+			Version:        dep.Version,
+			Source:         dep.Source,
+			BuildToolchain: toolchain,
 		},
 	}
 
@@ -59,6 +64,17 @@ func newPackageFromAudit(dep *rustaudit.Package, locations ...file.Location) pkg
 	return p
 }
 
+// registryFromSource returns the URL of the registry index from the source of a Cargo.lock entry, which is given as
+// "registry+<url>" (or "sparse+<url>" for sparse registries). Other sources (e.g. "git+<url>") are not registries.
+func registryFromSource(source string) string {
+	for _, prefix := range []string{"registry+", "sparse+"} {
+		if strings.HasPrefix(source, prefix) {
+			return strings.TrimPrefix(source, prefix)
+		}
+	}
+	return ""
+}
+
 // packageURL returns the PURL for the specific rust package (see https://github.com/package-url/purl-spec)
 func packageURL(name, version string) string {
 	return packageurl.NewPackageURL(
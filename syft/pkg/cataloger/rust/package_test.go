@@ -31,3 +31,30 @@ func Test_packageURL(t *testing.T) {
 		})
 	}
 }
+
+func Test_registryFromSource(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{
+			source: "registry+https://github.com/rust-lang/crates.io-index",
+			want:   "https://github.com/rust-lang/crates.io-index",
+		},
+		{
+			source: "sparse+https://cargo.example.com/index/",
+			want:   "https://cargo.example.com/index/",
+		},
+		{
+			source: "git+https://github.com/serde-rs/serde?branch=master#a1b2c3d4",
+		},
+		{
+			source: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			assert.Equal(t, tt.want, registryFromSource(tt.source))
+		})
+	}
+}
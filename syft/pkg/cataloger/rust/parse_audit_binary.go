@@ -23,15 +23,22 @@ func parseAuditBinary(_ context.Context, _ file.Resolver, _ *generic.Environment
 		return nil, nil, err
 	}
 
-	for _, versionInfo := range parseAuditBinaryEntry(unionReader, reader.RealPath) {
-		pkgs = append(pkgs, newPackagesFromAudit(reader.Location, versionInfo)...)
+	for _, entry := range parseAuditBinaryEntry(unionReader, reader.RealPath) {
+		pkgs = append(pkgs, newPackagesFromAudit(reader.Location, entry.versionInfo, entry.toolchain)...)
 	}
 
 	return pkgs, nil, nil
 }
 
+// auditBinaryEntry is the dependency information of a single binary (of a possibly universal binary), along with the
+// toolchain it was built with (when known).
+type auditBinaryEntry struct {
+	versionInfo rustaudit.VersionInfo
+	toolchain   string
+}
+
 // scanFile scans file to try to report the Rust crate dependencies
-func parseAuditBinaryEntry(reader unionreader.UnionReader, filename string) []rustaudit.VersionInfo {
+func parseAuditBinaryEntry(reader unionreader.UnionReader, filename string) []auditBinaryEntry {
 	// NOTE: multiple readers are returned to cover universal binaries, which are files
 	// with more than one binary
 	readers, err := unionreader.GetReaders(reader)
@@ -40,22 +47,26 @@ func parseAuditBinaryEntry(reader unionreader.UnionReader, filename string) []ru
 		return nil
 	}
 
-	var versionInfos []rustaudit.VersionInfo
+	var entries []auditBinaryEntry
 	for _, r := range readers {
-		versionInfo, err := rustaudit.GetDependencyInfo(r)
+		versionInfo, err := readAuditDependencyInfo(r)
 
 		if err != nil {
-			if errors.Is(err, rustaudit.ErrNoRustDepInfo) {
+			if errors.Is(err, rustaudit.ErrNoRustDepInfo) || errors.Is(err, rustaudit.ErrUnknownFileFormat) {
 				// since the cataloger can only select executables and not distinguish if they are a Rust-compiled
 				// binary, we should not show warnings/logs in this case.
-				return nil
+				// note: the other binaries of a universal binary may still have dependency information
+				continue
 			}
 			log.Tracef("rust cataloger: unable to read dependency information (file=%q): %v", filename, err)
-			return nil
+			continue
 		}
 
-		versionInfos = append(versionInfos, versionInfo)
+		entries = append(entries, auditBinaryEntry{
+			versionInfo: versionInfo,
+			toolchain:   readRustcToolchain(r),
+		})
 	}
 
-	return versionInfos
+	return entries
 }
@@ -29,6 +29,7 @@ func TestParseCargoLock(t *testing.T) {
 				Dependencies: []string{
 					"winapi",
 				},
+				Registry: "https://github.com/rust-lang/crates.io-index",
 			},
 		},
 		{
@@ -45,6 +46,7 @@ func TestParseCargoLock(t *testing.T) {
 				Source:       "registry+https://github.com/rust-lang/crates.io-index",
 				Checksum:     "7ffc5c5338469d4d3ea17d269fa8ea3512ad247247c30bd2df69e68309ed0a08",
 				Dependencies: []string{},
+				Registry:     "https://github.com/rust-lang/crates.io-index",
 			},
 		},
 		{
@@ -61,6 +63,7 @@ func TestParseCargoLock(t *testing.T) {
 				Source:       "registry+https://github.com/rust-lang/crates.io-index",
 				Checksum:     "3728d817d99e5ac407411fa471ff9800a778d88a24685968b36824eaf4bee400",
 				Dependencies: []string{},
+				Registry:     "https://github.com/rust-lang/crates.io-index",
 			},
 		},
 		{
@@ -77,6 +80,7 @@ func TestParseCargoLock(t *testing.T) {
 				Source:       "registry+https://github.com/rust-lang/crates.io-index",
 				Checksum:     "308d96db8debc727c3fd9744aac51751243420e46edf401010908da7f8d5e57c",
 				Dependencies: []string{},
+				Registry:     "https://github.com/rust-lang/crates.io-index",
 			},
 		},
 		{
@@ -96,6 +100,7 @@ func TestParseCargoLock(t *testing.T) {
 					"memchr",
 					"version_check",
 				},
+				Registry: "https://github.com/rust-lang/crates.io-index",
 			},
 		},
 		{
@@ -114,6 +119,7 @@ func TestParseCargoLock(t *testing.T) {
 				Dependencies: []string{
 					"matches",
 				},
+				Registry: "https://github.com/rust-lang/crates.io-index",
 			},
 		},
 		{
@@ -130,6 +136,7 @@ func TestParseCargoLock(t *testing.T) {
 				Source:       "registry+https://github.com/rust-lang/crates.io-index",
 				Checksum:     "914b1a6776c4c929a602fafd8bc742e06365d4bcbe48c30f9cca5824f70dc9dd",
 				Dependencies: []string{},
+				Registry:     "https://github.com/rust-lang/crates.io-index",
 			},
 		},
 		{
@@ -149,6 +156,7 @@ func TestParseCargoLock(t *testing.T) {
 					"winapi-i686-pc-windows-gnu",
 					"winapi-x86_64-pc-windows-gnu",
 				},
+				Registry: "https://github.com/rust-lang/crates.io-index",
 			},
 		},
 		{
@@ -165,6 +173,7 @@ func TestParseCargoLock(t *testing.T) {
 				Source:       "registry+https://github.com/rust-lang/crates.io-index",
 				Checksum:     "ac3b87c63620426dd9b991e5ce0329eff545bccbbb34f3be09ff6fb6ab51b7b6",
 				Dependencies: []string{},
+				Registry:     "https://github.com/rust-lang/crates.io-index",
 			},
 		},
 		{
@@ -181,6 +190,7 @@ func TestParseCargoLock(t *testing.T) {
 				Source:       "registry+https://github.com/rust-lang/crates.io-index",
 				Checksum:     "712e227841d057c1ee1cd2fb22fa7e5a5461ae8e48fa2ca79ec42cfc1931183f",
 				Dependencies: []string{},
+				Registry:     "https://github.com/rust-lang/crates.io-index",
 			},
 		},
 	}
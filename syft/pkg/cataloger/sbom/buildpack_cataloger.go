@@ -0,0 +1,56 @@
+package sbom
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+const buildpackCatalogerName = "buildpack-sbom-cataloger"
+
+// buildpackSBOMDir is where the CNB lifecycle exports the SBOMs written by each buildpack, organized as
+// <phase>/<buildpack-id>/[<layer>/]sbom.<format>.json
+const buildpackSBOMDir = "layers/sbom"
+
+// NewBuildpackCataloger returns a new cataloger for the SBOMs written by Cloud Native Buildpacks, where each package
+// is attributed to the buildpack (and layer) that described it.
+func NewBuildpackCataloger() pkg.Cataloger {
+	return generic.NewCataloger(buildpackCatalogerName).
+		WithParserByGlobs(parseBuildpackSBOM,
+			"**/layers/sbom/*/*/sbom.*.json",
+			"**/layers/sbom/*/*/*/sbom.*.json",
+		)
+}
+
+func parseBuildpackSBOM(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	return packagesFromSBOM(reader, withBuildpackAnnotations(reader.Location), buildpackCatalogerName)
+}
+
+// withBuildpackAnnotations annotates the location of a buildpack SBOM with the buildpack, layer, and phase it was
+// written for, as indicated by the path of the SBOM within the buildpack SBOM directory.
+func withBuildpackAnnotations(location file.Location) file.Location {
+	_, rel, found := strings.Cut(location.RealPath, buildpackSBOMDir+"/")
+	if !found {
+		return location
+	}
+
+	parts := strings.Split(path.Dir(rel), "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return location
+	}
+
+	location = location.
+		WithAnnotation(pkg.BuildpackPhaseAnnotationKey, parts[0]).
+		// buildpack IDs may contain "/" but not "_", so the lifecycle escapes "/" as "_" in directory names
+		WithAnnotation(pkg.BuildpackAnnotationKey, strings.ReplaceAll(parts[1], "_", "/"))
+
+	if len(parts) == 3 {
+		location = location.WithAnnotation(pkg.BuildpackLayerAnnotationKey, parts[2])
+	}
+	return location
+}
@@ -0,0 +1,101 @@
+package sbom
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/directorysource"
+)
+
+func Test_withBuildpackAnnotations(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want map[string]string
+	}{
+		{
+			name: "layer SBOM",
+			path: "/layers/sbom/launch/paketo-buildpacks_node-engine/node/sbom.cdx.json",
+			want: map[string]string{
+				pkg.BuildpackPhaseAnnotationKey: "launch",
+				pkg.BuildpackAnnotationKey:      "paketo-buildpacks/node-engine",
+				pkg.BuildpackLayerAnnotationKey: "node",
+			},
+		},
+		{
+			name: "buildpack SBOM",
+			path: "/layers/sbom/build/paketo-buildpacks_npm-install/sbom.syft.json",
+			want: map[string]string{
+				pkg.BuildpackPhaseAnnotationKey: "build",
+				pkg.BuildpackAnnotationKey:      "paketo-buildpacks/npm-install",
+			},
+		},
+		{
+			name: "not within the buildpack SBOM directory",
+			path: "/app/sbom.cdx.json",
+			want: map[string]string{},
+		},
+		{
+			name: "unexpected nesting",
+			path: "/layers/sbom/launch/sbom.cdx.json",
+			want: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := withBuildpackAnnotations(file.NewLocation(tt.path))
+			assert.Equal(t, tt.want, got.Annotations)
+		})
+	}
+}
+
+func Test_BuildpackCataloger(t *testing.T) {
+	src, err := directorysource.NewFromPath("test-fixtures/buildpack")
+	require.NoError(t, err)
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	pkgs, relationships, err := NewBuildpackCataloger().Catalog(context.Background(), resolver)
+	require.NoError(t, err)
+	require.Len(t, pkgs, 2)
+	assert.Len(t, relationships, 2)
+
+	buildpacks := make(map[string]map[string]string)
+	for _, p := range pkgs {
+		assert.Equal(t, buildpackCatalogerName, p.FoundBy)
+		locations := p.Locations.ToSlice()
+		require.Len(t, locations, 1)
+		buildpacks[p.Name] = locations[0].Annotations
+	}
+
+	assert.Equal(t, map[string]map[string]string{
+		"node": {
+			pkg.EvidenceAnnotationKey:       pkg.PrimaryEvidenceAnnotation,
+			pkg.BuildpackPhaseAnnotationKey: "launch",
+			pkg.BuildpackAnnotationKey:      "paketo-buildpacks/node-engine",
+			pkg.BuildpackLayerAnnotationKey: "node",
+		},
+		"express": {
+			pkg.EvidenceAnnotationKey:       pkg.PrimaryEvidenceAnnotation,
+			pkg.BuildpackPhaseAnnotationKey: "build",
+			pkg.BuildpackAnnotationKey:      "paketo-buildpacks/npm-install",
+		},
+	}, buildpacks)
+}
+
+func Test_BuildpackCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/buildpack").
+		ExpectsResolverContentQueries([]string{
+			"layers/sbom/launch/paketo-buildpacks_node-engine/node/sbom.cdx.json",
+			"layers/sbom/build/paketo-buildpacks_npm-install/sbom.cdx.json",
+		}).
+		TestCataloger(t, NewBuildpackCataloger())
+}
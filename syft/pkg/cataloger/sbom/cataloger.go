@@ -38,6 +38,11 @@ func NewCataloger() pkg.Cataloger {
 }
 
 func parseSBOM(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	return packagesFromSBOM(reader, reader.Location, catalogerName)
+}
+
+// packagesFromSBOM decodes the SBOM and returns the packages (found at the given location) and relationships within it.
+func packagesFromSBOM(reader file.LocationReadCloser, location file.Location, foundBy string) ([]pkg.Package, []artifact.Relationship, error) {
 	readSeeker, err := adaptToReadSeeker(reader)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to read SBOM file %q: %w", reader.Location.RealPath, err)
@@ -60,14 +65,14 @@ func parseSBOM(_ context.Context, _ file.Resolver, _ *generic.Environment, reade
 		// where there is evidence of this file, and the catalogers have not run against any file other than,
 		// the SBOM, this is the only location that is relevant for this cataloger.
 		p.Locations = file.NewLocationSet(
-			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
 		)
-		p.FoundBy = catalogerName
+		p.FoundBy = foundBy
 
 		pkgs = append(pkgs, p)
 		relationships = append(relationships, artifact.Relationship{
 			From: p,
-			To:   reader.Location.Coordinates,
+			To:   location.Coordinates,
 			Type: artifact.DescribedByRelationship,
 		})
 	}
@@ -0,0 +1,19 @@
+/*
+Package vscode provides a concrete Cataloger implementation for extensions installed into VS Code or compatible editors.
+*/
+package vscode
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// extensionManifestGlob matches the manifest of each extension installed within an extensions directory, such as
+// ~/.vscode/extensions, ~/.vscode-server/extensions, ~/.vscode-oss/extensions, or the builtin extensions of the editor.
+const extensionManifestGlob = "**/extensions/*/package.json"
+
+// NewExtensionCataloger returns a new cataloger object for the package.json manifests of installed VS Code extensions.
+func NewExtensionCataloger() pkg.Cataloger {
+	return generic.NewCataloger("vscode-extension-cataloger").
+		WithParserByGlobs(parseExtensionManifest, extensionManifestGlob)
+}
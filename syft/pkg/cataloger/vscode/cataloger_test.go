@@ -0,0 +1,32 @@
+package vscode
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestCataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain extension manifests",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"home/user/.vscode/extensions/ms-python.python-2024.0.1/package.json",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewExtensionCataloger())
+		})
+	}
+}
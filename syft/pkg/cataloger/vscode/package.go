@@ -0,0 +1,43 @@
+package vscode
+
+import (
+	"path"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newPackage(m extensionManifest, location file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      m.Name,
+		Version:   m.Version,
+		Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromLocation(location, m.licenses()...)...),
+		PURL:      packageURL(m.Publisher, m.Name, m.Version),
+		Language:  pkg.JavaScript,
+		Type:      pkg.VSCodeExtensionPkg,
+		Metadata: pkg.VSCodeExtensionEntry{
+			Publisher:        m.Publisher,
+			DisplayName:      m.DisplayName,
+			Description:      m.Description,
+			Engine:           m.Engines.VSCode,
+			InstallDirectory: path.Dir(location.RealPath),
+		},
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func packageURL(publisher, name, version string) string {
+	return packageurl.NewPackageURL(
+		pkg.VSCodeExtensionPkg.PackageURLType(),
+		publisher,
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
@@ -0,0 +1,79 @@
+package vscode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// integrity check
+var _ generic.Parser = parseExtensionManifest
+
+// extensionManifest represents the package.json manifest of a VS Code extension, see
+// https://code.visualstudio.com/api/references/extension-manifest
+type extensionManifest struct {
+	Name        string  `json:"name"`
+	Version     string  `json:"version"`
+	Publisher   string  `json:"publisher"`
+	DisplayName string  `json:"displayName"`
+	Description string  `json:"description"`
+	License     string  `json:"license"`
+	Engines     engines `json:"engines"`
+}
+
+type engines struct {
+	VSCode string `json:"vscode"`
+}
+
+// parseExtensionManifest parses the package.json manifest of an installed VS Code extension. Manifests without a
+// publisher and a VS Code engine (e.g. regular npm packages within an extensions directory) are ignored.
+func parseExtensionManifest(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var m extensionManifest
+	if err := json.NewDecoder(reader).Decode(&m); err != nil {
+		// since all package.json files within an extensions directory are considered, not all are expected to
+		// have the shape of a manifest
+		log.WithFields("path", reader.RealPath, "error", err).Trace("unable to parse possible VS Code extension manifest")
+		return nil, nil, nil
+	}
+
+	if m.Publisher == "" || m.Engines.VSCode == "" {
+		return nil, nil, nil
+	}
+
+	if m.Name == "" || m.Version == "" {
+		log.WithFields("path", reader.RealPath).Debug("encountered VS Code extension manifest without a name and/or version, ignoring")
+		return nil, nil, nil
+	}
+
+	return []pkg.Package{newPackage(m, reader.Location)}, nil, nil
+}
+
+func (m extensionManifest) licenses() []string {
+	// the license may instead refer to a file within the extension, e.g. "SEE LICENSE IN LICENSE.txt"
+	if m.License == "" || strings.HasPrefix(strings.ToUpper(m.License), "SEE LICENSE IN") {
+		return nil
+	}
+	return []string{m.License}
+}
+
+func (e *engines) UnmarshalJSON(b []byte) error {
+	// the engines field of npm packages is not always an object (old packages may use a list), which should not fail
+	// the parsing of the rest of the manifest
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil
+	}
+	if raw, ok := fields["vscode"]; ok {
+		if err := json.Unmarshal(raw, &e.VSCode); err != nil {
+			return fmt.Errorf("unable to parse VS Code engine: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package vscode
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseExtensionManifest(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		expected []pkg.Package
+	}{
+		{
+			fixture: "test-fixtures/extensions/ms-python.python-2024.0.1/package.json",
+			expected: []pkg.Package{
+				{
+					Name:     "python",
+					Version:  "2024.0.1",
+					PURL:     "pkg:vscode-extension/ms-python/python@2024.0.1",
+					Language: pkg.JavaScript,
+					Type:     pkg.VSCodeExtensionPkg,
+					Licenses: pkg.NewLicenseSet(
+						pkg.NewLicenseFromLocations("MIT", file.NewLocation("test-fixtures/extensions/ms-python.python-2024.0.1/package.json")),
+					),
+					Metadata: pkg.VSCodeExtensionEntry{
+						Publisher:        "ms-python",
+						DisplayName:      "Python",
+						Description:      "Python language support with extension access points for IntelliSense (Pylance), Debugging (Python Debugger), linting, formatting, refactoring, unit tests, and more.",
+						Engine:           "^1.82.0",
+						InstallDirectory: "test-fixtures/extensions/ms-python.python-2024.0.1",
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/extensions/redhat.vscode-yaml-1.14.0/package.json",
+			expected: []pkg.Package{
+				{
+					Name:     "vscode-yaml",
+					Version:  "1.14.0",
+					PURL:     "pkg:vscode-extension/redhat/vscode-yaml@1.14.0",
+					Language: pkg.JavaScript,
+					Type:     pkg.VSCodeExtensionPkg,
+					Metadata: pkg.VSCodeExtensionEntry{
+						Publisher:        "redhat",
+						DisplayName:      "YAML",
+						Engine:           "^1.63.0",
+						InstallDirectory: "test-fixtures/extensions/redhat.vscode-yaml-1.14.0",
+					},
+				},
+			},
+		},
+		{
+			// npm packages bundled with an extension are not extensions themselves
+			fixture: "test-fixtures/extensions/ms-python.python-2024.0.1/node_modules/semver/package.json",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			for i := range test.expected {
+				test.expected[i].Locations = file.NewLocationSet(file.NewLocation(test.fixture))
+			}
+			pkgtest.TestFileParser(t, test.fixture, parseExtensionManifest, test.expected, nil)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+/*
+Package wasm provides a concrete Cataloger implementation for packages imported by WebAssembly components.
+*/
+package wasm
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewWasmComponentCataloger returns a new cataloger object for WIT package imports found in WebAssembly binaries.
+func NewWasmComponentCataloger() pkg.Cataloger {
+	return generic.NewCataloger("wasm-component-cataloger").
+		WithParserByGlobs(parseWasmBinary, "**/*.wasm")
+}
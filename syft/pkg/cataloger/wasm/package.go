@@ -0,0 +1,33 @@
+package wasm
+
+import (
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newPackage(namespace, name, version string, m pkg.WasmImportEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      namespace + ":" + name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(namespace, name, version),
+		Type:      pkg.WasmPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func packageURL(namespace, name, version string) string {
+	return packageurl.NewPackageURL(
+		pkg.WasmPkg.PackageURLType(),
+		namespace,
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
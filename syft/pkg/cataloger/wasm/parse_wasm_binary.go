@@ -0,0 +1,370 @@
+package wasm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+const (
+	wasmMagic = "\x00asm"
+
+	// the layer field of the preamble distinguishes core modules from components
+	coreModuleLayer = 0
+	componentLayer  = 1
+
+	customSectionID          = 0
+	componentImportSectionID = 10
+
+	// sections larger than this are skipped instead of read into memory
+	maxSectionSize = 64 * 1024 * 1024
+
+	componentTypeSectionPrefix = "component-type"
+)
+
+// interfaceNamePattern matches WIT interface names such as "wasi:cli/environment@0.2.0"
+var interfaceNamePattern = regexp.MustCompile(`^(?P<namespace>[a-z][a-z0-9-]*):(?P<package>[a-z][a-z0-9-]*)(?:/(?P<interface>[a-z][a-z0-9-]*))?(?:@(?P<version>[0-9A-Za-z.+-]+))?$`)
+
+var _ generic.Parser = parseWasmBinary
+
+type wasmBinary struct {
+	module  string
+	imports []wasmImport
+}
+
+type wasmImport struct {
+	name  string
+	world string
+}
+
+type witName struct {
+	namespace string
+	pkg       string
+	iface     string
+	version   string
+}
+
+// parseWasmBinary is a parser function for WebAssembly binaries (core modules and components), returning the WIT packages
+// imported by the binary.
+func parseWasmBinary(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	bin, err := readWasmBinary(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse wasm binary: %w", err)
+	}
+
+	return newPackages(bin, reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)), nil, nil
+}
+
+func readWasmBinary(r io.Reader) (*wasmBinary, error) {
+	br := bufio.NewReader(r)
+
+	header := make([]byte, 8)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, fmt.Errorf("unable to read preamble: %w", err)
+	}
+	if string(header[:4]) != wasmMagic {
+		return nil, fmt.Errorf("not a wasm binary (bad magic)")
+	}
+
+	layer := binary.LittleEndian.Uint16(header[6:8])
+	if layer != coreModuleLayer && layer != componentLayer {
+		return nil, fmt.Errorf("unsupported wasm layer: %d", layer)
+	}
+	isComponent := layer == componentLayer
+
+	var bin wasmBinary
+	for {
+		id, err := br.ReadByte()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		size, err := readULEB128(br)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read section size: %w", err)
+		}
+
+		interesting := id == customSectionID || (isComponent && id == componentImportSectionID)
+		if !interesting || size > maxSectionSize {
+			if _, err := io.CopyN(io.Discard, br, int64(size)); err != nil {
+				return nil, fmt.Errorf("unable to skip section %d: %w", id, err)
+			}
+			continue
+		}
+
+		data := make([]byte, size)
+		if _, err := io.ReadFull(br, data); err != nil {
+			return nil, fmt.Errorf("unable to read section %d: %w", id, err)
+		}
+
+		if id == componentImportSectionID {
+			names, err := parseComponentImports(data)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse import section: %w", err)
+			}
+			for _, n := range names {
+				bin.imports = append(bin.imports, wasmImport{name: n})
+			}
+			continue
+		}
+
+		bin.readCustomSection(data)
+	}
+
+	return &bin, nil
+}
+
+func (b *wasmBinary) readCustomSection(data []byte) {
+	r := bytes.NewReader(data)
+	name, err := readName(r)
+	if err != nil {
+		return
+	}
+	payload := data[len(data)-r.Len():]
+
+	switch {
+	case name == "name" || name == "component-name":
+		if b.module == "" {
+			b.module = moduleName(payload)
+		}
+	case strings.HasPrefix(name, componentTypeSectionPrefix):
+		// e.g. "component-type:my-world" or, as emitted by wit-bindgen, "component-type:wit-bindgen:0.20.0:my-world:encoded world"
+		world := strings.TrimSuffix(name[len(componentTypeSectionPrefix):], ":encoded world")
+		if idx := strings.LastIndex(world, ":"); idx >= 0 {
+			world = world[idx+1:]
+		}
+		for _, n := range scanComponentTypeImports(payload) {
+			b.imports = append(b.imports, wasmImport{name: n, world: world})
+		}
+	}
+}
+
+// moduleName returns the module (or component) name from the payload of a name custom section, found in subsection 0.
+func moduleName(payload []byte) string {
+	r := bytes.NewReader(payload)
+	for r.Len() > 0 {
+		id, err := r.ReadByte()
+		if err != nil {
+			return ""
+		}
+		size, err := readULEB128(r)
+		if err != nil || uint64(size) > uint64(r.Len()) {
+			return ""
+		}
+		if id == 0 {
+			name, err := readName(r)
+			if err != nil {
+				return ""
+			}
+			return name
+		}
+		if _, err := r.Seek(int64(size), io.SeekCurrent); err != nil {
+			return ""
+		}
+	}
+	return ""
+}
+
+// parseComponentImports returns the import names from the payload of a component import section.
+func parseComponentImports(data []byte) ([]string, error) {
+	r := bytes.NewReader(data)
+	count, err := readULEB128(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for i := uint32(0); i < count; i++ {
+		// importname' ::= 0x00 len:<u32> <importname> | 0x01 len:<u32> <importname>
+		prefix, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if prefix != 0x00 && prefix != 0x01 {
+			return nil, fmt.Errorf("unexpected import name prefix: 0x%02x", prefix)
+		}
+		name, err := readName(r)
+		if err != nil {
+			return nil, err
+		}
+		if err := skipExternDesc(r); err != nil {
+			return nil, fmt.Errorf("unable to read import %q: %w", name, err)
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func skipExternDesc(r *bytes.Reader) error {
+	kind, err := r.ReadByte()
+	if err != nil {
+		return err
+	}
+	switch kind {
+	case 0x00: // core module: 0x00 0x11 <core:typeidx>
+		if _, err := r.ReadByte(); err != nil {
+			return err
+		}
+		return skipLEB128(r)
+	case 0x01, 0x04, 0x05: // func, component, instance: <typeidx>
+		return skipLEB128(r)
+	case 0x02, 0x03: // value bound or type bound
+		bound, err := r.ReadByte()
+		if err != nil {
+			return err
+		}
+		if bound == 0x00 || kind == 0x02 {
+			// eq <idx> or (for values) a valtype, both are LEB128 encoded
+			return skipLEB128(r)
+		}
+		// sub resource has no immediate
+		return nil
+	}
+	return fmt.Errorf("unexpected extern descriptor kind: 0x%02x", kind)
+}
+
+// scanComponentTypeImports finds the import declarations within the encoded world of a "component-type" custom
+// section. Instead of decoding all (nested) component types, this looks for importdecl entries
+// (0x03 <importname'>) that carry a WIT interface name.
+func scanComponentTypeImports(payload []byte) []string {
+	var names []string
+	for i := 0; i+2 < len(payload); i++ {
+		if payload[i] != 0x03 || (payload[i+1] != 0x00 && payload[i+1] != 0x01) {
+			continue
+		}
+		r := bytes.NewReader(payload[i+2:])
+		name, err := readName(r)
+		if err != nil || !interfaceNamePattern.MatchString(name) {
+			continue
+		}
+		names = append(names, name)
+		i += len(payload[i+2:]) - r.Len() + 1
+	}
+	return names
+}
+
+func parseWITName(name string) *witName {
+	matches := internal.MatchNamedCaptureGroups(interfaceNamePattern, name)
+	if matches["namespace"] == "" || matches["package"] == "" {
+		return nil
+	}
+	return &witName{
+		namespace: matches["namespace"],
+		pkg:       matches["package"],
+		iface:     matches["interface"],
+		version:   matches["version"],
+	}
+}
+
+func newPackages(bin *wasmBinary, location file.Location) []pkg.Package {
+	type key struct {
+		namespace, pkg, version, world string
+	}
+
+	interfaces := make(map[key]*strset.Set)
+	for _, imp := range bin.imports {
+		n := parseWITName(imp.name)
+		if n == nil {
+			// not an interface import (e.g. a plain kebab-case name)
+			continue
+		}
+		k := key{namespace: n.namespace, pkg: n.pkg, version: n.version, world: imp.world}
+		if _, ok := interfaces[k]; !ok {
+			interfaces[k] = strset.New()
+		}
+		if n.iface != "" {
+			interfaces[k].Add(n.iface)
+		}
+	}
+
+	var keys []key
+	for k := range interfaces {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.namespace != b.namespace {
+			return a.namespace < b.namespace
+		}
+		if a.pkg != b.pkg {
+			return a.pkg < b.pkg
+		}
+		if a.version != b.version {
+			return a.version < b.version
+		}
+		return a.world < b.world
+	})
+
+	var pkgs []pkg.Package
+	for _, k := range keys {
+		ifaces := interfaces[k].List()
+		sort.Strings(ifaces)
+		pkgs = append(pkgs, newPackage(k.namespace, k.pkg, k.version, pkg.WasmImportEntry{
+			Interfaces: ifaces,
+			Module:     bin.module,
+			World:      k.world,
+		}, location))
+	}
+	return pkgs
+}
+
+func readName(r *bytes.Reader) (string, error) {
+	length, err := readULEB128(r)
+	if err != nil {
+		return "", err
+	}
+	if uint64(length) > uint64(r.Len()) {
+		return "", fmt.Errorf("name length %d exceeds remaining data", length)
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+func readULEB128(r io.ByteReader) (uint32, error) {
+	var result uint32
+	for shift := uint(0); shift < 35; shift += 7 {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		result |= uint32(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return result, nil
+		}
+	}
+	return 0, fmt.Errorf("LEB128 value exceeds 32 bits")
+}
+
+func skipLEB128(r io.ByteReader) error {
+	for i := 0; i < 10; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return err
+		}
+		if b&0x80 == 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("malformed LEB128 value")
+}
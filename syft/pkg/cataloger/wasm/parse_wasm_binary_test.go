@@ -0,0 +1,123 @@
+package wasm
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseWasm_Component(t *testing.T) {
+	fixture := "test-fixtures/component.wasm"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	expected := []pkg.Package{
+		{
+			Name:      "wasi:cli",
+			Version:   "0.2.0",
+			Type:      pkg.WasmPkg,
+			Locations: locations,
+			PURL:      "pkg:wasm/wasi/cli@0.2.0",
+			Metadata: pkg.WasmImportEntry{
+				Interfaces: []string{"environment", "stdin"},
+				Module:     "my-component",
+			},
+		},
+		{
+			Name:      "wasi:io",
+			Version:   "0.2.0",
+			Type:      pkg.WasmPkg,
+			Locations: locations,
+			PURL:      "pkg:wasm/wasi/io@0.2.0",
+			Metadata: pkg.WasmImportEntry{
+				Interfaces: []string{"streams"},
+				Module:     "my-component",
+			},
+		},
+	}
+
+	var expectedRelationships []artifact.Relationship
+
+	pkgtest.TestFileParser(t, fixture, parseWasmBinary, expected, expectedRelationships)
+}
+
+func TestParseWasm_ModuleComponentType(t *testing.T) {
+	fixture := "test-fixtures/module.wasm"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	expected := []pkg.Package{
+		{
+			Name:      "wasi:http",
+			Version:   "0.2.0",
+			Type:      pkg.WasmPkg,
+			Locations: locations,
+			PURL:      "pkg:wasm/wasi/http@0.2.0",
+			Metadata: pkg.WasmImportEntry{
+				Interfaces: []string{"outgoing-handler", "types"},
+				Module:     "my-module",
+				World:      "proxy",
+			},
+		},
+	}
+
+	var expectedRelationships []artifact.Relationship
+
+	pkgtest.TestFileParser(t, fixture, parseWasmBinary, expected, expectedRelationships)
+}
+
+func Test_parseWITName(t *testing.T) {
+	tests := []struct {
+		name string
+		want *witName
+	}{
+		{
+			name: "wasi:cli/environment@0.2.0",
+			want: &witName{namespace: "wasi", pkg: "cli", iface: "environment", version: "0.2.0"},
+		},
+		{
+			name: "wasi:http/types@0.2.0-rc-2023-11-10",
+			want: &witName{namespace: "wasi", pkg: "http", iface: "types", version: "0.2.0-rc-2023-11-10"},
+		},
+		{
+			name: "my:pkg/iface",
+			want: &witName{namespace: "my", pkg: "pkg", iface: "iface"},
+		},
+		{
+			name: "log",
+		},
+		{
+			name: "locked-dep=<a:b@1.2.3>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseWITName(tt.name))
+		})
+	}
+}
+
+func Test_readULEB128(t *testing.T) {
+	tests := []struct {
+		input   []byte
+		want    uint32
+		wantErr require.ErrorAssertionFunc
+	}{
+		{input: []byte{0x00}, want: 0},
+		{input: []byte{0x7f}, want: 127},
+		{input: []byte{0xe5, 0x8e, 0x26}, want: 624485},
+		{input: []byte{0x80}, wantErr: require.Error},
+		{input: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0x0f}, wantErr: require.Error},
+	}
+	for _, tt := range tests {
+		if tt.wantErr == nil {
+			tt.wantErr = require.NoError
+		}
+		got, err := readULEB128(bytes.NewReader(tt.input))
+		tt.wantErr(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+}
@@ -0,0 +1,15 @@
+/*
+Package windows provides a concrete Cataloger implementation for packages shipped as Windows Installer databases (.msi files).
+*/
+package windows
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewMSICataloger returns a new cataloger object initialized for Windows Installer databases.
+func NewMSICataloger() pkg.Cataloger {
+	return generic.NewCataloger("windows-msi-cataloger").
+		WithParserByGlobs(parseMSI, "**/*.msi")
+}
@@ -0,0 +1,326 @@
+package windows
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf16"
+)
+
+// the structures of the compound file binary format (aka OLE structured storage), which is the container format of
+// Windows Installer databases (see [MS-CFB]).
+const (
+	cfbHeaderSize       = 512
+	cfbDirEntrySize     = 128
+	cfbHeaderDIFATCount = 109
+
+	// sector numbers at or above cfbMaxRegularSector are special values (e.g. the end of a chain)
+	cfbMaxRegularSector = 0xFFFFFFFA
+	cfbEndOfChain       = 0xFFFFFFFE
+	cfbNoStream         = 0xFFFFFFFF
+
+	cfbTypeStream = 2
+	cfbTypeRoot   = 5
+)
+
+var cfbSignature = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+
+var errNotCompoundFile = errors.New("not a compound file")
+
+type cfbHeader struct {
+	Signature            [8]byte
+	CLSID                [16]byte
+	MinorVersion         uint16
+	MajorVersion         uint16
+	ByteOrder            uint16
+	SectorShift          uint16
+	MiniSectorShift      uint16
+	Reserved             [6]byte
+	NumDirSectors        uint32
+	NumFATSectors        uint32
+	FirstDirSector       uint32
+	TransactionSignature uint32
+	MiniStreamCutoff     uint32
+	FirstMiniFATSector   uint32
+	NumMiniFATSectors    uint32
+	FirstDIFATSector     uint32
+	NumDIFATSectors      uint32
+	DIFAT                [cfbHeaderDIFATCount]uint32
+}
+
+type cfbDirEntry struct {
+	Name         [32]uint16
+	NameLength   uint16
+	Type         uint8
+	Color        uint8
+	LeftSibling  uint32
+	RightSibling uint32
+	Child        uint32
+	CLSID        [16]byte
+	StateBits    uint32
+	CreationTime uint64
+	ModifiedTime uint64
+	StartSector  uint32
+	Size         uint64
+}
+
+func (e cfbDirEntry) name() string {
+	n := int(e.NameLength/2) - 1 // the length includes the terminating NUL
+	if n < 0 || n > len(e.Name) {
+		return ""
+	}
+	return string(utf16.Decode(e.Name[:n]))
+}
+
+// compoundFile provides access to the streams of a compound file. Malformed files (e.g. sector chains that are
+// cyclic or point beyond the end of the file) are reported as errors rather than being read as far as possible.
+type compoundFile struct {
+	reader           io.ReaderAt
+	size             int64
+	sectorSize       int64
+	miniSectorSize   int64
+	miniStreamCutoff uint64
+	majorVersion     uint16
+	fat              []uint32
+	miniFAT          []uint32
+	miniStream       []uint32
+	entries          []cfbDirEntry
+}
+
+func newCompoundFile(reader io.ReaderAt, size int64) (*compoundFile, error) {
+	if size < cfbHeaderSize {
+		return nil, errNotCompoundFile
+	}
+
+	var h cfbHeader
+	if err := binary.Read(io.NewSectionReader(reader, 0, cfbHeaderSize), binary.LittleEndian, &h); err != nil {
+		return nil, fmt.Errorf("unable to read compound file header: %w", err)
+	}
+	if !bytes.Equal(h.Signature[:], cfbSignature) {
+		return nil, errNotCompoundFile
+	}
+
+	switch {
+	case h.MajorVersion == 3 && h.SectorShift == 9, h.MajorVersion == 4 && h.SectorShift == 12:
+	default:
+		return nil, fmt.Errorf("unsupported compound file version %d (sector shift %d)", h.MajorVersion, h.SectorShift)
+	}
+	if h.MiniSectorShift != 6 {
+		return nil, fmt.Errorf("unsupported compound file mini sector shift %d", h.MiniSectorShift)
+	}
+
+	cf := &compoundFile{
+		reader:           reader,
+		size:             size,
+		sectorSize:       1 << h.SectorShift,
+		miniSectorSize:   1 << h.MiniSectorShift,
+		miniStreamCutoff: uint64(h.MiniStreamCutoff),
+		majorVersion:     h.MajorVersion,
+	}
+
+	if int64(h.NumFATSectors) > cf.sectorCount() {
+		return nil, fmt.Errorf("compound file declares more FAT sectors (%d) than it contains", h.NumFATSectors)
+	}
+
+	fatSectors, err := cf.fatSectors(h)
+	if err != nil {
+		return nil, err
+	}
+	cf.fat, err = cf.readTable(fatSectors)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read compound file FAT: %w", err)
+	}
+
+	dirSectors, err := cf.chain(h.FirstDirSector, cf.fat)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read compound file directory: %w", err)
+	}
+	dir, err := cf.readSectors(dirSectors)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read compound file directory: %w", err)
+	}
+	cf.entries = make([]cfbDirEntry, len(dir)/cfbDirEntrySize)
+	if err := binary.Read(bytes.NewReader(dir[:len(cf.entries)*cfbDirEntrySize]), binary.LittleEndian, cf.entries); err != nil {
+		return nil, fmt.Errorf("unable to read compound file directory: %w", err)
+	}
+	if len(cf.entries) == 0 || cf.entries[0].Type != cfbTypeRoot {
+		return nil, errors.New("compound file has no root storage")
+	}
+
+	if h.NumMiniFATSectors > 0 {
+		miniFATSectors, err := cf.chain(h.FirstMiniFATSector, cf.fat)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read compound file mini FAT: %w", err)
+		}
+		cf.miniFAT, err = cf.readTable(miniFATSectors)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read compound file mini FAT: %w", err)
+		}
+		// the mini stream is held by the root storage
+		cf.miniStream, err = cf.chain(cf.entries[0].StartSector, cf.fat)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read compound file mini stream: %w", err)
+		}
+	}
+
+	return cf, nil
+}
+
+// sectorCount is the number of (possibly partial) sectors following the header.
+func (cf *compoundFile) sectorCount() int64 {
+	return (cf.size - cfbHeaderSize + cf.sectorSize - 1) / cf.sectorSize
+}
+
+// fatSectors returns the sectors holding the FAT, as listed by the header and the chain of DIFAT sectors.
+func (cf *compoundFile) fatSectors(h cfbHeader) ([]uint32, error) {
+	n := int(h.NumFATSectors)
+	sectors := make([]uint32, 0, n)
+	for i := 0; i < n && i < cfbHeaderDIFATCount; i++ {
+		sectors = append(sectors, h.DIFAT[i])
+	}
+
+	perSector := int(cf.sectorSize/4) - 1 // the last entry of a DIFAT sector points to the next DIFAT sector
+	next := h.FirstDIFATSector
+	for i := uint32(0); len(sectors) < n; i++ {
+		if i >= h.NumDIFATSectors || next >= cfbMaxRegularSector {
+			return nil, errors.New("compound file DIFAT is truncated")
+		}
+		entries, err := cf.readTable([]uint32{next})
+		if err != nil {
+			return nil, fmt.Errorf("unable to read compound file DIFAT: %w", err)
+		}
+		for _, s := range entries[:perSector] {
+			if len(sectors) == n {
+				break
+			}
+			sectors = append(sectors, s)
+		}
+		next = entries[perSector]
+	}
+	return sectors, nil
+}
+
+// chain returns the sectors of the chain starting at the given sector within the given allocation table.
+func (cf *compoundFile) chain(start uint32, table []uint32) ([]uint32, error) {
+	var sectors []uint32
+	for s := start; s != cfbEndOfChain; s = table[s] {
+		if s >= cfbMaxRegularSector || int(s) >= len(table) {
+			return nil, fmt.Errorf("invalid sector %#x in chain", s)
+		}
+		if len(sectors) >= len(table) {
+			return nil, errors.New("cyclic sector chain")
+		}
+		sectors = append(sectors, s)
+	}
+	return sectors, nil
+}
+
+// readSectors returns the contents of the given sectors, where the last sector of the file may be partial.
+func (cf *compoundFile) readSectors(sectors []uint32) ([]byte, error) {
+	if int64(len(sectors)) > cf.sectorCount() {
+		return nil, errors.New("sector chain is longer than the file")
+	}
+	buf := make([]byte, int64(len(sectors))*cf.sectorSize)
+	for i, s := range sectors {
+		if int64(s) >= cf.sectorCount() {
+			return nil, fmt.Errorf("sector %d is beyond the end of the file", s)
+		}
+		chunk := buf[int64(i)*cf.sectorSize : int64(i+1)*cf.sectorSize]
+		if _, err := cf.reader.ReadAt(chunk, cfbHeaderSize+int64(s)*cf.sectorSize); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+// readTable returns the sector numbers held by the given sectors (e.g. those of the FAT).
+func (cf *compoundFile) readTable(sectors []uint32) ([]uint32, error) {
+	data, err := cf.readSectors(sectors)
+	if err != nil {
+		return nil, err
+	}
+	table := make([]uint32, len(data)/4)
+	for i := range table {
+		table[i] = binary.LittleEndian.Uint32(data[i*4:])
+	}
+	return table, nil
+}
+
+// rootStreams returns the streams held directly by the root storage, by name.
+func (cf *compoundFile) rootStreams() map[string]cfbDirEntry {
+	streams := make(map[string]cfbDirEntry)
+	visited := make(map[uint32]bool)
+
+	// the children of a storage are held within a (red-black) tree of siblings
+	var walk func(id uint32)
+	walk = func(id uint32) {
+		if id == cfbNoStream || int(id) >= len(cf.entries) || visited[id] {
+			return
+		}
+		visited[id] = true
+		e := cf.entries[id]
+		if e.Type == cfbTypeStream {
+			streams[e.name()] = e
+		}
+		walk(e.LeftSibling)
+		walk(e.RightSibling)
+	}
+	walk(cf.entries[0].Child)
+
+	return streams
+}
+
+// readStream returns the contents of the given stream, which may not be larger than the given size.
+func (cf *compoundFile) readStream(e cfbDirEntry, maxSize int64) ([]byte, error) {
+	size := e.Size
+	if cf.majorVersion == 3 {
+		// the most significant 32 bits may be garbage within version 3 files
+		size &= 0xFFFFFFFF
+	}
+	if size > uint64(maxSize) {
+		return nil, fmt.Errorf("stream %q is too large (%d bytes)", e.name(), size)
+	}
+	if size == 0 {
+		return nil, nil
+	}
+
+	if size >= cf.miniStreamCutoff {
+		sectors, err := cf.chain(e.StartSector, cf.fat)
+		if err != nil {
+			return nil, err
+		}
+		data, err := cf.readSectors(sectors)
+		if err != nil {
+			return nil, err
+		}
+		if uint64(len(data)) < size {
+			return nil, fmt.Errorf("stream %q is truncated", e.name())
+		}
+		return data[:size], nil
+	}
+
+	miniSectors, err := cf.chain(e.StartSector, cf.miniFAT)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, 0, int64(len(miniSectors))*cf.miniSectorSize)
+	perSector := cf.sectorSize / cf.miniSectorSize
+	for _, ms := range miniSectors {
+		i := int64(ms) / perSector
+		if i >= int64(len(cf.miniStream)) {
+			return nil, fmt.Errorf("mini sector %d is beyond the end of the mini stream", ms)
+		}
+		sector, err := cf.readSectors([]uint32{cf.miniStream[i]})
+		if err != nil {
+			return nil, err
+		}
+		offset := (int64(ms) % perSector) * cf.miniSectorSize
+		data = append(data, sector[offset:offset+cf.miniSectorSize]...)
+	}
+	if uint64(len(data)) < size {
+		return nil, fmt.Errorf("stream %q is truncated", e.name())
+	}
+	return data[:size], nil
+}
@@ -0,0 +1,300 @@
+package windows
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// the structures of a Windows Installer database, which holds each table and the string pool shared by the tables in
+// a stream of a compound file.
+const (
+	// msiTableMarker prefixes the (encoded) names of the streams holding tables
+	msiTableMarker = 0x4840
+
+	// msiStringRefLong flags the string pool header when string references are 3 bytes (rather than 2 bytes)
+	msiStringRefLong = 0x80000000
+
+	// the type of a column, which is held within the _Columns table
+	msiColumnSizeMask   = 0x00FF
+	msiColumnTypeValid  = 0x0100
+	msiColumnTypeMask   = 0x0C00
+	msiColumnTypeShort  = 0x0400
+	msiColumnTypeBinary = 0x0800
+	msiColumnTypeString = 0x0C00
+
+	// msiMaxStreamSize bounds the size of the streams read from the database (the tables of interest are small,
+	// unlike the cabinets of the files being installed, which are never read)
+	msiMaxStreamSize = 64 << 20
+)
+
+// msiColumn is a column of a table within a Windows Installer database.
+type msiColumn struct {
+	name string
+	kind uint16
+}
+
+// size returns the number of bytes a value of the column is stored in.
+func (c msiColumn) size(stringRefSize int) int {
+	switch {
+	case c.kind&msiColumnTypeMask == msiColumnTypeString:
+		return stringRefSize
+	case c.kind&msiColumnTypeMask == msiColumnTypeBinary:
+		// binary data is held in a separate stream, referenced by a 2 byte value
+		return 2
+	case c.kind&msiColumnSizeMask <= 2:
+		return 2
+	default:
+		return 4
+	}
+}
+
+// msiRow is a row of a table within a Windows Installer database, where integers are given in decimal and nulls
+// as empty strings.
+type msiRow map[string]string
+
+// msiDatabase provides access to the tables of a Windows Installer database.
+type msiDatabase struct {
+	file          *compoundFile
+	streams       map[string]cfbDirEntry
+	strings       []string
+	stringRefSize int
+	columns       map[string][]msiColumn
+}
+
+// the schema of the table listing the columns of every table, which is not listed within itself
+var msiColumnsTableColumns = []msiColumn{
+	{name: "Table", kind: msiColumnTypeValid | msiColumnTypeString | 64},
+	{name: "Number", kind: msiColumnTypeValid | msiColumnTypeShort | 2},
+	{name: "Name", kind: msiColumnTypeValid | msiColumnTypeString | 64},
+	{name: "Type", kind: msiColumnTypeValid | msiColumnTypeShort | 2},
+}
+
+func newMSIDatabase(cf *compoundFile) (*msiDatabase, error) {
+	db := &msiDatabase{
+		file:    cf,
+		streams: make(map[string]cfbDirEntry),
+	}
+	for name, e := range cf.rootStreams() {
+		db.streams[decodeMSIStreamName(name)] = e
+	}
+
+	if err := db.readStringPool(); err != nil {
+		return nil, err
+	}
+	if err := db.readColumns(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// decodeMSIStreamName decodes the name of a stream within a Windows Installer database, where pairs of characters
+// from [0-9A-Za-z._] are packed into a single character to fit the 31 character limit of compound file names. The
+// names of streams holding tables are returned prefixed with "!".
+func decodeMSIStreamName(name string) string {
+	var sb strings.Builder
+	for _, c := range name {
+		switch {
+		case c == msiTableMarker:
+			sb.WriteByte('!')
+		case c >= 0x3800 && c < 0x4800:
+			c -= 0x3800
+			sb.WriteByte(msiStreamNameChar(c & 0x3F))
+			sb.WriteByte(msiStreamNameChar((c >> 6) & 0x3F))
+		case c >= 0x4800 && c < 0x4840:
+			sb.WriteByte(msiStreamNameChar(c - 0x4800))
+		default:
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
+
+func msiStreamNameChar(c rune) byte {
+	switch {
+	case c < 10:
+		return byte('0' + c)
+	case c < 36:
+		return byte('A' + c - 10)
+	case c < 62:
+		return byte('a' + c - 36)
+	case c == 62:
+		return '.'
+	default:
+		return '_'
+	}
+}
+
+func (db *msiDatabase) stream(name string) ([]byte, error) {
+	e, ok := db.streams[name]
+	if !ok {
+		return nil, nil
+	}
+	return db.file.readStream(e, msiMaxStreamSize)
+}
+
+// readStringPool reads the strings referenced by the tables. The pool holds the length of each string (in order of
+// their IDs, starting at 1), while the string data holds the strings themselves, one after another.
+func (db *msiDatabase) readStringPool() error {
+	pool, err := db.stream("!_StringPool")
+	if err != nil {
+		return fmt.Errorf("unable to read MSI string pool: %w", err)
+	}
+	data, err := db.stream("!_StringData")
+	if err != nil {
+		return fmt.Errorf("unable to read MSI string data: %w", err)
+	}
+	if len(pool) < 4 {
+		return fmt.Errorf("MSI string pool is missing")
+	}
+
+	db.stringRefSize = 2
+	if binary.LittleEndian.Uint32(pool)&msiStringRefLong != 0 {
+		db.stringRefSize = 3
+	}
+
+	// the string with ID 0 is the null string
+	db.strings = []string{""}
+	var offset int
+	for i := 4; i+4 <= len(pool); i += 4 {
+		length := int(binary.LittleEndian.Uint16(pool[i:]))
+		refs := binary.LittleEndian.Uint16(pool[i+2:])
+		if length == 0 && refs != 0 {
+			// the length of a string longer than 64k is held by the next entry, which has no ID of its own
+			if i+8 > len(pool) {
+				return fmt.Errorf("MSI string pool is truncated")
+			}
+			length = int(binary.LittleEndian.Uint16(pool[i+6:]))<<16 | int(binary.LittleEndian.Uint16(pool[i+4:]))
+			i += 4
+		}
+		if offset+length > len(data) {
+			return fmt.Errorf("MSI string %d overflows the string data", len(db.strings))
+		}
+		db.strings = append(db.strings, decodeMSIString(data[offset:offset+length]))
+		offset += length
+	}
+	return nil
+}
+
+// decodeMSIString decodes a string of the database, which is given in the codepage of the database (most often UTF-8
+// or Windows-1252, which is treated as Latin-1).
+func decodeMSIString(b []byte) string {
+	if utf8.Valid(b) {
+		return string(b)
+	}
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		runes[i] = rune(c)
+	}
+	return string(runes)
+}
+
+// readColumns reads the schema of every table from the _Columns table.
+func (db *msiDatabase) readColumns() error {
+	rows, err := db.readTable("_Columns", msiColumnsTableColumns)
+	if err != nil {
+		return err
+	}
+
+	type numberedColumn struct {
+		number int
+		msiColumn
+	}
+	tables := make(map[string][]numberedColumn)
+	for _, row := range rows {
+		number, err := strconv.Atoi(row["Number"])
+		if err != nil {
+			return fmt.Errorf("invalid MSI column number %q", row["Number"])
+		}
+		kind, err := strconv.ParseInt(row["Type"], 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid MSI column type %q", row["Type"])
+		}
+		tables[row["Table"]] = append(tables[row["Table"]], numberedColumn{
+			number:    number,
+			msiColumn: msiColumn{name: row["Name"], kind: uint16(kind)},
+		})
+	}
+
+	db.columns = make(map[string][]msiColumn)
+	for table, columns := range tables {
+		sort.Slice(columns, func(i, j int) bool {
+			return columns[i].number < columns[j].number
+		})
+		for _, c := range columns {
+			db.columns[table] = append(db.columns[table], c.msiColumn)
+		}
+	}
+	return nil
+}
+
+// table returns the rows of the given table, which are empty if the database does not hold the table.
+func (db *msiDatabase) table(name string) ([]msiRow, error) {
+	columns, ok := db.columns[name]
+	if !ok {
+		return nil, nil
+	}
+	return db.readTable(name, columns)
+}
+
+// readTable reads the rows of a table with the given columns. The values of a table are stored by column, so all
+// values of the first column are followed by all values of the second column, and so on.
+func (db *msiDatabase) readTable(name string, columns []msiColumn) ([]msiRow, error) {
+	data, err := db.stream("!" + name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read MSI table %q: %w", name, err)
+	}
+
+	var rowSize int
+	for _, c := range columns {
+		rowSize += c.size(db.stringRefSize)
+	}
+	if rowSize == 0 || len(data)%rowSize != 0 {
+		return nil, fmt.Errorf("MSI table %q has an invalid size of %d bytes", name, len(data))
+	}
+
+	rows := make([]msiRow, len(data)/rowSize)
+	for i := range rows {
+		rows[i] = make(msiRow, len(columns))
+	}
+
+	var offset int
+	for _, c := range columns {
+		size := c.size(db.stringRefSize)
+		for i := range rows {
+			value := data[offset+i*size : offset+(i+1)*size]
+			rows[i][c.name] = db.value(c, value)
+		}
+		offset += len(rows) * size
+	}
+	return rows, nil
+}
+
+func (db *msiDatabase) value(c msiColumn, b []byte) string {
+	var v uint32
+	for i := len(b) - 1; i >= 0; i-- {
+		v = v<<8 | uint32(b[i])
+	}
+	if v == 0 {
+		return ""
+	}
+
+	switch {
+	case c.kind&msiColumnTypeMask == msiColumnTypeBinary:
+		// the contents of binary data are not read
+		return ""
+	case c.kind&msiColumnTypeMask == msiColumnTypeString:
+		if int(v) >= len(db.strings) {
+			return ""
+		}
+		return db.strings[v]
+	case len(b) == 2:
+		// integers are stored offset by half the range of the type, keeping 0 for null
+		return strconv.Itoa(int(int16(uint16(v) ^ 0x8000)))
+	default:
+		return strconv.Itoa(int(int32(v ^ 0x80000000)))
+	}
+}
@@ -0,0 +1,33 @@
+package windows
+
+import (
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newPackage(m pkg.WindowsMSIProduct, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      m.ProductName,
+		Version:   m.ProductVersion,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(m),
+		Type:      pkg.WindowsMSIPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func packageURL(m pkg.WindowsMSIProduct) string {
+	return packageurl.NewPackageURL(
+		pkg.WindowsMSIPkg.PackageURLType(),
+		"",
+		m.ProductName,
+		m.ProductVersion,
+		nil,
+		"",
+	).ToString()
+}
@@ -0,0 +1,128 @@
+package windows
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseMSI
+
+// parseMSI is a parser function for Windows Installer databases, returning the product being installed (from the
+// Property table) along with the files it installs (from the File and Component tables).
+func parseMSI(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	r, err := unionreader.GetUnionReader(reader.ReadCloser)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read MSI file: %w", err)
+	}
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read MSI file: %w", err)
+	}
+
+	cf, err := newCompoundFile(r, size)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse MSI file: %w", err)
+	}
+	db, err := newMSIDatabase(cf)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse MSI file: %w", err)
+	}
+
+	product, err := newMSIProduct(db)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse MSI file: %w", err)
+	}
+	if product.ProductName == "" {
+		log.WithFields("path", reader.RealPath).Trace("no product name found in MSI file")
+		return nil, nil, nil
+	}
+
+	files, err := msiFiles(db)
+	if err != nil {
+		// the product is still reported without the files it installs
+		log.WithFields("path", reader.RealPath, "error", err).Debug("unable to read files of MSI file")
+	}
+	product.Files = files
+
+	return []pkg.Package{
+		newPackage(product, reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+	}, nil, nil
+}
+
+func newMSIProduct(db *msiDatabase) (pkg.WindowsMSIProduct, error) {
+	rows, err := db.table("Property")
+	if err != nil {
+		return pkg.WindowsMSIProduct{}, err
+	}
+
+	properties := make(map[string]string, len(rows))
+	for _, row := range rows {
+		properties[row["Property"]] = row["Value"]
+	}
+
+	return pkg.WindowsMSIProduct{
+		ProductName:     strings.TrimSpace(properties["ProductName"]),
+		ProductVersion:  strings.TrimSpace(properties["ProductVersion"]),
+		Manufacturer:    properties["Manufacturer"],
+		ProductCode:     properties["ProductCode"],
+		UpgradeCode:     properties["UpgradeCode"],
+		ProductLanguage: properties["ProductLanguage"],
+	}, nil
+}
+
+// msiFiles returns the files listed by the File table (in order of installation), along with the components they are
+// installed with.
+func msiFiles(db *msiDatabase) ([]pkg.WindowsMSIFile, error) {
+	components, err := db.table("Component")
+	if err != nil {
+		return nil, err
+	}
+	componentIDs := make(map[string]string, len(components))
+	for _, row := range components {
+		componentIDs[row["Component"]] = row["ComponentId"]
+	}
+
+	rows, err := db.table("File")
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return atoi(rows[i]["Sequence"]) < atoi(rows[j]["Sequence"])
+	})
+
+	var files []pkg.WindowsMSIFile
+	for _, row := range rows {
+		files = append(files, pkg.WindowsMSIFile{
+			Name:        msiLongFileName(row["FileName"]),
+			Version:     row["Version"],
+			Size:        int64(atoi(row["FileSize"])),
+			Component:   row["Component_"],
+			ComponentID: componentIDs[row["Component_"]],
+		})
+	}
+	return files, nil
+}
+
+// msiLongFileName returns the long name of a file, given as either "name" or "SHORT~1.EXT|long name".
+func msiLongFileName(name string) string {
+	if _, long, ok := strings.Cut(name, "|"); ok {
+		return long
+	}
+	return name
+}
+
+func atoi(s string) int {
+	i, _ := strconv.Atoi(s)
+	return i
+}
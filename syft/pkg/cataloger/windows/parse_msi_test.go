@@ -0,0 +1,154 @@
+package windows
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseMSI(t *testing.T) {
+	fixture := "test-fixtures/example.msi"
+	expected := []pkg.Package{
+		{
+			Name:      "Example Tool",
+			Version:   "3.2.1",
+			Type:      pkg.WindowsMSIPkg,
+			Locations: file.NewLocationSet(file.NewLocation(fixture)),
+			PURL:      "pkg:generic/Example%20Tool@3.2.1",
+			Metadata: pkg.WindowsMSIProduct{
+				ProductName:     "Example Tool",
+				ProductVersion:  "3.2.1",
+				Manufacturer:    "Example Corp",
+				ProductCode:     "{2C8B5F8E-4A61-4E8B-9E7A-2B1D6B0C3F11}",
+				UpgradeCode:     "{8E1F0C2A-7D3B-4F5E-A6C9-1B2D3E4F5A6B}",
+				ProductLanguage: "1033",
+				Files: []pkg.WindowsMSIFile{
+					{
+						Name:        "tool.exe",
+						Version:     "3.2.1.0",
+						Size:        204800,
+						Component:   "MainExecutable",
+						ComponentID: "{0D5C2B1A-3E4F-4A5B-8C7D-9E0F1A2B3C4D}",
+					},
+					{
+						Name:        "readme.txt",
+						Size:        1234,
+						Component:   "Docs",
+						ComponentID: "{5A6B7C8D-9E0F-4A1B-2C3D-4E5F6A7B8C9D}",
+					},
+					{
+						Name:        "libexample.dll",
+						Version:     "1.4.0.0",
+						Size:        65536,
+						Component:   "MainExecutable",
+						ComponentID: "{0D5C2B1A-3E4F-4A5B-8C7D-9E0F1A2B3C4D}",
+					},
+				},
+			},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseMSI, expected, nil)
+}
+
+func TestParseMSI_malformed(t *testing.T) {
+	original, err := os.ReadFile("test-fixtures/example.msi")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		modify func([]byte) []byte
+	}{
+		{
+			name: "not a compound file",
+			modify: func([]byte) []byte {
+				return []byte("MZ this is not an installer database")
+			},
+		},
+		{
+			name: "truncated",
+			modify: func(b []byte) []byte {
+				return b[:1024]
+			},
+		},
+		{
+			name: "unsupported sector size",
+			modify: func(b []byte) []byte {
+				binary.LittleEndian.PutUint16(b[0x1E:], 16)
+				return b
+			},
+		},
+		{
+			name: "too many FAT sectors",
+			modify: func(b []byte) []byte {
+				binary.LittleEndian.PutUint32(b[0x2C:], 0xFFFF)
+				return b
+			},
+		},
+		{
+			name: "cyclic directory chain",
+			modify: func(b []byte) []byte {
+				// the FAT is held by the first sector, point the first directory sector at itself
+				dir := binary.LittleEndian.Uint32(b[0x30:])
+				binary.LittleEndian.PutUint32(b[512+dir*4:], dir)
+				return b
+			},
+		},
+		{
+			name: "directory beyond end of file",
+			modify: func(b []byte) []byte {
+				binary.LittleEndian.PutUint32(b[0x30:], 100)
+				return b
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			contents := test.modify(append([]byte(nil), original...))
+			fixture := filepath.Join(t.TempDir(), "malformed.msi")
+			require.NoError(t, os.WriteFile(fixture, contents, 0o600))
+
+			pkgtest.NewCatalogTester().
+				FromFile(t, fixture).
+				WithError().
+				TestParser(t, parseMSI)
+		})
+	}
+}
+
+func Test_decodeMSIStreamName(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoded  string
+		expected string
+	}{
+		{
+			name:     "table",
+			encoded:  "䡀㽿䅤䈯䠶",
+			expected: "!_Tables",
+		},
+		{
+			name:     "plain name",
+			encoded:  "\x05SummaryInformation",
+			expected: "\x05SummaryInformation",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, decodeMSIStreamName(test.encoded))
+		})
+	}
+}
+
+func Test_msiLongFileName(t *testing.T) {
+	assert.Equal(t, "libexample.dll", msiLongFileName("LIBEXA~1.DLL|libexample.dll"))
+	assert.Equal(t, "tool.exe", msiLongFileName("tool.exe"))
+}
@@ -24,7 +24,7 @@ type ConanV2LockEntry struct {
 	TimeStamp       string `json:"timestamp,omitempty"`
 }
 
-// ConanfileEntry represents a single "Requires" entry from a conanfile.txt.
+// ConanfileEntry represents a single "Requires" entry from a conanfile.txt (or a requirement of a conanfile.py).
 type ConanfileEntry struct {
 	Ref string `mapstructure:"ref" json:"ref"`
 }
@@ -33,4 +33,9 @@ type ConanfileEntry struct {
 type ConaninfoEntry struct {
 	Ref       string `json:"ref"`
 	PackageID string `json:"package_id,omitempty"`
+
+	// Settings and Options are the settings (e.g. os, arch, compiler, and build_type) and options (e.g. shared or fPIC)
+	// the package was built with, which are only known for the package the conaninfo.txt describes.
+	Settings KeyValues `json:"settings,omitempty"`
+	Options  KeyValues `json:"options,omitempty"`
 }
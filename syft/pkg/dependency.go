@@ -0,0 +1,13 @@
+package pkg
+
+// DependencyKind indicates whether a package is depended on by the project directly or only brought in through
+// another dependency. This is only populated when the source format records the distinction (e.g. lockfiles).
+type DependencyKind string
+
+const (
+	// DirectDependency is a package explicitly required by the project
+	DirectDependency DependencyKind = "direct"
+
+	// TransitiveDependency is a package only required by another dependency of the project
+	TransitiveDependency DependencyKind = "transitive"
+)
@@ -7,6 +7,8 @@ type DotnetDepsEntry struct {
 	Path     string `mapstructure:"path" json:"path"`
 	Sha512   string `mapstructure:"sha512" json:"sha512"`
 	HashPath string `mapstructure:"hashPath" json:"hashPath"`
+
+	DependencyKind DependencyKind `mapstructure:"dependencyKind" json:"dependencyKind,omitempty"`
 }
 
 // DotnetPortableExecutableEntry is a struct that represents a single entry found within "VersionResources" section of a .NET Portable Executable binary file.
@@ -19,3 +21,24 @@ type DotnetPortableExecutableEntry struct {
 	ProductName     string `json:"productName"`
 	ProductVersion  string `json:"productVersion"`
 }
+
+// DotnetRuntimeConfigEntry is a struct that represents a single framework reference found in the "runtimeOptions" section of a .NET [*.]runtimeconfig.json file.
+type DotnetRuntimeConfigEntry struct {
+	Name            string `mapstructure:"name" json:"name"`
+	Version         string `mapstructure:"version" json:"version"`
+	TargetFramework string `mapstructure:"tfm" json:"targetFramework,omitempty"`
+	RollForward     string `mapstructure:"rollForward" json:"rollForward,omitempty"`
+
+	// SelfContained indicates that the framework is bundled with the application (listed under "includedFrameworks")
+	// instead of being expected to be installed on the host.
+	SelfContained bool `mapstructure:"selfContained" json:"selfContained,omitempty"`
+}
+
+// DotnetNativeAOTEntry is a struct that represents the .NET runtime compiled into a Native AOT binary, as identified by the version marker embedded within the runtime.
+type DotnetNativeAOTEntry struct {
+	// RuntimeFileVersion is the file version of the runtime (e.g. "8.0.1224.60305"), from which the product version is derived
+	RuntimeFileVersion string `mapstructure:"runtimeFileVersion" json:"runtimeFileVersion"`
+
+	// Commit is the commit of the dotnet/runtime repository the runtime was built from
+	Commit string `mapstructure:"commit" json:"commit,omitempty"`
+}
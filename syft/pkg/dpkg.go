@@ -36,6 +36,10 @@ type DpkgDBEntry struct {
 	// address inside angle brackets <> (in RFC822 format).
 	Maintainer string `json:"maintainer"`
 
+	// Status is the dpkg status of the package (the desired action, error flag, and state, e.g. "install ok
+	// half-configured"), which is only recorded when the package is not fully installed (and is not part of the package ID).
+	Status string `hash:"ignore" json:"status,omitempty" cyclonedx:"status"`
+
 	InstalledSize int `json:"installedSize" cyclonedx:"installedSize"`
 
 	// Description contains a description of the binary package, consisting of two parts, the synopsis or the short
@@ -5,3 +5,10 @@ const (
 	PrimaryEvidenceAnnotation    = "primary"
 	SupportingEvidenceAnnotation = "supporting"
 )
+
+const (
+	// AvailabilityAnnotationKey is the location annotation used to indicate the packages described by the file are
+	// not installed, but are only available to be installed (e.g. as listed by a package repository index).
+	AvailabilityAnnotationKey = "availability"
+	AvailableAnnotation       = "available"
+)
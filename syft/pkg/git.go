@@ -0,0 +1,14 @@
+package pkg
+
+// GitSubmoduleEntry represents a single submodule declared within a .gitmodules file, pinned at the commit recorded
+// by the superproject.
+type GitSubmoduleEntry struct {
+	// Path is the location of the submodule relative to the top-level directory of the superproject
+	Path string `mapstructure:"path" json:"path"`
+
+	// URL is the location the submodule repository is cloned from
+	URL string `mapstructure:"url" json:"url"`
+
+	// Branch is the remote branch tracked for updates to the submodule (optional)
+	Branch string `mapstructure:"branch" json:"branch,omitempty"`
+}
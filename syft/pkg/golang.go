@@ -8,6 +8,33 @@ type GolangBinaryBuildinfoEntry struct {
 	H1Digest          string    `json:"h1Digest,omitempty" cyclonedx:"h1Digest"`
 	MainModule        string    `json:"mainModule,omitempty" cyclonedx:"mainModule"`
 	GoCryptoSettings  []string  `json:"goCryptoSettings,omitempty" cyclonedx:"goCryptoSettings"`
+
+	// BuildToolchain is the compiler the binary was built with, as the toolchain name and version (e.g. "go 1.22.1"),
+	// which is consistent with the toolchain recorded for binaries of other ecosystems
+	BuildToolchain string `hash:"ignore" json:"buildToolchain,omitempty" cyclonedx:"buildToolchain"` // note: this is already implied by the go compiled version
+
+	// VCS is the version control information stamped into the binary for the main module (go 1.18+)
+	VCS *GolangBinaryVCS `json:"vcs,omitempty" cyclonedx:"vcs"`
+
+	// BuildID is the build ID the go linker embedded within the binary (as reported by "go tool buildid"), which is
+	// only set for the main module and can be used to correlate the binary with the build that produced it
+	BuildID string `hash:"ignore" json:"buildID,omitempty" cyclonedx:"buildID"`
+}
+
+// GolangBinaryVCS represents the version control information of the source a Golang binary was built from, as
+// captured from the vcs.* build settings.
+type GolangBinaryVCS struct {
+	// System is the version control system used (e.g. "git")
+	System string `json:"system,omitempty" cyclonedx:"system"`
+
+	// Revision is the revision (e.g. the commit hash) the binary was built from
+	Revision string `json:"revision,omitempty" cyclonedx:"revision"`
+
+	// Time is the modification time of the revision (in RFC3339 format)
+	Time string `json:"time,omitempty" cyclonedx:"time"`
+
+	// Modified indicates that the working tree had uncommitted changes when the binary was built
+	Modified bool `json:"modified" cyclonedx:"modified"`
 }
 
 // GolangModuleEntry represents all captured data for a Golang source scan with go.mod/go.sum
@@ -0,0 +1,18 @@
+package pkg
+
+// HomebrewFormula represents the metadata of a formula installed by Homebrew, as captured by the install receipt
+// (Cellar/<formula>/<version>/INSTALL_RECEIPT.json) and the copy of the formula kept alongside it (.brew/<formula>.rb).
+type HomebrewFormula struct {
+	// Tap is the repository of formulae the formula was installed from (e.g. homebrew/core)
+	Tap string `mapstructure:"Tap" json:"tap,omitempty"`
+
+	// Homepage is the homepage of the software, as declared by the formula
+	Homepage string `mapstructure:"Homepage" json:"homepage,omitempty"`
+
+	// Description is the description of the software, as declared by the formula
+	Description string `mapstructure:"Description" json:"description,omitempty"`
+
+	// InstalledOnRequest indicates the formula was explicitly installed by the user (rather than only as a dependency
+	// of another formula)
+	InstalledOnRequest bool `mapstructure:"InstalledOnRequest" json:"installedOnRequest"`
+}
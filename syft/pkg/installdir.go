@@ -0,0 +1,16 @@
+package pkg
+
+// InstallDirectoryEntry represents software installed within a directory of its own under a common prefix (e.g.
+// /opt/<vendor>/<product>/<version>) and not tracked by any package manager, as inferred from the directory structure
+// and any file within the directory declaring the version.
+type InstallDirectoryEntry struct {
+	// Vendor is the vendor of the software, as inferred from the directory the product directory is within (if any)
+	Vendor string `mapstructure:"Vendor" json:"vendor,omitempty"`
+
+	// Path is the directory the software is installed within
+	Path string `mapstructure:"Path" json:"path"`
+
+	// VersionFile is the path of the file the version was read from, which is empty when the version was inferred
+	// from the name of the directory
+	VersionFile string `mapstructure:"VersionFile" json:"versionFile,omitempty"`
+}
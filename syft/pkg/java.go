@@ -25,7 +25,31 @@ type JavaArchive struct {
 	PomProperties  *JavaPomProperties `mapstructure:"PomProperties" json:"pomProperties,omitempty" cyclonedx:"-"`
 	PomProject     *JavaPomProject    `mapstructure:"PomProject" json:"pomProject,omitempty"`
 	ArchiveDigests []file.Digest      `hash:"ignore" json:"digest,omitempty"`
-	Parent         *Package           `hash:"ignore" json:"-"` // note: the parent cannot be included in the minimal definition of uniqueness since this field is not reproducible in an encode-decode cycle (is lossy).
+	NativeImage    *JavaNativeImage   `hash:"ignore" mapstructure:"NativeImage" json:"nativeImage,omitempty"` // note: details of the containing executable do not change the identity of the package
+	Parent         *Package           `hash:"ignore" json:"-"`                                                // note: the parent cannot be included in the minimal definition of uniqueness since this field is not reproducible in an encode-decode cycle (is lossy).
+
+	// MultiReleaseVersions are the JDK feature versions a multi-release jar provides versioned classes for (from META-INF/versions/<n>/)
+	MultiReleaseVersions []int `hash:"ignore" mapstructure:"MultiReleaseVersions" json:"multiReleaseVersions,omitempty"` // note: this is already implied by the archive contents and manifest
+}
+
+// JavaNativeImage represents details about the GraalVM native image executable that a package was embedded within.
+type JavaNativeImage struct {
+	// LinkTimestamp is when the executable was linked (RFC 3339, UTC), as recorded in the PE export directory
+	LinkTimestamp string `mapstructure:"linkTimestamp" json:"linkTimestamp,omitempty"`
+
+	// LinkTimestampZeroed indicates the recorded link timestamp was zero, which is typical of reproducible builds
+	LinkTimestampZeroed bool `mapstructure:"linkTimestampZeroed" json:"linkTimestampZeroed,omitempty"`
+
+	// Digests are the digests of the executable contents, identifying the exact binary the package was found in
+	Digests []file.Digest `mapstructure:"digests" json:"digests,omitempty"`
+
+	// SvmVersionInfo is the description of the GraalVM release the executable was built with, as recorded in the
+	// __svm_version_info symbol (only set on the package representing the GraalVM runtime itself)
+	SvmVersionInfo string `mapstructure:"svmVersionInfo" json:"svmVersionInfo,omitempty"`
+
+	// BuildToolchain is the GraalVM release the executable was built with, as the toolchain name and version (e.g.
+	// "graalvm 22.3.0"), which is consistent with the toolchain recorded for binaries of other ecosystems
+	BuildToolchain string `mapstructure:"buildToolchain" json:"buildToolchain,omitempty"`
 }
 
 // JavaPomProperties represents the fields of interest extracted from a Java archive's pom.properties file.
@@ -21,6 +21,7 @@ const (
 	Haskell         Language = "haskell"
 	Java            Language = "java"
 	JavaScript      Language = "javascript"
+	Perl            Language = "perl"
 	PHP             Language = "php"
 	Python          Language = "python"
 	R               Language = "R"
@@ -40,6 +41,7 @@ var AllLanguages = []Language{
 	Haskell,
 	Java,
 	JavaScript,
+	Perl,
 	PHP,
 	Python,
 	R,
@@ -95,6 +97,8 @@ func LanguageByName(name string) Language {
 		return UnknownLanguage
 	case packageurl.TypeCran, "r":
 		return R
+	case "cpan", string(PerlCpanPkg), string(Perl):
+		return Perl
 	default:
 		return UnknownLanguage
 	}
@@ -78,6 +78,10 @@ func TestLanguageFromPURL(t *testing.T) {
 			purl: "pkg:swift/github.com/apple/swift-numerics/swift-numerics@1.0.2",
 			want: Swift,
 		},
+		{
+			purl: "pkg:cpan/MIYAGAWA/Plack@1.0047",
+			want: Perl,
+		},
 	}
 
 	var languages = strset.New()
@@ -247,6 +251,14 @@ func TestLanguageByName(t *testing.T) {
 			name:     "R",
 			language: R,
 		},
+		{
+			name:     "perl",
+			language: Perl,
+		},
+		{
+			name:     "cpan",
+			language: Perl,
+		},
 	}
 
 	for _, test := range tests {
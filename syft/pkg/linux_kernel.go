@@ -26,6 +26,28 @@ type LinuxKernelModule struct {
 	KernelVersion string                                `mapstructure:"kernelVersion" json:"kernelVersion,omitempty" cyclonedx:"kernelVersion"`
 	VersionMagic  string                                `mapstructure:"versionMagic" json:"versionMagic,omitempty" cyclonedx:"versionMagic"`
 	Parameters    map[string]LinuxKernelModuleParameter `mapstructure:"parameters" json:"parameters,omitempty" cyclonedx:"parameters"`
+
+	// Signed indicates the module has a signature appended ("~Module signature appended~"), which the kernel verifies
+	// when loading the module (e.g. when secure boot or module signature enforcement is enabled).
+	Signed bool `mapstructure:"signed" json:"signed" cyclonedx:"signed"`
+
+	// Signature describes the appended signature of the module (when signed and the signature could be read).
+	Signature *LinuxKernelModuleSignature `mapstructure:"signature" json:"signature,omitempty" cyclonedx:"signature"`
+}
+
+// LinuxKernelModuleSignature describes the signature appended to a kernel module, as shown by modinfo.
+type LinuxKernelModuleSignature struct {
+	// Type is the type of the signature (e.g. "PKCS#7").
+	Type string `mapstructure:"type" json:"type" cyclonedx:"type"`
+
+	// Signer is the name of the certificate issuer the module was signed with (e.g. "Build time autogenerated kernel key").
+	Signer string `mapstructure:"signer" json:"signer,omitempty" cyclonedx:"signer"`
+
+	// KeyID identifies the signing key, which is the serial number of the certificate (or the subject key identifier) as colon-separated hex.
+	KeyID string `mapstructure:"keyID" json:"keyID,omitempty" cyclonedx:"keyID"`
+
+	// HashAlgorithm is the digest algorithm of the signature (e.g. "sha512").
+	HashAlgorithm string `mapstructure:"hashAlgorithm" json:"hashAlgorithm,omitempty" cyclonedx:"hashAlgorithm"`
 }
 
 type LinuxKernelModuleParameter struct {
@@ -0,0 +1,54 @@
+package pkg
+
+// MacOSReceiptGlob is the glob for the property list receipts written by the macOS installer for each installed .pkg.
+const MacOSReceiptGlob = "**/var/db/receipts/*.plist"
+
+// MacOSInstallerReceipt represents all captured data from a macOS installer receipt (/var/db/receipts/<identifier>.plist).
+type MacOSInstallerReceipt struct {
+	// PackageIdentifier is the reverse-DNS style identifier of the installed package (e.g. com.apple.pkg.CLTools_Executables)
+	PackageIdentifier string `mapstructure:"PackageIdentifier" json:"packageIdentifier"`
+
+	// PackageVersion is the version of the installed package
+	PackageVersion string `mapstructure:"PackageVersion" json:"packageVersion"`
+
+	// PackageFileName is the name of the .pkg file the package was installed from
+	PackageFileName string `mapstructure:"PackageFileName" json:"packageFileName,omitempty"`
+
+	// InstallDate is when the package was installed (RFC 3339, UTC)
+	InstallDate string `mapstructure:"InstallDate" json:"installDate,omitempty"`
+
+	// InstallPrefixPath is the path, relative to the target volume, that the package was installed into
+	InstallPrefixPath string `mapstructure:"InstallPrefixPath" json:"installPrefixPath,omitempty"`
+
+	// InstallProcessName is the name of the process that performed the installation (e.g. installer, softwareupdated)
+	InstallProcessName string `mapstructure:"InstallProcessName" json:"installProcessName,omitempty"`
+}
+
+// MacOSAppBundle represents all captured data from the Info.plist of a macOS application bundle
+// (<name>.app/Contents/Info.plist), or of a framework bundle embedded within an application
+// (<name>.app/Contents/Frameworks/<name>.framework/Resources/Info.plist).
+type MacOSAppBundle struct {
+	// BundleIdentifier is the reverse-DNS style identifier of the bundle (CFBundleIdentifier, e.g. com.example.App)
+	BundleIdentifier string `mapstructure:"CFBundleIdentifier" json:"bundleIdentifier"`
+
+	// BundleName is the short name of the bundle (CFBundleName)
+	BundleName string `mapstructure:"CFBundleName" json:"bundleName,omitempty"`
+
+	// DisplayName is the user-visible name of the bundle (CFBundleDisplayName)
+	DisplayName string `mapstructure:"CFBundleDisplayName" json:"displayName,omitempty"`
+
+	// ShortVersion is the release version of the bundle (CFBundleShortVersionString, e.g. 1.2.3)
+	ShortVersion string `mapstructure:"CFBundleShortVersionString" json:"shortVersion,omitempty"`
+
+	// BundleVersion is the build version of the bundle (CFBundleVersion)
+	BundleVersion string `mapstructure:"CFBundleVersion" json:"bundleVersion,omitempty"`
+
+	// PackageType is the kind of bundle (CFBundlePackageType, e.g. APPL for applications and FMWK for frameworks)
+	PackageType string `mapstructure:"CFBundlePackageType" json:"packageType,omitempty"`
+
+	// Executable is the name of the main executable of the bundle (CFBundleExecutable)
+	Executable string `mapstructure:"CFBundleExecutable" json:"executable,omitempty"`
+
+	// MinimumSystemVersion is the minimum version of macOS the bundle runs on (LSMinimumSystemVersion)
+	MinimumSystemVersion string `mapstructure:"LSMinimumSystemVersion" json:"minimumSystemVersion,omitempty"`
+}
@@ -0,0 +1,27 @@
+package pkg
+
+import (
+	"regexp"
+	"strings"
+)
+
+// pythonNameSeparators matches runs of the characters considered equivalent within python package names (see PEP 503).
+var pythonNameSeparators = regexp.MustCompile(`[-_.]+`)
+
+// NormalizeName returns the canonical form of the given package name for the ecosystem of the given package type, such
+// that names considered equal by the ecosystem (but which may be spelled differently by different sources) are equal.
+// The rules applied per ecosystem are:
+//
+//   - python: lowercased, with runs of "-", "_", and "." replaced by a single "-" (see https://peps.python.org/pep-0503/#normalized-names)
+//   - php-composer: lowercased, since composer package names are case-insensitive (see https://getcomposer.org/doc/04-schema.md#name)
+//
+// Names for all other ecosystems are returned unchanged.
+func NormalizeName(t Type, name string) string {
+	switch t {
+	case PythonPkg:
+		return pythonNameSeparators.ReplaceAllString(strings.ToLower(name), "-")
+	case PhpComposerPkg:
+		return strings.ToLower(name)
+	}
+	return name
+}
@@ -0,0 +1,64 @@
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		pkgType  Type
+		input    string
+		expected string
+	}{
+		{
+			name:     "python name is lowercased",
+			pkgType:  PythonPkg,
+			input:    "Pygments",
+			expected: "pygments",
+		},
+		{
+			name:     "python separators are collapsed",
+			pkgType:  PythonPkg,
+			input:    "Friendly-Bard_.Extras",
+			expected: "friendly-bard-extras",
+		},
+		{
+			name:     "python underscores and dots are replaced",
+			pkgType:  PythonPkg,
+			input:    "zope.interface_extras",
+			expected: "zope-interface-extras",
+		},
+		{
+			name:     "normalized python name is unchanged",
+			pkgType:  PythonPkg,
+			input:    "requests",
+			expected: "requests",
+		},
+		{
+			name:     "composer name is lowercased",
+			pkgType:  PhpComposerPkg,
+			input:    "Vendor/Package_Name",
+			expected: "vendor/package_name",
+		},
+		{
+			name:     "other ecosystems are unchanged",
+			pkgType:  GemPkg,
+			input:    "Some_Gem",
+			expected: "Some_Gem",
+		},
+		{
+			name:     "case is preserved for go modules",
+			pkgType:  GoModulePkg,
+			input:    "github.com/Azure/go-autorest",
+			expected: "github.com/Azure/go-autorest",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, NormalizeName(test.pkgType, test.input))
+		})
+	}
+}
@@ -23,3 +23,33 @@ func (m NixStoreEntry) OwnedFiles() (result []string) {
 	sort.Strings(result)
 	return
 }
+
+// NixFlakeLockEntry represents a single locked input within a flake.lock file.
+type NixFlakeLockEntry struct {
+	// Input is the name of the node within the lock file (e.g. "nixpkgs")
+	Input string `mapstructure:"input" json:"input"`
+
+	// Type is the kind of flake reference that was locked (e.g. "github", "git", "tarball", "path")
+	Type string `mapstructure:"type" json:"type"`
+
+	// Owner is the repository owner (for github, gitlab, and sourcehut references)
+	Owner string `mapstructure:"owner" json:"owner,omitempty"`
+
+	// Repo is the repository name (for github, gitlab, and sourcehut references)
+	Repo string `mapstructure:"repo" json:"repo,omitempty"`
+
+	// URL is the location of the input (for git, tarball, file, and path references)
+	URL string `mapstructure:"url" json:"url,omitempty"`
+
+	// Ref is the branch or tag originally requested for the input
+	Ref string `mapstructure:"ref" json:"ref,omitempty"`
+
+	// Rev is the commit the input is pinned to
+	Rev string `mapstructure:"rev" json:"rev,omitempty"`
+
+	// NarHash is the hash of the NAR serialization of the input contents
+	NarHash string `mapstructure:"narHash" json:"narHash,omitempty"`
+
+	// LastModified is the commit or modification time of the input (seconds since the epoch)
+	LastModified int64 `mapstructure:"lastModified" json:"lastModified,omitempty"`
+}
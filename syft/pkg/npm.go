@@ -1,5 +1,7 @@
 package pkg
 
+var _ ProjectURLProvider = (*NpmPackage)(nil)
+
 // NpmPackage represents the contents of a javascript package.json file.
 type NpmPackage struct {
 	Name        string `mapstructure:"name" json:"name"`
@@ -13,12 +15,41 @@ type NpmPackage struct {
 
 // NpmPackageLockEntry represents a single entry within the "packages" section of a package-lock.json file.
 type NpmPackageLockEntry struct {
-	Resolved  string `mapstructure:"resolved" json:"resolved"`
-	Integrity string `mapstructure:"integrity" json:"integrity"`
+	Resolved       string         `mapstructure:"resolved" json:"resolved"`
+	Integrity      string         `mapstructure:"integrity" json:"integrity"`
+	DependencyKind DependencyKind `mapstructure:"dependencyKind" json:"dependencyKind,omitempty"`
+
+	// DevDependency indicates the package is only required for development (i.e. it is only reachable from the
+	// devDependencies of the project).
+	DevDependency bool `mapstructure:"devDependency" json:"devDependency,omitempty"`
+
+	// Registry is the base URL of the registry the package was resolved from (derived from the resolved URL), which is
+	// empty when the package was not resolved from a registry (e.g. git or local dependencies).
+	Registry string `mapstructure:"registry" json:"registry,omitempty"`
 }
 
 // YarnLockEntry represents a single entry section of a yarn.lock file.
 type YarnLockEntry struct {
-	Resolved  string `mapstructure:"resolved" json:"resolved"`
-	Integrity string `mapstructure:"integrity" json:"integrity"`
+	Resolved       string         `mapstructure:"resolved" json:"resolved"`
+	Integrity      string         `mapstructure:"integrity" json:"integrity"`
+	DependencyKind DependencyKind `mapstructure:"dependencyKind" json:"dependencyKind,omitempty"`
+}
+
+// NpmSourceMapEntry represents an npm package bundled into a javascript bundle, as found from the original sources listed
+// within the source map of the bundle.
+type NpmSourceMapEntry struct {
+	// Bundle is the name of the generated bundle (the "file" of the source map), if given.
+	Bundle string `mapstructure:"bundle" json:"bundle,omitempty"`
+
+	// Sources are the files of the package (relative to the package directory) that were bundled.
+	Sources []string `mapstructure:"sources" json:"sources,omitempty"`
+}
+
+func (m NpmPackage) HomepageURL() string {
+	return m.Homepage
+}
+
+// SourceURL returns the repository URL of the package.
+func (m NpmPackage) SourceURL() string {
+	return m.URL
 }
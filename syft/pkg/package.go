@@ -27,6 +27,7 @@ type Package struct {
 	Type      Type             `cyclonedx:"type"`                   // the package type (e.g. Npm, Yarn, Python, Rpm, Deb, etc)
 	CPEs      []cpe.CPE        `hash:"ignore"`                      // all possible Common Platform Enumerators (note: this is NOT included in the definition of the ID since all fields on a CPE are derived from other fields)
 	PURL      string           `hash:"ignore"`                      // the Package URL (see https://github.com/package-url/purl-spec)
+	Platform  string           `hash:"ignore" cyclonedx:"platform"` // the platform of a multi-platform image the package was found in (e.g. "linux/arm64/v8")
 	Metadata  interface{}      // additional data found while parsing the package source
 }
 
@@ -0,0 +1,25 @@
+package pkg
+
+// PerlCpanfileEntry represents a single module requirement declared in a cpanfile.
+type PerlCpanfileEntry struct {
+	// Phase is the phase the requirement applies to (e.g. "runtime", "test", "build", "configure", "develop")
+	Phase string `mapstructure:"phase" json:"phase"`
+
+	// Relationship is the strength of the requirement (e.g. "requires", "recommends", "suggests", "conflicts")
+	Relationship string `mapstructure:"relationship" json:"relationship"`
+
+	// VersionConstraint is the version requirement for the module (e.g. ">= 1.0, < 2.0")
+	VersionConstraint string `mapstructure:"versionConstraint" json:"versionConstraint,omitempty"`
+}
+
+// PerlCpanfileSnapshotEntry represents a single distribution pinned in a cpanfile.snapshot file (written by Carton).
+type PerlCpanfileSnapshotEntry struct {
+	// Pathname is the CPAN path of the distribution archive (e.g. "M/MI/MIYAGAWA/Plack-1.0047.tar.gz")
+	Pathname string `mapstructure:"pathname" json:"pathname,omitempty"`
+
+	// Provides are the modules provided by the distribution
+	Provides []string `mapstructure:"provides" json:"provides,omitempty"`
+
+	// Requirements are the modules required by the distribution
+	Requirements []string `mapstructure:"requirements" json:"requirements,omitempty"`
+}
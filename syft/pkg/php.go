@@ -1,5 +1,8 @@
 package pkg
 
+var _ ProjectURLProvider = (*PhpComposerLockEntry)(nil)
+var _ ProjectURLProvider = (*PhpComposerInstalledEntry)(nil)
+
 // PhpComposerInstalledEntry represents a single package entry from a composer v1/v2 "installed.json" files (very similar to composer.lock files).
 type PhpComposerInstalledEntry PhpComposerLockEntry
 
@@ -22,6 +25,26 @@ type PhpComposerLockEntry struct {
 	Homepage        string                       `json:"homepage,omitempty"`
 	Keywords        []string                     `json:"keywords,omitempty"`
 	Time            string                       `json:"time,omitempty"`
+
+	// DevDependency indicates the package is only required for development (listed within the "packages-dev" section
+	// of the composer.lock file).
+	DevDependency bool `json:"devDependency,omitempty"`
+}
+
+// PhpComposerPlatformEntry represents a platform package (PHP itself, a PHP extension, or a system library) that a
+// composer.lock file was resolved against, as given by the "platform-overrides" section (the "config.platform"
+// setting of the project).
+type PhpComposerPlatformEntry struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+
+	// Constraint is the requirement of the project on the platform package (from the "platform" or "platform-dev"
+	// section of the composer.lock file), if any.
+	Constraint string `json:"constraint,omitempty"`
+
+	// DevDependency indicates the platform package is only required for development (listed within the
+	// "platform-dev" section of the composer.lock file).
+	DevDependency bool `json:"devDependency,omitempty"`
 }
 
 type PhpComposerExternalReference struct {
@@ -42,3 +65,21 @@ type PhpPeclEntry struct {
 	Version string   `json:"version"`
 	License []string `json:"license,omitempty"`
 }
+
+func (m PhpComposerLockEntry) HomepageURL() string {
+	return m.Homepage
+}
+
+// SourceURL returns the URL of the source reference of the package, which is typically a VCS repository.
+func (m PhpComposerLockEntry) SourceURL() string {
+	return m.Source.URL
+}
+
+func (m PhpComposerInstalledEntry) HomepageURL() string {
+	return m.Homepage
+}
+
+// SourceURL returns the URL of the source reference of the package, which is typically a VCS repository.
+func (m PhpComposerInstalledEntry) SourceURL() string {
+	return m.Source.URL
+}
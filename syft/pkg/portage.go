@@ -12,8 +12,19 @@ var _ FileOwner = (*PortageEntry)(nil)
 
 // PortageEntry represents a single package entry in the portage DB flat-file store.
 type PortageEntry struct {
-	InstalledSize int                 `mapstructure:"InstalledSize" json:"installedSize" cyclonedx:"installedSize"`
-	Files         []PortageFileRecord `json:"files"`
+	InstalledSize int `mapstructure:"InstalledSize" json:"installedSize" cyclonedx:"installedSize"`
+
+	// UseFlags are the USE flags the package was built with, which change the features that are built in.
+	UseFlags []string `hash:"ignore" json:"useFlags,omitempty" cyclonedx:"useFlags"`
+
+	// Slot is the slot (and any sub-slot, e.g. "3/3.0") the package is installed in, where packages of the same name
+	// in different slots may be installed side by side.
+	Slot string `hash:"ignore" json:"slot,omitempty" cyclonedx:"slot"`
+
+	// Repository is the name of the ebuild repository the package was installed from (e.g. "gentoo").
+	Repository string `hash:"ignore" json:"repository,omitempty" cyclonedx:"repository"`
+
+	Files []PortageFileRecord `json:"files"`
 }
 
 // PortageFileRecord represents a single file attributed to a portage package.
@@ -0,0 +1,11 @@
+package pkg
+
+// ProjectURLProvider is the interface that wraps the HomepageURL and SourceURL methods.
+//
+// HomepageURL returns the homepage of the project and SourceURL returns where the
+// source of the project is hosted (e.g. the VCS repository), as indicated by a piece
+// of package Metadata. Either is empty when not provided by the package manifest.
+type ProjectURLProvider interface {
+	HomepageURL() string
+	SourceURL() string
+}
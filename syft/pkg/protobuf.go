@@ -0,0 +1,33 @@
+package pkg
+
+// ProtobufFile represents the declarations of a protocol buffers (.proto) file within the proto package it belongs to.
+type ProtobufFile struct {
+	// Syntax is the syntax version of the file ("proto2" or "proto3"), where files without a syntax declaration are proto2
+	Syntax string `mapstructure:"syntax" json:"syntax"`
+
+	// Imports are the other .proto files imported by the file
+	Imports []ProtobufImport `mapstructure:"imports" json:"imports,omitempty"`
+
+	// Services are the (gRPC) services defined by the file
+	Services []ProtobufService `mapstructure:"services" json:"services,omitempty"`
+}
+
+// ProtobufImport represents a single import statement of a .proto file.
+type ProtobufImport struct {
+	// Path is the imported file, relative to the import paths given to protoc (e.g. "google/protobuf/timestamp.proto")
+	Path string `mapstructure:"path" json:"path"`
+
+	// Public indicates the definitions of the imported file are re-exported to the importers of this file
+	Public bool `mapstructure:"public" json:"public,omitempty"`
+
+	// Weak indicates the imported file is allowed to not exist
+	Weak bool `mapstructure:"weak" json:"weak,omitempty"`
+}
+
+// ProtobufService represents a service definition of a .proto file.
+type ProtobufService struct {
+	Name string `mapstructure:"name" json:"name"`
+
+	// Methods are the names of the rpc methods of the service
+	Methods []string `mapstructure:"methods" json:"methods,omitempty"`
+}
@@ -64,9 +64,14 @@ type PythonPipfileLockEntry struct {
 // PythonPoetryLockEntry represents a single package entry within a Pipfile.lock file.
 type PythonPoetryLockEntry struct {
 	Index string `mapstructure:"index" json:"index"`
+
+	// DevDependency indicates the package is only required for development (as given by the category of the package,
+	// which is only recorded by poetry.lock files written by poetry versions before 1.5).
+	DevDependency bool `mapstructure:"devDependency" json:"devDependency,omitempty"`
 }
 
-// PythonRequirementsEntry represents a single entry within a [*-]requirements.txt file.
+// PythonRequirementsEntry represents a single entry within a [*-]requirements.txt file (or a dependency declared
+// within a pyproject.toml, setup.cfg, or Pipfile file).
 type PythonRequirementsEntry struct {
 	Name              string   `json:"name" mapstruct:"Name"`
 	Extras            []string `json:"extras,omitempty" mapstruct:"Extras"`
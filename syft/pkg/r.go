@@ -21,3 +21,17 @@ type RDescription struct {
 	Depends          []string `json:"depends,omitempty"`
 	Suggests         []string `json:"suggests,omitempty"`
 }
+
+// RRenvLockEntry represents a single package entry in the "Packages" section of an renv.lock file.
+type RRenvLockEntry struct {
+	Source         string   `json:"source,omitempty"`
+	Repository     string   `json:"repository,omitempty"`
+	Hash           string   `json:"hash,omitempty"`
+	Requirements   []string `json:"requirements,omitempty"`
+	RemoteType     string   `json:"remoteType,omitempty"`
+	RemoteHost     string   `json:"remoteHost,omitempty"`
+	RemoteUsername string   `json:"remoteUsername,omitempty"`
+	RemoteRepo     string   `json:"remoteRepo,omitempty"`
+	RemoteRef      string   `json:"remoteRef,omitempty"`
+	RemoteSha      string   `json:"remoteSha,omitempty"`
+}
@@ -1,5 +1,7 @@
 package pkg
 
+var _ ProjectURLProvider = (*RubyGemspec)(nil)
+
 // RubyGemspec represents all metadata parsed from the *.gemspec file
 type RubyGemspec struct {
 	Name    string `mapstructure:"name" json:"name"`
@@ -22,4 +24,14 @@ type RubyGemspec struct {
 	Files    []string `mapstructure:"files" json:"files,omitempty"`
 	Authors  []string `mapstructure:"authors" json:"authors,omitempty"`
 	Homepage string   `mapstructure:"homepage" json:"homepage,omitempty"`
+	// SourceCodeURI is the "source_code_uri" entry of the gem metadata, which is where the source of the gem is hosted.
+	SourceCodeURI string `mapstructure:"sourceCodeURI" json:"sourceCodeURI,omitempty"`
+}
+
+func (m RubyGemspec) HomepageURL() string {
+	return m.Homepage
+}
+
+func (m RubyGemspec) SourceURL() string {
+	return m.SourceCodeURI
 }
@@ -6,10 +6,18 @@ type RustCargoLockEntry struct {
 	Source       string   `toml:"source" json:"source"`
 	Checksum     string   `toml:"checksum" json:"checksum"`
 	Dependencies []string `toml:"dependencies" json:"dependencies"`
+
+	// Registry is the URL of the index of the registry the crate was resolved from (derived from the source), which is
+	// empty for git and path dependencies.
+	Registry string `toml:"-" json:"registry,omitempty"`
 }
 
 type RustBinaryAuditEntry struct {
 	Name    string `toml:"name" json:"name"`
 	Version string `toml:"version" json:"version"`
 	Source  string `toml:"source" json:"source"`
+
+	// BuildToolchain is the compiler the binary was built with, as the toolchain name and version (e.g. "rustc 1.79.0").
+	// This is only known for ELF binaries, where rustc identifies itself within the .comment section.
+	BuildToolchain string `hash:"ignore" toml:"-" json:"buildToolchain,omitempty"` // note: this describes the containing binary, not the crate
 }
@@ -11,7 +11,9 @@ const (
 	// the full set of supported packages
 	UnknownPkg              Type = "UnknownPackage"
 	AlpmPkg                 Type = "alpm"
+	AndroidAppPkg           Type = "android-app"
 	ApkPkg                  Type = "apk"
+	BazelModulePkg          Type = "bazel-module"
 	BinaryPkg               Type = "binary"
 	CocoapodsPkg            Type = "pod"
 	ConanPkg                Type = "conan"
@@ -20,34 +22,46 @@ const (
 	DotnetPkg               Type = "dotnet"
 	ErlangOTPPkg            Type = "erlang-otp"
 	GemPkg                  Type = "gem"
+	GitSubmodulePkg         Type = "git-submodule"
 	GithubActionPkg         Type = "github-action"
 	GithubActionWorkflowPkg Type = "github-action-workflow"
 	GoModulePkg             Type = "go-module"
 	GraalVMNativeImagePkg   Type = "graalvm-native-image"
 	HackagePkg              Type = "hackage"
 	HexPkg                  Type = "hex"
+	HomebrewPkg             Type = "homebrew"
 	JavaPkg                 Type = "java-archive"
 	JenkinsPluginPkg        Type = "jenkins-plugin"
 	KbPkg                   Type = "msrc-kb"
 	LinuxKernelPkg          Type = "linux-kernel"
 	LinuxKernelModulePkg    Type = "linux-kernel-module"
+	MacOSAppPkg             Type = "macos-app"
+	MacOSPkg                Type = "macos-pkg"
 	NixPkg                  Type = "nix"
 	NpmPkg                  Type = "npm"
+	PerlCpanPkg             Type = "perl-cpan"
 	PhpComposerPkg          Type = "php-composer"
 	PhpPeclPkg              Type = "php-pecl"
 	PortagePkg              Type = "portage"
+	ProtobufPkg             Type = "protobuf"
 	PythonPkg               Type = "python"
 	Rpkg                    Type = "R-package"
 	RpmPkg                  Type = "rpm"
 	RustPkg                 Type = "rust-crate"
 	SwiftPkg                Type = "swift"
+	VcpkgPkg                Type = "vcpkg"
+	VSCodeExtensionPkg      Type = "vscode-extension"
+	WasmPkg                 Type = "wasm"
+	WindowsMSIPkg           Type = "windows-msi"
 	WordpressPluginPkg      Type = "wordpress-plugin"
 )
 
 // AllPkgs represents all supported package types
 var AllPkgs = []Type{
 	AlpmPkg,
+	AndroidAppPkg,
 	ApkPkg,
+	BazelModulePkg,
 	BinaryPkg,
 	CocoapodsPkg,
 	ConanPkg,
@@ -56,26 +70,36 @@ var AllPkgs = []Type{
 	DotnetPkg,
 	ErlangOTPPkg,
 	GemPkg,
+	GitSubmodulePkg,
 	GithubActionPkg,
 	GithubActionWorkflowPkg,
 	GoModulePkg,
 	HackagePkg,
 	HexPkg,
+	HomebrewPkg,
 	JavaPkg,
 	JenkinsPluginPkg,
 	KbPkg,
 	LinuxKernelPkg,
 	LinuxKernelModulePkg,
+	MacOSAppPkg,
+	MacOSPkg,
 	NixPkg,
 	NpmPkg,
+	PerlCpanPkg,
 	PhpComposerPkg,
 	PhpPeclPkg,
 	PortagePkg,
+	ProtobufPkg,
 	PythonPkg,
 	Rpkg,
 	RpmPkg,
 	RustPkg,
 	SwiftPkg,
+	VcpkgPkg,
+	VSCodeExtensionPkg,
+	WasmPkg,
+	WindowsMSIPkg,
 	WordpressPluginPkg,
 }
 
@@ -104,6 +128,11 @@ func (t Type) PackageURLType() string {
 		return packageurl.TypeGem
 	case HexPkg:
 		return packageurl.TypeHex
+	case HomebrewPkg:
+		// note: this is not a real purl type, but there is no registered type for homebrew formulae yet
+		return "brew"
+	case AndroidAppPkg, BazelModulePkg, GitSubmodulePkg, MacOSAppPkg, MacOSPkg, WindowsMSIPkg:
+		return packageurl.TypeGeneric
 	case GithubActionPkg, GithubActionWorkflowPkg:
 		// note: this is not a real purl type, but it is the closest thing we have for now
 		return packageurl.TypeGithub
@@ -117,6 +146,8 @@ func (t Type) PackageURLType() string {
 		return "generic/linux-kernel"
 	case LinuxKernelModulePkg:
 		return packageurl.TypeGeneric
+	case PerlCpanPkg:
+		return "cpan"
 	case PhpComposerPkg:
 		return packageurl.TypeComposer
 	case PhpPeclPkg:
@@ -137,6 +168,15 @@ func (t Type) PackageURLType() string {
 		return "cargo"
 	case SwiftPkg:
 		return packageurl.TypeSwift
+	case VcpkgPkg:
+		// note: this is not a real purl type, but there is no registered type for vcpkg ports yet
+		return "vcpkg"
+	case VSCodeExtensionPkg:
+		// note: this is not a real purl type, but there is no registered type for editor extensions yet
+		return "vscode-extension"
+	case WasmPkg:
+		// note: this is not a real purl type, but there is no registered type for WIT packages yet
+		return "wasm"
 	case WordpressPluginPkg:
 		return "wordpress-plugin"
 	default:
@@ -175,6 +215,8 @@ func TypeByName(name string) Type {
 		return PhpComposerPkg
 	case "pecl":
 		return PhpPeclPkg
+	case "cpan":
+		return PerlCpanPkg
 	case packageurl.TypeGolang:
 		return GoModulePkg
 	case packageurl.TypeNPM:
@@ -199,6 +241,8 @@ func TypeByName(name string) Type {
 		return PortagePkg
 	case packageurl.TypeHex:
 		return HexPkg
+	case "brew":
+		return HomebrewPkg
 	case packageurl.TypeOTP:
 		return ErlangOTPPkg
 	case "linux-kernel":
@@ -211,6 +255,12 @@ func TypeByName(name string) Type {
 		return Rpkg
 	case packageurl.TypeSwift:
 		return SwiftPkg
+	case "vcpkg":
+		return VcpkgPkg
+	case "vscode-extension":
+		return VSCodeExtensionPkg
+	case "wasm":
+		return WasmPkg
 	case "wordpress-plugin":
 		return WordpressPluginPkg
 	default:
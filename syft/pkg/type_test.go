@@ -107,6 +107,26 @@ func TestTypeFromPURL(t *testing.T) {
 			purl:     "pkg:swift/github.com/apple/swift-numerics/swift-numerics@1.0.2",
 			expected: SwiftPkg,
 		},
+		{
+			purl:     "pkg:cpan/MIYAGAWA/Plack@1.0047",
+			expected: PerlCpanPkg,
+		},
+		{
+			purl:     "pkg:vscode-extension/ms-python/python@2024.0.1",
+			expected: VSCodeExtensionPkg,
+		},
+		{
+			purl:     "pkg:wasm/wasi/cli@0.2.0",
+			expected: WasmPkg,
+		},
+		{
+			purl:     "pkg:brew/wget@1.21.4",
+			expected: HomebrewPkg,
+		},
+		{
+			purl:     "pkg:vcpkg/fmt@10.1.1",
+			expected: VcpkgPkg,
+		},
 	}
 
 	var pkgTypes []string
@@ -122,6 +142,13 @@ func TestTypeFromPURL(t *testing.T) {
 	expectedTypes.Remove(string(PortagePkg))
 	expectedTypes.Remove(string(BinaryPkg))
 	expectedTypes.Remove(string(LinuxKernelModulePkg))
+	expectedTypes.Remove(string(AndroidAppPkg))
+	expectedTypes.Remove(string(MacOSAppPkg))
+	expectedTypes.Remove(string(MacOSPkg))
+	expectedTypes.Remove(string(WindowsMSIPkg))
+	expectedTypes.Remove(string(GitSubmodulePkg))
+	expectedTypes.Remove(string(BazelModulePkg))
+	expectedTypes.Remove(string(ProtobufPkg))
 	expectedTypes.Remove(string(GithubActionPkg), string(GithubActionWorkflowPkg))
 	expectedTypes.Remove(string(WordpressPluginPkg))
 
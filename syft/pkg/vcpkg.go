@@ -0,0 +1,16 @@
+package pkg
+
+// VcpkgManifestEntry represents a single dependency declared within a vcpkg.json manifest.
+type VcpkgManifestEntry struct {
+	// VersionConstraint is the minimum version of the port required by the dependency (the "version>=" field).
+	VersionConstraint string `json:"versionConstraint,omitempty"`
+
+	// Features are the features of the port requested by the dependency.
+	Features []string `json:"features,omitempty"`
+
+	// Host indicates that the port is required for the host (e.g. a build tool) rather than the target.
+	Host bool `json:"host,omitempty"`
+
+	// Platform is the platform expression that the dependency is limited to (e.g. "windows & !uwp").
+	Platform string `json:"platform,omitempty"`
+}
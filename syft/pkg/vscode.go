@@ -0,0 +1,20 @@
+package pkg
+
+// VSCodeExtensionEntry represents all metadata parsed from the package.json manifest of an installed VS Code (or
+// compatible editor) extension.
+type VSCodeExtensionEntry struct {
+	// Publisher is the marketplace publisher of the extension, which together with the name identifies the extension (e.g. "ms-python" for "ms-python.python")
+	Publisher string `mapstructure:"publisher" json:"publisher"`
+
+	// DisplayName is the name of the extension shown within the editor and marketplace
+	DisplayName string `mapstructure:"displayName" json:"displayName,omitempty"`
+
+	// Description is a short description of the extension
+	Description string `mapstructure:"description" json:"description,omitempty"`
+
+	// Engine is the range of VS Code versions the extension is compatible with (from engines.vscode)
+	Engine string `mapstructure:"engine" json:"engine"`
+
+	// InstallDirectory is the path to the directory the extension is installed within
+	InstallDirectory string `mapstructure:"installDirectory" json:"installDirectory"`
+}
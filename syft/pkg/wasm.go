@@ -0,0 +1,14 @@
+package pkg
+
+// WasmImportEntry represents a single WIT package (e.g. "wasi:cli@0.2.0") imported by a WebAssembly component, either
+// directly from the component import section or as described by a "component-type" custom section of a core module.
+type WasmImportEntry struct {
+	// Interfaces are the interfaces imported from the WIT package (e.g. "environment", "stdin")
+	Interfaces []string `mapstructure:"interfaces" json:"interfaces,omitempty"`
+
+	// Module is the name of the importing module or component, as found in the name custom section
+	Module string `mapstructure:"module" json:"module,omitempty"`
+
+	// World is the name of the WIT world the imports were declared by, as found in the "component-type" custom section
+	World string `mapstructure:"world" json:"world,omitempty"`
+}
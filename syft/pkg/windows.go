@@ -0,0 +1,44 @@
+package pkg
+
+// WindowsMSIProduct represents the product described by a Windows Installer database (.msi file), as declared by its
+// Property table.
+type WindowsMSIProduct struct {
+	// ProductName is the name of the product being installed
+	ProductName string `mapstructure:"ProductName" json:"productName"`
+
+	// ProductVersion is the version of the product being installed
+	ProductVersion string `mapstructure:"ProductVersion" json:"productVersion"`
+
+	// Manufacturer is the name of the manufacturer of the product
+	Manufacturer string `mapstructure:"Manufacturer" json:"manufacturer,omitempty"`
+
+	// ProductCode is the GUID uniquely identifying this release of the product
+	ProductCode string `mapstructure:"ProductCode" json:"productCode,omitempty"`
+
+	// UpgradeCode is the GUID shared by all releases of the product
+	UpgradeCode string `mapstructure:"UpgradeCode" json:"upgradeCode,omitempty"`
+
+	// ProductLanguage is the numeric language identifier (LANGID) of the product
+	ProductLanguage string `mapstructure:"ProductLanguage" json:"productLanguage,omitempty"`
+
+	// Files are the files installed by the product, as listed by the File table
+	Files []WindowsMSIFile `mapstructure:"Files" json:"files,omitempty"`
+}
+
+// WindowsMSIFile represents a single file installed by a Windows Installer database.
+type WindowsMSIFile struct {
+	// Name is the (long) name of the file
+	Name string `mapstructure:"Name" json:"name"`
+
+	// Version is the version of the file (set for versioned files, such as executables and libraries)
+	Version string `mapstructure:"Version" json:"version,omitempty"`
+
+	// Size is the size of the file in bytes
+	Size int64 `mapstructure:"Size" json:"size,omitempty"`
+
+	// Component is the component the file is installed with
+	Component string `mapstructure:"Component" json:"component,omitempty"`
+
+	// ComponentID is the GUID identifying the component the file is installed with
+	ComponentID string `mapstructure:"ComponentID" json:"componentId,omitempty"`
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/diagnostic"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/linux"
 	"github.com/anchore/syft/syft/pkg"
@@ -17,16 +18,20 @@ type SBOM struct {
 	Relationships []artifact.Relationship
 	Source        source.Description
 	Descriptor    Descriptor
+
+	// Warnings are the problems encountered while cataloging which did not stop cataloging (see the diagnostic package).
+	Warnings []diagnostic.Warning
 }
 
 type Artifacts struct {
-	Packages          *pkg.Collection
-	FileMetadata      map[file.Coordinates]file.Metadata
-	FileDigests       map[file.Coordinates][]file.Digest
-	FileContents      map[file.Coordinates]string
-	FileLicenses      map[file.Coordinates][]file.License
-	Executables       map[file.Coordinates]file.Executable
-	LinuxDistribution *linux.Release
+	Packages               *pkg.Collection
+	FileMetadata           map[file.Coordinates]file.Metadata
+	FileDigests            map[file.Coordinates][]file.Digest
+	FileContents           map[file.Coordinates]string
+	FileLicenses           map[file.Coordinates][]file.License
+	Executables            map[file.Coordinates]file.Executable
+	FileRegistryReferences map[file.Coordinates][]file.RegistryReference
+	LinuxDistribution      *linux.Release
 }
 
 type Descriptor struct {
@@ -60,6 +65,9 @@ func (s SBOM) AllCoordinates() []file.Coordinates {
 	for coordinates := range s.Artifacts.FileDigests {
 		set.Add(coordinates)
 	}
+	for coordinates := range s.Artifacts.FileRegistryReferences {
+		set.Add(coordinates)
+	}
 	for _, relationship := range s.Relationships {
 		for _, coordinates := range extractCoordinates(relationship) {
 			set.Add(coordinates)
@@ -0,0 +1,364 @@
+/*
+Package checkpointsource provides a source for container checkpoints created with CRIU (e.g. by "podman container
+checkpoint --export" or the kubelet checkpoint API). The captured root filesystem changes (rootfs-diff.tar) are
+cataloged when present, otherwise the contents of the checkpoint itself are cataloged.
+*/
+package checkpointsource
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/mholt/archiver/v3"
+	"github.com/opencontainers/go-digest"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/directorysource"
+	"github.com/anchore/syft/syft/source/internal"
+)
+
+const (
+	// rootfsDiffArchive holds the changes made to the container root filesystem since the container was started
+	rootfsDiffArchive = "rootfs-diff.tar"
+
+	// criuInventoryImage is always written by CRIU when dumping a process tree
+	criuInventoryImage = "inventory.img"
+
+	// checkpointImagesDir is where container engines place the CRIU images within a checkpoint
+	checkpointImagesDir = "checkpoint"
+
+	// configDump and specDump are written by container engines next to the CRIU images
+	configDump = "config.dump"
+	specDump   = "spec.dump"
+
+	// maxArchiveEntriesToDetect bounds how much of an archive is read when deciding if it is a checkpoint. The CRIU
+	// images and engine metadata are written before the (potentially large) filesystem contents.
+	maxArchiveEntriesToDetect = 256
+)
+
+// ErrNotCheckpoint is returned when the given path does not have the layout of a container checkpoint.
+var ErrNotCheckpoint = errors.New("not a container checkpoint")
+
+var _ source.Source = (*checkpointSource)(nil)
+
+type Config struct {
+	Path    string
+	Exclude source.ExcludeConfig
+	Alias   source.Alias
+	// MaxArchiveEntries is the maximum number of entries unarchived from the checkpoint archives, where the remaining
+	// entries are skipped with a warning (0 means there is no limit)
+	MaxArchiveEntries int
+}
+
+type checkpointSource struct {
+	source.Source
+	id      artifact.ID
+	config  Config
+	cleanup func() error
+}
+
+// New creates a source for the container checkpoint at the given path, which may be a directory or a (optionally
+// gzip compressed) tar archive. ErrNotCheckpoint is returned if the checkpoint layout is not recognized.
+func New(cfg Config) (source.Source, error) {
+	fi, err := os.Stat(cfg.Path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to stat path=%q: %w", cfg.Path, err)
+	}
+
+	var analysisPath string
+	var cleanup func() error
+	if fi.IsDir() {
+		analysisPath, cleanup, err = analysisPathFromDirectory(cfg.Path, cfg.MaxArchiveEntries)
+	} else {
+		analysisPath, cleanup, err = analysisPathFromArchive(cfg.Path, cfg.MaxArchiveEntries)
+	}
+	if err != nil {
+		if cleanup != nil {
+			_ = cleanup()
+		}
+		return nil, err
+	}
+
+	alias := cfg.Alias
+	if alias.Name == "" {
+		// the analysis path is typically a temp dir, which is not a meaningful name for the source
+		alias.Name = strings.TrimSuffix(filepath.Base(cfg.Path), filepath.Ext(cfg.Path))
+		if ext := filepath.Ext(alias.Name); ext == ".tar" {
+			alias.Name = strings.TrimSuffix(alias.Name, ext)
+		}
+	}
+
+	src, err := directorysource.New(directorysource.Config{
+		Path:    analysisPath,
+		Base:    analysisPath,
+		Exclude: cfg.Exclude,
+		Alias:   alias,
+	})
+	if err != nil {
+		_ = cleanup()
+		return nil, err
+	}
+
+	return &checkpointSource{
+		Source:  src,
+		id:      deriveIDFromCheckpoint(cfg),
+		config:  cfg,
+		cleanup: cleanup,
+	}, nil
+}
+
+func deriveIDFromCheckpoint(cfg Config) artifact.ID {
+	info := filepath.Clean(cfg.Path)
+	if !cfg.Alias.IsEmpty() {
+		info = fmt.Sprintf("%s@%s", cfg.Alias.Name, cfg.Alias.Version)
+	}
+	return internal.ArtifactIDFromDigest(digest.SHA256.FromString(info).String())
+}
+
+func (s checkpointSource) ID() artifact.ID {
+	return s.id
+}
+
+func (s checkpointSource) Describe() source.Description {
+	d := s.Source.Describe()
+	d.ID = string(s.id)
+	// report the checkpoint that was given, not where the contents were extracted to
+	d.Metadata = source.DirectoryMetadata{
+		Path: s.config.Path,
+		Base: s.config.Path,
+	}
+	return d
+}
+
+func (s *checkpointSource) Close() error {
+	err := s.Source.Close()
+	if s.cleanup != nil {
+		if cleanupErr := s.cleanup(); cleanupErr != nil && err == nil {
+			err = cleanupErr
+		}
+	}
+	return err
+}
+
+// analysisPathFromDirectory returns the directory to catalog for an unpacked checkpoint.
+func analysisPathFromDirectory(dir string, maxArchiveEntries int) (string, func() error, error) {
+	noop := func() error { return nil }
+
+	names, err := entryNames(dir)
+	if err != nil {
+		return "", noop, err
+	}
+	if !isCheckpointLayout(names) {
+		return "", noop, fmt.Errorf("%w: %s", ErrNotCheckpoint, dir)
+	}
+
+	rootfsDiff := filepath.Join(dir, rootfsDiffArchive)
+	if _, err := os.Stat(rootfsDiff); err != nil {
+		log.WithFields("path", dir).Debug("container checkpoint has no root filesystem changes, cataloging the checkpoint contents")
+		return dir, noop, nil
+	}
+
+	return unarchiveToTmp(rootfsDiff, "syft-checkpoint-rootfs-", maxArchiveEntries)
+}
+
+// analysisPathFromArchive returns the directory to catalog for an exported checkpoint archive.
+func analysisPathFromArchive(archivePath string, maxArchiveEntries int) (string, func() error, error) {
+	noop := func() error { return nil }
+
+	names, hasRootfsDiff, err := archiveEntryNames(archivePath)
+	if err != nil {
+		return "", noop, fmt.Errorf("%w: %s: %v", ErrNotCheckpoint, archivePath, err)
+	}
+	if !isCheckpointLayout(names) {
+		return "", noop, fmt.Errorf("%w: %s", ErrNotCheckpoint, archivePath)
+	}
+
+	if !hasRootfsDiff {
+		log.WithFields("path", archivePath).Debug("container checkpoint has no root filesystem changes, cataloging the checkpoint contents")
+		return unarchiveToTmp(archivePath, "syft-checkpoint-contents-", maxArchiveEntries)
+	}
+
+	rootfsDiff, err := os.CreateTemp("", "syft-checkpoint-rootfs-diff-*.tar")
+	if err != nil {
+		return "", noop, fmt.Errorf("unable to create temp file for checkpoint processing: %w", err)
+	}
+	removeRootfsDiff := func() error { return os.Remove(rootfsDiff.Name()) }
+
+	err = copyArchiveEntry(archivePath, rootfsDiffArchive, rootfsDiff)
+	if closeErr := rootfsDiff.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", removeRootfsDiff, fmt.Errorf("unable to extract %s from checkpoint: %w", rootfsDiffArchive, err)
+	}
+
+	analysisPath, cleanupRootfs, err := unarchiveToTmp(rootfsDiff.Name(), "syft-checkpoint-rootfs-", maxArchiveEntries)
+	cleanup := func() error {
+		return errors.Join(cleanupRootfs(), removeRootfsDiff())
+	}
+	return analysisPath, cleanup, err
+}
+
+// isCheckpointLayout indicates if the given top-level entry names (directories have a trailing slash) match the
+// layout of a checkpoint: either the CRIU images themselves, or CRIU images with container engine metadata.
+func isCheckpointLayout(names map[string]bool) bool {
+	if names[criuInventoryImage] || names[path.Join(checkpointImagesDir, criuInventoryImage)] {
+		return true
+	}
+	return names[checkpointImagesDir+"/"] && names[configDump] && names[specDump]
+}
+
+// entryNames returns the names of the entries needed to detect the checkpoint layout of a directory.
+func entryNames(dir string) (map[string]bool, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read directory=%q: %w", dir, err)
+	}
+
+	names := make(map[string]bool)
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() {
+			name += "/"
+		}
+		names[name] = true
+	}
+	if _, err := os.Stat(filepath.Join(dir, checkpointImagesDir, criuInventoryImage)); err == nil {
+		names[path.Join(checkpointImagesDir, criuInventoryImage)] = true
+	}
+	return names, nil
+}
+
+// archiveEntryNames returns the names of the leading entries of a tar archive, and if the archive contains the root
+// filesystem changes.
+func archiveEntryNames(archivePath string) (map[string]bool, bool, error) {
+	names := make(map[string]bool)
+	var hasRootfsDiff bool
+
+	err := walkTar(archivePath, func(i int, hdr *tar.Header, _ io.Reader) (bool, error) {
+		name := path.Clean(strings.TrimPrefix(hdr.Name, "./"))
+		if hdr.Typeflag == tar.TypeDir {
+			name += "/"
+		}
+		names[name] = true
+		if top, _, found := strings.Cut(name, "/"); found {
+			names[top+"/"] = true
+		}
+		if name == rootfsDiffArchive {
+			hasRootfsDiff = true
+		}
+		if !isCheckpointLayout(names) {
+			// stop early when the leading entries do not look like a checkpoint
+			return i < maxArchiveEntriesToDetect, nil
+		}
+		// this is a checkpoint, keep looking for the rootfs changes (which are typically the last entry)
+		return !hasRootfsDiff, nil
+	})
+	return names, hasRootfsDiff, err
+}
+
+// copyArchiveEntry writes the contents of the named tar entry to the given writer.
+func copyArchiveEntry(archivePath, name string, w io.Writer) error {
+	var found bool
+	err := walkTar(archivePath, func(_ int, hdr *tar.Header, r io.Reader) (bool, error) {
+		if path.Clean(strings.TrimPrefix(hdr.Name, "./")) != name || hdr.Typeflag != tar.TypeReg {
+			return true, nil
+		}
+		found = true
+		_, err := io.Copy(w, r) //nolint:gosec // the entry is bounded by the size of the (uncompressed) archive
+		return false, err
+	})
+	if err == nil && !found {
+		err = fmt.Errorf("no %s entry found", name)
+	}
+	return err
+}
+
+// walkTar calls the given function for each entry of a (optionally gzip compressed) tar archive until it returns false.
+func walkTar(archivePath string, fn func(int, *tar.Header, io.Reader) (bool, error)) error {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	br := bufio.NewReader(f)
+	var r io.Reader = br
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	for i := 0; ; i++ {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		more, err := fn(i, hdr, tr)
+		if err != nil || !more {
+			return err
+		}
+	}
+}
+
+func unarchiveToTmp(archivePath, prefix string, maxEntries int) (string, func() error, error) {
+	// when tar files are extracted, if there are multiple entries at the same location, the last entry wins
+	var unarchiver archiver.Unarchiver
+	if isGzip(archivePath) {
+		tgz := archiver.NewTarGz()
+		tgz.OverwriteExisting = true
+		unarchiver = tgz
+	} else {
+		t := archiver.NewTar()
+		t.OverwriteExisting = true
+		unarchiver = t
+	}
+
+	tempDir, err := os.MkdirTemp("", prefix)
+	if err != nil {
+		return "", func() error { return nil }, fmt.Errorf("unable to create tempdir for checkpoint processing: %w", err)
+	}
+
+	cleanupFn := func() error {
+		return os.RemoveAll(tempDir)
+	}
+
+	err = internal.Unarchive(unarchiver, archivePath, tempDir, maxEntries)
+	if errors.Is(err, internal.ErrTooManyArchiveEntries) {
+		log.WithFields("path", archivePath, "limit", maxEntries).Warn("checkpoint archive exceeds the maximum number of archive entries, skipping the remaining entries")
+		err = nil
+	}
+	return tempDir, cleanupFn, err
+}
+
+func isGzip(archivePath string) bool {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	magic := make([]byte, 2)
+	if _, err := io.ReadFull(f, magic); err != nil {
+		return false
+	}
+	return magic[0] == 0x1f && magic[1] == 0x8b
+}
@@ -0,0 +1,58 @@
+package checkpointsource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/anchore/syft/syft/source"
+)
+
+// ProviderConfig is the configuration of the checkpoint source provider.
+type ProviderConfig struct {
+	Exclude source.ExcludeConfig
+	Alias   source.Alias
+	// MaxArchiveEntries is the maximum number of entries unarchived from the checkpoint archives, where the remaining
+	// entries are skipped with a warning (0 means there is no limit)
+	MaxArchiveEntries int
+}
+
+func NewSourceProvider(path string, exclude source.ExcludeConfig, alias source.Alias) source.Provider {
+	return NewSourceProviderWithConfig(path, ProviderConfig{
+		Exclude: exclude,
+		Alias:   alias,
+	})
+}
+
+func NewSourceProviderWithConfig(path string, cfg ProviderConfig) source.Provider {
+	return &checkpointSourceProvider{
+		path: path,
+		cfg:  cfg,
+	}
+}
+
+type checkpointSourceProvider struct {
+	path string
+	cfg  ProviderConfig
+}
+
+func (p checkpointSourceProvider) Name() string {
+	return "checkpoint"
+}
+
+func (p checkpointSourceProvider) Provide(_ context.Context) (source.Source, error) {
+	location, err := homedir.Expand(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to expand potential checkpoint path: %w", err)
+	}
+
+	return New(
+		Config{
+			Path:              location,
+			Exclude:           p.cfg.Exclude,
+			Alias:             p.cfg.Alias,
+			MaxArchiveEntries: p.cfg.MaxArchiveEntries,
+		},
+	)
+}
@@ -0,0 +1,234 @@
+package checkpointsource
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/source"
+)
+
+const osRelease = "ID=alpine\nVERSION_ID=3.19.1\n"
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T) string
+		wantPaths    []string
+		wantNotPaths []string
+		wantName     string
+		wantErr      error
+	}{
+		{
+			name: "checkpoint directory with rootfs changes",
+			setup: func(t *testing.T) string {
+				dir := filepath.Join(t.TempDir(), "my-checkpoint")
+				writeFiles(t, dir, checkpointFiles(t))
+				return dir
+			},
+			wantPaths:    []string{"/etc/os-release"},
+			wantNotPaths: []string{"/config.dump", "/checkpoint/inventory.img"},
+			wantName:     "my-checkpoint",
+		},
+		{
+			name: "exported checkpoint archive with rootfs changes",
+			setup: func(t *testing.T) string {
+				p := filepath.Join(t.TempDir(), "my-checkpoint.tar.gz")
+				writeTar(t, p, true, checkpointFiles(t))
+				return p
+			},
+			wantPaths:    []string{"/etc/os-release"},
+			wantNotPaths: []string{"/config.dump", "/checkpoint/inventory.img"},
+			wantName:     "my-checkpoint",
+		},
+		{
+			name: "exported checkpoint archive without rootfs changes",
+			setup: func(t *testing.T) string {
+				files := checkpointFiles(t)
+				delete(files, rootfsDiffArchive)
+				p := filepath.Join(t.TempDir(), "my-checkpoint.tar")
+				writeTar(t, p, false, files)
+				return p
+			},
+			wantPaths: []string{"/config.dump", "/checkpoint/inventory.img"},
+			wantName:  "my-checkpoint",
+		},
+		{
+			name: "CRIU images only",
+			setup: func(t *testing.T) string {
+				dir := filepath.Join(t.TempDir(), "dump")
+				writeFiles(t, dir, map[string][]byte{criuInventoryImage: []byte("criu")})
+				return dir
+			},
+			wantPaths: []string{"/inventory.img"},
+			wantName:  "dump",
+		},
+		{
+			name: "directory that is not a checkpoint",
+			setup: func(t *testing.T) string {
+				dir := t.TempDir()
+				writeFiles(t, dir, map[string][]byte{"etc/os-release": []byte(osRelease)})
+				return dir
+			},
+			wantErr: ErrNotCheckpoint,
+		},
+		{
+			name: "archive that is not a checkpoint",
+			setup: func(t *testing.T) string {
+				p := filepath.Join(t.TempDir(), "rootfs.tar")
+				writeTar(t, p, false, map[string][]byte{"etc/os-release": []byte(osRelease)})
+				return p
+			},
+			wantErr: ErrNotCheckpoint,
+		},
+		{
+			name: "file that is not an archive",
+			setup: func(t *testing.T) string {
+				p := filepath.Join(t.TempDir(), "os-release")
+				require.NoError(t, os.WriteFile(p, []byte(osRelease), 0o600))
+				return p
+			},
+			wantErr: ErrNotCheckpoint,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := tt.setup(t)
+
+			src, err := New(Config{Path: input})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			t.Cleanup(func() { require.NoError(t, src.Close()) })
+
+			desc := src.Describe()
+			assert.Equal(t, tt.wantName, desc.Name)
+			assert.Equal(t, string(src.ID()), desc.ID)
+			assert.Equal(t, source.DirectoryMetadata{Path: input, Base: input}, desc.Metadata)
+
+			res, err := src.FileResolver(source.SquashedScope)
+			require.NoError(t, err)
+			for _, p := range tt.wantPaths {
+				assert.Truef(t, res.HasPath(p), "expected path %q", p)
+			}
+			for _, p := range tt.wantNotPaths {
+				assert.Falsef(t, res.HasPath(p), "unexpected path %q", p)
+			}
+		})
+	}
+}
+
+func TestNew_Alias(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "my-checkpoint")
+	writeFiles(t, dir, checkpointFiles(t))
+
+	withoutAlias, err := New(Config{Path: dir})
+	require.NoError(t, err)
+	require.NoError(t, withoutAlias.Close())
+
+	withAlias, err := New(Config{Path: dir, Alias: source.Alias{Name: "app", Version: "1.0"}})
+	require.NoError(t, err)
+	require.NoError(t, withAlias.Close())
+
+	desc := withAlias.Describe()
+	assert.Equal(t, "app", desc.Name)
+	assert.Equal(t, "1.0", desc.Version)
+	assert.NotEqual(t, withoutAlias.ID(), withAlias.ID())
+}
+
+func TestClose_RemovesExtractedContents(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "my-checkpoint.tar")
+	writeTar(t, p, false, checkpointFiles(t))
+
+	tmp := t.TempDir()
+	t.Setenv("TMPDIR", tmp)
+
+	src, err := New(Config{Path: p})
+	require.NoError(t, err)
+
+	res, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+	assert.True(t, res.HasPath("/etc/os-release"))
+
+	entries, err := os.ReadDir(tmp)
+	require.NoError(t, err)
+	assert.NotEmpty(t, entries)
+
+	require.NoError(t, src.Close())
+
+	entries, err = os.ReadDir(tmp)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+// checkpointFiles returns the contents of a checkpoint as exported by a container engine.
+func checkpointFiles(t *testing.T) map[string][]byte {
+	t.Helper()
+	rootfs := filepath.Join(t.TempDir(), rootfsDiffArchive)
+	writeTar(t, rootfs, false, map[string][]byte{"etc/os-release": []byte(osRelease)})
+	rootfsContents, err := os.ReadFile(rootfs)
+	require.NoError(t, err)
+
+	return map[string][]byte{
+		"checkpoint/" + criuInventoryImage: []byte("criu"),
+		"checkpoint/pstree.img":            []byte("criu"),
+		configDump:                         []byte("{}"),
+		specDump:                           []byte("{}"),
+		rootfsDiffArchive:                  rootfsContents,
+	}
+}
+
+func writeFiles(t *testing.T, dir string, files map[string][]byte) {
+	t.Helper()
+	for name, contents := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, contents, 0o600))
+	}
+}
+
+func writeTar(t *testing.T, p string, compress bool, files map[string][]byte) {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	// write the root filesystem changes last, as container engines do
+	names := make([]string, 0, len(files))
+	for name := range files {
+		if name != rootfsDiffArchive {
+			names = append(names, name)
+		}
+	}
+	if _, ok := files[rootfsDiffArchive]; ok {
+		names = append(names, rootfsDiffArchive)
+	}
+	for _, name := range names {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o600,
+			Size:     int64(len(files[name])),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write(files[name])
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+
+	contents := buf.Bytes()
+	if compress {
+		var gzBuf bytes.Buffer
+		gw := gzip.NewWriter(&gzBuf)
+		_, err := gw.Write(contents)
+		require.NoError(t, err)
+		require.NoError(t, gw.Close())
+		contents = gzBuf.Bytes()
+	}
+	require.NoError(t, os.WriteFile(p, contents, 0o600))
+}
@@ -0,0 +1,113 @@
+/*
+Package containersstoragesource provides a source for images within a local containers-storage store (as used by
+podman, buildah, and cri-o), which are read directly from disk without a daemon or exporting the image first.
+*/
+package containersstoragesource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/distribution/reference"
+
+	"github.com/anchore/stereoscope/pkg/file"
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/stereoscopesource"
+)
+
+// providerName is the name of the provider, which may also be given as a scheme (e.g. "containers-storage:alpine:latest").
+const providerName = "containers-storage"
+
+func NewSourceProvider(userInput string, platform *image.Platform, exclude source.ExcludeConfig, alias source.Alias) source.Provider {
+	return &containersStorageSourceProvider{
+		reference: userInput,
+		platform:  platform,
+		exclude:   exclude,
+		alias:     alias,
+	}
+}
+
+type containersStorageSourceProvider struct {
+	reference string
+	platform  *image.Platform
+	exclude   source.ExcludeConfig
+	alias     source.Alias
+}
+
+func (p containersStorageSourceProvider) Name() string {
+	return providerName
+}
+
+// Provide reads the referenced image from the store, which is given as "[driver@graphroot+runroot]" before the image
+// reference or otherwise found in the same way as podman does (from storage.conf).
+func (p containersStorageSourceProvider) Provide(_ context.Context) (source.Source, error) {
+	driver, root, ref := splitStoreSpec(p.reference)
+
+	s, err := openStore(driver, root)
+	if err != nil {
+		return nil, err
+	}
+
+	storeImg, rawConfig, err := s.findImage(ref, p.platform)
+	if err != nil {
+		return nil, err
+	}
+
+	v1Img, err := newStorageV1Image(*s, *storeImg, rawConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := v1Img.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse config of image %q: %w", storeImg.ID, err)
+	}
+
+	metadata := []image.AdditionalMetadata{
+		image.WithTags(storeImg.Names...),
+		image.WithRepoDigests(repoDigests(*storeImg)...),
+		image.WithArchitecture(cfg.Architecture, cfg.Variant),
+		image.WithOS(cfg.OS),
+	}
+	if v1Img.manifest != nil {
+		metadata = append(metadata, image.WithManifest(v1Img.rawManifest))
+	}
+
+	tmpDirGen := file.NewTempDirGenerator("syft-containers-storage")
+	contentTempDir, err := tmpDirGen.NewDirectory("containers-storage-image")
+	if err != nil {
+		return nil, err
+	}
+
+	img := image.New(v1Img, tmpDirGen, contentTempDir, metadata...)
+	if err := img.Read(); err != nil {
+		_ = img.Cleanup()
+		return nil, fmt.Errorf("unable to read image %q from containers-storage: %w", ref, err)
+	}
+
+	return stereoscopesource.New(img, stereoscopesource.ImageConfig{
+		Reference: ref,
+		Platform:  p.platform,
+		Exclude:   p.exclude,
+		Alias:     p.alias,
+	}), nil
+}
+
+// repoDigests returns the references of the image by the manifest digest, for each repository the image is tagged in.
+func repoDigests(img storageImage) []string {
+	if img.Digest == "" {
+		return nil
+	}
+	var digests []string
+	seen := make(map[string]bool)
+	for _, n := range img.Names {
+		named, err := reference.ParseNormalizedNamed(n)
+		if err != nil || seen[named.Name()] {
+			continue
+		}
+		seen[named.Name()] = true
+		digests = append(digests, named.Name()+"@"+img.Digest)
+	}
+	return digests
+}
@@ -0,0 +1,370 @@
+package containersstoragesource
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vbatts/tar-split/tar/asm"
+	"github.com/vbatts/tar-split/tar/storage"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/syft/source"
+)
+
+func Test_splitStoreSpec(t *testing.T) {
+	tests := []struct {
+		input      string
+		wantDriver string
+		wantRoot   string
+		wantRef    string
+	}{
+		{
+			input:   "alpine:latest",
+			wantRef: "alpine:latest",
+		},
+		{
+			input:    "[/var/lib/containers/storage]alpine:latest",
+			wantRoot: "/var/lib/containers/storage",
+			wantRef:  "alpine:latest",
+		},
+		{
+			input:      "[overlay@/var/lib/containers/storage+/run/containers/storage]docker.io/library/alpine:latest",
+			wantDriver: "overlay",
+			wantRoot:   "/var/lib/containers/storage",
+			wantRef:    "docker.io/library/alpine:latest",
+		},
+		{
+			input:   "[unterminated",
+			wantRef: "[unterminated",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			driver, root, ref := splitStoreSpec(tt.input)
+			assert.Equal(t, tt.wantDriver, driver)
+			assert.Equal(t, tt.wantRoot, root)
+			assert.Equal(t, tt.wantRef, ref)
+		})
+	}
+}
+
+func Test_matchingImages(t *testing.T) {
+	manifestDigest := "sha256:" + strings.Repeat("1", 64)
+	listDigest := "sha256:" + strings.Repeat("3", 64)
+	images := []storageImage{
+		{
+			ID:     "aaaa1111",
+			Digest: manifestDigest,
+			Names:  []string{"docker.io/library/alpine:latest", "docker.io/library/alpine:3.19"},
+		},
+		{
+			ID:           "bbbb2222",
+			Digest:       "sha256:" + strings.Repeat("2", 64),
+			NamesHistory: []string{"docker.io/library/alpine:latest"},
+			BigDataNames: []string{"manifest-" + listDigest, "manifest"},
+		},
+		{
+			ID:    "cccc3333",
+			Names: []string{"localhost/myimage:latest"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		ref         string
+		withHistory bool
+		wantIDs     []string
+	}{
+		{
+			name:    "short docker hub name",
+			ref:     "alpine",
+			wantIDs: []string{"aaaa1111"},
+		},
+		{
+			name:    "fully qualified name",
+			ref:     "docker.io/library/alpine:3.19",
+			wantIDs: []string{"aaaa1111"},
+		},
+		{
+			name:        "previously tagged images are considered when selecting a platform",
+			ref:         "alpine:latest",
+			withHistory: true,
+			wantIDs:     []string{"aaaa1111", "bbbb2222"},
+		},
+		{
+			name:    "short name of a locally built image",
+			ref:     "myimage",
+			wantIDs: []string{"cccc3333"},
+		},
+		{
+			name: "short name does not match another registry when the registry is given",
+			ref:  "quay.io/myimage",
+		},
+		{
+			name:    "manifest digest",
+			ref:     "alpine@" + manifestDigest,
+			wantIDs: []string{"aaaa1111"},
+		},
+		{
+			name:        "manifest list digest",
+			ref:         "alpine@" + listDigest,
+			withHistory: true,
+			wantIDs:     []string{"bbbb2222"},
+		},
+		{
+			name:    "image ID prefix",
+			ref:     "cccc",
+			wantIDs: []string{"cccc3333"},
+		},
+		{
+			name: "unknown image",
+			ref:  "busybox",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids []string
+			for _, img := range matchingImages(images, tt.ref, tt.withHistory) {
+				ids = append(ids, img.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
+
+func TestContainersStorageSourceProvider(t *testing.T) {
+	root := t.TempDir()
+	fs := newTestStore(t, root)
+	fs.addImage(t, "amd64", []string{"docker.io/library/alpine:latest"}, nil,
+		map[string]string{"etc/os-release": "ID=alpine\nVERSION_ID=3.19.1\n"},
+		map[string]string{"usr/bin/app": "amd64 app"},
+	)
+	fs.addImage(t, "arm64", nil, []string{"docker.io/library/alpine:latest"},
+		map[string]string{"etc/os-release": "ID=alpine\nVERSION_ID=3.19.1\n"},
+		map[string]string{"usr/bin/app": "arm64 app"},
+	)
+	fs.write(t)
+
+	tests := []struct {
+		name     string
+		input    string
+		platform *image.Platform
+		wantArch string
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "tagged image",
+			input:    fmt.Sprintf("[overlay@%s]alpine:latest", root),
+			wantArch: "amd64",
+		},
+		{
+			name:     "platform of an image no longer tagged with the name",
+			input:    fmt.Sprintf("[%s]alpine:latest", root),
+			platform: &image.Platform{OS: "linux", Architecture: "arm64"},
+			wantArch: "arm64",
+		},
+		{
+			name:     "platform not within the store",
+			input:    fmt.Sprintf("[%s]alpine:latest", root),
+			platform: &image.Platform{OS: "linux", Architecture: "s390x"},
+			wantErr:  require.Error,
+		},
+		{
+			name:    "image not within the store",
+			input:   fmt.Sprintf("[%s]busybox:latest", root),
+			wantErr: require.Error,
+		},
+		{
+			name:    "store does not exist",
+			input:   fmt.Sprintf("[%s]alpine:latest", filepath.Join(root, "missing")),
+			wantErr: require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			src, err := NewSourceProvider(tt.input, tt.platform, source.ExcludeConfig{}, source.Alias{}).Provide(context.Background())
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { require.NoError(t, src.Close()) })
+
+			metadata, ok := src.Describe().Metadata.(source.ImageMetadata)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantArch, metadata.Architecture)
+			assert.Equal(t, "linux", metadata.OS)
+			assert.Len(t, metadata.Layers, 2)
+
+			res, err := src.FileResolver(source.SquashedScope)
+			require.NoError(t, err)
+
+			for p, want := range map[string]string{
+				"/etc/os-release": "ID=alpine\nVERSION_ID=3.19.1\n",
+				"/usr/bin/app":    tt.wantArch + " app",
+			} {
+				locations, err := res.FilesByPath(p)
+				require.NoError(t, err)
+				require.Len(t, locations, 1, p)
+
+				rc, err := res.FileContentsByLocation(locations[0])
+				require.NoError(t, err)
+				contents, err := io.ReadAll(rc)
+				require.NoError(t, err)
+				require.NoError(t, rc.Close())
+				assert.Equal(t, want, string(contents))
+			}
+		})
+	}
+}
+
+// testStore writes a minimal containers-storage store (with the overlay driver) in the same layout podman does.
+type testStore struct {
+	root   string
+	images []storageImage
+	layers []storageLayer
+}
+
+func newTestStore(t *testing.T, root string) *testStore {
+	t.Helper()
+	for _, dir := range []string{"overlay", "overlay-images", "overlay-layers"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, dir), 0o755))
+	}
+	return &testStore{root: root}
+}
+
+func (s *testStore) addImage(t *testing.T, arch string, names, namesHistory []string, layers ...map[string]string) {
+	t.Helper()
+
+	var parent string
+	var diffIDs []v1.Hash
+	var descriptors []v1.Descriptor
+	for _, files := range layers {
+		l := s.addLayer(t, parent, files)
+		parent = l.ID
+
+		diffID, err := v1.NewHash(l.UncompressedDigest)
+		require.NoError(t, err)
+		diffIDs = append(diffIDs, diffID)
+		descriptors = append(descriptors, v1.Descriptor{
+			MediaType: types.OCIUncompressedLayer,
+			Size:      l.UncompressedSize,
+			Digest:    diffID,
+		})
+	}
+
+	rawConfig, err := json.Marshal(v1.ConfigFile{
+		Architecture: arch,
+		OS:           "linux",
+		RootFS:       v1.RootFS{Type: "layers", DiffIDs: diffIDs},
+	})
+	require.NoError(t, err)
+	configDigest := fmt.Sprintf("sha256:%x", sha256.Sum256(rawConfig))
+
+	rawManifest, err := json.Marshal(v1.Manifest{
+		SchemaVersion: 2,
+		MediaType:     types.OCIManifestSchema1,
+		Config: v1.Descriptor{
+			MediaType: types.OCIConfigJSON,
+			Size:      int64(len(rawConfig)),
+			Digest:    v1.Hash{Algorithm: "sha256", Hex: configDigest[len("sha256:"):]},
+		},
+		Layers: descriptors,
+	})
+	require.NoError(t, err)
+	manifestDigest := fmt.Sprintf("sha256:%x", sha256.Sum256(rawManifest))
+
+	img := storageImage{
+		ID:           configDigest[len("sha256:"):],
+		Digest:       manifestDigest,
+		Names:        names,
+		NamesHistory: namesHistory,
+		TopLayer:     parent,
+		BigDataNames: []string{configDigest, manifestDigestBigDataKeyPrefix + manifestDigest, manifestBigDataKey},
+	}
+	imgDir := filepath.Join(s.root, "overlay-images", img.ID)
+	require.NoError(t, os.MkdirAll(imgDir, 0o755))
+	for key, data := range map[string][]byte{
+		configDigest: rawConfig,
+		manifestDigestBigDataKeyPrefix + manifestDigest: rawManifest,
+		manifestBigDataKey: rawManifest,
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(imgDir, bigDataFileName(key)), data, 0o644))
+	}
+	s.images = append(s.images, img)
+}
+
+// addLayer extracts the layer content (as the overlay driver does) and records the tar-split metadata of the layer.
+func (s *testStore) addLayer(t *testing.T, parent string, files map[string]string) storageLayer {
+	t.Helper()
+
+	layerTar := &bytes.Buffer{}
+	tw := tar.NewWriter(layerTar)
+	for name, contents := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(contents)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+
+	l := storageLayer{
+		ID:                 fmt.Sprintf("%x", sha256.Sum256(append([]byte(parent), layerTar.Bytes()...))),
+		Parent:             parent,
+		UncompressedDigest: fmt.Sprintf("sha256:%x", sha256.Sum256(layerTar.Bytes())),
+		UncompressedSize:   int64(layerTar.Len()),
+	}
+
+	diffDir := filepath.Join(s.root, "overlay", l.ID, "diff")
+	for name, contents := range files {
+		p := filepath.Join(diffDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte(contents), 0o644))
+	}
+
+	fh, err := os.Create(filepath.Join(s.root, "overlay-layers", l.ID+".tar-split.gz"))
+	require.NoError(t, err)
+	zw := gzip.NewWriter(fh)
+	r, err := asm.NewInputTarStream(layerTar, storage.NewJSONPacker(zw), storage.NewDiscardFilePutter())
+	require.NoError(t, err)
+	_, err = io.Copy(io.Discard, r)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	require.NoError(t, fh.Close())
+
+	// layers with the same content and parent are shared between images
+	for _, existing := range s.layers {
+		if existing.ID == l.ID {
+			return l
+		}
+	}
+	s.layers = append(s.layers, l)
+	return l
+}
+
+func (s *testStore) write(t *testing.T) {
+	t.Helper()
+	for p, v := range map[string]any{
+		filepath.Join(s.root, "overlay-images", "images.json"): s.images,
+		filepath.Join(s.root, "overlay-layers", "layers.json"): s.layers,
+	} {
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(p, data, 0o644))
+	}
+}
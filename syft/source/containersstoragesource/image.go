@@ -0,0 +1,219 @@
+package containersstoragesource
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/vbatts/tar-split/tar/asm"
+	"github.com/vbatts/tar-split/tar/storage"
+)
+
+var (
+	_ v1.Image = (*storageV1Image)(nil)
+	_ v1.Layer = (*storageV1Layer)(nil)
+)
+
+// storageV1Image is an image within containers-storage, whose layers are reassembled from the layer content on disk.
+type storageV1Image struct {
+	rawConfig   []byte
+	rawManifest []byte
+	manifest    *v1.Manifest
+	layers      []v1.Layer
+}
+
+// storageV1Layer is a layer within containers-storage. The storage only keeps the content of the layer (extracted),
+// along with the tar-split metadata needed to reassemble the (uncompressed) layer tar exactly as it was pulled.
+type storageV1Layer struct {
+	store store
+	layer storageLayer
+	// descriptor is the descriptor of the layer within the image manifest (if known)
+	descriptor *v1.Descriptor
+}
+
+func newStorageV1Image(s store, img storageImage, rawConfig []byte) (*storageV1Image, error) {
+	out := &storageV1Image{
+		rawConfig: rawConfig,
+	}
+
+	// images committed locally may not have a manifest recorded
+	if rawManifest, err := s.bigData(img, manifestBigDataKey); err == nil {
+		var manifest v1.Manifest
+		if err := json.Unmarshal(rawManifest, &manifest); err != nil {
+			return nil, fmt.Errorf("unable to parse manifest of image %q: %w", img.ID, err)
+		}
+		out.rawManifest = rawManifest
+		out.manifest = &manifest
+	}
+
+	chain, err := s.layerChain(img)
+	if err != nil {
+		return nil, err
+	}
+	for idx, l := range chain {
+		layer := &storageV1Layer{
+			store: s,
+			layer: l,
+		}
+		if out.manifest != nil && len(out.manifest.Layers) == len(chain) {
+			layer.descriptor = &out.manifest.Layers[idx]
+		}
+		out.layers = append(out.layers, layer)
+	}
+	return out, nil
+}
+
+func (i *storageV1Image) Layers() ([]v1.Layer, error) {
+	return i.layers, nil
+}
+
+func (i *storageV1Image) MediaType() (types.MediaType, error) {
+	if i.manifest != nil && i.manifest.MediaType != "" {
+		return i.manifest.MediaType, nil
+	}
+	return types.OCIManifestSchema1, nil
+}
+
+func (i *storageV1Image) Size() (int64, error) {
+	return int64(len(i.rawManifest)), nil
+}
+
+func (i *storageV1Image) ConfigName() (v1.Hash, error) {
+	h, _, err := v1.SHA256(bytes.NewReader(i.rawConfig))
+	return h, err
+}
+
+func (i *storageV1Image) ConfigFile() (*v1.ConfigFile, error) {
+	return v1.ParseConfigFile(bytes.NewReader(i.rawConfig))
+}
+
+func (i *storageV1Image) RawConfigFile() ([]byte, error) {
+	return i.rawConfig, nil
+}
+
+func (i *storageV1Image) Digest() (v1.Hash, error) {
+	if i.manifest == nil {
+		return v1.Hash{}, fmt.Errorf("image has no manifest")
+	}
+	h, _, err := v1.SHA256(bytes.NewReader(i.rawManifest))
+	return h, err
+}
+
+func (i *storageV1Image) Manifest() (*v1.Manifest, error) {
+	if i.manifest == nil {
+		return nil, fmt.Errorf("image has no manifest")
+	}
+	return i.manifest, nil
+}
+
+func (i *storageV1Image) RawManifest() ([]byte, error) {
+	if i.manifest == nil {
+		return nil, fmt.Errorf("image has no manifest")
+	}
+	return i.rawManifest, nil
+}
+
+func (i *storageV1Image) LayerByDigest(h v1.Hash) (v1.Layer, error) {
+	for _, l := range i.layers {
+		if d, err := l.Digest(); err == nil && d == h {
+			return l, nil
+		}
+	}
+	return nil, fmt.Errorf("unable to find layer with digest %q", h)
+}
+
+func (i *storageV1Image) LayerByDiffID(h v1.Hash) (v1.Layer, error) {
+	for _, l := range i.layers {
+		if d, err := l.DiffID(); err == nil && d == h {
+			return l, nil
+		}
+	}
+	return nil, fmt.Errorf("unable to find layer with diff ID %q", h)
+}
+
+func (l *storageV1Layer) Digest() (v1.Hash, error) {
+	if l.descriptor != nil {
+		return l.descriptor.Digest, nil
+	}
+	return l.DiffID()
+}
+
+func (l *storageV1Layer) DiffID() (v1.Hash, error) {
+	if l.layer.UncompressedDigest == "" {
+		return v1.Hash{}, fmt.Errorf("layer %q has no diff digest", l.layer.ID)
+	}
+	return v1.NewHash(l.layer.UncompressedDigest)
+}
+
+// Compressed returns the layer tar compressed with gzip (when the layer is described as compressed), which is not
+// necessarily identical to the layer as pulled (so may not match the layer digest).
+func (l *storageV1Layer) Compressed() (io.ReadCloser, error) {
+	rc, err := l.Uncompressed()
+	if err != nil {
+		return nil, err
+	}
+	mediaType, err := l.MediaType()
+	if err != nil || mediaType == types.OCIUncompressedLayer || mediaType == types.DockerUncompressedLayer {
+		return rc, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		defer rc.Close()
+		zw := gzip.NewWriter(pw)
+		_, err := io.Copy(zw, rc)
+		if err == nil {
+			err = zw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr, nil
+}
+
+func (l *storageV1Layer) Uncompressed() (io.ReadCloser, error) {
+	fh, err := os.Open(l.store.layerTarSplitPath(l.layer))
+	if err != nil {
+		return nil, fmt.Errorf("unable to open tar-split metadata of layer %q: %w", l.layer.ID, err)
+	}
+	zr, err := gzip.NewReader(fh)
+	if err != nil {
+		_ = fh.Close()
+		return nil, fmt.Errorf("unable to read tar-split metadata of layer %q: %w", l.layer.ID, err)
+	}
+
+	tarStream := asm.NewOutputTarStream(storage.NewPathFileGetter(l.store.layerContentDir(l.layer)), storage.NewJSONUnpacker(zr))
+	return &tarSplitReadCloser{ReadCloser: tarStream, closers: []io.Closer{zr, fh}}, nil
+}
+
+func (l *storageV1Layer) Size() (int64, error) {
+	if l.descriptor != nil {
+		return l.descriptor.Size, nil
+	}
+	return l.layer.UncompressedSize, nil
+}
+
+func (l *storageV1Layer) MediaType() (types.MediaType, error) {
+	if l.descriptor != nil && l.descriptor.MediaType != "" {
+		return l.descriptor.MediaType, nil
+	}
+	return types.OCIUncompressedLayer, nil
+}
+
+// tarSplitReadCloser closes the tar-split metadata along with the reassembled tar stream.
+type tarSplitReadCloser struct {
+	io.ReadCloser
+	closers []io.Closer
+}
+
+func (r *tarSplitReadCloser) Close() error {
+	err := r.ReadCloser.Close()
+	for _, c := range r.closers {
+		_ = c.Close()
+	}
+	return err
+}
@@ -0,0 +1,160 @@
+package containersstoragesource
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/distribution/reference"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"github.com/anchore/stereoscope/pkg/image"
+)
+
+var imageIDPrefixPattern = regexp.MustCompile(`^[a-f0-9]{3,64}$`)
+
+// findImage returns the image matching the given reference (a name, a name with a digest, or an image ID) along with
+// its config. When a platform is given, the image must have been built for that platform, where images that are no
+// longer tagged with the given name are considered too (since pulling another platform of the same name moves the
+// name to the newly pulled image).
+func (s store) findImage(ref string, platform *image.Platform) (*storageImage, []byte, error) {
+	images, err := s.images()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	candidates := matchingImages(images, ref, platform != nil)
+	if len(candidates) == 0 {
+		return nil, nil, fmt.Errorf("unable to find image %q within containers-storage %q", ref, s.root)
+	}
+
+	for _, img := range candidates {
+		rawConfig, err := s.bigData(img, configBigDataKey(img))
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to read config of image %q: %w", img.ID, err)
+		}
+		if platform == nil || matchesPlatform(rawConfig, *platform) {
+			return &img, rawConfig, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("unable to find image %q for platform %q within containers-storage %q", ref, platform.String(), s.root)
+}
+
+// configBigDataKey returns the key of the config of an image within the image big data, which is the digest of the
+// config (the image ID).
+func configBigDataKey(img storageImage) string {
+	return "sha256:" + img.ID
+}
+
+// matchingImages returns the images matching the given reference, ordered by how closely each matches: images tagged
+// with the name, then images previously tagged with the name (when withHistory is set), and finally images matching a
+// short name (e.g. "myimage" is matched by "localhost/myimage:latest", as podman does).
+func matchingImages(images []storageImage, ref string, withHistory bool) []storageImage {
+	var exact, history, short []storageImage
+	if named, err := reference.ParseNormalizedNamed(ref); err == nil {
+		shortName := !hasDomain(ref)
+		for _, img := range images {
+			switch {
+			case matchesAnyName(named, img, img.Names):
+				exact = append(exact, img)
+			case withHistory && matchesAnyName(named, img, img.NamesHistory):
+				history = append(history, img)
+			case shortName && matchesAnyShortName(named, img):
+				short = append(short, img)
+			}
+		}
+	}
+	if matches := append(append(exact, history...), short...); len(matches) > 0 {
+		return matches
+	}
+
+	// the reference may be (a prefix of) an image ID instead
+	id := strings.TrimPrefix(ref, "sha256:")
+	if !imageIDPrefixPattern.MatchString(id) {
+		return nil
+	}
+	var matches []storageImage
+	for _, img := range images {
+		if strings.HasPrefix(img.ID, id) {
+			matches = append(matches, img)
+		}
+	}
+	if len(matches) > 1 {
+		// an ambiguous prefix does not identify an image
+		return nil
+	}
+	return matches
+}
+
+func matchesAnyName(named reference.Named, img storageImage, names []string) bool {
+	canonical, hasDigest := named.(reference.Canonical)
+	if !hasDigest {
+		tagged := reference.TagNameOnly(named).String()
+		return slices.Contains(names, tagged)
+	}
+
+	if slices.Contains(names, canonical.String()) {
+		return true
+	}
+	for _, n := range names {
+		if stored, err := reference.ParseNormalizedNamed(n); err == nil && stored.Name() == named.Name() {
+			return matchesDigest(img, canonical.Digest().String())
+		}
+	}
+	return false
+}
+
+func matchesAnyShortName(named reference.Named, img storageImage) bool {
+	familiar := reference.FamiliarName(named)
+	canonical, hasDigest := named.(reference.Canonical)
+	tag := ""
+	if tagged, ok := reference.TagNameOnly(named).(reference.Tagged); ok && !hasDigest {
+		tag = tagged.Tag()
+	}
+
+	for _, n := range img.Names {
+		stored, err := reference.ParseNormalizedNamed(n)
+		if err != nil || reference.Path(stored) != familiar {
+			continue
+		}
+		if hasDigest {
+			return matchesDigest(img, canonical.Digest().String())
+		}
+		if storedTag, ok := stored.(reference.Tagged); ok && storedTag.Tag() == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesDigest indicates if the image has a manifest with the given digest (which includes the manifest list of a
+// multi-arch image the image was pulled from).
+func matchesDigest(img storageImage, digest string) bool {
+	return img.Digest == digest || slices.Contains(img.BigDataNames, manifestDigestBigDataKeyPrefix+digest)
+}
+
+// hasDomain indicates if the given reference explicitly names a registry (following the same rules as the docker
+// reference grammar, where the first component is a domain when it has a "." or ":", or is "localhost").
+func hasDomain(ref string) bool {
+	first, _, found := strings.Cut(ref, "/")
+	if !found {
+		return false
+	}
+	return strings.ContainsAny(first, ".:") || first == "localhost"
+}
+
+func matchesPlatform(rawConfig []byte, platform image.Platform) bool {
+	var cfg v1.ConfigFile
+	if err := json.Unmarshal(rawConfig, &cfg); err != nil {
+		return false
+	}
+	if platform.OS != "" && cfg.OS != platform.OS {
+		return false
+	}
+	if platform.Architecture != "" && cfg.Architecture != platform.Architecture {
+		return false
+	}
+	return platform.Variant == "" || cfg.Variant == platform.Variant
+}
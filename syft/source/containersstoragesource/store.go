@@ -0,0 +1,270 @@
+package containersstoragesource
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/pelletier/go-toml"
+
+	"github.com/anchore/syft/internal/log"
+)
+
+const (
+	// manifestBigDataKey is the key of the (per-platform) manifest of an image within the image big data.
+	manifestBigDataKey = "manifest"
+
+	// manifestDigestBigDataKeyPrefix prefixes the keys of the manifests of an image by digest (which includes the
+	// manifest list of a multi-arch image), e.g. "manifest-sha256:...".
+	manifestDigestBigDataKeyPrefix = manifestBigDataKey + "-"
+)
+
+// layerContentDirs are the directories containing the (extracted) content of a layer, by storage driver.
+var layerContentDirs = map[string]func(root, id string) string{
+	"overlay": func(root, id string) string { return filepath.Join(root, "overlay", id, "diff") },
+	"vfs":     func(root, id string) string { return filepath.Join(root, "vfs", "dir", id) },
+	"btrfs":   func(root, id string) string { return filepath.Join(root, "btrfs", "subvolumes", id) },
+}
+
+// store is a containers-storage store on disk (as written by podman, buildah, skopeo, and cri-o), which consists of
+// the metadata of the images and layers (images.json and layers.json) and the content of the layers.
+type store struct {
+	root   string
+	driver string
+}
+
+// storageImage is an image entry within <driver>-images/images.json.
+type storageImage struct {
+	ID           string   `json:"id"`
+	Digest       string   `json:"digest,omitempty"`
+	Names        []string `json:"names,omitempty"`
+	NamesHistory []string `json:"names-history,omitempty"`
+	TopLayer     string   `json:"layer,omitempty"`
+	BigDataNames []string `json:"big-data-names,omitempty"`
+}
+
+// storageLayer is a layer entry within <driver>-layers/layers.json.
+type storageLayer struct {
+	ID                 string `json:"id"`
+	Parent             string `json:"parent,omitempty"`
+	CompressedDigest   string `json:"compressed-diff-digest,omitempty"`
+	CompressedSize     int64  `json:"compressed-size,omitempty"`
+	UncompressedDigest string `json:"diff-digest,omitempty"`
+	UncompressedSize   int64  `json:"diff-size,omitempty"`
+}
+
+// storageConfig is the subset of storage.conf describing where the store is.
+type storageConfig struct {
+	Storage struct {
+		Driver              string `toml:"driver"`
+		GraphRoot           string `toml:"graphroot"`
+		RootlessStoragePath string `toml:"rootless_storage_path"`
+	} `toml:"storage"`
+}
+
+// splitStoreSpec splits the store specification from the given image reference, which may be given as
+// "[driver@/path/to/graphroot+/path/to/runroot]image:tag" (as done by the containers-storage transport).
+func splitStoreSpec(userInput string) (driver, root, ref string) {
+	if !strings.HasPrefix(userInput, "[") {
+		return "", "", userInput
+	}
+	spec, ref, found := strings.Cut(userInput[1:], "]")
+	if !found {
+		return "", "", userInput
+	}
+	if d, r, hasDriver := strings.Cut(spec, "@"); hasDriver {
+		driver, spec = d, r
+	}
+	root, _, _ = strings.Cut(spec, "+")
+	return driver, root, ref
+}
+
+// openStore returns the store with the given driver and root, where the defaults are taken from storage.conf (in the
+// same way podman does for the current user).
+func openStore(driver, root string) (*store, error) {
+	if root == "" || driver == "" {
+		cfg := readStorageConfig()
+		if driver == "" {
+			driver = cfg.Storage.Driver
+		}
+		if root == "" {
+			root = defaultStorageRoot(cfg)
+		}
+	}
+
+	root, err := homedir.Expand(root)
+	if err != nil {
+		return nil, fmt.Errorf("unable to expand containers-storage root: %w", err)
+	}
+
+	if driver == "" {
+		driver = detectStorageDriver(root)
+	}
+	if _, ok := layerContentDirs[driver]; !ok {
+		return nil, fmt.Errorf("unsupported containers-storage driver: %q", driver)
+	}
+
+	s := &store{
+		root:   root,
+		driver: driver,
+	}
+	if _, err := os.Stat(s.imagesPath()); err != nil {
+		return nil, fmt.Errorf("unable to find containers-storage images within %q: %w", root, err)
+	}
+	return s, nil
+}
+
+func readStorageConfig() storageConfig {
+	var cfg storageConfig
+	for _, p := range storageConfigPaths() {
+		fh, err := os.Open(p)
+		if err != nil {
+			continue
+		}
+		tree, err := toml.LoadReader(fh)
+		_ = fh.Close()
+		if err == nil {
+			err = tree.Unmarshal(&cfg)
+		}
+		if err != nil {
+			log.WithFields("path", p, "error", err).Debug("unable to parse containers storage config")
+			continue
+		}
+		return cfg
+	}
+	return cfg
+}
+
+func storageConfigPaths() []string {
+	if p := os.Getenv("CONTAINERS_STORAGE_CONF"); p != "" {
+		return []string{p}
+	}
+	var paths []string
+	if os.Geteuid() != 0 {
+		configHome := os.Getenv("XDG_CONFIG_HOME")
+		if configHome == "" {
+			configHome = filepath.Join("~", ".config")
+		}
+		if p, err := homedir.Expand(filepath.Join(configHome, "containers", "storage.conf")); err == nil {
+			paths = append(paths, p)
+		}
+	}
+	return append(paths, "/etc/containers/storage.conf", "/usr/share/containers/storage.conf")
+}
+
+func defaultStorageRoot(cfg storageConfig) string {
+	if os.Geteuid() == 0 {
+		if cfg.Storage.GraphRoot != "" {
+			return cfg.Storage.GraphRoot
+		}
+		return "/var/lib/containers/storage"
+	}
+
+	if cfg.Storage.RootlessStoragePath != "" {
+		return os.ExpandEnv(cfg.Storage.RootlessStoragePath)
+	}
+	dataHome := os.Getenv("XDG_DATA_HOME")
+	if dataHome == "" {
+		dataHome = filepath.Join("~", ".local", "share")
+	}
+	return filepath.Join(dataHome, "containers", "storage")
+}
+
+// detectStorageDriver returns the driver of the store at the given root, based on the metadata present (defaulting to
+// overlay, the default driver of podman).
+func detectStorageDriver(root string) string {
+	for _, driver := range []string{"overlay", "vfs", "btrfs"} {
+		if _, err := os.Stat(filepath.Join(root, driver+"-images", "images.json")); err == nil {
+			return driver
+		}
+	}
+	return "overlay"
+}
+
+func (s store) imagesPath() string {
+	return filepath.Join(s.root, s.driver+"-images", "images.json")
+}
+
+func (s store) layersPath() string {
+	return filepath.Join(s.root, s.driver+"-layers", "layers.json")
+}
+
+func (s store) images() ([]storageImage, error) {
+	var images []storageImage
+	if err := readJSONFile(s.imagesPath(), &images); err != nil {
+		return nil, fmt.Errorf("unable to read containers-storage images: %w", err)
+	}
+	return images, nil
+}
+
+// layerChain returns the layers of the given image, starting with the base layer.
+func (s store) layerChain(img storageImage) ([]storageLayer, error) {
+	var layers []storageLayer
+	if err := readJSONFile(s.layersPath(), &layers); err != nil {
+		return nil, fmt.Errorf("unable to read containers-storage layers: %w", err)
+	}
+	byID := make(map[string]storageLayer, len(layers))
+	for _, l := range layers {
+		byID[l.ID] = l
+	}
+
+	var chain []storageLayer
+	for id := img.TopLayer; id != ""; {
+		l, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("unable to find layer %q of image %q", id, img.ID)
+		}
+		if len(chain) > len(layers) {
+			return nil, fmt.Errorf("cycle within the layers of image %q", img.ID)
+		}
+		chain = append([]storageLayer{l}, chain...)
+		id = l.Parent
+	}
+	return chain, nil
+}
+
+// bigData returns the data stored with the image under the given key (e.g. the manifest or the config).
+func (s store) bigData(img storageImage, key string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(s.root, s.driver+"-images", img.ID, bigDataFileName(key)))
+}
+
+// layerContentDir returns the directory with the content of the given layer.
+func (s store) layerContentDir(l storageLayer) string {
+	return layerContentDirs[s.driver](s.root, l.ID)
+}
+
+// layerTarSplitPath returns the tar-split metadata of the given layer, which allows reassembling the layer tar from
+// the layer content.
+func (s store) layerTarSplitPath(l storageLayer) string {
+	return filepath.Join(s.root, s.driver+"-layers", l.ID+".tar-split.gz")
+}
+
+// bigDataFileName returns the name of the file a big data item is stored in, where the key is base64 encoded when it
+// has characters other than lowercase letters, digits, and dots.
+func bigDataFileName(key string) string {
+	for _, c := range key {
+		if c != '.' && (c < '0' || c > '9') && (c < 'a' || c > 'z') {
+			return "=" + base64.StdEncoding.EncodeToString([]byte(key))
+		}
+	}
+	return key
+}
+
+func readJSONFile(path string, v any) error {
+	fh, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer fh.Close()
+
+	if err := json.NewDecoder(fh).Decode(v); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("unable to parse %q: %w", path, err)
+	}
+	return nil
+}
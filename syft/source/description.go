@@ -6,4 +6,8 @@ type Description struct {
 	Name     string `hash:"ignore"`
 	Version  string `hash:"ignore"`
 	Metadata interface{}
+
+	// Fingerprint is a reproducible identity of the cataloged contents (the image manifest digest, or a hash of the
+	// file digests of a directory), when computed (see cataloging.SourceFingerprintConfig)
+	Fingerprint string `hash:"ignore"`
 }
@@ -7,7 +7,6 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/bmatcuk/doublestar/v4"
 	"github.com/opencontainers/go-digest"
 
 	"github.com/anchore/syft/internal/log"
@@ -30,7 +29,7 @@ type Config struct {
 type directorySource struct {
 	id       artifact.ID
 	config   Config
-	resolver *fileresolver.Directory
+	resolver file.Resolver
 	mutex    *sync.Mutex
 }
 
@@ -137,17 +136,28 @@ func (s *directorySource) FileResolver(_ source.Scope) (file.Resolver, error) {
 	defer s.mutex.Unlock()
 
 	if s.resolver == nil {
-		exclusionFunctions, err := GetDirectoryExclusionFunctions(s.config.Path, s.config.Exclude.Paths)
+		exclusionFunctions, err := GetDirectoryExclusionFunctionsFromConfig(s.config.Path, s.config.Exclude)
 		if err != nil {
 			return nil, err
 		}
 
+		if s.config.Exclude.SyftIgnore {
+			syftIgnoreExclusionFunction, err := getSyftIgnoreExclusionFunction(s.config.Path)
+			if err != nil {
+				return nil, err
+			}
+			exclusionFunctions = append(exclusionFunctions, syftIgnoreExclusionFunction)
+		}
+
 		res, err := fileresolver.NewFromDirectory(s.config.Path, s.config.Base, exclusionFunctions...)
 		if err != nil {
 			return nil, fmt.Errorf("unable to create directory resolver: %w", err)
 		}
 
 		s.resolver = res
+		if s.config.Exclude.CaseInsensitive {
+			s.resolver = fileresolver.NewCaseInsensitiveDecorator(res)
+		}
 	}
 
 	return s.resolver, nil
@@ -161,6 +171,14 @@ func (s *directorySource) Close() error {
 }
 
 func GetDirectoryExclusionFunctions(root string, exclusions []string) ([]fileresolver.PathIndexVisitor, error) {
+	return GetDirectoryExclusionFunctionsFromConfig(root, source.ExcludeConfig{Paths: exclusions})
+}
+
+// GetDirectoryExclusionFunctionsFromConfig returns the visitors excluding the paths (relative to the scan root) that
+// match the exclusion globs of the given configuration.
+func GetDirectoryExclusionFunctionsFromConfig(root string, cfg source.ExcludeConfig) ([]fileresolver.PathIndexVisitor, error) {
+	// copy, since the exclusions are made absolute below
+	exclusions := append([]string(nil), cfg.Paths...)
 	if len(exclusions) == 0 {
 		return nil, nil
 	}
@@ -198,7 +216,7 @@ func GetDirectoryExclusionFunctions(root string, exclusions []string) ([]fileres
 			for _, exclusion := range exclusions {
 				// this is required to handle Windows filepaths
 				path = filepath.ToSlash(path)
-				matches, err := doublestar.Match(exclusion, path)
+				matches, err := cfg.MatchesGlob(exclusion, path)
 				if err != nil {
 					return nil
 				}
@@ -312,14 +312,77 @@ func Test_DirectorySource_Exclusions(t *testing.T) {
 	}
 }
 
+func Test_DirectorySource_SyftIgnore(t *testing.T) {
+	testutil.Chdir(t, "..") // run with source/test-fixtures
+
+	tests := []struct {
+		name       string
+		syftIgnore bool
+		expected   []string
+	}{
+		{
+			name:       "syftignore files are honored",
+			syftIgnore: true,
+			expected: []string{
+				".syftignore",
+				"keep.log",
+				"src/.syftignore",
+				"src/main.py",
+			},
+		},
+		{
+			name: "syftignore files are ignored by default",
+			expected: []string{
+				".syftignore",
+				"app.log",
+				"build/out.txt",
+				"keep.log",
+				"src/.syftignore",
+				"src/generated/gen.py",
+				"src/main.py",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			src, err := New(Config{
+				Path: "test-fixtures/syftignore",
+				Exclude: source.ExcludeConfig{
+					Paths:      []string{"**/notes.txt"},
+					SyftIgnore: test.syftIgnore,
+				},
+			})
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				require.NoError(t, src.Close())
+			})
+
+			res, err := src.FileResolver(source.SquashedScope)
+			require.NoError(t, err)
+
+			locations, err := res.FilesByGlob("**")
+			require.NoError(t, err)
+
+			var actual []string
+			for _, l := range locations {
+				actual = append(actual, l.RealPath)
+			}
+
+			assert.ElementsMatchf(t, test.expected, actual, "diff \n"+cmp.Diff(test.expected, actual))
+		})
+	}
+}
+
 func Test_getDirectoryExclusionFunctions_crossPlatform(t *testing.T) {
 	testCases := []struct {
-		desc     string
-		root     string
-		path     string
-		finfo    os.FileInfo
-		exclude  string
-		walkHint error
+		desc    string
+		root    string
+		path    string
+		finfo   os.FileInfo
+		exclude string
+		// caseInsensitive matches the exclusion regardless of case (e.g. for Windows filesystems)
+		caseInsensitive bool
+		walkHint        error
 	}{
 		{
 			desc:     "directory exclusion",
@@ -392,11 +455,58 @@ func Test_getDirectoryExclusionFunctions_crossPlatform(t *testing.T) {
 			finfo:    file.ManualInfo{},
 			walkHint: nil,
 		},
+		{
+			desc:     "windows mixed case is case sensitive by default",
+			root:     "/C:/Users/stuff",
+			path:     "/C:/Users/stuff/Program Files/App/thing.TXT",
+			exclude:  "./program files/**/*.txt",
+			finfo:    file.ManualInfo{},
+			walkHint: nil,
+		},
+		{
+			desc:            "windows mixed case path",
+			root:            "/C:/Users/stuff",
+			path:            "/C:/Users/stuff/Program Files/App/thing.TXT",
+			exclude:         "./program files/**/*.txt",
+			caseInsensitive: true,
+			finfo:           file.ManualInfo{},
+			walkHint:        fileresolver.ErrSkipPath,
+		},
+		{
+			desc:            "windows mixed case exclusion",
+			root:            "/c:/users/stuff",
+			path:            "/C:/Users/Stuff/Windows/System32",
+			exclude:         "./WINDOWS/**",
+			caseInsensitive: true,
+			finfo:           file.ManualInfo{ModeValue: os.ModeDir},
+			walkHint:        fs.SkipDir,
+		},
+		{
+			desc:            "windows mixed case doublestar",
+			root:            "/C:/Users/stuff",
+			path:            "/C:/Users/stuff/AppData/Local/Temp/setup.LOG",
+			exclude:         "**/temp/*.log",
+			caseInsensitive: true,
+			finfo:           file.ManualInfo{},
+			walkHint:        fileresolver.ErrSkipPath,
+		},
+		{
+			desc:            "windows mixed case non-matching",
+			root:            "/C:/Users/stuff",
+			path:            "/C:/Users/stuff/Program Files/App/thing.exe",
+			exclude:         "./program files/**/*.txt",
+			caseInsensitive: true,
+			finfo:           file.ManualInfo{},
+			walkHint:        nil,
+		},
 	}
 
 	for _, test := range testCases {
 		t.Run(test.desc, func(t *testing.T) {
-			fns, err := GetDirectoryExclusionFunctions(test.root, []string{test.exclude})
+			fns, err := GetDirectoryExclusionFunctionsFromConfig(test.root, source.ExcludeConfig{
+				Paths:           []string{test.exclude},
+				CaseInsensitive: test.caseInsensitive,
+			})
 			require.NoError(t, err)
 
 			for _, f := range fns {
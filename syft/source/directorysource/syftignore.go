@@ -0,0 +1,86 @@
+package directorysource
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/internal/fileresolver"
+)
+
+const syftIgnoreFileName = ".syftignore"
+
+// getSyftIgnoreExclusionFunction returns a visitor that excludes the paths matching the patterns (in gitignore
+// syntax) of the .syftignore files within the scan root. As with .gitignore files, the patterns of a .syftignore
+// file in a subdirectory only apply to paths within that subdirectory. Each .syftignore file is read when its
+// directory is visited, which is always before the contents of the directory.
+func getSyftIgnoreExclusionFunction(root string) (fileresolver.PathIndexVisitor, error) {
+	// this is what directoryResolver.indexTree is doing to get the absolute path:
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var patterns []gitignore.Pattern
+	return func(_, path string, info os.FileInfo, _ error) error {
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == ".." || strings.HasPrefix(rel, "../") {
+			// paths outside the scan root (e.g. resolved from symlinks) are not subject to the .syftignore files
+			return nil
+		}
+
+		var parts []string
+		if rel != "." {
+			parts = strings.Split(rel, "/")
+		}
+		isDir := info != nil && info.IsDir()
+
+		if len(parts) > 0 && len(patterns) > 0 && gitignore.NewMatcher(patterns).Match(parts, isDir) {
+			if isDir {
+				return filepath.SkipDir
+			}
+			return fileresolver.ErrSkipPath
+		}
+
+		if isDir {
+			patterns = append(patterns, readSyftIgnore(path, parts)...)
+		}
+		return nil
+	}, nil
+}
+
+// readSyftIgnore returns the patterns of the .syftignore file within the given directory (if any), which apply to
+// the paths within the given domain (the path elements of the directory relative to the scan root).
+func readSyftIgnore(dir string, domain []string) []gitignore.Pattern {
+	f, err := os.Open(filepath.Join(dir, syftIgnoreFileName))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.WithFields("path", dir, "error", err).Debug("unable to read .syftignore file")
+		}
+		return nil
+	}
+	defer f.Close()
+
+	var patterns []gitignore.Pattern
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+		patterns = append(patterns, gitignore.ParsePattern(line, domain))
+	}
+	if err := scanner.Err(); err != nil {
+		log.WithFields("path", dir, "error", err).Debug("unable to read .syftignore file")
+	}
+	return patterns
+}
@@ -1,5 +1,32 @@
 package source
 
+import (
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
 type ExcludeConfig struct {
 	Paths []string
+
+	// CaseInsensitive indicates that the exclusion globs, along with the globs and paths requested from the resolver of
+	// the source (e.g. by catalogers), match paths regardless of case, which is useful when scanning filesystems from
+	// case-insensitive hosts (e.g. a Windows filesystem dump, where "C:/Program Files" and "c:/program files" are the
+	// same path). Paths are matched case-sensitively by default.
+	CaseInsensitive bool
+
+	// SyftIgnore indicates that directory sources additionally exclude the paths matching the patterns of any
+	// .syftignore files (in the same syntax as .gitignore files) within the scan root. These files are ignored by
+	// default.
+	SyftIgnore bool
+}
+
+// MatchesGlob reports whether the path matches the given exclusion glob (in the doublestar syntax), honoring the case
+// sensitivity of the configuration.
+func (c ExcludeConfig) MatchesGlob(pattern, path string) (bool, error) {
+	if c.CaseInsensitive {
+		pattern = strings.ToLower(pattern)
+		path = strings.ToLower(path)
+	}
+	return doublestar.Match(pattern, path)
 }
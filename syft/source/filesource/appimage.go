@@ -0,0 +1,99 @@
+package filesource
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/anchore/syft/syft/source/internal"
+)
+
+// appImageMagic is found within the padding of the ELF identification of (type 2) AppImage files, which are an ELF
+// runtime with a squashfs filesystem appended (see https://github.com/AppImage/AppImageSpec/blob/master/draft.md).
+var appImageMagic = []byte{'A', 'I', 0x02}
+
+// appImageMagicOffset is the offset of the AppImage magic within the ELF identification (EI_PAD).
+const appImageMagicOffset = 8
+
+// isAppImage indicates if the file at the given path is a (type 2) AppImage, based on the file contents.
+func isAppImage(path string) bool {
+	fh, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer fh.Close()
+
+	ident := make([]byte, appImageMagicOffset+len(appImageMagic))
+	if _, err := io.ReadFull(fh, ident); err != nil {
+		return false
+	}
+	return bytes.Equal(ident[:len(elf.ELFMAG)], []byte(elf.ELFMAG)) && bytes.Equal(ident[appImageMagicOffset:], appImageMagic)
+}
+
+func unpackAppImageToTmp(path string, maxEntries int) (string, func() error, error) {
+	tempDir, err := os.MkdirTemp("", "syft-appimage-contents-")
+	if err != nil {
+		return "", func() error { return nil }, fmt.Errorf("unable to create tempdir for appimage processing: %w", err)
+	}
+
+	cleanupFn := func() error {
+		return os.RemoveAll(tempDir)
+	}
+
+	fh, err := os.Open(path)
+	if err != nil {
+		return "", cleanupFn, fmt.Errorf("unable to open appimage file=%q: %w", path, err)
+	}
+	defer fh.Close()
+
+	fi, err := fh.Stat()
+	if err != nil {
+		return "", cleanupFn, fmt.Errorf("unable to stat appimage file=%q: %w", path, err)
+	}
+
+	offset, err := appImageSquashFSOffset(fh)
+	if err != nil {
+		return "", cleanupFn, err
+	}
+	if offset <= 0 || offset >= fi.Size() {
+		return "", cleanupFn, fmt.Errorf("appimage has no filesystem appended to the runtime")
+	}
+
+	fsys := io.NewSectionReader(fh, offset, fi.Size()-offset)
+	magic := make([]byte, len(squashFSMagic))
+	if _, err := fsys.ReadAt(magic, 0); err != nil || !bytes.Equal(magic, squashFSMagic) {
+		return "", cleanupFn, fmt.Errorf("appimage does not have a squashfs filesystem appended to the runtime")
+	}
+
+	return tempDir, cleanupFn, extractSquashFS(fsys, tempDir, maxSquashFSExtractionSize, internal.NewEntryLimit(maxEntries))
+}
+
+// appImageSquashFSOffset returns the offset of the filesystem appended to the ELF runtime of an AppImage, which is
+// the end of the ELF file: the section header table is the last part of the runtime.
+func appImageSquashFSOffset(r io.ReaderAt) (int64, error) {
+	f, err := elf.NewFile(r)
+	if err != nil {
+		return 0, fmt.Errorf("unable to read appimage runtime: %w", err)
+	}
+	defer f.Close()
+
+	header := io.NewSectionReader(r, 0, 1<<16)
+	switch f.Class {
+	case elf.ELFCLASS64:
+		var h elf.Header64
+		if err := binary.Read(header, f.ByteOrder, &h); err != nil {
+			return 0, fmt.Errorf("unable to read appimage runtime header: %w", err)
+		}
+		return int64(h.Shoff) + int64(h.Shentsize)*int64(h.Shnum), nil
+	case elf.ELFCLASS32:
+		var h elf.Header32
+		if err := binary.Read(header, f.ByteOrder, &h); err != nil {
+			return 0, fmt.Errorf("unable to read appimage runtime header: %w", err)
+		}
+		return int64(h.Shoff) + int64(h.Shentsize)*int64(h.Shnum), nil
+	}
+	return 0, fmt.Errorf("unsupported appimage runtime class: %s", f.Class)
+}
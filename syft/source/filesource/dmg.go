@@ -0,0 +1,590 @@
+package filesource
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/syft/source/internal"
+)
+
+const (
+	dmgSectorSize = 512
+
+	// the UDIF trailer ("koly" block) is found at the end of every UDIF disk image
+	udifTrailerSize = 512
+
+	// bound the size of the property list describing the block tables, which is read into memory
+	udifMaxPlistSize = 64 * 1024 * 1024
+
+	// bound the number of chunks (across all block tables), since each is held in memory
+	udifMaxChunks = 1 << 20
+
+	// bound the decompressed size of a single chunk, which is read into memory (hdiutil writes chunks of 1 MB)
+	udifMaxChunkSize = 64 * 1024 * 1024
+
+	// the number of decompressed chunks kept in memory, since neighbouring reads commonly fall within the same chunk
+	udifChunkCacheSize = 4
+
+	// bound the number of partition map entries considered
+	dmgMaxPartitions = 128
+)
+
+// the kinds of chunks within a UDIF block table
+const (
+	udifChunkZero       = 0x00000000
+	udifChunkRaw        = 0x00000001
+	udifChunkIgnore     = 0x00000002
+	udifChunkADC        = 0x80000004
+	udifChunkZlib       = 0x80000005
+	udifChunkBzip2      = 0x80000006
+	udifChunkLZFSE      = 0x80000007
+	udifChunkLZMA       = 0x80000008
+	udifChunkComment    = 0x7ffffffe
+	udifChunkTerminator = 0xffffffff
+)
+
+var (
+	udifTrailerMagic    = []byte("koly")
+	udifBlockTableMagic = []byte("mish")
+	gptMagic            = []byte("EFI PART")
+	apmMagic            = []byte("PM")
+	// the APFS container superblock magic, found 32 bytes into a partition
+	apfsMagic = []byte("NXSB")
+)
+
+// maxDMGExtractionSize is the upper bound on the total size of file contents extracted from a DMG disk image, which
+// protects against decompression bombs (10 GB).
+var maxDMGExtractionSize int64 = 10 * 1024 * 1024 * 1024
+
+var (
+	errDMGTooLarge    = errors.New("dmg contents exceed the max extraction size")
+	errDMGMalformed   = errors.New("malformed dmg disk image")
+	errDMGUnsupported = errors.New("unsupported dmg disk image")
+)
+
+// isDMG indicates if the file at the given path is a macOS disk image, based on the UDIF trailer at the end of the
+// file. Images without a trailer (e.g. uncompressed images) are only identified by the .dmg extension.
+func isDMG(path string) bool {
+	if strings.EqualFold(filepath.Ext(path), ".dmg") {
+		return true
+	}
+
+	fh, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer fh.Close()
+
+	fi, err := fh.Stat()
+	if err != nil || fi.Size() < udifTrailerSize {
+		return false
+	}
+	return hasUDIFTrailer(fh, fi.Size())
+}
+
+func hasUDIFTrailer(r io.ReaderAt, size int64) bool {
+	if size < udifTrailerSize {
+		return false
+	}
+	magic := make([]byte, len(udifTrailerMagic))
+	if _, err := r.ReadAt(magic, size-udifTrailerSize); err != nil {
+		return false
+	}
+	return bytes.Equal(magic, udifTrailerMagic)
+}
+
+func unpackDMGToTmp(path string, maxEntries int) (string, func() error, error) {
+	tempDir, err := os.MkdirTemp("", "syft-dmg-contents-")
+	if err != nil {
+		return "", func() error { return nil }, fmt.Errorf("unable to create tempdir for dmg processing: %w", err)
+	}
+
+	cleanupFn := func() error {
+		return os.RemoveAll(tempDir)
+	}
+
+	fh, err := os.Open(path)
+	if err != nil {
+		return "", cleanupFn, fmt.Errorf("unable to open dmg file=%q: %w", path, err)
+	}
+	defer fh.Close()
+
+	fi, err := fh.Stat()
+	if err != nil {
+		return "", cleanupFn, fmt.Errorf("unable to stat dmg file=%q: %w", path, err)
+	}
+
+	return tempDir, cleanupFn, extractDMG(fh, fi.Size(), tempDir, maxDMGExtractionSize, internal.NewEntryLimit(maxEntries))
+}
+
+// extractDMG writes all directories, regular files, and symlinks from the HFS+ (or HFSX) volume within the disk image
+// to the given destination directory. The disk is read from the block tables of UDIF images (with raw, zlib, bzip2,
+// or ADC compressed chunks), or as-is for images without a UDIF trailer. The volume is found at the start of the disk
+// or within a GUID or Apple partition map. Extraction stops with an error once the total size of file contents
+// exceeds maxSize, or once the entry limit is reached.
+func extractDMG(r io.ReaderAt, size int64, dest string, maxSize int64, limit *internal.EntryLimit) error {
+	disk, diskSize := r, size
+	if hasUDIFTrailer(r, size) {
+		udif, err := readUDIF(r, size)
+		if err != nil {
+			return err
+		}
+		disk, diskSize = udif, udif.size
+	}
+
+	offset, volumeSize, err := findHFSPlusVolume(disk, diskSize)
+	if err != nil {
+		return err
+	}
+
+	return extractHFSPlus(io.NewSectionReader(disk, offset, volumeSize), volumeSize, dest, maxSize, limit)
+}
+
+// UDIF images ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// udifChunk is a run of sectors of the disk, which is stored (possibly compressed) at the given offset of the image.
+type udifChunk struct {
+	kind             uint32
+	sector           int64
+	sectors          int64
+	compressedOffset int64
+	compressedLength int64
+}
+
+// udifDisk reads the disk held by a UDIF image, decompressing chunks as they are read. Sectors not described by any
+// chunk read as zeros.
+type udifDisk struct {
+	r      io.ReaderAt
+	size   int64
+	chunks []udifChunk
+	cache  []udifCachedChunk
+}
+
+type udifCachedChunk struct {
+	index int
+	data  []byte
+}
+
+// readUDIF reads the block tables listed by the property list referenced from the UDIF trailer.
+func readUDIF(r io.ReaderAt, size int64) (*udifDisk, error) {
+	trailer := make([]byte, udifTrailerSize)
+	if _, err := r.ReadAt(trailer, size-udifTrailerSize); err != nil {
+		return nil, fmt.Errorf("%w: unable to read udif trailer: %v", errDMGMalformed, err)
+	}
+
+	dataForkOffset := int64(binary.BigEndian.Uint64(trailer[24:]))
+	plistOffset := int64(binary.BigEndian.Uint64(trailer[216:]))
+	plistLength := int64(binary.BigEndian.Uint64(trailer[224:]))
+	sectors := int64(binary.BigEndian.Uint64(trailer[492:]))
+
+	if plistLength == 0 {
+		// older images describe the block tables within a resource fork instead
+		return nil, fmt.Errorf("%w: udif image without a property list", errDMGUnsupported)
+	}
+	if plistOffset < 0 || plistLength < 0 || plistLength > udifMaxPlistSize || plistOffset+plistLength > size {
+		return nil, fmt.Errorf("%w: udif property list is out of bounds", errDMGMalformed)
+	}
+	if sectors <= 0 || sectors > (1<<62)/dmgSectorSize {
+		return nil, fmt.Errorf("%w: invalid udif sector count %d", errDMGMalformed, sectors)
+	}
+
+	plist := make([]byte, plistLength)
+	if _, err := r.ReadAt(plist, plistOffset); err != nil {
+		return nil, fmt.Errorf("%w: unable to read udif property list: %v", errDMGMalformed, err)
+	}
+
+	tables, err := parseUDIFBlockTables(plist)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &udifDisk{
+		r:    r,
+		size: sectors * dmgSectorSize,
+	}
+	for _, table := range tables {
+		chunks, err := parseUDIFBlockTable(table, dataForkOffset, size, sectors)
+		if err != nil {
+			return nil, err
+		}
+		if len(d.chunks)+len(chunks) > udifMaxChunks {
+			return nil, fmt.Errorf("%w: too many udif chunks", errDMGMalformed)
+		}
+		d.chunks = append(d.chunks, chunks...)
+	}
+
+	sort.Slice(d.chunks, func(i, j int) bool {
+		return d.chunks[i].sector < d.chunks[j].sector
+	})
+	for i := 1; i < len(d.chunks); i++ {
+		prev := d.chunks[i-1]
+		if prev.sector+prev.sectors > d.chunks[i].sector {
+			return nil, fmt.Errorf("%w: overlapping udif chunks", errDMGMalformed)
+		}
+	}
+	return d, nil
+}
+
+// parseUDIFBlockTables returns the (decoded) data of each entry of the "blkx" array within the resource-fork
+// dictionary of the property list.
+func parseUDIFBlockTables(plist []byte) ([][]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(plist))
+	// the DOCTYPE references an external DTD, which should never be fetched or processed
+	decoder.Strict = false
+
+	var tables [][]byte
+	var key string
+	var inBlockTables, found bool
+	depth, arrayDepth := 0, 0
+	for {
+		tok, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: unable to parse udif property list: %v", errDMGMalformed, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			switch {
+			case t.Name.Local == "key":
+				var text string
+				if err := decoder.DecodeElement(&text, &t); err != nil {
+					return nil, fmt.Errorf("%w: unable to parse udif property list: %v", errDMGMalformed, err)
+				}
+				depth--
+				key = text
+			case t.Name.Local == "array" && key == "blkx" && !found:
+				inBlockTables, found = true, true
+				arrayDepth = depth
+			case t.Name.Local == "data" && inBlockTables && key == "Data":
+				var text string
+				if err := decoder.DecodeElement(&text, &t); err != nil {
+					return nil, fmt.Errorf("%w: unable to parse udif property list: %v", errDMGMalformed, err)
+				}
+				depth--
+				data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(text), ""))
+				if err != nil {
+					return nil, fmt.Errorf("%w: invalid udif block table: %v", errDMGMalformed, err)
+				}
+				tables = append(tables, data)
+			}
+		case xml.EndElement:
+			if inBlockTables && depth == arrayDepth {
+				inBlockTables = false
+			}
+			depth--
+		}
+	}
+
+	if len(tables) == 0 {
+		return nil, fmt.Errorf("%w: no udif block tables", errDMGMalformed)
+	}
+	return tables, nil
+}
+
+// parseUDIFBlockTable returns the chunks described by a block table ("mish" block), where sectors are relative to
+// the first sector of the table and compressed offsets are relative to the data fork.
+func parseUDIFBlockTable(table []byte, dataForkOffset, imageSize, diskSectors int64) ([]udifChunk, error) {
+	const headerSize, chunkSize = 204, 40
+	if len(table) < headerSize || !bytes.Equal(table[0:4], udifBlockTableMagic) {
+		return nil, fmt.Errorf("%w: invalid udif block table", errDMGMalformed)
+	}
+
+	first := int64(binary.BigEndian.Uint64(table[8:]))
+	count := int64(binary.BigEndian.Uint64(table[16:]))
+	dataOffset := int64(binary.BigEndian.Uint64(table[24:]))
+	numChunks := int(binary.BigEndian.Uint32(table[200:]))
+	if first < 0 || count < 0 || first+count > diskSectors || first+count < first {
+		return nil, fmt.Errorf("%w: udif block table is out of bounds", errDMGMalformed)
+	}
+	if numChunks > (len(table)-headerSize)/chunkSize {
+		return nil, fmt.Errorf("%w: udif block table is truncated", errDMGMalformed)
+	}
+
+	var chunks []udifChunk
+	for i := 0; i < numChunks; i++ {
+		raw := table[headerSize+i*chunkSize:]
+		c := udifChunk{
+			kind:             binary.BigEndian.Uint32(raw[0:]),
+			sector:           int64(binary.BigEndian.Uint64(raw[8:])),
+			sectors:          int64(binary.BigEndian.Uint64(raw[16:])),
+			compressedOffset: int64(binary.BigEndian.Uint64(raw[24:])),
+			compressedLength: int64(binary.BigEndian.Uint64(raw[32:])),
+		}
+
+		switch c.kind {
+		case udifChunkTerminator:
+			return chunks, nil
+		case udifChunkComment, udifChunkZero, udifChunkIgnore:
+			// sectors not described by any chunk read as zeros
+			continue
+		}
+
+		if c.sector < 0 || c.sectors <= 0 || c.sector+c.sectors > count || c.sector+c.sectors < c.sector {
+			return nil, fmt.Errorf("%w: udif chunk is out of bounds", errDMGMalformed)
+		}
+		if c.sectors*dmgSectorSize > udifMaxChunkSize {
+			return nil, fmt.Errorf("%w: udif chunk is too large", errDMGMalformed)
+		}
+		c.sector += first
+		c.compressedOffset += dataForkOffset + dataOffset
+		if c.compressedOffset < 0 || c.compressedLength < 0 || c.compressedLength > udifMaxChunkSize ||
+			c.compressedOffset+c.compressedLength > imageSize {
+			return nil, fmt.Errorf("%w: udif chunk data is out of bounds", errDMGMalformed)
+		}
+		chunks = append(chunks, c)
+	}
+	return chunks, nil
+}
+
+func (d *udifDisk) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("%w: negative offset", errDMGMalformed)
+	}
+
+	n := 0
+	for n < len(p) {
+		pos := off + int64(n)
+		if pos >= d.size {
+			return n, io.EOF
+		}
+		sector := pos / dmgSectorSize
+
+		// find the first chunk that ends after the current sector
+		i := sort.Search(len(d.chunks), func(i int) bool {
+			return d.chunks[i].sector+d.chunks[i].sectors > sector
+		})
+
+		end := d.size
+		if i < len(d.chunks) && d.chunks[i].sector <= sector {
+			data, err := d.chunkData(i)
+			if err != nil {
+				return n, err
+			}
+			start := d.chunks[i].sector * dmgSectorSize
+			n += copy(p[n:], data[pos-start:])
+			continue
+		} else if i < len(d.chunks) {
+			end = d.chunks[i].sector * dmgSectorSize
+		}
+
+		// the sectors before the next chunk are zeros
+		zeros := min(int64(len(p)-n), end-pos)
+		clear(p[n : n+int(zeros)])
+		n += int(zeros)
+	}
+	return n, nil
+}
+
+// chunkData returns the decompressed contents of the chunk with the given index.
+func (d *udifDisk) chunkData(index int) ([]byte, error) {
+	for _, cached := range d.cache {
+		if cached.index == index {
+			return cached.data, nil
+		}
+	}
+
+	data, err := d.decompressChunk(d.chunks[index])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(d.cache) == udifChunkCacheSize {
+		d.cache = d.cache[1:]
+	}
+	d.cache = append(d.cache, udifCachedChunk{index: index, data: data})
+	return data, nil
+}
+
+func (d *udifDisk) decompressChunk(c udifChunk) ([]byte, error) {
+	size := c.sectors * dmgSectorSize
+	compressed := io.NewSectionReader(d.r, c.compressedOffset, c.compressedLength)
+
+	var r io.Reader
+	switch c.kind {
+	case udifChunkRaw:
+		r = compressed
+	case udifChunkZlib:
+		zr, err := zlib.NewReader(compressed)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid zlib chunk: %v", errDMGMalformed, err)
+		}
+		defer zr.Close()
+		r = zr
+	case udifChunkBzip2:
+		r = bzip2.NewReader(compressed)
+	case udifChunkADC:
+		data := make([]byte, c.compressedLength)
+		if _, err := io.ReadFull(compressed, data); err != nil {
+			return nil, fmt.Errorf("%w: unable to read adc chunk: %v", errDMGMalformed, err)
+		}
+		decompressed, err := adcDecompress(data, size)
+		if err != nil {
+			return nil, err
+		}
+		r = bytes.NewReader(decompressed)
+	case udifChunkLZFSE, udifChunkLZMA:
+		return nil, fmt.Errorf("%w: udif chunk compression 0x%08x", errDMGUnsupported, c.kind)
+	default:
+		return nil, fmt.Errorf("%w: unknown udif chunk type 0x%08x", errDMGMalformed, c.kind)
+	}
+
+	// chunks may decompress to less than the sectors they describe, where the remainder reads as zeros
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: unable to decompress udif chunk: %v", errDMGMalformed, err)
+	}
+	return data, nil
+}
+
+// adcDecompress decodes Apple Data Compression (as used by older UDCO images), which is a series of literal runs
+// and back references into the output, up to the given size.
+func adcDecompress(data []byte, size int64) ([]byte, error) {
+	out := make([]byte, 0, size)
+	for i := 0; i < len(data) && int64(len(out)) < size; {
+		b := data[i]
+		var length, distance int
+		switch {
+		case b&0x80 != 0:
+			// a run of up to 128 literal bytes
+			length = int(b&0x7f) + 1
+			if i+1+length > len(data) {
+				return nil, fmt.Errorf("%w: truncated adc literal", errDMGMalformed)
+			}
+			out = append(out, data[i+1:i+1+length]...)
+			i += 1 + length
+			continue
+		case b&0x40 != 0:
+			// a three byte back reference of up to 67 bytes
+			if i+3 > len(data) {
+				return nil, fmt.Errorf("%w: truncated adc reference", errDMGMalformed)
+			}
+			length = int(b&0x3f) + 4
+			distance = int(binary.BigEndian.Uint16(data[i+1:]))
+			i += 3
+		default:
+			// a two byte back reference of up to 18 bytes
+			if i+2 > len(data) {
+				return nil, fmt.Errorf("%w: truncated adc reference", errDMGMalformed)
+			}
+			length = int((b&0x3c)>>2) + 3
+			distance = int(b&0x03)<<8 | int(data[i+1])
+			i += 2
+		}
+
+		start := len(out) - distance - 1
+		if start < 0 {
+			return nil, fmt.Errorf("%w: invalid adc reference", errDMGMalformed)
+		}
+		// the reference may overlap the bytes being written, so it is copied byte by byte
+		for j := 0; j < length; j++ {
+			out = append(out, out[start+j])
+		}
+	}
+	if int64(len(out)) > size {
+		out = out[:size]
+	}
+	return out, nil
+}
+
+// partition maps ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// dmgPartition is a region of the disk which may hold a volume.
+type dmgPartition struct {
+	offset int64
+	size   int64
+}
+
+// findHFSPlusVolume returns the offset and size of the first HFS+ (or HFSX) volume of the disk, which is either the
+// whole disk or a partition described by a GUID or Apple partition map.
+func findHFSPlusVolume(disk io.ReaderAt, size int64) (int64, int64, error) {
+	partitions := append([]dmgPartition{{offset: 0, size: size}}, gptPartitions(disk, size)...)
+	partitions = append(partitions, apmPartitions(disk, size)...)
+
+	var apfs bool
+	for _, p := range partitions {
+		if p.size <= 0 || p.offset < 0 || p.offset+p.size > size {
+			continue
+		}
+		if isHFSPlusVolume(io.NewSectionReader(disk, p.offset, p.size)) {
+			return p.offset, p.size, nil
+		}
+		magic := make([]byte, len(apfsMagic))
+		if _, err := disk.ReadAt(magic, p.offset+32); err == nil && bytes.Equal(magic, apfsMagic) {
+			apfs = true
+		}
+	}
+
+	if apfs {
+		return 0, 0, fmt.Errorf("%w: apfs volumes are not supported", errDMGUnsupported)
+	}
+	return 0, 0, fmt.Errorf("%w: no hfs+ volume found", errDMGUnsupported)
+}
+
+// gptPartitions returns the partitions listed by the GUID partition table following the protective MBR.
+func gptPartitions(disk io.ReaderAt, size int64) []dmgPartition {
+	header := make([]byte, 92)
+	if _, err := disk.ReadAt(header, dmgSectorSize); err != nil || !bytes.Equal(header[0:8], gptMagic) {
+		return nil
+	}
+
+	entriesLBA := int64(binary.LittleEndian.Uint64(header[72:]))
+	numEntries := int(binary.LittleEndian.Uint32(header[80:]))
+	entrySize := int(binary.LittleEndian.Uint32(header[84:]))
+	if entrySize < 128 || entrySize > 4096 {
+		return nil
+	}
+
+	var partitions []dmgPartition
+	entry := make([]byte, entrySize)
+	for i := 0; i < min(numEntries, dmgMaxPartitions); i++ {
+		if _, err := disk.ReadAt(entry, entriesLBA*dmgSectorSize+int64(i*entrySize)); err != nil {
+			break
+		}
+		if bytes.Equal(entry[0:16], make([]byte, 16)) {
+			// an unused entry
+			continue
+		}
+		first := int64(binary.LittleEndian.Uint64(entry[32:]))
+		last := int64(binary.LittleEndian.Uint64(entry[40:]))
+		if first < 0 || last < first || last >= size/dmgSectorSize {
+			continue
+		}
+		partitions = append(partitions, dmgPartition{offset: first * dmgSectorSize, size: (last - first + 1) * dmgSectorSize})
+	}
+	return partitions
+}
+
+// apmPartitions returns the partitions listed by the Apple partition map, which starts at the second sector.
+func apmPartitions(disk io.ReaderAt, size int64) []dmgPartition {
+	var partitions []dmgPartition
+	entry := make([]byte, 16)
+	count := 1
+	for i := 1; i <= min(count, dmgMaxPartitions); i++ {
+		if _, err := disk.ReadAt(entry, int64(i)*dmgSectorSize); err != nil || !bytes.Equal(entry[0:2], apmMagic) {
+			break
+		}
+		count = int(binary.BigEndian.Uint32(entry[4:]))
+		start := int64(binary.BigEndian.Uint32(entry[8:]))
+		blocks := int64(binary.BigEndian.Uint32(entry[12:]))
+		if (start+blocks)*dmgSectorSize > size {
+			continue
+		}
+		partitions = append(partitions, dmgPartition{offset: start * dmgSectorSize, size: blocks * dmgSectorSize})
+	}
+	return partitions
+}
@@ -2,6 +2,7 @@ package filesource
 
 import (
 	"crypto"
+	"errors"
 	"fmt"
 	"io/fs"
 	"os"
@@ -30,13 +31,16 @@ type Config struct {
 	Exclude          source.ExcludeConfig
 	DigestAlgorithms []crypto.Hash
 	Alias            source.Alias
+	// MaxArchiveEntries is the maximum number of entries unarchived from an archive (or unpacked from a filesystem
+	// image), where the remaining entries are skipped with a warning (0 means there is no limit)
+	MaxArchiveEntries int
 }
 
 type fileSource struct {
 	id               artifact.ID
 	digestForVersion string
 	config           Config
-	resolver         *fileresolver.Directory
+	resolver         file.Resolver
 	mutex            *sync.Mutex
 	closer           func() error
 	digests          []file.Digest
@@ -58,7 +62,7 @@ func New(cfg Config) (source.Source, error) {
 		return nil, fmt.Errorf("given path is a directory: %q", cfg.Path)
 	}
 
-	analysisPath, cleanupFn := fileAnalysisPath(cfg.Path)
+	analysisPath, cleanupFn := fileAnalysisPath(cfg.Path, cfg.MaxArchiveEntries)
 
 	var digests []file.Digest
 	if len(cfg.DigestAlgorithms) > 0 {
@@ -149,7 +153,7 @@ func (s fileSource) FileResolver(_ source.Scope) (file.Resolver, error) {
 		return s.resolver, nil
 	}
 
-	exclusionFunctions, err := directorysource.GetDirectoryExclusionFunctions(s.analysisPath, s.config.Exclude.Paths)
+	exclusionFunctions, err := directorysource.GetDirectoryExclusionFunctionsFromConfig(s.analysisPath, s.config.Exclude)
 	if err != nil {
 		return nil, err
 	}
@@ -206,6 +210,9 @@ func (s fileSource) FileResolver(_ source.Scope) (file.Resolver, error) {
 	}
 
 	s.resolver = res
+	if s.config.Exclude.CaseInsensitive {
+		s.resolver = fileresolver.NewCaseInsensitiveDecorator(res)
+	}
 
 	return s.resolver, nil
 }
@@ -230,12 +237,54 @@ func (s *fileSource) Close() error {
 	return s.closer()
 }
 
+// fileExtractor unpacks a kind of file that is detected by its contents (instead of by the file extension, as archives
+// are) into a temp dir, where the unpacked contents are used as the source.
+type fileExtractor struct {
+	name   string
+	detect func(path string) bool
+	unpack func(path string, maxEntries int) (string, func() error, error)
+}
+
+var fileExtractors = []fileExtractor{
+	// squashfs filesystems (e.g. snap packages) are detected by contents since the file extension varies
+	{name: "squashfs filesystem", detect: isSquashFS, unpack: unsquashToTmp},
+	// appimages are an ELF runtime with a squashfs filesystem appended, where the filesystem holds the application
+	{name: "appimage", detect: isAppImage, unpack: unpackAppImageToTmp},
+	// iso 9660 disk images (e.g. installer media) are detected by contents, since hybrid images may not use the .iso extension
+	{name: "iso9660 image", detect: isISO9660, unpack: unpackISO9660ToTmp},
+	// an initramfs is detected by contents, since boot images use many names (e.g. initrd.img, initramfs-linux.img)
+	{name: "initramfs", detect: isInitramfs, unpack: unpackInitramfsToTmp},
+	// macOS disk images are detected by the UDIF trailer, or the extension for images without one (e.g. uncompressed images)
+	{name: "dmg disk image", detect: isDMG, unpack: unpackDMGToTmp},
+}
+
 // fileAnalysisPath returns the path given, or in the case the path is an archive, the location where the archive
 // contents have been made available. A cleanup function is provided for any temp files created (if any).
-func fileAnalysisPath(path string) (string, func() error) {
+func fileAnalysisPath(path string, maxArchiveEntries int) (string, func() error) {
 	var analysisPath = path
 	var cleanupFn = func() error { return nil }
 
+	for _, x := range fileExtractors {
+		if !x.detect(path) {
+			continue
+		}
+		unpackedPath, tmpCleanup, err := x.unpack(path, maxArchiveEntries)
+		switch {
+		case errors.Is(err, internal.ErrTooManyArchiveEntries):
+			log.WithFields("path", path, "limit", maxArchiveEntries).Warnf("%s exceeds the maximum number of archive entries, skipping the remaining entries", x.name)
+			analysisPath = unpackedPath
+		case err != nil:
+			log.Warnf("%s could not be extracted: %+v", x.name, err)
+		default:
+			log.Debugf("source path is a %s", x.name)
+			analysisPath = unpackedPath
+		}
+		if tmpCleanup != nil {
+			cleanupFn = tmpCleanup
+		}
+		return analysisPath, cleanupFn
+	}
+
 	// if the given file is an archive (as indicated by the file extension and not MIME type) then unarchive it and
 	// use the contents as the source. Note: this does NOT recursively unarchive contents, only the given path is
 	// unarchived.
@@ -247,10 +296,14 @@ func fileAnalysisPath(path string) (string, func() error) {
 			// NOTE: this currently does not display any messages if an overwrite happens
 			tar.OverwriteExisting = true
 		}
-		unarchivedPath, tmpCleanup, err := unarchiveToTmp(path, unarchiver)
-		if err != nil {
+		unarchivedPath, tmpCleanup, err := unarchiveToTmp(path, unarchiver, maxArchiveEntries)
+		switch {
+		case errors.Is(err, internal.ErrTooManyArchiveEntries):
+			log.WithFields("path", path, "limit", maxArchiveEntries).Warn("archive exceeds the maximum number of archive entries, skipping the remaining entries")
+			analysisPath = unarchivedPath
+		case err != nil:
 			log.Warnf("file could not be unarchived: %+v", err)
-		} else {
+		default:
 			log.Debugf("source path is an archive")
 			analysisPath = unarchivedPath
 		}
@@ -275,7 +328,7 @@ func digestOfFileContents(path string) string {
 	return di.String()
 }
 
-func unarchiveToTmp(path string, unarchiver archiver.Unarchiver) (string, func() error, error) {
+func unarchiveToTmp(path string, unarchiver archiver.Unarchiver, maxEntries int) (string, func() error, error) {
 	tempDir, err := os.MkdirTemp("", "syft-archive-contents-")
 	if err != nil {
 		return "", func() error { return nil }, fmt.Errorf("unable to create tempdir for archive processing: %w", err)
@@ -285,5 +338,5 @@ func unarchiveToTmp(path string, unarchiver archiver.Unarchiver) (string, func()
 		return os.RemoveAll(tempDir)
 	}
 
-	return tempDir, cleanupFn, unarchiver.Unarchive(path, tempDir)
+	return tempDir, cleanupFn, internal.Unarchive(unarchiver, path, tempDir, maxEntries)
 }
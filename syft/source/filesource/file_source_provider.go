@@ -11,20 +11,34 @@ import (
 	"github.com/anchore/syft/syft/source"
 )
 
+// ProviderConfig is the configuration of the file source provider.
+type ProviderConfig struct {
+	Exclude          source.ExcludeConfig
+	DigestAlgorithms []crypto.Hash
+	Alias            source.Alias
+	// MaxArchiveEntries is the maximum number of entries unarchived from an archive (or unpacked from a filesystem
+	// image), where the remaining entries are skipped with a warning (0 means there is no limit)
+	MaxArchiveEntries int
+}
+
 func NewSourceProvider(path string, exclude source.ExcludeConfig, digestAlgorithms []crypto.Hash, alias source.Alias) source.Provider {
+	return NewSourceProviderWithConfig(path, ProviderConfig{
+		Exclude:          exclude,
+		DigestAlgorithms: digestAlgorithms,
+		Alias:            alias,
+	})
+}
+
+func NewSourceProviderWithConfig(path string, cfg ProviderConfig) source.Provider {
 	return &fileSourceProvider{
-		path:             path,
-		exclude:          exclude,
-		digestAlgorithms: digestAlgorithms,
-		alias:            alias,
+		path: path,
+		cfg:  cfg,
 	}
 }
 
 type fileSourceProvider struct {
-	path             string
-	exclude          source.ExcludeConfig
-	digestAlgorithms []crypto.Hash
-	alias            source.Alias
+	path string
+	cfg  ProviderConfig
 }
 
 func (p fileSourceProvider) Name() string {
@@ -49,10 +63,11 @@ func (p fileSourceProvider) Provide(_ context.Context) (source.Source, error) {
 
 	return New(
 		Config{
-			Path:             location,
-			Exclude:          p.exclude,
-			DigestAlgorithms: p.digestAlgorithms,
-			Alias:            p.alias,
+			Path:              location,
+			Exclude:           p.cfg.Exclude,
+			DigestAlgorithms:  p.cfg.DigestAlgorithms,
+			Alias:             p.cfg.Alias,
+			MaxArchiveEntries: p.cfg.MaxArchiveEntries,
 		},
 	)
 }
@@ -1,21 +1,29 @@
 package filesource
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
 	"io"
 	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
+	"slices"
+	"strings"
 	"syscall"
 	"testing"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/ulikunitz/xz"
 
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/internal/testutil"
 	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/internal"
 )
 
 func TestNewFromFile(t *testing.T) {
@@ -99,13 +107,14 @@ func TestNewFromFile_WithArchive(t *testing.T) {
 	testutil.Chdir(t, "..") // run with source/test-fixtures
 
 	testCases := []struct {
-		desc       string
-		input      string
-		expString  string
-		inputPaths []string
-		expRefs    int
-		layer2     bool
-		contents   string
+		desc              string
+		input             string
+		expString         string
+		inputPaths        []string
+		expRefs           int
+		layer2            bool
+		contents          string
+		maxArchiveEntries int
 	}{
 		{
 			desc:       "path detected",
@@ -121,13 +130,23 @@ func TestNewFromFile_WithArchive(t *testing.T) {
 			layer2:     true,
 			contents:   "Another .vimrc file",
 		},
+		{
+			desc:              "skip the entries after the maximum number of archive entries",
+			input:             "test-fixtures/path-detected",
+			inputPaths:        []string{"/.vimrc"},
+			expRefs:           1,
+			layer2:            true,
+			contents:          "\" A .vimrc file\n",
+			maxArchiveEntries: 3,
+		},
 	}
 	for _, test := range testCases {
 		t.Run(test.desc, func(t *testing.T) {
 			archivePath := setupArchiveTest(t, test.input, test.layer2)
 
 			src, err := New(Config{
-				Path: archivePath,
+				Path:              archivePath,
+				MaxArchiveEntries: test.maxArchiveEntries,
 			})
 			require.NoError(t, err)
 			t.Cleanup(func() {
@@ -306,3 +325,618 @@ func Test_FileSource_ID(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFromFile_WithUnpackedFile(t *testing.T) {
+	testutil.Chdir(t, "..") // run with source/test-fixtures
+
+	dmgPaths := []string{
+		"/Example.app/Contents/Info.plist",
+		"/Example.app/Contents/MacOS/example",
+		"/Example.app/Contents/Frameworks/Sparkle.framework/Versions/A/Resources/Info.plist",
+		"/Example.app/Contents/Frameworks/Sparkle.framework/Resources/Info.plist",
+		"/fragmented.txt",
+	}
+	// symlinks outside of the volume, filesystem metadata, and compressed files are not extracted
+	dmgMissingPaths := []string{"/Applications", "/compressed.txt", "/\x00\x00\x00\x00HFS+ Private Data"}
+	// hard links have the contents of the inode file
+	dmgContents := map[string]string{
+		"/hello.txt":      "hello from a dmg\n",
+		"/hello-link.txt": "hello from a dmg\n",
+	}
+	// the contents of the fragmented file are read from the extents overflow file after the first 8 extents
+	dmgFragmentedFile := func(t *testing.T, data map[string]string) {
+		fragmented := data["/fragmented.txt"]
+		require.Len(t, fragmented, 10*4096-100)
+		assert.True(t, strings.HasPrefix(fragmented, "0000 fragmented contents\n"))
+		assert.Contains(t, fragmented, "1633 fragmented contents\n")
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		paths    []string
+		missing  []string
+		contents map[string]string
+		// check makes further assertions on the contents of all found paths
+		check func(t *testing.T, data map[string]string)
+	}{
+		{
+			name:     "squashfs filesystem",
+			input:    "test-fixtures/squashfs/root.snap",
+			paths:    []string{"/hello.txt"},
+			contents: map[string]string{"/hello.txt": "Hello from Sylabs!\n"},
+		},
+		{
+			// the fixture is a minimal ELF runtime with the squashfs filesystem of test-fixtures/squashfs/root.snap appended
+			name:     "appimage",
+			input:    "test-fixtures/appimage/hello.AppImage",
+			paths:    []string{"/hello.txt"},
+			contents: map[string]string{"/hello.txt": "Hello from Sylabs!\n"},
+		},
+		{
+			name:     "iso9660 image with rock ridge names",
+			input:    "test-fixtures/iso9660/rock-ridge.iso",
+			paths:    []string{"/hello.txt", "/Packages/a-long-package-name-1.0.txt"},
+			contents: map[string]string{"/hello.txt": "hello from an iso\n"},
+		},
+		{
+			name:     "iso9660 image with joliet names",
+			input:    "test-fixtures/iso9660/joliet.iso",
+			paths:    []string{"/hello.txt", "/Packages/a-long-package-name-1.0.txt"},
+			contents: map[string]string{"/hello.txt": "hello from an iso\n"},
+		},
+		{
+			name:     "iso9660 image with primary volume names",
+			input:    "test-fixtures/iso9660/plain.iso",
+			paths:    []string{"/HELLO.TXT", "/PACKAGES/A_LONG_P.TXT"},
+			contents: map[string]string{"/HELLO.TXT": "hello from an iso\n"},
+		},
+		{
+			// the fixture is an uncompressed (crc) cpio archive followed by a gzip compressed (newc) cpio archive
+			name:  "initramfs",
+			input: "test-fixtures/initramfs/initrd.img",
+			paths: []string{"/kernel/x86/microcode/GenuineIntel.bin", "/bin/busybox", "/bin/sh", "/bin/ash", "/etc/os-release"},
+			// entries outside of the archive are not extracted
+			missing: []string{"/escape.txt", "/etc/passwd"},
+			// the hard linked file has the contents of the last entry of the inode
+			check: func(t *testing.T, data map[string]string) {
+				assert.Contains(t, data["/bin/busybox"], "BusyBox v1.36.1")
+			},
+		},
+		{
+			name:     "zlib compressed udif image with a guid partition table",
+			input:    "test-fixtures/dmg/udzo.dmg",
+			paths:    dmgPaths,
+			missing:  dmgMissingPaths,
+			contents: dmgContents,
+			check:    dmgFragmentedFile,
+		},
+		{
+			name:     "bzip2 compressed udif image without a partition map",
+			input:    "test-fixtures/dmg/udbz.dmg",
+			paths:    dmgPaths,
+			missing:  dmgMissingPaths,
+			contents: dmgContents,
+			check:    dmgFragmentedFile,
+		},
+		{
+			name:     "uncompressed image with an apple partition map",
+			input:    "test-fixtures/dmg/raw.dmg",
+			paths:    dmgPaths,
+			missing:  dmgMissingPaths,
+			contents: dmgContents,
+			check:    dmgFragmentedFile,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			src, err := New(Config{
+				Path: test.input,
+			})
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				require.NoError(t, src.Close())
+			})
+
+			assert.Equal(t, test.input, src.Describe().Metadata.(source.FileMetadata).Path)
+
+			res, err := src.FileResolver(source.SquashedScope)
+			require.NoError(t, err)
+
+			paths := slices.Clone(test.paths)
+			for p := range test.contents {
+				paths = append(paths, p)
+			}
+
+			data := make(map[string]string)
+			for _, p := range paths {
+				refs, err := res.FilesByPath(p)
+				require.NoError(t, err)
+				require.Len(t, refs, 1, p)
+
+				reader, err := res.FileContentsByLocation(refs[0])
+				require.NoError(t, err)
+				contents, err := io.ReadAll(reader)
+				require.NoError(t, err)
+				data[p] = string(contents)
+			}
+
+			for _, p := range test.missing {
+				refs, err := res.FilesByPath(p)
+				require.NoError(t, err)
+				assert.Empty(t, refs, p)
+			}
+
+			for p, expected := range test.contents {
+				assert.Equal(t, expected, data[p], p)
+			}
+
+			if test.check != nil {
+				test.check(t, data)
+			}
+		})
+	}
+}
+
+func Test_fileExtractors_detect(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "../test-fixtures/squashfs/root.snap", expected: "squashfs filesystem"},
+		{input: "../test-fixtures/appimage/hello.AppImage", expected: "appimage"},
+		{input: "../test-fixtures/iso9660/plain.iso", expected: "iso9660 image"},
+		{input: "../test-fixtures/initramfs/initrd.img", expected: "initramfs"},
+		{input: "../test-fixtures/dmg/raw.dmg", expected: "dmg disk image"},
+		{input: "../test-fixtures/dmg/udzo.dmg", expected: "dmg disk image"},
+		{input: "../test-fixtures/image-simple/file-1.txt"},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			var detected []string
+			for _, x := range fileExtractors {
+				if x.detect(test.input) {
+					detected = append(detected, x.name)
+				}
+			}
+			if test.expected == "" {
+				assert.Empty(t, detected)
+				return
+			}
+			// the first matching extractor is used, so more specific formats (e.g. appimages, which hold a squashfs
+			// filesystem) must not be shadowed by an earlier extractor
+			require.NotEmpty(t, detected)
+			assert.Equal(t, test.expected, detected[0])
+		})
+	}
+}
+
+func Test_appImageSquashFSOffset(t *testing.T) {
+	fh, err := os.Open("../test-fixtures/appimage/hello.AppImage")
+	require.NoError(t, err)
+	defer fh.Close()
+
+	offset, err := appImageSquashFSOffset(fh)
+	require.NoError(t, err)
+	// the ELF header (64 bytes) is followed by a single (null) section header (64 bytes)
+	assert.Equal(t, int64(128), offset)
+}
+
+// extractFunc extracts the given file contents into dest, without extracting more than maxSize bytes of file contents.
+type extractFunc func(r *bytes.Reader, dest string, maxSize int64) error
+
+func extractSquashFSFrom(r *bytes.Reader, dest string, maxSize int64) error {
+	return extractSquashFS(r, dest, maxSize, nil)
+}
+
+func extractISO9660From(r *bytes.Reader, dest string, maxSize int64) error {
+	return extractISO9660(r, r.Size(), dest, maxSize, nil)
+}
+
+func extractInitramfsFrom(r *bytes.Reader, dest string, maxSize int64) error {
+	return extractInitramfs(r, dest, maxSize, nil)
+}
+
+func extractDMGFrom(r *bytes.Reader, dest string, maxSize int64) error {
+	return extractDMG(r, r.Size(), dest, maxSize, nil)
+}
+
+func Test_extract_maxSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		extract extractFunc
+		// size is the number of bytes of file contents held by the fixture
+		size    int64
+		wantErr error
+	}{
+		{
+			name:    "squashfs",
+			fixture: "squashfs/root.snap",
+			extract: extractSquashFSFrom,
+			size:    19,
+			wantErr: errSquashFSTooLarge,
+		},
+		{
+			name:    "iso9660",
+			fixture: "iso9660/rock-ridge.iso",
+			extract: extractISO9660From,
+			size:    26,
+			wantErr: errISO9660TooLarge,
+		},
+		{
+			// hard linked contents are only extracted once
+			name:    "initramfs",
+			fixture: "initramfs/initrd.img",
+			extract: extractInitramfsFrom,
+			size:    119,
+			wantErr: errInitramfsTooLarge,
+		},
+		{
+			// the hard linked file is extracted twice
+			name:    "dmg",
+			fixture: "dmg/udzo.dmg",
+			extract: extractDMGFrom,
+			size:    41619,
+			wantErr: errDMGTooLarge,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			contents, err := os.ReadFile(filepath.Join("../test-fixtures", test.fixture))
+			require.NoError(t, err)
+
+			require.ErrorIs(t, test.extract(bytes.NewReader(contents), t.TempDir(), test.size-1), test.wantErr)
+			require.NoError(t, test.extract(bytes.NewReader(contents), t.TempDir(), test.size))
+		})
+	}
+}
+
+func Test_extract_malformed(t *testing.T) {
+	readFixture := func(name string) []byte {
+		contents, err := os.ReadFile(filepath.Join("../test-fixtures", name))
+		require.NoError(t, err)
+		return contents
+	}
+	iso := readFixture("iso9660/rock-ridge.iso")
+	udzo := readFixture("dmg/udzo.dmg")
+	raw := readFixture("dmg/raw.dmg")
+	early, root := initramfsFixtureArchives(t)
+
+	const (
+		// the volume of the uncompressed dmg fixture starts at sector 64, and uses 4096 byte allocation blocks
+		dmgVolume      = 64 * 512
+		dmgCatalogFile = dmgVolume + 4*4096
+	)
+
+	tests := []struct {
+		name     string
+		contents []byte
+		// mutate corrupts a copy of the contents (when given)
+		mutate  func([]byte) []byte
+		extract extractFunc
+		maxSize int64
+		wantErr error
+	}{
+		{
+			name:     "iso9660 truncated image",
+			contents: iso,
+			mutate: func(b []byte) []byte {
+				return b[:19*2048]
+			},
+			extract: extractISO9660From,
+			maxSize: maxISO9660ExtractionSize,
+			wantErr: errISO9660Malformed,
+		},
+		{
+			name:     "iso9660 missing descriptor set terminator",
+			contents: iso,
+			mutate: func(b []byte) []byte {
+				b[17*2048+1] = 'X'
+				return b
+			},
+			extract: extractISO9660From,
+			maxSize: maxISO9660ExtractionSize,
+			wantErr: errISO9660Malformed,
+		},
+		{
+			name:     "iso9660 directory loop",
+			contents: iso,
+			mutate: func(b []byte) []byte {
+				// point the "Packages" directory record at the root directory
+				root := b[16*2048+156+2 : 16*2048+156+6]
+				pkgs := bytes.Index(b, []byte("PACKAGES")) - 31
+				copy(b[pkgs:pkgs+4], root)
+				return b
+			},
+			extract: extractISO9660From,
+			maxSize: maxISO9660ExtractionSize,
+			wantErr: errISO9660Malformed,
+		},
+		{
+			name:     "initramfs not an archive",
+			contents: []byte("not an initramfs"),
+			extract:  extractInitramfsFrom,
+			maxSize:  maxInitramfsExtractionSize,
+			wantErr:  errInitramfsMalformed,
+		},
+		{
+			name:     "initramfs truncated archive",
+			contents: root[:len(root)/2],
+			extract:  extractInitramfsFrom,
+			maxSize:  maxInitramfsExtractionSize,
+			wantErr:  errInitramfsMalformed,
+		},
+		{
+			name:     "initramfs checksum mismatch",
+			contents: early,
+			mutate: func(b []byte) []byte {
+				b[bytes.Index(b, []byte("microcode\n"))] = 'M'
+				return b
+			},
+			extract: extractInitramfsFrom,
+			maxSize: maxInitramfsExtractionSize,
+			wantErr: errInitramfsMalformed,
+		},
+		{
+			name:     "initramfs unsupported cpio format",
+			contents: append([]byte("070707"), root[6:]...),
+			extract:  extractInitramfsFrom,
+			maxSize:  maxInitramfsExtractionSize,
+			wantErr:  errInitramfsMalformed,
+		},
+		{
+			name:     "dmg property list out of bounds",
+			contents: udzo,
+			mutate: func(b []byte) []byte {
+				binary.BigEndian.PutUint64(b[len(b)-512+224:], uint64(len(b)))
+				return b
+			},
+			extract: extractDMGFrom,
+			maxSize: maxDMGExtractionSize,
+			wantErr: errDMGMalformed,
+		},
+		{
+			name:     "dmg invalid sector count",
+			contents: udzo,
+			mutate: func(b []byte) []byte {
+				binary.BigEndian.PutUint64(b[len(b)-512+492:], 0)
+				return b
+			},
+			extract: extractDMGFrom,
+			maxSize: maxDMGExtractionSize,
+			wantErr: errDMGMalformed,
+		},
+		{
+			name:     "dmg corrupt chunk",
+			contents: udzo,
+			mutate: func(b []byte) []byte {
+				// the first chunks hold the partition map, which is followed by the chunks of the volume
+				start := bytes.Index(b[1024:], []byte{0x78, 0x9c}) + 1024
+				for i := start + 2; i < start+64; i++ {
+					b[i] ^= 0xff
+				}
+				return b
+			},
+			extract: extractDMGFrom,
+			maxSize: maxDMGExtractionSize,
+			wantErr: errDMGMalformed,
+		},
+		{
+			name:     "dmg invalid block size",
+			contents: raw,
+			mutate: func(b []byte) []byte {
+				binary.BigEndian.PutUint32(b[dmgVolume+1024+40:], 3000)
+				return b
+			},
+			extract: extractDMGFrom,
+			maxSize: maxDMGExtractionSize,
+			wantErr: errDMGMalformed,
+		},
+		{
+			name:     "dmg invalid catalog node size",
+			contents: raw,
+			mutate: func(b []byte) []byte {
+				binary.BigEndian.PutUint16(b[dmgCatalogFile+14+18:], 3000)
+				return b
+			},
+			extract: extractDMGFrom,
+			maxSize: maxDMGExtractionSize,
+			wantErr: errDMGMalformed,
+		},
+		{
+			name:     "dmg leaf node loop",
+			contents: raw,
+			mutate: func(b []byte) []byte {
+				// point the forward link of the first leaf node at itself
+				binary.BigEndian.PutUint32(b[dmgCatalogFile+4096:], 1)
+				return b
+			},
+			extract: extractDMGFrom,
+			maxSize: maxDMGExtractionSize,
+			wantErr: errDMGMalformed,
+		},
+		{
+			// an APFS container, which starts with the container superblock
+			name: "dmg unsupported apfs container",
+			contents: func() []byte {
+				apfs := make([]byte, 64*1024)
+				copy(apfs[32:], apfsMagic)
+				return apfs
+			}(),
+			extract: extractDMGFrom,
+			maxSize: maxDMGExtractionSize,
+			wantErr: errDMGUnsupported,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := test.contents
+			if test.mutate != nil {
+				data = test.mutate(bytes.Clone(data))
+			}
+			require.ErrorIs(t, test.extract(bytes.NewReader(data), t.TempDir(), test.maxSize), test.wantErr)
+		})
+	}
+}
+
+func Test_extractInitramfs_compression(t *testing.T) {
+	early, root := initramfsFixtureArchives(t)
+
+	compress := func(t *testing.T, newWriter func(io.Writer) (io.WriteCloser, error)) []byte {
+		var buf bytes.Buffer
+		w, err := newWriter(&buf)
+		require.NoError(t, err)
+		_, err = w.Write(root)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+
+	tests := []struct {
+		name     string
+		contents []byte
+	}{
+		{
+			name:     "uncompressed",
+			contents: root,
+		},
+		{
+			name: "gzip",
+			contents: compress(t, func(w io.Writer) (io.WriteCloser, error) {
+				return gzip.NewWriter(w), nil
+			}),
+		},
+		{
+			name: "xz",
+			contents: compress(t, func(w io.Writer) (io.WriteCloser, error) {
+				return xz.NewWriter(w)
+			}),
+		},
+		{
+			name: "zstd",
+			contents: compress(t, func(w io.Writer) (io.WriteCloser, error) {
+				return zstd.NewWriter(w)
+			}),
+		},
+		{
+			name: "zstd after an uncompressed archive",
+			contents: append(bytes.Clone(early), compress(t, func(w io.Writer) (io.WriteCloser, error) {
+				return zstd.NewWriter(w)
+			})...),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.True(t, isInitramfs(writeTempFile(t, test.contents)))
+
+			dest := t.TempDir()
+			require.NoError(t, extractInitramfs(bytes.NewReader(test.contents), dest, maxInitramfsExtractionSize, nil))
+
+			data, err := os.ReadFile(filepath.Join(dest, "etc", "os-release"))
+			require.NoError(t, err)
+			assert.Contains(t, string(data), "ID=alpine")
+		})
+	}
+}
+
+func Test_adcDecompress(t *testing.T) {
+	data := []byte{
+		0x82, 'a', 'b', 'c', // a literal run of 3 bytes
+		0x04, 0x02, // a (two byte) reference of 4 bytes, starting 3 bytes back (overlapping the output)
+		0x41, 0x00, 0x00, // a (three byte) reference of 5 bytes, starting 1 byte back
+	}
+	out, err := adcDecompress(data, 512)
+	require.NoError(t, err)
+	assert.Equal(t, "abcabcaaaaaa", string(out))
+
+	// the output is bounded by the given size
+	out, err = adcDecompress(data, 5)
+	require.NoError(t, err)
+	assert.Equal(t, "abcab", string(out))
+
+	_, err = adcDecompress([]byte{0x04, 0x02}, 512)
+	require.ErrorIs(t, err, errDMGMalformed)
+	_, err = adcDecompress([]byte{0x85, 'a'}, 512)
+	require.ErrorIs(t, err, errDMGMalformed)
+}
+
+// initramfsFixtureArchives returns the uncompressed (crc) archive and the decompressed (newc) archive of the
+// initramfs fixture.
+func initramfsFixtureArchives(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+	contents, err := os.ReadFile("../test-fixtures/initramfs/initrd.img")
+	require.NoError(t, err)
+
+	idx := bytes.Index(contents, gzipMagic)
+	require.Positive(t, idx)
+
+	r, err := gzip.NewReader(bytes.NewReader(contents[idx:]))
+	require.NoError(t, err)
+	root, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	return contents[:idx], root
+}
+
+func writeTempFile(t *testing.T, contents []byte) string {
+	t.Helper()
+	p := filepath.Join(t.TempDir(), "initrd.img")
+	require.NoError(t, os.WriteFile(p, contents, 0o600))
+	return p
+}
+
+func Test_fileExtractors_maxEntries(t *testing.T) {
+	tests := []struct {
+		extractor string
+		fixture   string
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			// the fixture holds a single file, which is within the limit
+			extractor: "squashfs filesystem",
+			fixture:   "../test-fixtures/squashfs/root.snap",
+			wantErr:   require.NoError,
+		},
+		{
+			extractor: "appimage",
+			fixture:   "../test-fixtures/appimage/hello.AppImage",
+			wantErr:   require.NoError,
+		},
+		{
+			extractor: "iso9660 image",
+			fixture:   "../test-fixtures/iso9660/rock-ridge.iso",
+		},
+		{
+			extractor: "initramfs",
+			fixture:   "../test-fixtures/initramfs/initrd.img",
+		},
+		{
+			extractor: "dmg disk image",
+			fixture:   "../test-fixtures/dmg/udzo.dmg",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.extractor, func(t *testing.T) {
+			idx := slices.IndexFunc(fileExtractors, func(x fileExtractor) bool {
+				return x.name == test.extractor
+			})
+			require.NotEqual(t, -1, idx)
+
+			unpackedPath, cleanup, err := fileExtractors[idx].unpack(test.fixture, 1)
+			require.NotNil(t, cleanup)
+			t.Cleanup(func() {
+				require.NoError(t, cleanup())
+			})
+			if test.wantErr == nil {
+				test.wantErr = func(t require.TestingT, err error, _ ...interface{}) {
+					require.ErrorIs(t, err, internal.ErrTooManyArchiveEntries)
+				}
+			}
+			test.wantErr(t, err)
+
+			// the entries up to the limit are still extracted
+			assert.DirExists(t, unpackedPath)
+		})
+	}
+}
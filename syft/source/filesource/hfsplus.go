@@ -0,0 +1,557 @@
+package filesource
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/source/internal"
+)
+
+const (
+	// the volume header is found 1024 bytes into the volume
+	hfsPlusHeaderOffset = 1024
+	hfsPlusHeaderSize   = 512
+
+	hfsPlusRootFolderID  = 2
+	hfsPlusCatalogFileID = 4
+
+	hfsPlusFolderRecord = 1
+	hfsPlusFileRecord   = 2
+
+	hfsPlusFolderRecordSize        = 88
+	hfsPlusFileRecordSize          = 248
+	hfsPlusExtentsRecordSize       = 64
+	hfsPlusNodeDescriptorSize      = 14
+	hfsPlusExtentsPerRecord        = 8
+	hfsPlusForkDataSize            = 80
+	hfsPlusHeaderNodeKind     int8 = 1
+	hfsPlusLeafNodeKind       int8 = -1
+
+	hfsPlusMinNodeSize = 512
+	hfsPlusMaxNodeSize = 32768
+
+	// bound the depth of the directory tree
+	hfsPlusMaxDepth = 256
+
+	// bound the number of catalog records, which are held in memory
+	hfsPlusMaxRecords = 4 * 1024 * 1024
+
+	// bound the size of symlink targets, which are read into memory
+	hfsPlusMaxSymlinkSize = 4096
+
+	// the BSD flag of files with contents compressed by the filesystem (within an extended attribute or resource fork)
+	hfsPlusCompressedFlag = 0x20
+
+	hfsPlusModeTypeMask = 0o170000
+	hfsPlusModeRegular  = 0o100000
+	hfsPlusModeSymlink  = 0o120000
+)
+
+var (
+	hfsPlusSignature = []byte("H+")
+	// HFSX volumes are HFS+ volumes which may use case-sensitive names
+	hfsxSignature = []byte("HX")
+
+	// hard links are files within this (hidden) directory of the root folder, which are referenced by link files
+	hfsPlusPrivateDataName = "\x00\x00\x00\x00HFS+ Private Data"
+
+	// entries of the root folder holding filesystem metadata, which have no contents to catalog
+	hfsPlusMetadataNames = map[string]bool{
+		hfsPlusPrivateDataName:           true,
+		".HFS+ Private Directory Data\r": true,
+		".journal":                       true,
+		".journal_info_block":            true,
+	}
+)
+
+// isHFSPlusVolume indicates if the volume header of an HFS+ (or HFSX) volume is found within the given partition.
+func isHFSPlusVolume(r io.ReaderAt) bool {
+	signature := make([]byte, 4)
+	if _, err := r.ReadAt(signature, hfsPlusHeaderOffset); err != nil {
+		return false
+	}
+	version := binary.BigEndian.Uint16(signature[2:])
+	return (bytes.Equal(signature[0:2], hfsPlusSignature) && version == 4) ||
+		(bytes.Equal(signature[0:2], hfsxSignature) && version == 5)
+}
+
+// hfsPlusExtent is a run of allocation blocks holding (part of) the contents of a fork.
+type hfsPlusExtent struct {
+	start uint32
+	count uint32
+}
+
+// hfsPlusFork describes the contents of a file, where extents beyond the first eight are found within the extents
+// overflow file.
+type hfsPlusFork struct {
+	size    int64
+	blocks  uint32
+	extents []hfsPlusExtent
+}
+
+// hfsPlusEntry is a file or folder record of the catalog file.
+type hfsPlusEntry struct {
+	name       string
+	id         uint32
+	folder     bool
+	mode       uint16
+	ownerFlags uint8
+	// special is the inode number of hard link files
+	special  uint32
+	fileType string
+	creator  string
+	data     hfsPlusFork
+}
+
+// hfsPlusOverflowRecord holds the extents of a fork following the given (fork relative) allocation block.
+type hfsPlusOverflowRecord struct {
+	start   uint32
+	extents []hfsPlusExtent
+}
+
+type hfsPlusVolume struct {
+	r         io.ReaderAt
+	size      int64
+	blockSize int64
+	// overflow holds the extents overflow records of the data forks, by file ID
+	overflow map[uint32][]hfsPlusOverflowRecord
+	// children holds the catalog entries of each folder, by folder ID
+	children   map[uint32][]*hfsPlusEntry
+	privateDir *hfsPlusEntry
+	records    int
+	dest       string
+	extracted  int64
+	maxSize    int64
+	limit      *internal.EntryLimit
+	visited    map[uint32]bool
+}
+
+// extractHFSPlus writes all directories, regular files, and symlinks from the HFS+ (or HFSX) volume to the given
+// destination directory. Hard links are extracted as copies of the linked file. Files compressed by the filesystem
+// are not extracted, nor are symlinks that would resolve outside of the destination directory. Extraction stops with
+// an error once the total size of file contents exceeds maxSize, or once the entry limit is reached.
+func extractHFSPlus(r io.ReaderAt, size int64, dest string, maxSize int64, limit *internal.EntryLimit) error {
+	v := &hfsPlusVolume{
+		r:        r,
+		size:     size,
+		overflow: make(map[uint32][]hfsPlusOverflowRecord),
+		children: make(map[uint32][]*hfsPlusEntry),
+		dest:     dest,
+		maxSize:  maxSize,
+		limit:    limit,
+		visited:  make(map[uint32]bool),
+	}
+
+	if err := v.readVolumeHeader(); err != nil {
+		return err
+	}
+
+	for _, e := range v.children[hfsPlusRootFolderID] {
+		if e.folder && e.name == hfsPlusPrivateDataName {
+			v.privateDir = e
+		}
+	}
+
+	return v.extractDir(hfsPlusRootFolderID, dest, 0)
+}
+
+// readVolumeHeader reads the extents overflow file and the catalog file, as described by the volume header.
+func (v *hfsPlusVolume) readVolumeHeader() error {
+	header := make([]byte, hfsPlusHeaderSize)
+	if _, err := v.r.ReadAt(header, hfsPlusHeaderOffset); err != nil {
+		return fmt.Errorf("%w: unable to read hfs+ volume header: %v", errDMGMalformed, err)
+	}
+	if !isHFSPlusVolume(v.r) {
+		return fmt.Errorf("%w: invalid hfs+ volume header", errDMGMalformed)
+	}
+
+	v.blockSize = int64(binary.BigEndian.Uint32(header[40:]))
+	if v.blockSize < 512 || v.blockSize&(v.blockSize-1) != 0 {
+		return fmt.Errorf("%w: invalid hfs+ block size %d", errDMGMalformed, v.blockSize)
+	}
+
+	// the extents overflow file cannot have extents of its own within the extents overflow file
+	extentsFile, err := v.forkReader(parseHFSPlusFork(header[192:]), 0)
+	if err != nil {
+		return err
+	}
+	if err := forEachHFSPlusLeafRecord(extentsFile, v.addOverflowRecord); err != nil {
+		return err
+	}
+
+	catalogFile, err := v.forkReader(parseHFSPlusFork(header[272:]), hfsPlusCatalogFileID)
+	if err != nil {
+		return err
+	}
+	return forEachHFSPlusLeafRecord(catalogFile, v.addCatalogRecord)
+}
+
+func parseHFSPlusFork(b []byte) hfsPlusFork {
+	fork := hfsPlusFork{
+		size:   int64(binary.BigEndian.Uint64(b[0:])),
+		blocks: binary.BigEndian.Uint32(b[12:]),
+	}
+	fork.extents = parseHFSPlusExtents(b[16:hfsPlusForkDataSize])
+	return fork
+}
+
+func parseHFSPlusExtents(b []byte) []hfsPlusExtent {
+	var extents []hfsPlusExtent
+	for i := 0; i < hfsPlusExtentsPerRecord; i++ {
+		e := hfsPlusExtent{
+			start: binary.BigEndian.Uint32(b[i*8:]),
+			count: binary.BigEndian.Uint32(b[i*8+4:]),
+		}
+		if e.count == 0 {
+			break
+		}
+		extents = append(extents, e)
+	}
+	return extents
+}
+
+// addOverflowRecord records the extents of a data fork from a leaf record of the extents overflow file.
+func (v *hfsPlusVolume) addOverflowRecord(key, data []byte) error {
+	if len(key) < 10 || len(data) < hfsPlusExtentsRecordSize {
+		return fmt.Errorf("%w: invalid hfs+ extents record", errDMGMalformed)
+	}
+	if key[0] != 0 {
+		// resource forks are not extracted
+		return nil
+	}
+	fileID := binary.BigEndian.Uint32(key[2:])
+	v.overflow[fileID] = append(v.overflow[fileID], hfsPlusOverflowRecord{
+		start:   binary.BigEndian.Uint32(key[6:]),
+		extents: parseHFSPlusExtents(data),
+	})
+	return v.countRecord()
+}
+
+// addCatalogRecord records the file or folder from a leaf record of the catalog file (thread records are not needed
+// since the whole catalog is read).
+func (v *hfsPlusVolume) addCatalogRecord(key, data []byte) error {
+	if len(key) < 6 || len(data) < 2 {
+		return fmt.Errorf("%w: invalid hfs+ catalog record", errDMGMalformed)
+	}
+	parentID := binary.BigEndian.Uint32(key[0:])
+	nameLen := int(binary.BigEndian.Uint16(key[4:]))
+	if 6+nameLen*2 > len(key) {
+		return fmt.Errorf("%w: hfs+ catalog record name is out of bounds", errDMGMalformed)
+	}
+	units := make([]uint16, nameLen)
+	for i := range units {
+		units[i] = binary.BigEndian.Uint16(key[6+i*2:])
+	}
+
+	e := &hfsPlusEntry{
+		name: string(utf16.Decode(units)),
+	}
+	switch int16(binary.BigEndian.Uint16(data)) {
+	case hfsPlusFolderRecord:
+		if len(data) < hfsPlusFolderRecordSize {
+			return fmt.Errorf("%w: hfs+ folder record is truncated", errDMGMalformed)
+		}
+		e.folder = true
+		e.id = binary.BigEndian.Uint32(data[8:])
+	case hfsPlusFileRecord:
+		if len(data) < hfsPlusFileRecordSize {
+			return fmt.Errorf("%w: hfs+ file record is truncated", errDMGMalformed)
+		}
+		e.id = binary.BigEndian.Uint32(data[8:])
+		e.ownerFlags = data[41]
+		e.mode = binary.BigEndian.Uint16(data[42:])
+		e.special = binary.BigEndian.Uint32(data[44:])
+		e.fileType = string(data[48:52])
+		e.creator = string(data[52:56])
+		e.data = parseHFSPlusFork(data[88:])
+	default:
+		return nil
+	}
+
+	v.children[parentID] = append(v.children[parentID], e)
+	return v.countRecord()
+}
+
+func (v *hfsPlusVolume) countRecord() error {
+	v.records++
+	if v.records > hfsPlusMaxRecords {
+		return fmt.Errorf("%w: too many hfs+ records", errDMGMalformed)
+	}
+	return nil
+}
+
+// forEachHFSPlusLeafRecord calls the given function with the key and data of every record of the B-tree file, by
+// following the list of leaf nodes.
+func forEachHFSPlusLeafRecord(r *io.SectionReader, fn func(key, data []byte) error) error {
+	header := make([]byte, hfsPlusNodeDescriptorSize+106)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		return fmt.Errorf("%w: unable to read hfs+ b-tree header: %v", errDMGMalformed, err)
+	}
+	if int8(header[8]) != hfsPlusHeaderNodeKind {
+		return fmt.Errorf("%w: invalid hfs+ b-tree header node", errDMGMalformed)
+	}
+
+	record := header[hfsPlusNodeDescriptorSize:]
+	firstLeaf := binary.BigEndian.Uint32(record[10:])
+	nodeSize := int64(binary.BigEndian.Uint16(record[18:]))
+	totalNodes := int64(binary.BigEndian.Uint32(record[22:]))
+	if nodeSize < hfsPlusMinNodeSize || nodeSize > hfsPlusMaxNodeSize || nodeSize&(nodeSize-1) != 0 {
+		return fmt.Errorf("%w: invalid hfs+ b-tree node size %d", errDMGMalformed, nodeSize)
+	}
+	if totalNodes*nodeSize > r.Size() {
+		return fmt.Errorf("%w: hfs+ b-tree is out of bounds", errDMGMalformed)
+	}
+
+	node := make([]byte, nodeSize)
+	visited := make(map[uint32]bool)
+	for current := firstLeaf; current != 0; current = binary.BigEndian.Uint32(node[0:]) {
+		if int64(current) >= totalNodes || visited[current] {
+			return fmt.Errorf("%w: invalid hfs+ b-tree leaf node %d", errDMGMalformed, current)
+		}
+		visited[current] = true
+
+		if _, err := r.ReadAt(node, int64(current)*nodeSize); err != nil {
+			return fmt.Errorf("%w: unable to read hfs+ b-tree node: %v", errDMGMalformed, err)
+		}
+		if int8(node[8]) != hfsPlusLeafNodeKind {
+			return fmt.Errorf("%w: invalid hfs+ b-tree leaf node %d", errDMGMalformed, current)
+		}
+
+		// the offset of each record is stored in reverse order at the end of the node, followed by the offset of
+		// the free space of the node
+		numRecords := int(binary.BigEndian.Uint16(node[10:]))
+		tableStart := int(nodeSize) - 2*(numRecords+1)
+		if tableStart < hfsPlusNodeDescriptorSize {
+			return fmt.Errorf("%w: invalid hfs+ b-tree record count", errDMGMalformed)
+		}
+		for i := 0; i < numRecords; i++ {
+			start := int(binary.BigEndian.Uint16(node[int(nodeSize)-2*(i+1):]))
+			end := int(binary.BigEndian.Uint16(node[int(nodeSize)-2*(i+2):]))
+			if start < hfsPlusNodeDescriptorSize || end > tableStart || start+2 > end {
+				return fmt.Errorf("%w: hfs+ b-tree record is out of bounds", errDMGMalformed)
+			}
+			rec := node[start:end]
+			keyLen := int(binary.BigEndian.Uint16(rec))
+			if 2+keyLen > len(rec) {
+				return fmt.Errorf("%w: hfs+ b-tree key is out of bounds", errDMGMalformed)
+			}
+			if err := fn(rec[2:2+keyLen], rec[2+keyLen:]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// forkReader returns a reader of the contents of a fork, where the fork of the given file may have further extents
+// within the extents overflow file.
+func (v *hfsPlusVolume) forkReader(fork hfsPlusFork, fileID uint32) (*io.SectionReader, error) {
+	extents := fork.extents
+	blocks := uint32(0)
+	for _, e := range extents {
+		blocks += e.count
+	}
+
+	if fileID != 0 && blocks < fork.blocks {
+		records := v.overflow[fileID]
+		sort.Slice(records, func(i, j int) bool {
+			return records[i].start < records[j].start
+		})
+		for _, rec := range records {
+			if rec.start != blocks {
+				return nil, fmt.Errorf("%w: discontiguous hfs+ extents overflow record", errDMGMalformed)
+			}
+			for _, e := range rec.extents {
+				extents = append(extents, e)
+				blocks += e.count
+			}
+		}
+	}
+
+	if int64(blocks)*v.blockSize < fork.size {
+		return nil, fmt.Errorf("%w: hfs+ fork extents do not cover the fork contents", errDMGMalformed)
+	}
+
+	fr := &hfsPlusForkReader{r: v.r}
+	var logical int64
+	for _, e := range extents {
+		physical := int64(e.start) * v.blockSize
+		length := int64(e.count) * v.blockSize
+		if physical+length > v.size {
+			return nil, fmt.Errorf("%w: hfs+ extent is out of bounds", errDMGMalformed)
+		}
+		fr.ranges = append(fr.ranges, hfsPlusRange{logical: logical, physical: physical, length: length})
+		logical += length
+	}
+	return io.NewSectionReader(fr, 0, fork.size), nil
+}
+
+type hfsPlusRange struct {
+	logical  int64
+	physical int64
+	length   int64
+}
+
+// hfsPlusForkReader reads the contents of a fork from the allocation blocks of its extents.
+type hfsPlusForkReader struct {
+	r      io.ReaderAt
+	ranges []hfsPlusRange
+}
+
+func (f *hfsPlusForkReader) ReadAt(p []byte, off int64) (int, error) {
+	n := 0
+	for n < len(p) {
+		pos := off + int64(n)
+		i := sort.Search(len(f.ranges), func(i int) bool {
+			return f.ranges[i].logical+f.ranges[i].length > pos
+		})
+		if i == len(f.ranges) || pos < 0 {
+			return n, io.EOF
+		}
+		rng := f.ranges[i]
+		end := min(int64(len(p)-n), rng.logical+rng.length-pos)
+		read, err := f.r.ReadAt(p[n:n+int(end)], rng.physical+pos-rng.logical)
+		n += read
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func (v *hfsPlusVolume) extractDir(id uint32, target string, depth int) error {
+	if depth > hfsPlusMaxDepth {
+		return fmt.Errorf("%w: directory tree is too deep", errDMGMalformed)
+	}
+	if v.visited[id] {
+		return fmt.Errorf("%w: directory loop detected", errDMGMalformed)
+	}
+	v.visited[id] = true
+
+	if err := os.MkdirAll(target, 0o755); err != nil {
+		return err
+	}
+
+	for _, e := range v.children[id] {
+		if depth == 0 && hfsPlusMetadataNames[e.name] {
+			continue
+		}
+
+		// names with a "/" are presented with a ":" instead (since the Carbon path separator is stored as "/")
+		name := strings.ReplaceAll(e.name, "/", ":")
+		p := filepath.Join(target, name)
+		if !isSafeEntryName(name) {
+			log.WithFields("name", name).Trace("skipping hfs+ entry with an invalid name")
+			continue
+		}
+		if err := v.limit.Next(); err != nil {
+			return err
+		}
+
+		if e.folder {
+			if err := v.extractDir(e.id, p, depth+1); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := v.extractEntry(e, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *hfsPlusVolume) extractEntry(e *hfsPlusEntry, target string) error {
+	if e.fileType == "hlnk" && e.creator == "hfs+" {
+		inode := v.hardLinkInode(e.special)
+		if inode == nil {
+			log.WithFields("path", target).Trace("skipping hfs+ hard link without an inode")
+			return nil
+		}
+		e = inode
+	}
+
+	switch {
+	case e.ownerFlags&hfsPlusCompressedFlag != 0:
+		log.WithFields("path", target).Trace("skipping compressed file in hfs+ volume")
+		return nil
+	case e.mode&hfsPlusModeTypeMask == hfsPlusModeSymlink:
+		return v.extractSymlink(e, target)
+	case e.mode&hfsPlusModeTypeMask == hfsPlusModeRegular, e.mode == 0:
+		// files created without BSD permissions have no mode
+		return v.extractFile(e, target)
+	default:
+		// devices, fifos, and sockets have no contents to catalog
+		log.WithFields("path", target).Trace("skipping special file in hfs+ volume")
+		return nil
+	}
+}
+
+// hardLinkInode returns the file holding the contents of a hard link, which is named by the inode number within the
+// private data directory.
+func (v *hfsPlusVolume) hardLinkInode(inode uint32) *hfsPlusEntry {
+	if v.privateDir == nil {
+		return nil
+	}
+	name := fmt.Sprintf("iNode%d", inode)
+	for _, e := range v.children[v.privateDir.id] {
+		if !e.folder && e.name == name && e.fileType != "hlnk" {
+			return e
+		}
+	}
+	return nil
+}
+
+func (v *hfsPlusVolume) extractFile(e *hfsPlusEntry, target string) error {
+	if v.extracted+e.data.size > v.maxSize {
+		return errDMGTooLarge
+	}
+	v.extracted += e.data.size
+
+	src, err := v.forkReader(e.data, e.id)
+	if err != nil {
+		return err
+	}
+
+	dst, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		return fmt.Errorf("unable to extract %q from hfs+ volume: %w", e.name, err)
+	}
+	return nil
+}
+
+func (v *hfsPlusVolume) extractSymlink(e *hfsPlusEntry, target string) error {
+	if e.data.size > hfsPlusMaxSymlinkSize {
+		return fmt.Errorf("%w: invalid symlink target size %d", errDMGMalformed, e.data.size)
+	}
+
+	src, err := v.forkReader(e.data, e.id)
+	if err != nil {
+		return err
+	}
+	raw := make([]byte, e.data.size)
+	if _, err := io.ReadFull(src, raw); err != nil {
+		return fmt.Errorf("%w: unable to read symlink: %v", errDMGMalformed, err)
+	}
+
+	link := string(raw)
+	resolved := filepath.Join(filepath.Dir(target), filepath.FromSlash(link))
+	if filepath.IsAbs(link) || (resolved != v.dest && !strings.HasPrefix(resolved, v.dest+string(filepath.Separator))) {
+		log.WithFields("path", target, "link", link).Trace("skipping hfs+ symlink that resolves outside of the volume")
+		return nil
+	}
+	return os.Symlink(link, target)
+}
@@ -0,0 +1,445 @@
+package filesource
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/source/internal"
+)
+
+const (
+	// the newc (and crc) cpio header is the magic followed by 13 fields, each 8 hex characters
+	cpioHeaderSize = 110
+
+	// the name of the last entry of every cpio archive
+	cpioTrailer = "TRAILER!!!"
+
+	// bound the size of names and symlink targets, which are read into memory
+	cpioMaxNameSize = 4096
+
+	cpioModeTypeMask = 0o170000
+	cpioModeDir      = 0o040000
+	cpioModeRegular  = 0o100000
+	cpioModeSymlink  = 0o120000
+)
+
+var (
+	cpioNewcMagic = []byte("070701")
+	// the crc format is identical to newc, except the check field is the sum of the bytes of the file contents
+	cpioCRCMagic = []byte("070702")
+
+	gzipMagic = []byte{0x1f, 0x8b}
+	xzMagic   = []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// maxInitramfsExtractionSize is the upper bound on the total size of file contents extracted from an initramfs, which
+// protects against decompression bombs (10 GB).
+var maxInitramfsExtractionSize int64 = 10 * 1024 * 1024 * 1024
+
+var (
+	errInitramfsTooLarge  = errors.New("initramfs contents exceed the max extraction size")
+	errInitramfsMalformed = errors.New("malformed initramfs archive")
+)
+
+// isInitramfs indicates if the file at the given path is an initramfs (a newc or crc cpio archive, which may be
+// compressed with gzip, xz, or zstd), based on the file contents.
+func isInitramfs(path string) bool {
+	fh, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer fh.Close()
+
+	r, closer, err := decompressInitramfs(bufio.NewReader(fh))
+	if err != nil {
+		return false
+	}
+	defer closer()
+
+	magic := make([]byte, len(cpioNewcMagic))
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return false
+	}
+	return isCPIOMagic(magic)
+}
+
+func unpackInitramfsToTmp(path string, maxEntries int) (string, func() error, error) {
+	tempDir, err := os.MkdirTemp("", "syft-initramfs-contents-")
+	if err != nil {
+		return "", func() error { return nil }, fmt.Errorf("unable to create tempdir for initramfs processing: %w", err)
+	}
+
+	cleanupFn := func() error {
+		return os.RemoveAll(tempDir)
+	}
+
+	fh, err := os.Open(path)
+	if err != nil {
+		return "", cleanupFn, fmt.Errorf("unable to open initramfs file=%q: %w", path, err)
+	}
+	defer fh.Close()
+
+	return tempDir, cleanupFn, extractInitramfs(fh, tempDir, maxInitramfsExtractionSize, internal.NewEntryLimit(maxEntries))
+}
+
+// extractInitramfs writes all directories, regular files, and symlinks from the initramfs to the given destination
+// directory. Like the kernel, several cpio archives may be concatenated (e.g. an uncompressed archive with CPU
+// microcode followed by the compressed root filesystem), where each archive may be preceded by zero padding.
+// Extraction stops with an error once the total size of file contents exceeds maxSize, or once the entry limit is
+// reached. Symlinks that would resolve outside of the destination directory are not extracted.
+func extractInitramfs(r io.Reader, dest string, maxSize int64, limit *internal.EntryLimit) error {
+	x := &cpioExtractor{
+		dest:      dest,
+		maxSize:   maxSize,
+		limit:     limit,
+		hardlinks: make(map[cpioInode][]string),
+	}
+
+	br := bufio.NewReader(r)
+	archives := 0
+	for {
+		if err := skipZeroPadding(br); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+
+		magic, err := br.Peek(len(cpioNewcMagic))
+		if err != nil {
+			// trailing bytes shorter than any magic are ignored
+			break
+		}
+
+		if !isCPIOMagic(magic) {
+			decompressed, closer, err := decompressInitramfs(br)
+			if err != nil || decompressed == br {
+				if archives > 0 {
+					// like the kernel, anything that is not an archive after the first archive is ignored
+					log.WithFields("archives", archives).Trace("ignoring trailing data in initramfs")
+					break
+				}
+				return errInitramfsMalformed
+			}
+			defer closer()
+			br = bufio.NewReader(decompressed)
+			continue
+		}
+
+		if err := x.extractArchive(br); err != nil {
+			return err
+		}
+		archives++
+	}
+
+	if archives == 0 {
+		return errInitramfsMalformed
+	}
+	return nil
+}
+
+// decompressInitramfs returns a reader of the decompressed contents when the given reader starts with gzip, xz, or
+// zstd compressed data, otherwise the given reader is returned as-is.
+func decompressInitramfs(br *bufio.Reader) (io.Reader, func(), error) {
+	hasMagic := func(magic []byte) bool {
+		b, err := br.Peek(len(magic))
+		return err == nil && bytes.Equal(b, magic)
+	}
+
+	switch {
+	case hasMagic(gzipMagic):
+		r, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, nil, err
+		}
+		return r, func() { _ = r.Close() }, nil
+	case hasMagic(xzMagic):
+		r, err := xz.NewReader(br)
+		if err != nil {
+			return nil, nil, err
+		}
+		return r, func() {}, nil
+	case hasMagic(zstdMagic):
+		r, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, nil, err
+		}
+		return r, r.Close, nil
+	}
+	return br, func() {}, nil
+}
+
+func isCPIOMagic(magic []byte) bool {
+	return bytes.Equal(magic, cpioNewcMagic) || bytes.Equal(magic, cpioCRCMagic)
+}
+
+func skipZeroPadding(br *bufio.Reader) error {
+	for {
+		b, err := br.Peek(1)
+		if err != nil {
+			return err
+		}
+		if b[0] != 0 {
+			return nil
+		}
+		if _, err := br.Discard(1); err != nil {
+			return err
+		}
+	}
+}
+
+// cpioInode identifies the entries of a cpio archive that are hard links to the same file.
+type cpioInode struct {
+	devMajor, devMinor, ino uint64
+}
+
+type cpioHeader struct {
+	crc      bool
+	inode    cpioInode
+	mode     uint64
+	nlink    uint64
+	fileSize int64
+	nameSize int64
+	check    uint64
+}
+
+type cpioExtractor struct {
+	dest      string
+	maxSize   int64
+	extracted int64
+	limit     *internal.EntryLimit
+	// hardlinks are the paths of the entries (without contents) linked to each inode, where the contents are only
+	// found with the last entry of the inode
+	hardlinks map[cpioInode][]string
+}
+
+// extractArchive extracts each entry of a single cpio archive, up to and including the trailer entry.
+func (x *cpioExtractor) extractArchive(r io.Reader) error {
+	for {
+		h, err := readCPIOHeader(r)
+		if err != nil {
+			return err
+		}
+
+		name := make([]byte, h.nameSize)
+		if _, err := io.ReadFull(r, name); err != nil {
+			return fmt.Errorf("%w: %v", errInitramfsMalformed, err)
+		}
+		// the header and name are padded to a multiple of 4 bytes
+		if err := discard(r, pad4(cpioHeaderSize+h.nameSize)); err != nil {
+			return err
+		}
+
+		entry := string(bytes.TrimRight(name, "\x00"))
+		if entry == cpioTrailer {
+			// hard links within an archive are never linked to entries of another archive
+			x.hardlinks = make(map[cpioInode][]string)
+			return nil
+		}
+
+		if err := x.limit.Next(); err != nil {
+			return err
+		}
+		if err := x.extractEntry(r, entry, h); err != nil {
+			return err
+		}
+		if err := discard(r, pad4(h.fileSize)); err != nil {
+			return err
+		}
+	}
+}
+
+func readCPIOHeader(r io.Reader) (*cpioHeader, error) {
+	raw := make([]byte, cpioHeaderSize)
+	if _, err := io.ReadFull(r, raw); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInitramfsMalformed, err)
+	}
+
+	magic := raw[:len(cpioNewcMagic)]
+	if !isCPIOMagic(magic) {
+		return nil, fmt.Errorf("%w: unsupported cpio format %q", errInitramfsMalformed, magic)
+	}
+
+	var fields [13]uint64
+	for i := range fields {
+		start := len(cpioNewcMagic) + i*8
+		v, err := strconv.ParseUint(string(raw[start:start+8]), 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid cpio header: %v", errInitramfsMalformed, err)
+		}
+		fields[i] = v
+	}
+
+	h := &cpioHeader{
+		crc:      bytes.Equal(magic, cpioCRCMagic),
+		inode:    cpioInode{ino: fields[0], devMajor: fields[7], devMinor: fields[8]},
+		mode:     fields[1],
+		nlink:    fields[4],
+		fileSize: int64(fields[6]),
+		nameSize: int64(fields[11]),
+		check:    fields[12],
+	}
+
+	if h.nameSize == 0 || h.nameSize > cpioMaxNameSize {
+		return nil, fmt.Errorf("%w: invalid cpio name size %d", errInitramfsMalformed, h.nameSize)
+	}
+	return h, nil
+}
+
+// extractEntry extracts the entry with the given name, reading exactly the file contents from the given reader.
+func (x *cpioExtractor) extractEntry(r io.Reader, name string, h *cpioHeader) error {
+	target, ok := x.target(name)
+	if !ok {
+		log.WithFields("path", name).Trace("skipping initramfs entry that resolves outside of the archive")
+		return discard(r, h.fileSize)
+	}
+
+	fileType := h.mode & cpioModeTypeMask
+	if target == x.dest && fileType != cpioModeDir {
+		return discard(r, h.fileSize)
+	}
+
+	switch fileType {
+	case cpioModeDir:
+		if err := os.MkdirAll(target, 0o755); err != nil {
+			return err
+		}
+		return discard(r, h.fileSize)
+	case cpioModeSymlink:
+		return x.extractSymlink(r, name, target, h)
+	case cpioModeRegular:
+		return x.extractFile(r, name, target, h)
+	default:
+		// devices, fifos, and sockets have no contents to catalog
+		log.WithFields("path", name).Trace("skipping special file in initramfs")
+		return discard(r, h.fileSize)
+	}
+}
+
+// target returns the path within the destination directory for the entry with the given name.
+func (x *cpioExtractor) target(name string) (string, bool) {
+	cleaned := path.Clean(strings.TrimLeft(name, "/"))
+	switch {
+	case cleaned == ".":
+		// the root directory itself
+		return x.dest, true
+	case cleaned == ".." || strings.HasPrefix(cleaned, "../"):
+		return "", false
+	}
+	return filepath.Join(x.dest, filepath.FromSlash(cleaned)), true
+}
+
+func (x *cpioExtractor) extractFile(r io.Reader, name, target string, h *cpioHeader) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+
+	dst, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	remaining := x.maxSize - x.extracted
+	if h.fileSize > remaining {
+		return errInitramfsTooLarge
+	}
+
+	sum := &cpioChecksum{}
+	n, err := io.Copy(io.MultiWriter(dst, sum), io.LimitReader(r, h.fileSize))
+	x.extracted += n
+	if err != nil {
+		return fmt.Errorf("unable to extract %q from initramfs: %w", name, err)
+	}
+	if n != h.fileSize {
+		return fmt.Errorf("%w: truncated contents of %q", errInitramfsMalformed, name)
+	}
+	if h.crc && sum.value != uint32(h.check) {
+		return fmt.Errorf("%w: checksum mismatch for %q", errInitramfsMalformed, name)
+	}
+
+	if h.nlink < 2 {
+		return nil
+	}
+
+	// the contents of hard linked files are only stored with the last entry of the inode
+	if h.fileSize == 0 {
+		x.hardlinks[h.inode] = append(x.hardlinks[h.inode], target)
+		return nil
+	}
+	for _, link := range x.hardlinks[h.inode] {
+		if err := os.Remove(link); err != nil {
+			return err
+		}
+		if err := os.Link(target, link); err != nil {
+			return err
+		}
+	}
+	delete(x.hardlinks, h.inode)
+	return nil
+}
+
+func (x *cpioExtractor) extractSymlink(r io.Reader, name, target string, h *cpioHeader) error {
+	if h.fileSize > cpioMaxNameSize {
+		return fmt.Errorf("%w: invalid symlink target size %d", errInitramfsMalformed, h.fileSize)
+	}
+
+	raw := make([]byte, h.fileSize)
+	if _, err := io.ReadFull(r, raw); err != nil {
+		return fmt.Errorf("%w: %v", errInitramfsMalformed, err)
+	}
+
+	link := string(raw)
+	resolved := filepath.Join(filepath.Dir(target), filepath.FromSlash(link))
+	if filepath.IsAbs(link) || (resolved != x.dest && !strings.HasPrefix(resolved, x.dest+string(filepath.Separator))) {
+		log.WithFields("path", name, "link", link).Trace("skipping initramfs symlink that resolves outside of the archive")
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	// a later entry replaces an earlier entry at the same path
+	_ = os.Remove(target)
+	return os.Symlink(link, target)
+}
+
+// cpioChecksum is the (32-bit) sum of all bytes of the file contents, as recorded by the crc cpio format.
+type cpioChecksum struct {
+	value uint32
+}
+
+func (c *cpioChecksum) Write(p []byte) (int, error) {
+	for _, b := range p {
+		c.value += uint32(b)
+	}
+	return len(p), nil
+}
+
+func pad4(n int64) int64 {
+	return (4 - n%4) % 4
+}
+
+func discard(r io.Reader, n int64) error {
+	if n == 0 {
+		return nil
+	}
+	if _, err := io.CopyN(io.Discard, r, n); err != nil {
+		return fmt.Errorf("%w: %v", errInitramfsMalformed, err)
+	}
+	return nil
+}
@@ -0,0 +1,467 @@
+package filesource
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/source/internal"
+)
+
+const (
+	iso9660SectorSize = 2048
+
+	// volume descriptors start after the 16 sector system area
+	iso9660DescriptorStart = 16
+
+	// bound the number of volume descriptors read before the set terminator is found
+	iso9660MaxDescriptors = 64
+
+	// bound the depth of the directory tree (ISO 9660 allows 8 levels, but extensions allow deeper trees)
+	iso9660MaxDepth = 64
+
+	iso9660PrimaryDescriptor       = 1
+	iso9660SupplementaryDescriptor = 2
+	iso9660TerminatorDescriptor    = 255
+
+	iso9660DirectoryFlag   = 0x02
+	iso9660MultiExtentFlag = 0x80
+
+	iso9660MinRecordSize = 34
+
+	// bound the size of a single directory listing, which is read into memory
+	iso9660MaxDirectorySize = 64 * 1024 * 1024
+)
+
+// iso9660Magic is the standard identifier found in every ISO 9660 volume descriptor
+var iso9660Magic = []byte("CD001")
+
+// maxISO9660ExtractionSize is the upper bound on the total size of file contents extracted from an ISO 9660 image,
+// which protects against images that claim extents far larger than expected (10 GB).
+var maxISO9660ExtractionSize int64 = 10 * 1024 * 1024 * 1024
+
+var (
+	errISO9660TooLarge  = errors.New("iso9660 contents exceed the max extraction size")
+	errISO9660Malformed = errors.New("malformed iso9660 image")
+)
+
+// isISO9660 indicates if the file at the given path is an ISO 9660 disk image, based on the file contents.
+func isISO9660(path string) bool {
+	fh, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer fh.Close()
+
+	magic := make([]byte, len(iso9660Magic))
+	if _, err := fh.ReadAt(magic, iso9660DescriptorStart*iso9660SectorSize+1); err != nil {
+		return false
+	}
+	return bytes.Equal(magic, iso9660Magic)
+}
+
+func unpackISO9660ToTmp(path string, maxEntries int) (string, func() error, error) {
+	tempDir, err := os.MkdirTemp("", "syft-iso9660-contents-")
+	if err != nil {
+		return "", func() error { return nil }, fmt.Errorf("unable to create tempdir for iso9660 processing: %w", err)
+	}
+
+	cleanupFn := func() error {
+		return os.RemoveAll(tempDir)
+	}
+
+	fh, err := os.Open(path)
+	if err != nil {
+		return "", cleanupFn, fmt.Errorf("unable to open iso9660 file=%q: %w", path, err)
+	}
+	defer fh.Close()
+
+	fi, err := fh.Stat()
+	if err != nil {
+		return "", cleanupFn, fmt.Errorf("unable to stat iso9660 file=%q: %w", path, err)
+	}
+
+	return tempDir, cleanupFn, extractISO9660(fh, fi.Size(), tempDir, maxISO9660ExtractionSize, internal.NewEntryLimit(maxEntries))
+}
+
+// iso9660Record is a single entry within an ISO 9660 directory.
+type iso9660Record struct {
+	name    string
+	extent  int64
+	length  int64
+	dir     bool
+	symlink bool
+}
+
+type iso9660Volume struct {
+	r         io.ReaderAt
+	size      int64
+	blockSize int64
+	joliet    bool
+	rockRidge bool
+	// suspSkip is the number of bytes to skip at the start of each system use area (from the rock ridge SP entry)
+	suspSkip  int
+	extracted int64
+	maxSize   int64
+	limit     *internal.EntryLimit
+	visited   map[int64]bool
+}
+
+// extractISO9660 writes all directories and regular files from the ISO 9660 image to the given destination
+// directory. Names are taken from the Rock Ridge extensions when present, otherwise from the Joliet extensions when
+// present, falling back to the (uppercase, 8.3) names of the primary volume. Extraction stops with an error once the
+// total size of file contents exceeds maxSize, or once the entry limit is reached. Symlinks are not extracted.
+func extractISO9660(r io.ReaderAt, size int64, dest string, maxSize int64, limit *internal.EntryLimit) error {
+	v := &iso9660Volume{
+		r:       r,
+		size:    size,
+		maxSize: maxSize,
+		limit:   limit,
+		visited: make(map[int64]bool),
+	}
+
+	root, err := v.readVolumeDescriptors()
+	if err != nil {
+		return err
+	}
+
+	return v.extractDir(root, dest, 0)
+}
+
+// readVolumeDescriptors finds the root directory record of the volume, selecting the Joliet supplementary volume
+// when the primary volume does not use the Rock Ridge extensions.
+func (v *iso9660Volume) readVolumeDescriptors() (*iso9660Record, error) {
+	var primary, joliet *iso9660Record
+	var blockSize, jolietBlockSize int64
+
+	descriptor := make([]byte, iso9660SectorSize)
+	for i := 0; i < iso9660MaxDescriptors; i++ {
+		offset := int64(iso9660DescriptorStart+i) * iso9660SectorSize
+		if _, err := v.r.ReadAt(descriptor, offset); err != nil {
+			return nil, fmt.Errorf("%w: unable to read volume descriptor: %v", errISO9660Malformed, err)
+		}
+		if !bytes.Equal(descriptor[1:6], iso9660Magic) {
+			return nil, fmt.Errorf("%w: invalid volume descriptor identifier", errISO9660Malformed)
+		}
+
+		switch descriptor[0] {
+		case iso9660PrimaryDescriptor:
+			if primary != nil {
+				continue
+			}
+			rec, bs, err := parseISO9660VolumeRoot(descriptor)
+			if err != nil {
+				return nil, err
+			}
+			primary, blockSize = rec, bs
+		case iso9660SupplementaryDescriptor:
+			if joliet != nil || !isJolietDescriptor(descriptor) {
+				continue
+			}
+			rec, bs, err := parseISO9660VolumeRoot(descriptor)
+			if err != nil {
+				return nil, err
+			}
+			joliet, jolietBlockSize = rec, bs
+		case iso9660TerminatorDescriptor:
+			if primary == nil {
+				return nil, fmt.Errorf("%w: no primary volume descriptor", errISO9660Malformed)
+			}
+			v.blockSize = blockSize
+			if err := v.detectRockRidge(primary); err != nil {
+				return nil, err
+			}
+			if !v.rockRidge && joliet != nil {
+				v.joliet = true
+				v.blockSize = jolietBlockSize
+				return joliet, nil
+			}
+			return primary, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no volume descriptor set terminator", errISO9660Malformed)
+}
+
+func parseISO9660VolumeRoot(descriptor []byte) (*iso9660Record, int64, error) {
+	blockSize := int64(binary.LittleEndian.Uint16(descriptor[128:]))
+	switch blockSize {
+	case 512, 1024, 2048:
+	default:
+		return nil, 0, fmt.Errorf("%w: invalid logical block size %d", errISO9660Malformed, blockSize)
+	}
+
+	rec, _, err := parseISO9660Record(descriptor[156 : 156+iso9660MinRecordSize])
+	if err != nil {
+		return nil, 0, err
+	}
+	if !rec.dir {
+		return nil, 0, fmt.Errorf("%w: root record is not a directory", errISO9660Malformed)
+	}
+	return rec, blockSize, nil
+}
+
+// isJolietDescriptor indicates if the supplementary volume descriptor uses one of the UCS-2 escape sequences that
+// identify the Joliet extensions.
+func isJolietDescriptor(descriptor []byte) bool {
+	escape := descriptor[88:91]
+	return escape[0] == '%' && escape[1] == '/' && (escape[2] == '@' || escape[2] == 'C' || escape[2] == 'E')
+}
+
+// detectRockRidge looks for the SUSP "SP" entry within the first record (".") of the root directory, which
+// indicates the use of the Rock Ridge extensions.
+func (v *iso9660Volume) detectRockRidge(root *iso9660Record) error {
+	data, err := v.readDirExtent(root)
+	if err != nil {
+		return err
+	}
+	if len(data) < iso9660MinRecordSize || int(data[0]) > len(data) || data[0] < iso9660MinRecordSize {
+		return fmt.Errorf("%w: invalid root directory", errISO9660Malformed)
+	}
+
+	record := data[:data[0]]
+	su := record[systemUseOffset(record):]
+	if len(su) >= 7 && string(su[0:2]) == "SP" && su[4] == 0xbe && su[5] == 0xef {
+		v.rockRidge = true
+		v.suspSkip = int(su[6])
+	}
+	return nil
+}
+
+// readDirExtent reads the contents of a directory into memory.
+func (v *iso9660Volume) readDirExtent(rec *iso9660Record) ([]byte, error) {
+	offset := rec.extent * v.blockSize
+	if offset+rec.length > v.size {
+		return nil, fmt.Errorf("%w: directory extent is out of bounds", errISO9660Malformed)
+	}
+	if rec.length > iso9660MaxDirectorySize {
+		return nil, fmt.Errorf("%w: directory is too large", errISO9660Malformed)
+	}
+
+	data := make([]byte, rec.length)
+	if _, err := v.r.ReadAt(data, offset); err != nil {
+		return nil, fmt.Errorf("%w: unable to read extent: %v", errISO9660Malformed, err)
+	}
+	return data, nil
+}
+
+func (v *iso9660Volume) extractDir(dir *iso9660Record, target string, depth int) error {
+	if depth > iso9660MaxDepth {
+		return fmt.Errorf("%w: directory tree is too deep", errISO9660Malformed)
+	}
+	if v.visited[dir.extent] {
+		return fmt.Errorf("%w: directory loop detected", errISO9660Malformed)
+	}
+	v.visited[dir.extent] = true
+
+	if err := os.MkdirAll(target, 0o755); err != nil {
+		return err
+	}
+
+	records, err := v.readDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, rec := range records {
+		if err := v.limit.Next(); err != nil {
+			return err
+		}
+
+		p := filepath.Join(target, rec.name)
+		switch {
+		case rec.symlink:
+			log.WithFields("path", p).Trace("skipping symlink in iso9660 image")
+		case rec.dir:
+			if err := v.extractDir(rec, p, depth+1); err != nil {
+				return err
+			}
+		default:
+			if err := v.extractFile(rec, p); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (v *iso9660Volume) extractFile(rec *iso9660Record, target string) error {
+	offset := rec.extent * v.blockSize
+	if offset+rec.length > v.size {
+		return fmt.Errorf("%w: file extent is out of bounds", errISO9660Malformed)
+	}
+	if v.extracted+rec.length > v.maxSize {
+		return errISO9660TooLarge
+	}
+	v.extracted += rec.length
+
+	dst, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, io.NewSectionReader(v.r, offset, rec.length)); err != nil {
+		return fmt.Errorf("unable to extract %q from iso9660 image: %w", rec.name, err)
+	}
+	return nil
+}
+
+// readDir returns all records within the given directory (excluding the "." and ".." entries).
+func (v *iso9660Volume) readDir(dir *iso9660Record) ([]*iso9660Record, error) {
+	data, err := v.readDirExtent(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*iso9660Record
+	for offset := 0; offset < len(data); {
+		recordLen := int(data[offset])
+		if recordLen == 0 {
+			// records do not span sectors, so the remainder of the sector is padding
+			offset = (offset/iso9660SectorSize + 1) * iso9660SectorSize
+			continue
+		}
+		if offset+recordLen > len(data) {
+			return nil, fmt.Errorf("%w: directory record is out of bounds", errISO9660Malformed)
+		}
+
+		rec, rawName, err := parseISO9660Record(data[offset : offset+recordLen])
+		if err != nil {
+			return nil, err
+		}
+		offset += recordLen
+
+		if len(rawName) == 1 && (rawName[0] == 0 || rawName[0] == 1) {
+			// the current (".") and parent ("..") directories
+			continue
+		}
+
+		record := data[offset-recordLen : offset]
+		if flags := record[25]; flags&iso9660MultiExtentFlag != 0 {
+			log.WithFields("name", rec.name).Trace("skipping multi-extent file in iso9660 image")
+			continue
+		}
+
+		rec.name = v.decodeName(rawName)
+		if v.rockRidge {
+			su := record[systemUseOffset(record):]
+			if len(su) >= v.suspSkip {
+				relocated := v.applyRockRidge(rec, su[v.suspSkip:])
+				if relocated {
+					continue
+				}
+			}
+		}
+
+		if !isSafeEntryName(rec.name) {
+			log.WithFields("name", rec.name).Trace("skipping iso9660 entry with an invalid name")
+			continue
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// parseISO9660Record parses a directory record, returning the raw (undecoded) file identifier.
+func parseISO9660Record(record []byte) (*iso9660Record, []byte, error) {
+	if len(record) < 33 {
+		return nil, nil, fmt.Errorf("%w: directory record is truncated", errISO9660Malformed)
+	}
+	nameLen := int(record[32])
+	if 33+nameLen > len(record) {
+		return nil, nil, fmt.Errorf("%w: directory record name is out of bounds", errISO9660Malformed)
+	}
+
+	return &iso9660Record{
+		extent: int64(binary.LittleEndian.Uint32(record[2:])),
+		length: int64(binary.LittleEndian.Uint32(record[10:])),
+		dir:    record[25]&iso9660DirectoryFlag != 0,
+	}, record[33 : 33+nameLen], nil
+}
+
+// systemUseOffset returns the offset of the system use area within a directory record, which follows the file
+// identifier (and a padding byte when the identifier has an even length).
+func systemUseOffset(record []byte) int {
+	nameLen := int(record[32])
+	offset := 33 + nameLen
+	if nameLen%2 == 0 {
+		offset++
+	}
+	if offset > len(record) {
+		return len(record)
+	}
+	return offset
+}
+
+func (v *iso9660Volume) decodeName(raw []byte) string {
+	var name string
+	if v.joliet {
+		units := make([]uint16, len(raw)/2)
+		for i := range units {
+			units[i] = binary.BigEndian.Uint16(raw[i*2:])
+		}
+		name = string(utf16.Decode(units))
+	} else {
+		name = string(raw)
+	}
+
+	// remove the file version (e.g. "FILE.TXT;1") and the trailing separator of names without an extension
+	if idx := strings.LastIndex(name, ";"); idx >= 0 {
+		name = name[:idx]
+	}
+	return strings.TrimSuffix(name, ".")
+}
+
+// applyRockRidge updates the record from the Rock Ridge entries within the given system use area, indicating if the
+// record is a relocated directory (which is reached from elsewhere in the tree and should not be listed here).
+func (v *iso9660Volume) applyRockRidge(rec *iso9660Record, su []byte) bool {
+	var name strings.Builder
+	var hasName bool
+	for len(su) >= 4 {
+		entryLen := int(su[2])
+		if entryLen < 4 || entryLen > len(su) {
+			break
+		}
+		entry := su[:entryLen]
+		switch string(entry[0:2]) {
+		case "NM":
+			// the name may be split across multiple entries; the current and parent flags carry no name
+			if entryLen > 5 && entry[4]&0x06 == 0 {
+				name.Write(entry[5:])
+				hasName = true
+			}
+		case "SL":
+			rec.symlink = true
+		case "RE":
+			return true
+		case "CL":
+			// a child link points to a directory that was relocated to keep the tree shallow. Following it
+			// requires reading the relocated directory record, which is not supported.
+			log.WithFields("name", rec.name).Trace("skipping relocated directory in iso9660 image")
+			return true
+		case "ST":
+			su = nil
+			continue
+		}
+		su = su[entryLen:]
+	}
+
+	if hasName {
+		rec.name = name.String()
+	}
+	return false
+}
+
+// isSafeEntryName indicates if the name of an entry of a disk image may be used as a single path element within the
+// extraction directory.
+func isSafeEntryName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, "/\\\x00")
+}
@@ -0,0 +1,145 @@
+package filesource
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sylabs/squashfs"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/source/internal"
+)
+
+// squashFSMagic is found at the start of every squashfs filesystem (e.g. as used by snap packages)
+var squashFSMagic = []byte("hsqs")
+
+// maxSquashFSExtractionSize is the upper bound on the total size of file contents extracted from a squashfs
+// filesystem, which protects against decompression bombs (10 GB).
+var maxSquashFSExtractionSize int64 = 10 * 1024 * 1024 * 1024
+
+var errSquashFSTooLarge = errors.New("squashfs contents exceed the max extraction size")
+
+// isSquashFS indicates if the file at the given path is a squashfs filesystem, based on the file contents.
+func isSquashFS(path string) bool {
+	fh, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer fh.Close()
+
+	magic := make([]byte, len(squashFSMagic))
+	if _, err := io.ReadFull(fh, magic); err != nil {
+		return false
+	}
+	return bytes.Equal(magic, squashFSMagic)
+}
+
+func unsquashToTmp(path string, maxEntries int) (string, func() error, error) {
+	tempDir, err := os.MkdirTemp("", "syft-squashfs-contents-")
+	if err != nil {
+		return "", func() error { return nil }, fmt.Errorf("unable to create tempdir for squashfs processing: %w", err)
+	}
+
+	cleanupFn := func() error {
+		return os.RemoveAll(tempDir)
+	}
+
+	fh, err := os.Open(path)
+	if err != nil {
+		return "", cleanupFn, fmt.Errorf("unable to open squashfs file=%q: %w", path, err)
+	}
+	defer fh.Close()
+
+	return tempDir, cleanupFn, extractSquashFS(fh, tempDir, maxSquashFSExtractionSize, internal.NewEntryLimit(maxEntries))
+}
+
+// extractSquashFS writes all directories, regular files, and symlinks from the squashfs filesystem to the given
+// destination directory. Extraction stops with an error once the total size of file contents exceeds maxSize, or once
+// the entry limit is reached. Symlinks that would resolve outside of the destination directory are not extracted.
+func extractSquashFS(r io.ReaderAt, dest string, maxSize int64, limit *internal.EntryLimit) error {
+	fsys, err := squashfs.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("unable to read squashfs filesystem: %w", err)
+	}
+
+	var extracted int64
+	return fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if p != "." {
+			if err := limit.Next(); err != nil {
+				return err
+			}
+		}
+
+		target := filepath.Join(dest, filepath.FromSlash(p))
+
+		switch {
+		case d.IsDir():
+			return os.MkdirAll(target, 0o755)
+		case d.Type()&fs.ModeSymlink != 0:
+			return extractSquashFSSymlink(fsys, p, target, dest)
+		case d.Type().IsRegular():
+			n, err := extractSquashFSFile(fsys, p, target, maxSize-extracted)
+			extracted += n
+			return err
+		default:
+			// devices, fifos, and sockets have no contents to catalog
+			log.WithFields("path", p).Trace("skipping special file in squashfs filesystem")
+			return nil
+		}
+	})
+}
+
+func extractSquashFSFile(fsys fs.FS, p, target string, remaining int64) (int64, error) {
+	src, err := fsys.Open(p)
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return 0, err
+	}
+	defer dst.Close()
+
+	// read one byte past the remaining budget to detect when the budget has been exceeded
+	n, err := io.Copy(dst, io.LimitReader(src, remaining+1))
+	if err != nil {
+		return n, fmt.Errorf("unable to extract %q from squashfs filesystem: %w", p, err)
+	}
+	if n > remaining {
+		return n, errSquashFSTooLarge
+	}
+	return n, nil
+}
+
+func extractSquashFSSymlink(fsys fs.FS, p, target, dest string) error {
+	src, err := fsys.Open(p)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	f, ok := src.(*squashfs.File)
+	if !ok {
+		return errors.New("unexpected file type from squashfs")
+	}
+
+	link := f.SymlinkPath()
+	resolved := filepath.Join(filepath.Dir(target), filepath.FromSlash(link))
+	if filepath.IsAbs(link) || (resolved != dest && !strings.HasPrefix(resolved, dest+string(filepath.Separator))) {
+		log.WithFields("path", p, "link", link).Trace("skipping squashfs symlink that resolves outside of the filesystem")
+		return nil
+	}
+
+	return os.Symlink(link, target)
+}
@@ -17,6 +17,8 @@ type ImageMetadata struct {
 	Variant        string            `json:"architectureVariant,omitempty"`
 	OS             string            `json:"os"`
 	Labels         map[string]string `json:"labels,omitempty"`
+	Annotations    map[string]string `json:"annotations,omitempty"`
+	Provenance     *ImageProvenance  `json:"provenance,omitempty"`
 }
 
 // LayerMetadata represents all static metadata that defines what a container image layer is.
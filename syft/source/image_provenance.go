@@ -0,0 +1,68 @@
+package source
+
+// the pre-defined OCI annotation keys describing the provenance of an image
+// (see https://github.com/opencontainers/image-spec/blob/main/annotations.md)
+const (
+	ociAnnotationCreated       = "org.opencontainers.image.created"
+	ociAnnotationAuthors       = "org.opencontainers.image.authors"
+	ociAnnotationURL           = "org.opencontainers.image.url"
+	ociAnnotationDocumentation = "org.opencontainers.image.documentation"
+	ociAnnotationSource        = "org.opencontainers.image.source"
+	ociAnnotationVersion       = "org.opencontainers.image.version"
+	ociAnnotationRevision      = "org.opencontainers.image.revision"
+	ociAnnotationVendor        = "org.opencontainers.image.vendor"
+	ociAnnotationLicenses      = "org.opencontainers.image.licenses"
+	ociAnnotationTitle         = "org.opencontainers.image.title"
+	ociAnnotationDescription   = "org.opencontainers.image.description"
+	ociAnnotationBaseName      = "org.opencontainers.image.base.name"
+	ociAnnotationBaseDigest    = "org.opencontainers.image.base.digest"
+)
+
+// ImageProvenance is the provenance of a container image as declared by the pre-defined OCI annotations, which may be
+// set on the image manifest (as annotations) or on the image config (as labels).
+type ImageProvenance struct {
+	Created       string `json:"created,omitempty"`
+	Authors       string `json:"authors,omitempty"`
+	URL           string `json:"url,omitempty"`
+	Documentation string `json:"documentation,omitempty"`
+	Source        string `json:"source,omitempty"`
+	Version       string `json:"version,omitempty"`
+	Revision      string `json:"revision,omitempty"`
+	Vendor        string `json:"vendor,omitempty"`
+	Licenses      string `json:"licenses,omitempty"`
+	Title         string `json:"title,omitempty"`
+	Description   string `json:"description,omitempty"`
+	BaseName      string `json:"baseName,omitempty"`
+	BaseDigest    string `json:"baseDigest,omitempty"`
+}
+
+// NewImageProvenance returns the provenance declared by the given manifest annotations and config labels of an image,
+// where annotations take precedence over labels with the same key. Nil is returned if no provenance is declared.
+func NewImageProvenance(annotations, labels map[string]string) *ImageProvenance {
+	get := func(key string) string {
+		if v := annotations[key]; v != "" {
+			return v
+		}
+		return labels[key]
+	}
+
+	p := ImageProvenance{
+		Created:       get(ociAnnotationCreated),
+		Authors:       get(ociAnnotationAuthors),
+		URL:           get(ociAnnotationURL),
+		Documentation: get(ociAnnotationDocumentation),
+		Source:        get(ociAnnotationSource),
+		Version:       get(ociAnnotationVersion),
+		Revision:      get(ociAnnotationRevision),
+		Vendor:        get(ociAnnotationVendor),
+		Licenses:      get(ociAnnotationLicenses),
+		Title:         get(ociAnnotationTitle),
+		Description:   get(ociAnnotationDescription),
+		BaseName:      get(ociAnnotationBaseName),
+		BaseDigest:    get(ociAnnotationBaseDigest),
+	}
+	if p == (ImageProvenance{}) {
+		return nil
+	}
+	return &p
+}
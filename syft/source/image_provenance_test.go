@@ -0,0 +1,60 @@
+package source
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewImageProvenance(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		labels      map[string]string
+		expected    *ImageProvenance
+	}{
+		{
+			name: "no provenance",
+			labels: map[string]string{
+				"maintainer": "someone",
+			},
+		},
+		{
+			name: "from labels",
+			labels: map[string]string{
+				"org.opencontainers.image.source":   "https://github.com/anchore/syft",
+				"org.opencontainers.image.revision": "abc123",
+				"org.opencontainers.image.vendor":   "Anchore, Inc.",
+				"maintainer":                        "someone",
+			},
+			expected: &ImageProvenance{
+				Source:   "https://github.com/anchore/syft",
+				Revision: "abc123",
+				Vendor:   "Anchore, Inc.",
+			},
+		},
+		{
+			name: "annotations take precedence over labels",
+			annotations: map[string]string{
+				"org.opencontainers.image.revision":    "def456",
+				"org.opencontainers.image.base.name":   "docker.io/library/alpine:3.20",
+				"org.opencontainers.image.base.digest": "sha256:0a4eaa0eecf5f8c050e5bba433f58c052be7587ee8af3e8b3910ef9ab5fbe9f5",
+			},
+			labels: map[string]string{
+				"org.opencontainers.image.source":   "https://github.com/anchore/syft",
+				"org.opencontainers.image.revision": "abc123",
+			},
+			expected: &ImageProvenance{
+				Source:     "https://github.com/anchore/syft",
+				Revision:   "def456",
+				BaseName:   "docker.io/library/alpine:3.20",
+				BaseDigest: "sha256:0a4eaa0eecf5f8c050e5bba433f58c052be7587ee8af3e8b3910ef9ab5fbe9f5",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, NewImageProvenance(test.annotations, test.labels))
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package internal
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/mholt/archiver/v3"
+)
+
+// ErrTooManyArchiveEntries is returned once the maximum number of entries of an archive have been processed, where
+// the remaining entries are skipped. The entries processed up to that point are still usable.
+var ErrTooManyArchiveEntries = errors.New("archive exceeds the maximum number of entries")
+
+// EntryLimit counts the entries processed from an archive against the configured maximum (0 means there is no limit).
+type EntryLimit struct {
+	max   int
+	count int
+}
+
+func NewEntryLimit(maxEntries int) *EntryLimit {
+	return &EntryLimit{max: maxEntries}
+}
+
+// Next counts another entry to process, returning ErrTooManyArchiveEntries when the maximum number of entries have
+// already been processed (in which case the entry should be skipped).
+func (l *EntryLimit) Next() error {
+	if l == nil || l.max <= 0 {
+		return nil
+	}
+	if l.count >= l.max {
+		return fmt.Errorf("%w (%d)", ErrTooManyArchiveEntries, l.max)
+	}
+	l.count++
+	return nil
+}
+
+// Unarchive unarchives the given archive into the destination directory, stopping with ErrTooManyArchiveEntries after
+// maxEntries entries have been unarchived (0 means there is no limit). Archive formats other than tar and zip archives
+// are unarchived entirely.
+func Unarchive(unarchiver archiver.Unarchiver, archivePath, dest string, maxEntries int) error {
+	walker, ok := unarchiver.(archiver.Walker)
+	if _, isRar := unarchiver.(*archiver.Rar); maxEntries <= 0 || !ok || isRar {
+		return unarchiver.Unarchive(archivePath, dest)
+	}
+
+	limit := NewEntryLimit(maxEntries)
+	var limitErr error
+	err := walker.Walk(archivePath, func(f archiver.File) error {
+		if err := limit.Next(); err != nil {
+			limitErr = err
+			return archiver.ErrStopWalk
+		}
+		return unarchiveEntry(f, dest)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to unarchive %q: %w", archivePath, err)
+	}
+	if limitErr != nil {
+		return fmt.Errorf("%w: %s", limitErr, archivePath)
+	}
+	return nil
+}
+
+// unarchiveEntry writes a single directory, regular file, or link of an archive to the destination directory.
+// Entries are always written within the destination directory (regardless of any ".." path elements), and any
+// existing entry at the same location is overwritten (the last entry wins, as with unarchived tar files).
+func unarchiveEntry(f archiver.File, dest string) error {
+	var name, linkName string
+	var hardLink bool
+	switch hdr := f.Header.(type) {
+	case *tar.Header:
+		name, linkName = hdr.Name, hdr.Linkname
+		hardLink = hdr.Typeflag == tar.TypeLink
+	case zip.FileHeader:
+		name = hdr.Name
+	default:
+		return fmt.Errorf("unsupported archive entry header: %T", f.Header)
+	}
+
+	target := filepath.Join(dest, filepath.Clean(string(filepath.Separator)+filepath.FromSlash(name)))
+	if target == filepath.Clean(dest) {
+		return nil
+	}
+
+	mode := f.Mode()
+	switch {
+	case f.IsDir():
+		return os.MkdirAll(target, 0o755)
+	case hardLink:
+		if err := replaceable(target); err != nil {
+			return err
+		}
+		return os.Link(filepath.Join(dest, filepath.Clean(string(filepath.Separator)+filepath.FromSlash(linkName))), target)
+	case mode&os.ModeSymlink != 0:
+		if linkName == "" {
+			// zip archives hold the symlink target as the entry contents
+			b, err := io.ReadAll(io.LimitReader(f, 4096))
+			if err != nil {
+				return err
+			}
+			linkName = string(b)
+		}
+		if err := replaceable(target); err != nil {
+			return err
+		}
+		return os.Symlink(linkName, target)
+	case mode.IsRegular():
+		if err := replaceable(target); err != nil {
+			return err
+		}
+		out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode.Perm()|0o600)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+		_, err = io.Copy(out, f) //nolint:gosec // the entry is bounded by the size of the archive, as with archiver
+		return err
+	default:
+		// devices, fifos, and sockets have no contents to catalog
+		return nil
+	}
+}
+
+// replaceable prepares the given location to be written to, creating the parent directories and removing any
+// existing entry.
+func replaceable(target string) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	if err := os.Remove(target); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
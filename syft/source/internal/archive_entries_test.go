@@ -0,0 +1,83 @@
+package internal
+
+import (
+	"archive/tar"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mholt/archiver/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnarchive(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "example.tar")
+	f, err := os.Create(archivePath)
+	require.NoError(t, err)
+	w := tar.NewWriter(f)
+	require.NoError(t, w.WriteHeader(&tar.Header{Name: "dir/", Mode: 0o755, Typeflag: tar.TypeDir}))
+	for _, name := range []string{"dir/a.txt", "./b.txt"} {
+		require.NoError(t, w.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Typeflag: tar.TypeReg, Size: int64(len(name))}))
+		_, err = w.Write([]byte(name))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.WriteHeader(&tar.Header{Name: "c.txt", Typeflag: tar.TypeSymlink, Linkname: "dir/a.txt"}))
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+
+	tests := []struct {
+		name       string
+		maxEntries int
+		wantErr    require.ErrorAssertionFunc
+		expected   []string
+	}{
+		{
+			name:     "no limit",
+			wantErr:  require.NoError,
+			expected: []string{"dir/a.txt", "b.txt", "c.txt"},
+		},
+		{
+			name:       "limit not exceeded",
+			maxEntries: 4,
+			wantErr:    require.NoError,
+			expected:   []string{"dir/a.txt", "b.txt", "c.txt"},
+		},
+		{
+			name:       "limit exceeded",
+			maxEntries: 2,
+			wantErr: func(t require.TestingT, err error, _ ...interface{}) {
+				assert.ErrorIs(t, err, ErrTooManyArchiveEntries)
+			},
+			expected: []string{"dir/a.txt"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dest := t.TempDir()
+			test.wantErr(t, Unarchive(archiver.NewTar(), archivePath, dest, test.maxEntries))
+
+			var found []string
+			require.NoError(t, filepath.Walk(dest, func(p string, info os.FileInfo, err error) error {
+				if err != nil || info.IsDir() {
+					return err
+				}
+				rel, err := filepath.Rel(dest, p)
+				found = append(found, filepath.ToSlash(rel))
+				return err
+			}))
+			assert.ElementsMatch(t, test.expected, found)
+		})
+	}
+}
+
+func TestEntryLimit(t *testing.T) {
+	limit := NewEntryLimit(2)
+	require.NoError(t, limit.Next())
+	require.NoError(t, limit.Next())
+	require.ErrorIs(t, limit.Next(), ErrTooManyArchiveEntries)
+
+	var noLimit *EntryLimit
+	require.NoError(t, noLimit.Next())
+}
@@ -0,0 +1,283 @@
+/*
+Package processsource provides a source for the executable and shared libraries mapped into the memory of a running
+process (as listed by /proc/<pid>/maps), which reflects what is actually loaded at runtime rather than what is
+installed. The mapped files are read through the root filesystem of the process (/proc/<pid>/root), so processes
+running within containers are supported, and are copied upfront so the process may exit while being cataloged.
+*/
+package processsource
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/directorysource"
+	"github.com/anchore/syft/syft/source/internal"
+)
+
+const (
+	// defaultProcRoot is where the proc filesystem is mounted
+	defaultProcRoot = "/proc"
+
+	// deletedSuffix is appended by the kernel to the paths of mapped files that have since been removed (or replaced)
+	deletedSuffix = " (deleted)"
+)
+
+// ErrProcessNotFound is returned when the given process does not exist (or has exited).
+var ErrProcessNotFound = errors.New("process not found")
+
+var _ source.Source = (*processSource)(nil)
+
+type Config struct {
+	PID int
+
+	// ProcRoot is where the proc filesystem is mounted (defaults to /proc)
+	ProcRoot string
+
+	Exclude source.ExcludeConfig
+	Alias   source.Alias
+}
+
+type processSource struct {
+	source.Source
+	id      artifact.ID
+	config  Config
+	cleanup func() error
+}
+
+// New creates a source for the files mapped into the memory of the given process. ErrProcessNotFound is returned if
+// the process does not exist, and os.ErrPermission if the memory maps of the process cannot be read (which requires
+// running as the owner of the process, or with CAP_SYS_PTRACE).
+func New(cfg Config) (source.Source, error) {
+	if cfg.ProcRoot == "" {
+		cfg.ProcRoot = defaultProcRoot
+	}
+	procDir := filepath.Join(cfg.ProcRoot, strconv.Itoa(cfg.PID))
+
+	paths, err := mappedPaths(procDir)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no mapped files found for process %d", cfg.PID)
+	}
+
+	analysisPath, cleanup, err := copyToTmp(filepath.Join(procDir, "root"), paths)
+	if err != nil {
+		_ = cleanup()
+		return nil, err
+	}
+
+	alias := cfg.Alias
+	if alias.Name == "" {
+		// the analysis path is a temp dir, which is not a meaningful name for the source
+		alias.Name = fmt.Sprintf("pid:%d", cfg.PID)
+		if exe := executablePath(procDir); exe != "" {
+			alias.Name = path.Base(exe)
+		}
+	}
+
+	src, err := directorysource.New(directorysource.Config{
+		Path:    analysisPath,
+		Base:    analysisPath,
+		Exclude: cfg.Exclude,
+		Alias:   alias,
+	})
+	if err != nil {
+		_ = cleanup()
+		return nil, err
+	}
+
+	return &processSource{
+		Source:  src,
+		id:      deriveIDFromProcess(cfg, alias),
+		config:  cfg,
+		cleanup: cleanup,
+	}, nil
+}
+
+func deriveIDFromProcess(cfg Config, alias source.Alias) artifact.ID {
+	// process IDs are reused, so the name of the executable is considered as well
+	info := fmt.Sprintf("pid:%d:%s", cfg.PID, alias.Name)
+	if !cfg.Alias.IsEmpty() {
+		info = fmt.Sprintf("%s@%s", cfg.Alias.Name, cfg.Alias.Version)
+	}
+	return internal.ArtifactIDFromDigest(digest.SHA256.FromString(info).String())
+}
+
+func (s processSource) ID() artifact.ID {
+	return s.id
+}
+
+func (s processSource) Describe() source.Description {
+	d := s.Source.Describe()
+	d.ID = string(s.id)
+	// report the process that was given, not where the mapped files were copied to
+	procDir := path.Join(s.config.ProcRoot, strconv.Itoa(s.config.PID))
+	d.Metadata = source.DirectoryMetadata{
+		Path: procDir,
+		Base: procDir,
+	}
+	return d
+}
+
+func (s *processSource) Close() error {
+	err := s.Source.Close()
+	if s.cleanup != nil {
+		if cleanupErr := s.cleanup(); cleanupErr != nil && err == nil {
+			err = cleanupErr
+		}
+	}
+	return err
+}
+
+// mappedPaths returns the (sorted) paths of the executable and the files mapped into the memory of the process with
+// the given /proc/<pid> directory, as seen from the root filesystem of the process.
+func mappedPaths(procDir string) ([]string, error) {
+	f, err := os.Open(filepath.Join(procDir, "maps"))
+	if err != nil {
+		return nil, procError(procDir, err)
+	}
+	defer f.Close()
+
+	paths, err := parseMaps(f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read memory maps of process %s: %w", filepath.Base(procDir), err)
+	}
+
+	if exe := executablePath(procDir); exe != "" {
+		paths[exe] = true
+	}
+
+	sorted := make([]string, 0, len(paths))
+	for p := range paths {
+		sorted = append(sorted, p)
+	}
+	sort.Strings(sorted)
+	return sorted, nil
+}
+
+// parseMaps returns the paths of the files within the contents of a /proc/<pid>/maps file, for example:
+//
+//	7f2c4a400000-7f2c4a428000 r--p 00000000 08:01 1837  /usr/lib/x86_64-linux-gnu/libc.so.6
+//
+// Anonymous and pseudo mappings (e.g. [heap] or [vdso]) and files that have been deleted since being mapped are not
+// included.
+func parseMaps(r io.Reader) (map[string]bool, error) {
+	paths := make(map[string]bool)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 6 || fields[4] == "0" {
+			// no pathname, or not backed by a file (inode 0)
+			continue
+		}
+		// the pathname is the remainder of the line, which may contain spaces
+		p := strings.Join(fields[5:], " ")
+		if !strings.HasPrefix(p, "/") || strings.HasSuffix(p, deletedSuffix) {
+			continue
+		}
+		paths[path.Clean(p)] = true
+	}
+	return paths, scanner.Err()
+}
+
+// executablePath returns the path of the executable of the process, or an empty string if the executable cannot be
+// resolved (e.g. due to permissions) or has been deleted.
+func executablePath(procDir string) string {
+	exe, err := os.Readlink(filepath.Join(procDir, "exe"))
+	if err != nil {
+		log.WithFields("path", procDir, "error", err).Trace("unable to resolve the executable of the process")
+		return ""
+	}
+	if !strings.HasPrefix(exe, "/") || strings.HasSuffix(exe, deletedSuffix) {
+		return ""
+	}
+	return path.Clean(exe)
+}
+
+// copyToTmp copies the given paths from the given root filesystem into a temp dir (retaining the path of each file).
+// Files that cannot be read (e.g. they have been removed, or due to permissions) are skipped, however, an error is
+// returned if none of the files can be read.
+func copyToTmp(root string, paths []string) (string, func() error, error) {
+	tempDir, err := os.MkdirTemp("", "syft-process-")
+	if err != nil {
+		return "", func() error { return nil }, fmt.Errorf("unable to create tempdir for the mapped files of the process: %w", err)
+	}
+
+	cleanupFn := func() error {
+		return os.RemoveAll(tempDir)
+	}
+
+	var copied int
+	var errs []error
+	for _, p := range paths {
+		if err := copyFile(filepath.Join(root, filepath.FromSlash(p)), filepath.Join(tempDir, filepath.FromSlash(p))); err != nil {
+			log.WithFields("path", p, "error", err).Debug("unable to copy mapped file of process")
+			errs = append(errs, err)
+			continue
+		}
+		copied++
+	}
+
+	if copied == 0 {
+		return tempDir, cleanupFn, procError(filepath.Dir(root), errors.Join(errs...))
+	}
+	return tempDir, cleanupFn, nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("not a regular file: %s", src)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode().Perm()|0o200)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(out, in)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// procError describes an error reading from the /proc/<pid> directory of a process.
+func procError(procDir string, err error) error {
+	pid := filepath.Base(procDir)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return fmt.Errorf("%w: %s", ErrProcessNotFound, pid)
+	case errors.Is(err, os.ErrPermission):
+		return fmt.Errorf("unable to read process %s (this requires running as the owner of the process or with CAP_SYS_PTRACE): %w", pid, err)
+	default:
+		return fmt.Errorf("unable to read process %s: %w", pid, err)
+	}
+}
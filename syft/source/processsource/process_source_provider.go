@@ -0,0 +1,58 @@
+package processsource
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/anchore/syft/syft/source"
+)
+
+// processScheme prefixes the user input referencing a running process by ID (e.g. "pid:1234")
+const processScheme = "pid:"
+
+func NewSourceProvider(userInput string, exclude source.ExcludeConfig, alias source.Alias) source.Provider {
+	return &processSourceProvider{
+		userInput: userInput,
+		exclude:   exclude,
+		alias:     alias,
+	}
+}
+
+type processSourceProvider struct {
+	userInput string
+	exclude   source.ExcludeConfig
+	alias     source.Alias
+}
+
+func (p processSourceProvider) Name() string {
+	return "process"
+}
+
+func (p processSourceProvider) Provide(_ context.Context) (source.Source, error) {
+	pid, err := parsePID(p.userInput)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(
+		Config{
+			PID:     pid,
+			Exclude: p.exclude,
+			Alias:   p.alias,
+		},
+	)
+}
+
+func parsePID(userInput string) (int, error) {
+	value, ok := strings.CutPrefix(userInput, processScheme)
+	if !ok {
+		return 0, fmt.Errorf("not a process reference (must be %s<PID>): %q", processScheme, userInput)
+	}
+	pid, err := strconv.Atoi(value)
+	if err != nil || pid < 1 {
+		return 0, fmt.Errorf("invalid process ID: %q", value)
+	}
+	return pid, nil
+}
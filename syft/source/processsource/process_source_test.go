@@ -0,0 +1,128 @@
+package processsource
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/source"
+)
+
+const testMaps = `55d6c8a00000-55d6c8a02000 r--p 00000000 08:01 1001                       /usr/bin/app
+55d6c8a02000-55d6c8a06000 r-xp 00002000 08:01 1001                       /usr/bin/app
+55d6c9e4e000-55d6c9e6f000 rw-p 00000000 00:00 0                          [heap]
+7f2c4a400000-7f2c4a428000 r--p 00000000 08:01 1837                       /usr/lib/x86_64-linux-gnu/libc.so.6
+7f2c4a600000-7f2c4a601000 r--p 00000000 08:01 2042                       /opt/app/lib/lib with spaces.so
+7f2c4a700000-7f2c4a701000 r--p 00000000 08:01 2043                       /opt/app/lib/libold.so (deleted)
+7f2c4a800000-7f2c4a801000 r--p 00000000 08:01 2044                       /opt/app/lib/libmissing.so
+7f2c4a900000-7f2c4a901000 rw-s 00000000 00:01 3001                       /dev/zero
+7ffd3b5e1000-7ffd3b5e3000 r-xp 00000000 00:00 0                          [vdso]
+`
+
+// newProcFixture creates a proc filesystem with a single process, with a root filesystem holding the given files.
+func newProcFixture(t *testing.T, pid string, files ...string) string {
+	t.Helper()
+	dir := t.TempDir()
+
+	rootfs := filepath.Join(dir, "rootfs")
+	for _, f := range files {
+		p := filepath.Join(rootfs, filepath.FromSlash(f))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte(f), 0o755))
+	}
+
+	procDir := filepath.Join(dir, "proc", pid)
+	require.NoError(t, os.MkdirAll(procDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(procDir, "maps"), []byte(testMaps), 0o644))
+	require.NoError(t, os.Symlink("/usr/bin/app", filepath.Join(procDir, "exe")))
+	require.NoError(t, os.Symlink(rootfs, filepath.Join(procDir, "root")))
+
+	return filepath.Join(dir, "proc")
+}
+
+func TestNew(t *testing.T) {
+	procRoot := newProcFixture(t, "4242",
+		"usr/bin/app",
+		"usr/lib/x86_64-linux-gnu/libc.so.6",
+		"opt/app/lib/lib with spaces.so",
+		"opt/app/lib/libold.so",
+		"usr/lib/not-mapped.so",
+	)
+
+	src, err := New(Config{PID: 4242, ProcRoot: procRoot})
+	require.NoError(t, err)
+
+	res, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	locations, err := res.FilesByGlob("**")
+	require.NoError(t, err)
+
+	var actual []string
+	for _, l := range locations {
+		actual = append(actual, strings.TrimPrefix(l.RealPath, "/"))
+	}
+	assert.ElementsMatch(t, []string{
+		"usr/bin/app",
+		"usr/lib/x86_64-linux-gnu/libc.so.6",
+		"opt/app/lib/lib with spaces.so",
+	}, actual)
+
+	d := src.Describe()
+	assert.Equal(t, "app", d.Name)
+	assert.Equal(t, string(src.ID()), d.ID)
+	assert.Equal(t, source.DirectoryMetadata{
+		Path: filepath.Join(procRoot, "4242"),
+		Base: filepath.Join(procRoot, "4242"),
+	}, d.Metadata)
+
+	// the mapped files are copied, so are removed when the source is closed
+	locations, err = res.FilesByPath("/usr/bin/app")
+	require.NoError(t, err)
+	require.Len(t, locations, 1)
+	copied := locations[0].Reference().RealPath
+	require.FileExists(t, string(copied))
+
+	require.NoError(t, src.Close())
+	assert.NoFileExists(t, string(copied))
+}
+
+func TestNew_processNotFound(t *testing.T) {
+	procRoot := newProcFixture(t, "4242")
+
+	_, err := New(Config{PID: 1, ProcRoot: procRoot})
+	assert.ErrorIs(t, err, ErrProcessNotFound)
+
+	// the process exited before the mapped files could be read
+	require.NoError(t, os.Remove(filepath.Join(procRoot, "4242", "root")))
+	_, err = New(Config{PID: 4242, ProcRoot: procRoot})
+	assert.ErrorIs(t, err, ErrProcessNotFound)
+}
+
+func Test_parsePID(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int
+		wantErr require.ErrorAssertionFunc
+	}{
+		{input: "pid:1234", want: 1234},
+		{input: "pid:0", wantErr: require.Error},
+		{input: "pid:app", wantErr: require.Error},
+		{input: "1234", wantErr: require.Error},
+		{input: "docker:alpine", wantErr: require.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			pid, err := parsePID(tt.input)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, pid)
+		})
+	}
+}
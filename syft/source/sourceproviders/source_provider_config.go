@@ -15,6 +15,11 @@ type Config struct {
 	Exclude          source.ExcludeConfig
 	DigestAlgorithms []crypto.Hash
 	BasePath         string
+	// AllPlatforms indicates that every platform of a multi-arch image index should be cataloged, not just the selected one
+	AllPlatforms bool
+	// MaxArchiveEntries is the maximum number of entries unarchived from an archive (or unpacked from a filesystem
+	// image) given as the source, where the remaining entries are skipped with a warning (0 means there is no limit)
+	MaxArchiveEntries int
 }
 
 func (c *Config) WithAlias(alias source.Alias) *Config {
@@ -37,6 +42,20 @@ func (c *Config) WithExcludeConfig(excludeConfig source.ExcludeConfig) *Config {
 	return c
 }
 
+// WithGlobCaseInsensitive sets whether the exclusion globs, along with the globs and paths requested by catalogers,
+// match paths regardless of case (e.g. for Windows filesystems).
+func (c *Config) WithGlobCaseInsensitive(caseInsensitive bool) *Config {
+	c.Exclude.CaseInsensitive = caseInsensitive
+	return c
+}
+
+// WithSyftIgnore sets whether directory sources exclude the paths matching the patterns of the .syftignore files
+// within the scan root.
+func (c *Config) WithSyftIgnore(syftIgnore bool) *Config {
+	c.Exclude.SyftIgnore = syftIgnore
+	return c
+}
+
 func (c *Config) WithDigestAlgorithms(algorithms ...crypto.Hash) *Config {
 	c.DigestAlgorithms = algorithms
 	return c
@@ -47,6 +66,16 @@ func (c *Config) WithBasePath(basePath string) *Config {
 	return c
 }
 
+func (c *Config) WithAllPlatforms(allPlatforms bool) *Config {
+	c.AllPlatforms = allPlatforms
+	return c
+}
+
+func (c *Config) WithMaxArchiveEntries(n int) *Config {
+	c.MaxArchiveEntries = n
+	return c
+}
+
 func DefaultConfig() *Config {
 	return &Config{
 		DigestAlgorithms: []crypto.Hash{
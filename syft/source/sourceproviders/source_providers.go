@@ -5,8 +5,12 @@ import (
 	"github.com/anchore/stereoscope"
 	"github.com/anchore/stereoscope/pkg/image"
 	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/checkpointsource"
+	"github.com/anchore/syft/syft/source/containersstoragesource"
 	"github.com/anchore/syft/syft/source/directorysource"
 	"github.com/anchore/syft/syft/source/filesource"
+	"github.com/anchore/syft/syft/source/processsource"
+	"github.com/anchore/syft/syft/source/stdinsource"
 	"github.com/anchore/syft/syft/source/stereoscopesource"
 )
 
@@ -24,13 +28,31 @@ func All(userInput string, cfg *Config) []collections.TaggedValue[source.Provide
 	stereoscopeProviders := stereoscopeSourceProviders(userInput, cfg)
 
 	return collections.TaggedValueSet[source.Provider]{}.
+		// --from process (pid:<PID>), which must be considered before image references (e.g. "pid:1234" is a valid image reference)
+		Join(tagProvider(processsource.NewSourceProvider(userInput, cfg.Exclude, cfg.Alias))).
+		// --from stdin (given as "-"), which must be considered before the providers of files on disk
+		Join(tagProvider(stdinsource.NewSourceProvider(userInput, cfg.Exclude, cfg.DigestAlgorithms, cfg.Alias, cfg.MaxArchiveEntries), FileTag)).
+
 		// --from file, dir, oci-archive, etc.
 		Join(stereoscopeProviders.Select(FileTag, DirTag)...).
-		Join(tagProvider(filesource.NewSourceProvider(userInput, cfg.Exclude, cfg.DigestAlgorithms, cfg.Alias), FileTag)).
+		// note: container checkpoints are archives or directories, so must be considered before the generic file and directory providers
+		Join(tagProvider(checkpointsource.NewSourceProviderWithConfig(userInput, checkpointsource.ProviderConfig{
+			Exclude:           cfg.Exclude,
+			Alias:             cfg.Alias,
+			MaxArchiveEntries: cfg.MaxArchiveEntries,
+		}), FileTag, DirTag)).
+		Join(tagProvider(filesource.NewSourceProviderWithConfig(userInput, filesource.ProviderConfig{
+			Exclude:           cfg.Exclude,
+			DigestAlgorithms:  cfg.DigestAlgorithms,
+			Alias:             cfg.Alias,
+			MaxArchiveEntries: cfg.MaxArchiveEntries,
+		}), FileTag)).
 		Join(tagProvider(directorysource.NewSourceProvider(userInput, cfg.Exclude, cfg.Alias, cfg.BasePath), DirTag)).
 
 		// --from docker, registry, etc.
-		Join(stereoscopeProviders.Select(PullTag)...)
+		Join(stereoscopeProviders.Select(PullTag)...).
+		// --from containers-storage, which reads images from disk (so is considered after the daemons that may own the store)
+		Join(tagProvider(containersstoragesource.NewSourceProvider(userInput, cfg.Platform, cfg.Exclude, cfg.Alias), stereoscopesource.ImageTag, PullTag))
 }
 
 func stereoscopeSourceProviders(userInput string, cfg *Config) collections.TaggedValueSet[source.Provider] {
@@ -0,0 +1,134 @@
+/*
+Package stdinsource provides a source for a single file read from stdin (given as "-"), such as a binary piped into
+syft within a CI pipeline. Since stdin cannot be read at random (as catalogers of binaries require), the contents are
+buffered to a temp file upfront, which is then cataloged as any other file would be (including unarchiving archives).
+*/
+package stdinsource
+
+import (
+	"crypto"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/filesource"
+)
+
+const (
+	// Input is the user input referencing stdin
+	Input = "-"
+
+	// defaultName is the name of the source (and of the buffered file) when no alias is given
+	defaultName = "stdin"
+)
+
+var _ source.Source = (*stdinSource)(nil)
+
+type Config struct {
+	// Reader is where the contents of the file are read from (defaults to stdin)
+	Reader io.Reader
+
+	Exclude          source.ExcludeConfig
+	DigestAlgorithms []crypto.Hash
+	Alias            source.Alias
+	// MaxArchiveEntries is the maximum number of entries unarchived from an archive (or unpacked from a filesystem
+	// image), where the remaining entries are skipped with a warning (0 means there is no limit)
+	MaxArchiveEntries int
+}
+
+type stdinSource struct {
+	source.Source
+	config  Config
+	cleanup func() error
+}
+
+// New creates a source for the file read from the configured reader (stdin by default), which is read to completion
+// before returning.
+func New(cfg Config) (source.Source, error) {
+	if cfg.Reader == nil {
+		cfg.Reader = os.Stdin
+	}
+
+	path, cleanup, err := bufferToTmp(cfg.Reader)
+	if err != nil {
+		_ = cleanup()
+		return nil, err
+	}
+
+	alias := cfg.Alias
+	if alias.Name == "" {
+		// the buffered file is within a temp dir, which is not a meaningful name for the source
+		alias.Name = defaultName
+	}
+
+	src, err := filesource.New(filesource.Config{
+		Path:              path,
+		Exclude:           cfg.Exclude,
+		DigestAlgorithms:  cfg.DigestAlgorithms,
+		Alias:             alias,
+		MaxArchiveEntries: cfg.MaxArchiveEntries,
+	})
+	if err != nil {
+		_ = cleanup()
+		return nil, err
+	}
+
+	return &stdinSource{
+		Source:  src,
+		config:  cfg,
+		cleanup: cleanup,
+	}, nil
+}
+
+func (s stdinSource) Describe() source.Description {
+	d := s.Source.Describe()
+	// report the input that was given, not where the contents were buffered to
+	if m, ok := d.Metadata.(source.FileMetadata); ok {
+		m.Path = Input
+		d.Metadata = m
+	}
+	return d
+}
+
+func (s *stdinSource) Close() error {
+	err := s.Source.Close()
+	if s.cleanup != nil {
+		if cleanupErr := s.cleanup(); cleanupErr != nil && err == nil {
+			err = cleanupErr
+		}
+	}
+	return err
+}
+
+// bufferToTmp copies the contents of the given reader into a file within a temp dir, returning the path of the file.
+func bufferToTmp(reader io.Reader) (string, func() error, error) {
+	tempDir, err := os.MkdirTemp("", "syft-stdin-")
+	if err != nil {
+		return "", func() error { return nil }, fmt.Errorf("unable to create tempdir for stdin: %w", err)
+	}
+
+	cleanupFn := func() error {
+		return os.RemoveAll(tempDir)
+	}
+
+	path := filepath.Join(tempDir, defaultName)
+	f, err := os.Create(path)
+	if err != nil {
+		return "", cleanupFn, fmt.Errorf("unable to create file for stdin: %w", err)
+	}
+
+	n, err := io.Copy(f, reader)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", cleanupFn, fmt.Errorf("unable to read stdin: %w", err)
+	}
+	if n == 0 {
+		return "", cleanupFn, fmt.Errorf("no contents read from stdin")
+	}
+
+	return path, cleanupFn, nil
+}
@@ -0,0 +1,46 @@
+package stdinsource
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+
+	"github.com/anchore/syft/syft/source"
+)
+
+func NewSourceProvider(userInput string, exclude source.ExcludeConfig, digestAlgorithms []crypto.Hash, alias source.Alias, maxArchiveEntries int) source.Provider {
+	return &stdinSourceProvider{
+		userInput:         userInput,
+		exclude:           exclude,
+		digestAlgorithms:  digestAlgorithms,
+		alias:             alias,
+		maxArchiveEntries: maxArchiveEntries,
+	}
+}
+
+type stdinSourceProvider struct {
+	userInput         string
+	exclude           source.ExcludeConfig
+	digestAlgorithms  []crypto.Hash
+	alias             source.Alias
+	maxArchiveEntries int
+}
+
+func (p stdinSourceProvider) Name() string {
+	return "stdin"
+}
+
+func (p stdinSourceProvider) Provide(_ context.Context) (source.Source, error) {
+	if p.userInput != Input {
+		return nil, fmt.Errorf("not a stdin reference (must be %q): %q", Input, p.userInput)
+	}
+
+	return New(
+		Config{
+			Exclude:           p.exclude,
+			DigestAlgorithms:  p.digestAlgorithms,
+			Alias:             p.alias,
+			MaxArchiveEntries: p.maxArchiveEntries,
+		},
+	)
+}
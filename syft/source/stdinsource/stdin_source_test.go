@@ -0,0 +1,74 @@
+package stdinsource
+
+import (
+	"context"
+	"crypto"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/source"
+)
+
+func TestNew(t *testing.T) {
+	src, err := New(Config{
+		Reader:           strings.NewReader("\x7fELF not really a binary"),
+		DigestAlgorithms: []crypto.Hash{crypto.SHA256},
+	})
+	require.NoError(t, err)
+
+	res, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	locations, err := res.FilesByPath("/stdin")
+	require.NoError(t, err)
+	require.Len(t, locations, 1)
+
+	reader, err := res.FileContentsByLocation(locations[0])
+	require.NoError(t, err)
+	contents, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, "\x7fELF not really a binary", string(contents))
+
+	d := src.Describe()
+	assert.Equal(t, "stdin", d.Name)
+	m, ok := d.Metadata.(source.FileMetadata)
+	require.True(t, ok)
+	assert.Equal(t, Input, m.Path)
+	require.Len(t, m.Digests, 1)
+
+	// the buffered contents are removed when the source is closed
+	buffered := src.(*stdinSource).Source.Describe().Metadata.(source.FileMetadata).Path
+	require.FileExists(t, buffered)
+	require.NoError(t, src.Close())
+	_, err = os.Stat(buffered)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestNew_Alias(t *testing.T) {
+	src, err := New(Config{
+		Reader: strings.NewReader("contents"),
+		Alias:  source.Alias{Name: "my-app", Version: "1.0.0"},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = src.Close() })
+
+	d := src.Describe()
+	assert.Equal(t, "my-app", d.Name)
+	assert.Equal(t, "1.0.0", d.Version)
+}
+
+func TestNew_Empty(t *testing.T) {
+	_, err := New(Config{Reader: strings.NewReader("")})
+	require.ErrorContains(t, err, "no contents read from stdin")
+}
+
+func Test_stdinSourceProvider(t *testing.T) {
+	_, err := NewSourceProvider("path/to/file", source.ExcludeConfig{}, nil, source.Alias{}, 0).Provide(context.Background())
+	require.ErrorContains(t, err, "not a stdin reference")
+}
@@ -0,0 +1,271 @@
+package stereoscopesource
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/source"
+)
+
+const (
+	dsseEnvelopeMediaType = "application/vnd.dsse.envelope.v1+json"
+	inTotoMediaType       = "application/vnd.in-toto+json"
+
+	// cosign attaches SBOMs and attestations to an image under tags derived from the image digest
+	cosignSBOMTagSuffix        = ".sbom"
+	cosignAttestationTagSuffix = ".att"
+)
+
+// AttachedSBOM is an SBOM document attached to an image within a registry, either as an artifact referring to the
+// image (the OCI referrers API) or as a cosign SBOM or attestation.
+type AttachedSBOM struct {
+	// Reference is the registry reference of the artifact holding the SBOM document
+	Reference string
+
+	// Content is the raw SBOM document (unwrapped from any attestation)
+	Content []byte
+}
+
+// AttachedSBOMs returns the SBOM documents attached to the image described by the given metadata, which are looked up
+// within the repositories of the image by the manifest digest of the image. Artifacts that cannot be read are skipped,
+// however, an error is returned when the image has no digest in any registry repository to look up.
+func AttachedSBOMs(ctx context.Context, metadata source.ImageMetadata, registryOptions *image.RegistryOptions) ([]AttachedSBOM, error) {
+	var opts image.RegistryOptions
+	if registryOptions != nil {
+		opts = *registryOptions
+	}
+
+	subjects := attachmentSubjects(metadata, opts)
+	if len(subjects) == 0 {
+		return nil, fmt.Errorf("unable to find a registry digest for image=%q", metadata.UserInput)
+	}
+
+	var sboms []AttachedSBOM
+	seen := strset.New()
+	add := func(found []AttachedSBOM) {
+		for _, s := range found {
+			if seen.Has(s.Reference) {
+				continue
+			}
+			seen.Add(s.Reference)
+			sboms = append(sboms, s)
+		}
+	}
+
+	for _, subject := range subjects {
+		remoteOpts := remoteOptions(ctx, subject, opts)
+		add(referrerSBOMs(subject, remoteOpts))
+		add(cosignSBOMs(subject, remoteOpts))
+	}
+
+	return sboms, nil
+}
+
+// attachmentSubjects returns the digest references of the image within each registry repository it is known by.
+func attachmentSubjects(metadata source.ImageMetadata, opts image.RegistryOptions) []name.Digest {
+	var nameOpts []name.Option
+	if opts.InsecureUseHTTP {
+		nameOpts = append(nameOpts, name.Insecure)
+	}
+
+	var subjects []name.Digest
+	seen := strset.New()
+	add := func(d name.Digest) {
+		if seen.Has(d.String()) {
+			return
+		}
+		seen.Add(d.String())
+		subjects = append(subjects, d)
+	}
+
+	for _, repoDigest := range metadata.RepoDigests {
+		d, err := name.NewDigest(repoDigest, nameOpts...)
+		if err != nil {
+			log.WithFields("error", err, "digest", repoDigest).Trace("unable to parse repo digest")
+			continue
+		}
+		add(d)
+	}
+
+	if metadata.ManifestDigest != "" {
+		if ref, err := name.ParseReference(strings.TrimPrefix(metadata.UserInput, "registry:"), nameOpts...); err == nil {
+			add(ref.Context().Digest(metadata.ManifestDigest))
+		}
+	}
+
+	return subjects
+}
+
+// referrerSBOMs returns the SBOMs within the artifacts that refer to the given image digest (falling back to the
+// referrers tag schema for registries that do not support the referrers API).
+func referrerSBOMs(subject name.Digest, opts []remote.Option) []AttachedSBOM {
+	idx, err := remote.Referrers(subject, opts...)
+	if err != nil {
+		log.WithFields("error", err, "image", subject.String()).Debug("unable to list image referrers")
+		return nil
+	}
+
+	manifest, err := idx.IndexManifest()
+	if err != nil {
+		log.WithFields("error", err, "image", subject.String()).Debug("unable to read image referrers")
+		return nil
+	}
+
+	var sboms []AttachedSBOM
+	for _, desc := range manifest.Manifests {
+		if !isSBOMMediaType(desc.ArtifactType) && !isAttestationMediaType(desc.ArtifactType) {
+			continue
+		}
+		ref := subject.Context().Digest(desc.Digest.String())
+		sboms = append(sboms, artifactSBOMs(ref, opts)...)
+	}
+	return sboms
+}
+
+// cosignSBOMs returns the SBOMs attached to the given image digest by cosign (with "cosign attach sbom" or as an
+// attestation with "cosign attest").
+func cosignSBOMs(subject name.Digest, opts []remote.Option) []AttachedSBOM {
+	tagPrefix := strings.Replace(subject.DigestStr(), ":", "-", 1)
+
+	var sboms []AttachedSBOM
+	for _, suffix := range []string{cosignSBOMTagSuffix, cosignAttestationTagSuffix} {
+		sboms = append(sboms, artifactSBOMs(subject.Context().Tag(tagPrefix+suffix), opts)...)
+	}
+	return sboms
+}
+
+// artifactSBOMs returns the SBOMs held within the layers of the given artifact, which is expected to be missing for
+// most images (and is skipped when it is).
+func artifactSBOMs(ref name.Reference, opts []remote.Option) []AttachedSBOM {
+	img, err := remote.Image(ref, opts...)
+	if err != nil {
+		log.WithFields("error", err, "artifact", ref.String()).Trace("unable to fetch attached artifact")
+		return nil
+	}
+
+	layers, err := img.Layers()
+	if err != nil {
+		log.WithFields("error", err, "artifact", ref.String()).Debug("unable to read attached artifact")
+		return nil
+	}
+
+	var sboms []AttachedSBOM
+	for i, layer := range layers {
+		mediaType, err := layer.MediaType()
+		if err != nil {
+			continue
+		}
+
+		var content []byte
+		switch {
+		case isSBOMMediaType(string(mediaType)):
+			content, err = readLayer(layer)
+		case isAttestationMediaType(string(mediaType)):
+			content, err = readAttestationLayer(layer)
+		default:
+			continue
+		}
+		if err != nil {
+			log.WithFields("error", err, "artifact", ref.String()).Debug("unable to read attached SBOM")
+			continue
+		}
+		if len(content) == 0 {
+			continue
+		}
+
+		sboms = append(sboms, AttachedSBOM{
+			Reference: fmt.Sprintf("%s#%d", ref.String(), i),
+			Content:   content,
+		})
+	}
+	return sboms
+}
+
+func readLayer(layer v1.Layer) ([]byte, error) {
+	// artifact layers are stored as-is, so the blob is the document itself
+	rc, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+type dsseEnvelope struct {
+	PayloadType string `json:"payloadType"`
+	Payload     string `json:"payload"`
+}
+
+type inTotoStatement struct {
+	PredicateType string          `json:"predicateType"`
+	Predicate     json.RawMessage `json:"predicate"`
+}
+
+// readAttestationLayer returns the SBOM that is the predicate of the in-toto statement within the layer (which may be
+// wrapped in a DSSE envelope), or nothing if the statement does not attest to an SBOM.
+func readAttestationLayer(layer v1.Layer) ([]byte, error) {
+	content, err := readLayer(layer)
+	if err != nil {
+		return nil, err
+	}
+
+	var envelope dsseEnvelope
+	if err := json.Unmarshal(content, &envelope); err != nil {
+		return nil, fmt.Errorf("unable to parse attestation: %w", err)
+	}
+	if envelope.PayloadType != "" {
+		content, err = base64.StdEncoding.DecodeString(envelope.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode attestation payload: %w", err)
+		}
+	}
+
+	var statement inTotoStatement
+	if err := json.Unmarshal(content, &statement); err != nil {
+		return nil, fmt.Errorf("unable to parse attestation statement: %w", err)
+	}
+	if !isSBOMPredicateType(statement.PredicateType) || len(statement.Predicate) == 0 {
+		return nil, nil
+	}
+
+	// non-JSON documents (e.g. SPDX tag-value) are embedded within the predicate as a string
+	var document string
+	if err := json.Unmarshal(statement.Predicate, &document); err == nil {
+		return []byte(document), nil
+	}
+	return statement.Predicate, nil
+}
+
+func isSBOMMediaType(mediaType string) bool {
+	mediaType = strings.ToLower(mediaType)
+	for _, format := range []string{"spdx", "cyclonedx", "syft"} {
+		if strings.Contains(mediaType, format) {
+			return true
+		}
+	}
+	return false
+}
+
+func isAttestationMediaType(mediaType string) bool {
+	switch mediaType {
+	case dsseEnvelopeMediaType, inTotoMediaType:
+		return true
+	}
+	return false
+}
+
+func isSBOMPredicateType(predicateType string) bool {
+	// e.g. https://spdx.dev/Document, https://cyclonedx.org/bom, or https://syft.dev/bom
+	return isSBOMMediaType(predicateType)
+}
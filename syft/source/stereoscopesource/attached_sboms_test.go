@@ -0,0 +1,247 @@
+package stereoscopesource
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/syft/source"
+)
+
+func TestAttachedSBOMs(t *testing.T) {
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	opts := &image.RegistryOptions{InsecureUseHTTP: true}
+
+	imageRef := fmt.Sprintf("%s/app:latest", u.Host)
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(mustParse(t, imageRef), img))
+
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	subject, err := imageDescriptor(img)
+	require.NoError(t, err)
+
+	spdxDocument := []byte(`{"spdxVersion":"SPDX-2.3"}`)
+	cyclonedxDocument := []byte(`{"bomFormat":"CycloneDX"}`)
+
+	// an SBOM attached with "cosign attach sbom"
+	cosignTag := fmt.Sprintf("%s/app:sha256-%s", u.Host, digest.Hex)
+	pushArtifact(t, cosignTag+".sbom", "", nil, static.NewLayer(spdxDocument, "text/spdx+json"))
+
+	// an SBOM attested with "cosign attest", along with an attestation that is not for an SBOM
+	pushArtifact(t, cosignTag+".att", "", nil,
+		static.NewLayer(dsse(t, "https://cyclonedx.org/bom", cyclonedxDocument), dsseEnvelopeMediaType),
+		static.NewLayer(dsse(t, "https://slsa.dev/provenance/v0.2", []byte(`{"builder":{}}`)), dsseEnvelopeMediaType),
+	)
+
+	// an SBOM attached as a referrer of the image, along with a referrer that is not an SBOM
+	referrer := pushArtifact(t, fmt.Sprintf("%s/app:referrer-sbom", u.Host), "application/vnd.cyclonedx+json", subject,
+		static.NewLayer(cyclonedxDocument, "application/vnd.cyclonedx+json"))
+	pushArtifact(t, fmt.Sprintf("%s/app:referrer-signature", u.Host), "application/vnd.example.signature", subject,
+		static.NewLayer([]byte("signature"), "application/vnd.example.signature"))
+
+	tests := []struct {
+		name     string
+		metadata source.ImageMetadata
+		want     []AttachedSBOM
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name: "image with attached SBOMs",
+			metadata: source.ImageMetadata{
+				UserInput:      imageRef,
+				ManifestDigest: digest.String(),
+			},
+			want: []AttachedSBOM{
+				{
+					Reference: fmt.Sprintf("%s/app@%s#0", u.Host, referrer),
+					Content:   cyclonedxDocument,
+				},
+				{
+					Reference: cosignTag + ".sbom#0",
+					Content:   spdxDocument,
+				},
+				{
+					Reference: cosignTag + ".att#0",
+					Content:   cyclonedxDocument,
+				},
+			},
+		},
+		{
+			name: "image found by repo digest",
+			metadata: source.ImageMetadata{
+				UserInput:   "app:latest",
+				RepoDigests: []string{fmt.Sprintf("%s/app@%s", u.Host, digest)},
+			},
+			want: []AttachedSBOM{
+				{
+					Reference: fmt.Sprintf("%s/app@%s#0", u.Host, referrer),
+					Content:   cyclonedxDocument,
+				},
+				{
+					Reference: cosignTag + ".sbom#0",
+					Content:   spdxDocument,
+				},
+				{
+					Reference: cosignTag + ".att#0",
+					Content:   cyclonedxDocument,
+				},
+			},
+		},
+		{
+			name: "image without attached SBOMs",
+			metadata: source.ImageMetadata{
+				UserInput:      fmt.Sprintf("%s/other:latest", u.Host),
+				ManifestDigest: "sha256:" + digest.Hex[:60] + "0000",
+			},
+		},
+		{
+			name: "image without a registry digest",
+			metadata: source.ImageMetadata{
+				UserInput: "app:latest",
+			},
+			wantErr: require.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			got, err := AttachedSBOMs(context.Background(), tt.metadata, opts)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_readAttestationLayer(t *testing.T) {
+	document := []byte(`{"spdxVersion":"SPDX-2.3"}`)
+	statement := func(predicateType string, predicate any) []byte {
+		b, err := json.Marshal(map[string]any{
+			"_type":         "https://in-toto.io/Statement/v0.1",
+			"predicateType": predicateType,
+			"predicate":     predicate,
+		})
+		require.NoError(t, err)
+		return b
+	}
+
+	tests := []struct {
+		name    string
+		content []byte
+		want    []byte
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name:    "DSSE envelope",
+			content: dsse(t, "https://spdx.dev/Document", document),
+			want:    document,
+		},
+		{
+			name:    "bare in-toto statement",
+			content: statement("https://spdx.dev/Document", json.RawMessage(document)),
+			want:    document,
+		},
+		{
+			name:    "document embedded as a string",
+			content: statement("https://spdx.dev/Document", "SPDXVersion: SPDX-2.3"),
+			want:    []byte("SPDXVersion: SPDX-2.3"),
+		},
+		{
+			name:    "not an SBOM predicate",
+			content: statement("https://slsa.dev/provenance/v0.2", map[string]any{}),
+		},
+		{
+			name:    "not an attestation",
+			content: []byte("not json"),
+			wantErr: require.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			got, err := readAttestationLayer(static.NewLayer(tt.content, dsseEnvelopeMediaType))
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func pushArtifact(t *testing.T, reference string, artifactType types.MediaType, subject *v1.Descriptor, layers ...v1.Layer) v1.Hash {
+	t.Helper()
+	img, err := mutate.AppendLayers(mutate.MediaType(empty.Image, types.OCIManifestSchema1), layers...)
+	require.NoError(t, err)
+	if artifactType != "" {
+		img = mutate.ConfigMediaType(img, artifactType)
+	}
+	if subject != nil {
+		img = mutate.Subject(img, *subject).(v1.Image)
+	}
+	require.NoError(t, remote.Write(mustParse(t, reference), img))
+
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	return digest
+}
+
+func imageDescriptor(img v1.Image) (*v1.Descriptor, error) {
+	digest, err := img.Digest()
+	if err != nil {
+		return nil, err
+	}
+	size, err := img.Size()
+	if err != nil {
+		return nil, err
+	}
+	mediaType, err := img.MediaType()
+	if err != nil {
+		return nil, err
+	}
+	return &v1.Descriptor{MediaType: mediaType, Digest: digest, Size: size}, nil
+}
+
+func dsse(t *testing.T, predicateType string, predicate []byte) []byte {
+	t.Helper()
+	statement, err := json.Marshal(map[string]any{
+		"_type":         "https://in-toto.io/Statement/v0.1",
+		"predicateType": predicateType,
+		"predicate":     json.RawMessage(predicate),
+	})
+	require.NoError(t, err)
+
+	envelope, err := json.Marshal(dsseEnvelope{
+		PayloadType: inTotoMediaType,
+		Payload:     base64.StdEncoding.EncodeToString(statement),
+	})
+	require.NoError(t, err)
+	return envelope
+}
@@ -0,0 +1,111 @@
+package stereoscopesource
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal/log"
+)
+
+// Platforms returns every platform described by the image index (manifest list) that the given registry reference
+// resolves to. If the reference resolves to a single image manifest (not an index) then no platforms are returned.
+// Index entries without a usable platform (e.g. buildkit attestation manifests, which claim "unknown/unknown") are skipped.
+func Platforms(ctx context.Context, reference string, registryOptions *image.RegistryOptions) ([]*image.Platform, error) {
+	var opts image.RegistryOptions
+	if registryOptions != nil {
+		opts = *registryOptions
+	}
+
+	var nameOpts []name.Option
+	if opts.InsecureUseHTTP {
+		nameOpts = append(nameOpts, name.Insecure)
+	}
+
+	ref, err := name.ParseReference(reference, nameOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse registry reference=%q: %w", reference, err)
+	}
+
+	desc, err := remote.Get(ref, remoteOptions(ctx, ref, opts)...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get image descriptor for reference=%q: %w", reference, err)
+	}
+
+	if !desc.MediaType.IsIndex() {
+		return nil, nil
+	}
+
+	idx, err := desc.ImageIndex()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read image index for reference=%q: %w", reference, err)
+	}
+
+	manifest, err := idx.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read index manifest for reference=%q: %w", reference, err)
+	}
+
+	var platforms []*image.Platform
+	seen := strset.New()
+	for _, m := range manifest.Manifests {
+		if m.Platform == nil || !m.MediaType.IsImage() {
+			continue
+		}
+		if m.Platform.OS == "" || m.Platform.OS == "unknown" || m.Platform.Architecture == "unknown" {
+			continue
+		}
+		p := &image.Platform{
+			Architecture: m.Platform.Architecture,
+			OS:           m.Platform.OS,
+			Variant:      m.Platform.Variant,
+		}
+		if seen.Has(p.String()) {
+			continue
+		}
+		seen.Add(p.String())
+		platforms = append(platforms, p)
+	}
+
+	return platforms, nil
+}
+
+func remoteOptions(ctx context.Context, ref name.Reference, registryOptions image.RegistryOptions) []remote.Option {
+	options := []remote.Option{remote.WithContext(ctx)}
+
+	registryName := ref.Context().RegistryStr()
+
+	// note: the authn.Authenticator and authn.Keychain options are mutually exclusive, only one may be provided.
+	authenticator := registryOptions.Authenticator(registryName)
+	switch {
+	case authenticator != nil:
+		options = append(options, remote.WithAuth(authenticator))
+	case registryOptions.Keychain != nil:
+		options = append(options, remote.WithAuthFromKeychain(registryOptions.Keychain))
+	default:
+		options = append(options, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	}
+
+	tlsConfig, err := registryOptions.TLSConfig(registryName)
+	if err != nil {
+		log.WithFields("error", err).Warn("unable to configure TLS transport")
+	} else if tlsConfig != nil {
+		options = append(options, remote.WithTransport(transport(tlsConfig)))
+	}
+
+	return options
+}
+
+func transport(tlsConfig *tls.Config) *http.Transport {
+	// use the default transport to inherit existing default options (including proxy options)
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.TLSClientConfig = tlsConfig
+	return t
+}
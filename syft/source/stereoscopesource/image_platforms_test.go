@@ -0,0 +1,107 @@
+package stereoscopesource
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/stereoscope/pkg/image"
+)
+
+func TestPlatforms(t *testing.T) {
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	opts := &image.RegistryOptions{InsecureUseHTTP: true}
+
+	indexRef := fmt.Sprintf("%s/multi-arch:latest", u.Host)
+	pushIndex(t, indexRef,
+		&v1.Platform{OS: "linux", Architecture: "amd64"},
+		&v1.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"},
+		&v1.Platform{OS: "linux", Architecture: "amd64"},     // duplicate entries are reported once
+		&v1.Platform{OS: "unknown", Architecture: "unknown"}, // attestation manifests are not real platforms
+		nil, // entries without a platform are skipped
+	)
+
+	singleRef := fmt.Sprintf("%s/single-arch:latest", u.Host)
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(mustParse(t, singleRef), img))
+
+	tests := []struct {
+		name      string
+		reference string
+		want      []*image.Platform
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name:      "image index",
+			reference: indexRef,
+			want: []*image.Platform{
+				{OS: "linux", Architecture: "amd64"},
+				{OS: "linux", Architecture: "arm64", Variant: "v8"},
+			},
+		},
+		{
+			name:      "single image manifest",
+			reference: singleRef,
+		},
+		{
+			name:      "missing image",
+			reference: fmt.Sprintf("%s/missing:latest", u.Host),
+			wantErr:   require.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			got, err := Platforms(context.Background(), tt.reference, opts)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func pushIndex(t *testing.T, reference string, platforms ...*v1.Platform) {
+	t.Helper()
+	var adds []mutate.IndexAddendum
+	for _, p := range platforms {
+		img, err := random.Image(64, 1)
+		require.NoError(t, err)
+		adds = append(adds, mutate.IndexAddendum{
+			Add: img,
+			Descriptor: v1.Descriptor{
+				Platform: p,
+			},
+		})
+	}
+	idx := mutate.AppendManifests(empty.Index, adds...)
+	require.NoError(t, remote.WriteIndex(mustParse(t, reference), idx))
+}
+
+func mustParse(t *testing.T, reference string) name.Reference {
+	t.Helper()
+	ref, err := name.ParseReference(reference, name.Insecure)
+	require.NoError(t, err)
+	return ref
+}
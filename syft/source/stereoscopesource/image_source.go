@@ -1,10 +1,11 @@
 package stereoscopesource
 
 import (
+	"bytes"
 	"fmt"
 
-	"github.com/bmatcuk/doublestar/v4"
 	"github.com/distribution/reference"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/opencontainers/go-digest"
 
 	"github.com/anchore/stereoscope/pkg/image"
@@ -113,7 +114,11 @@ func (s stereoscopeImageSource) FileResolver(scope source.Scope) (file.Resolver,
 
 	// image tree contains all paths, so we filter out the excluded entries afterward
 	if len(s.config.Exclude.Paths) > 0 {
-		res = fileresolver.NewExcludingDecorator(res, getImageExclusionFunction(s.config.Exclude.Paths))
+		res = fileresolver.NewExcludingDecorator(res, getImageExclusionFunction(s.config.Exclude))
+	}
+
+	if s.config.Exclude.CaseInsensitive {
+		res = fileresolver.NewCaseInsensitiveDecorator(res)
 	}
 
 	return res, nil
@@ -141,6 +146,9 @@ func imageMetadataFromStereoscopeImage(img *image.Image, reference string) sourc
 		}
 	}
 
+	labels := img.Metadata.Config.Config.Labels
+	annotations := manifestAnnotations(img.Metadata.RawManifest)
+
 	return source.ImageMetadata{
 		ID:             img.Metadata.ID,
 		UserInput:      reference,
@@ -155,8 +163,27 @@ func imageMetadataFromStereoscopeImage(img *image.Image, reference string) sourc
 		Architecture:   img.Metadata.Architecture,
 		Variant:        img.Metadata.Variant,
 		OS:             img.Metadata.OS,
-		Labels:         img.Metadata.Config.Config.Labels,
+		Labels:         labels,
+		Annotations:    annotations,
+		Provenance:     source.NewImageProvenance(annotations, labels),
+	}
+}
+
+// manifestAnnotations returns the annotations of the given raw OCI image manifest (docker manifests do not support
+// annotations).
+func manifestAnnotations(rawManifest []byte) map[string]string {
+	if len(rawManifest) == 0 {
+		return nil
+	}
+	manifest, err := v1.ParseManifest(bytes.NewReader(rawManifest))
+	if err != nil {
+		log.WithFields("error", err).Trace("unable to parse image manifest for annotations")
+		return nil
+	}
+	if len(manifest.Annotations) == 0 {
+		return nil
 	}
+	return manifest.Annotations
 }
 
 // deriveIDFromStereoscopeImage derives an artifact ID from the given image metadata. The order of data precedence is:
@@ -214,17 +241,18 @@ func chain(chainID string, layers []source.LayerMetadata) string {
 	return chain(chainID, layers[1:])
 }
 
-func getImageExclusionFunction(exclusions []string) func(string) bool {
-	if len(exclusions) == 0 {
+func getImageExclusionFunction(cfg source.ExcludeConfig) func(string) bool {
+	if len(cfg.Paths) == 0 {
 		return nil
 	}
 	// add subpath exclusions
-	for _, exclusion := range exclusions {
+	exclusions := append([]string(nil), cfg.Paths...)
+	for _, exclusion := range cfg.Paths {
 		exclusions = append(exclusions, exclusion+"/**")
 	}
 	return func(path string) bool {
 		for _, exclusion := range exclusions {
-			matches, err := doublestar.Match(exclusion, path)
+			matches, err := cfg.MatchesGlob(exclusion, path)
 			if err != nil {
 				return false
 			}
@@ -1,16 +1,26 @@
 package stereoscopesource
 
 import (
+	"archive/tar"
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"io"
 	"strings"
 	"testing"
 
+	"github.com/google/go-containerregistry/pkg/compression"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/anchore/stereoscope"
+	"github.com/anchore/stereoscope/pkg/image"
 	"github.com/anchore/stereoscope/pkg/imagetest"
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/internal/testutil"
@@ -112,6 +122,135 @@ func Test_StereoscopeImage_Exclusions(t *testing.T) {
 	}
 }
 
+func Test_getImageExclusionFunction(t *testing.T) {
+	tests := []struct {
+		name            string
+		exclusions      []string
+		caseInsensitive bool
+		path            string
+		want            bool
+	}{
+		{
+			name:       "no exclusions",
+			exclusions: nil,
+			path:       "/Windows/System32/drivers/etc/hosts",
+			want:       false,
+		},
+		{
+			name:       "subpath of an exclusion",
+			exclusions: []string{"/Windows/System32"},
+			path:       "/Windows/System32/drivers/etc/hosts",
+			want:       true,
+		},
+		{
+			name:       "mixed case is case sensitive by default",
+			exclusions: []string{"/windows/system32"},
+			path:       "/Windows/System32/drivers/etc/hosts",
+			want:       false,
+		},
+		{
+			name:            "mixed case subpath",
+			exclusions:      []string{"/windows/system32"},
+			caseInsensitive: true,
+			path:            "/Windows/System32/drivers/etc/hosts",
+			want:            true,
+		},
+		{
+			name:            "mixed case glob",
+			exclusions:      []string{"/Program Files/**/*.DLL"},
+			caseInsensitive: true,
+			path:            "/program files/App/lib/Helper.dll",
+			want:            true,
+		},
+		{
+			name:            "mixed case non-matching",
+			exclusions:      []string{"/Program Files/**/*.dll"},
+			caseInsensitive: true,
+			path:            "/Program Files (x86)/App/lib/Helper.dll",
+			want:            false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exclude := getImageExclusionFunction(source.ExcludeConfig{
+				Paths:           tt.exclusions,
+				CaseInsensitive: tt.caseInsensitive,
+			})
+			if exclude == nil {
+				assert.False(t, tt.want)
+				return
+			}
+			assert.Equal(t, tt.want, exclude(tt.path))
+		})
+	}
+}
+
+func Test_StereoscopeImage_ZstdLayer(t *testing.T) {
+	ociDir := zstdLayerImageFixture(t, map[string]string{
+		"etc/os-release": "ID=test\n",
+		"app/main.py":    "print('hello')\n",
+	})
+
+	img, err := stereoscope.GetImageFromSource(context.TODO(), ociDir, image.OciDirectorySource)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, img.Cleanup())
+	})
+	require.Len(t, img.Layers, 1)
+	assert.Equal(t, string(types.OCILayerZStd), string(img.Layers[0].Metadata.MediaType))
+
+	src := New(img, ImageConfig{Reference: ociDir})
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	locations, err := resolver.FilesByPath("/app/main.py")
+	require.NoError(t, err)
+	require.Len(t, locations, 1)
+
+	reader, err := resolver.FileContentsByLocation(locations[0])
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, reader.Close())
+	})
+	contents, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "print('hello')\n", string(contents))
+}
+
+// zstdLayerImageFixture writes an OCI layout with an image of a single zstd compressed layer holding the given files.
+func zstdLayerImageFixture(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for name, contents := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o644,
+			Size:     int64(len(contents)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+
+	layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(buf.Bytes())), nil
+	}, tarball.WithCompression(compression.ZStd), tarball.WithMediaType(types.OCILayerZStd))
+	require.NoError(t, err)
+
+	img, err := mutate.AppendLayers(mutate.MediaType(empty.Image, types.OCIManifestSchema1), layer)
+	require.NoError(t, err)
+	img = mutate.ConfigMediaType(img, types.OCIConfigJSON)
+
+	dir := t.TempDir()
+	p, err := layout.Write(dir, empty.Index)
+	require.NoError(t, err)
+	require.NoError(t, p.AppendImage(img))
+	return dir
+}
+
 func Test_StereoscopeImageSource_ID(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -279,3 +418,36 @@ func Test_Describe(t *testing.T) {
 		require.Equal(t, test.expected, got)
 	}
 }
+
+func Test_manifestAnnotations(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		expected map[string]string
+	}{
+		{
+			name:     "oci manifest with annotations",
+			manifest: `{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","config":{"mediaType":"application/vnd.oci.image.config.v1+json","digest":"sha256:4f63ad9e2dbc3cf6c6cf067d4f5a4a3a8e1f0e0c4c1d7a3c5b0e1e0d9c8b7a6f","size":1024},"layers":[],"annotations":{"org.opencontainers.image.source":"https://github.com/anchore/syft","org.opencontainers.image.revision":"abc123"}}`,
+			expected: map[string]string{
+				"org.opencontainers.image.source":   "https://github.com/anchore/syft",
+				"org.opencontainers.image.revision": "abc123",
+			},
+		},
+		{
+			name:     "docker manifest",
+			manifest: `{"schemaVersion":2,"mediaType":"application/vnd.docker.distribution.manifest.v2+json","config":{"mediaType":"application/vnd.docker.container.image.v1+json","digest":"sha256:4f63ad9e2dbc3cf6c6cf067d4f5a4a3a8e1f0e0c4c1d7a3c5b0e1e0d9c8b7a6f","size":1024},"layers":[]}`,
+		},
+		{
+			name:     "invalid manifest",
+			manifest: `not a manifest`,
+		},
+		{
+			name: "no manifest",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, manifestAnnotations([]byte(test.manifest)))
+		})
+	}
+}
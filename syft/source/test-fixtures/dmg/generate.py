@@ -0,0 +1,519 @@
+#!/usr/bin/env python3
+"""
+Generates minimal macOS disk images holding the same HFS+ volume (an application bundle with an embedded framework,
+hard links, symlinks, a fragmented file described by the extents overflow file, and a file compressed by the
+filesystem), since hdiutil is only available on macOS:
+
+  udzo.dmg  a UDIF image with zlib compressed chunks and a GUID partition table (as created by hdiutil by default)
+  udbz.dmg  a UDIF image with bzip2 compressed and raw chunks, without a partition map (hdiutil -layout NONE)
+  raw.dmg   an uncompressed disk with an Apple partition map, without a UDIF trailer (as with hdiutil -format UDTO)
+
+note: checksums of the images are not populated, since they are not verified when reading.
+
+usage: ./generate.py  (writes the images next to this script)
+"""
+import base64
+import bz2
+import os
+import struct
+import zlib
+
+HERE = os.path.dirname(os.path.abspath(__file__))
+
+SECTOR = 512
+BLOCK = 4096
+NODE = 4096
+TOTAL_BLOCKS = 64
+
+ROOT_PARENT_ID = 1
+ROOT_FOLDER_ID = 2
+EXTENTS_FILE_ID = 3
+CATALOG_FILE_ID = 4
+FIRST_USER_ID = 16
+
+FOLDER_RECORD = 1
+FILE_RECORD = 2
+FOLDER_THREAD_RECORD = 3
+FILE_THREAD_RECORD = 4
+
+DIR_MODE = 0o040755
+FILE_MODE = 0o100644
+EXEC_MODE = 0o100755
+SYMLINK_MODE = 0o120755
+
+UF_COMPRESSED = 0x20
+PRIVATE_DIR_NAME = "\x00\x00\x00\x00HFS+ Private Data"
+
+INFO_PLIST = b"""<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>CFBundleIdentifier</key>
+	<string>com.example.app</string>
+	<key>CFBundleName</key>
+	<string>Example</string>
+	<key>CFBundleShortVersionString</key>
+	<string>1.2.3</string>
+	<key>CFBundlePackageType</key>
+	<string>APPL</string>
+</dict>
+</plist>
+"""
+
+FRAMEWORK_PLIST = b"""<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0">
+<dict>
+	<key>CFBundleIdentifier</key>
+	<string>org.sparkle-project.Sparkle</string>
+	<key>CFBundleShortVersionString</key>
+	<string>2.5.1</string>
+	<key>CFBundlePackageType</key>
+	<string>FMWK</string>
+</dict>
+</plist>
+"""
+
+HELLO = b"hello from a dmg\n"
+
+# spans 10 allocation blocks, which are not contiguous
+FRAGMENTED = b"".join(b"%04d fragmented contents\n" % i for i in range(2000))[: 10 * BLOCK - 100]
+
+
+class Node:
+    counter = FIRST_USER_ID
+
+    def __init__(self, name, mode, data=b"", children=None, kind=None, owner_flags=0, link_id=None, file_id=None):
+        self.name = name
+        self.mode = mode
+        self.data = data
+        self.children = children or []
+        self.kind = kind
+        self.owner_flags = owner_flags
+        self.link_id = link_id
+        if file_id is None:
+            file_id = Node.counter
+            Node.counter += 1
+        self.id = file_id
+        self.extents = []
+
+    @property
+    def folder(self):
+        return self.mode == DIR_MODE
+
+
+def tree():
+    inode = Node("iNode%d" % Node.counter, FILE_MODE, HELLO)
+    return [
+        Node(PRIVATE_DIR_NAME, DIR_MODE, children=[inode]),
+        Node("Applications", SYMLINK_MODE, b"/Applications"),
+        Node("Example.app", DIR_MODE, children=[
+            Node("Contents", DIR_MODE, children=[
+                Node("Info.plist", FILE_MODE, INFO_PLIST),
+                Node("MacOS", DIR_MODE, children=[
+                    Node("example", EXEC_MODE, b"#!/bin/sh\necho example\n"),
+                ]),
+                Node("Frameworks", DIR_MODE, children=[
+                    Node("Sparkle.framework", DIR_MODE, children=[
+                        Node("Resources", SYMLINK_MODE, b"Versions/Current/Resources"),
+                        Node("Versions", DIR_MODE, children=[
+                            Node("A", DIR_MODE, children=[
+                                Node("Resources", DIR_MODE, children=[
+                                    Node("Info.plist", FILE_MODE, FRAMEWORK_PLIST),
+                                ]),
+                            ]),
+                            Node("Current", SYMLINK_MODE, b"A"),
+                        ]),
+                    ]),
+                ]),
+            ]),
+        ]),
+        # hard links are recorded as link files referencing the inode file within the private directory
+        Node("hello.txt", FILE_MODE, kind="hlnk", link_id=inode.id),
+        Node("hello-link.txt", FILE_MODE, kind="hlnk", link_id=inode.id),
+        Node("fragmented.txt", FILE_MODE, FRAGMENTED, kind="fragmented"),
+        # the contents of compressed files are held by an extended attribute and the resource fork
+        Node("compressed.txt", FILE_MODE, owner_flags=UF_COMPRESSED),
+    ]
+
+
+def uni_str(name):
+    encoded = name.encode("utf-16-be")
+    return struct.pack(">H", len(encoded) // 2) + encoded
+
+
+def catalog_key(parent_id, name):
+    body = struct.pack(">I", parent_id) + uni_str(name)
+    return struct.pack(">H", len(body)) + body
+
+
+def fork_data(size, extents):
+    blocks = sum(count for _, count in extents)
+    out = struct.pack(">QII", size, 0, blocks)
+    inline = (extents + [(0, 0)] * 8)[:8]
+    for start, count in inline:
+        out += struct.pack(">II", start, count)
+    return out
+
+
+def bsd_info(mode, owner_flags=0, special=0):
+    return struct.pack(">IIBBHI", 501, 20, 0, owner_flags, mode, special)
+
+
+def folder_record(node, valence):
+    return (struct.pack(">hHII", FOLDER_RECORD, 0, valence, node.id)
+            + struct.pack(">5I", *[0] * 5)
+            + bsd_info(node.mode)
+            + b"\x00" * 32
+            + struct.pack(">II", 0, 0))
+
+
+def file_record(node):
+    file_type, creator, special = b"\x00" * 4, b"\x00" * 4, 0
+    if node.kind == "hlnk":
+        file_type, creator, special = b"hlnk", b"hfs+", node.link_id
+    elif node.mode == SYMLINK_MODE:
+        file_type, creator = b"slnk", b"rhap"
+    user_info = file_type + creator + b"\x00" * 8
+    return (struct.pack(">hHII", FILE_RECORD, 0x0002, 0, node.id)
+            + struct.pack(">5I", *[0] * 5)
+            + bsd_info(node.mode, node.owner_flags, special)
+            + user_info
+            + b"\x00" * 16
+            + struct.pack(">II", 0, 0)
+            + fork_data(len(node.data), node.extents)
+            + fork_data(0, []))
+
+
+def thread_record(record_type, parent_id, name):
+    return struct.pack(">hhI", record_type, 0, parent_id) + uni_str(name)
+
+
+def sort_key(parent_id, name):
+    # an approximation of the case-insensitive ordering of HFS+ (names are ASCII)
+    return parent_id, name.lower()
+
+
+def catalog_records(volume_name, nodes):
+    records = []
+
+    def add(parent_id, node):
+        if node.folder:
+            records.append((sort_key(parent_id, node.name), catalog_key(parent_id, node.name),
+                            folder_record(node, len(node.children))))
+            records.append((sort_key(node.id, ""), catalog_key(node.id, ""),
+                            thread_record(FOLDER_THREAD_RECORD, parent_id, node.name)))
+            for child in node.children:
+                add(node.id, child)
+        else:
+            records.append((sort_key(parent_id, node.name), catalog_key(parent_id, node.name), file_record(node)))
+            records.append((sort_key(node.id, ""), catalog_key(node.id, ""),
+                            thread_record(FILE_THREAD_RECORD, parent_id, node.name)))
+
+    root = Node(volume_name, DIR_MODE, children=nodes, file_id=ROOT_FOLDER_ID)
+    add(ROOT_PARENT_ID, root)
+    records.sort(key=lambda r: r[0])
+    return [(key, data) for _, key, data in records]
+
+
+def node_bytes(flink, blink, kind, height, records):
+    out = struct.pack(">IIbBHH", flink, blink, kind, height, len(records), 0)
+    offsets = []
+    for record in records:
+        offsets.append(len(out))
+        out += record
+    offsets.append(len(out))
+    assert len(out) + 2 * len(offsets) <= NODE, "b-tree node overflow"
+    table = b"".join(struct.pack(">H", o) for o in reversed(offsets))
+    return out + b"\x00" * (NODE - len(out) - len(table)) + table
+
+
+def header_node(depth, root, leaf_records, first_leaf, last_leaf, max_key, total_nodes, used_nodes, compare,
+                attributes):
+    header = struct.pack(">HIIIIHHIIHIBBI", depth, root, leaf_records, first_leaf, last_leaf, NODE, max_key,
+                         total_nodes, total_nodes - used_nodes, 0, NODE, 0, compare, attributes) + b"\x00" * 64
+    user = b"\x00" * 128
+    map_size = NODE - 14 - len(header) - len(user) - 8
+    bitmap = bytearray(map_size)
+    for n in range(used_nodes):
+        bitmap[n // 8] |= 0x80 >> (n % 8)
+    return node_bytes(0, 0, 1, 0, [header, user, bytes(bitmap)])
+
+
+def build_tree(records, total_nodes, max_key, compare, attributes):
+    """builds a b-tree file from the (sorted) leaf records, with an index node when more than one leaf is needed"""
+    leaves, current, used = [], [], 14
+    for key, data in records:
+        record = key + data
+        if used + len(record) + 2 * (len(current) + 2) > NODE:
+            leaves.append(current)
+            current, used = [], 14
+        current.append(record)
+        used += len(record)
+    if current:
+        leaves.append(current)
+
+    nodes = []
+    first_leaf = 1
+    leaf_numbers = list(range(first_leaf, first_leaf + len(leaves)))
+    for i, leaf in enumerate(leaves):
+        flink = leaf_numbers[i + 1] if i + 1 < len(leaves) else 0
+        blink = leaf_numbers[i - 1] if i > 0 else 0
+        nodes.append(node_bytes(flink, blink, -1, 1, leaf))
+
+    depth, root = 1, first_leaf
+    if len(leaves) > 1:
+        index_records = []
+        for number, leaf in zip(leaf_numbers, leaves):
+            key_length = struct.unpack(">H", leaf[0][:2])[0]
+            index_records.append(leaf[0][: 2 + key_length] + struct.pack(">I", number))
+        root = first_leaf + len(leaves)
+        nodes.append(node_bytes(0, 0, 0, 2, index_records))
+        depth = 2
+
+    used_nodes = 1 + len(nodes)
+    assert used_nodes <= total_nodes, "b-tree file is too small"
+    header = header_node(depth, root, len(records), first_leaf, leaf_numbers[-1], max_key, total_nodes, used_nodes,
+                         compare, attributes)
+    out = header + b"".join(nodes)
+    return out + b"\x00" * (total_nodes * NODE - len(out))
+
+
+def walk(nodes):
+    for node in nodes:
+        yield node
+        yield from walk(node.children)
+
+
+def hfsplus_volume():
+    nodes = tree()
+    image = bytearray(TOTAL_BLOCKS * BLOCK)
+    used_blocks = set(range(12))  # volume header, allocation file, extents file, and catalog file
+
+    # the fragmented file occupies every other block, so needs more extents than fit within the catalog record
+    next_block = 12
+    overflow = []
+    for node in walk(nodes):
+        if node.kind != "fragmented":
+            continue
+        for i in range(0, len(node.data), BLOCK):
+            node.extents.append((next_block, 1))
+            image[next_block * BLOCK: next_block * BLOCK + BLOCK] = node.data[i: i + BLOCK].ljust(BLOCK, b"\x00")
+            used_blocks.add(next_block)
+            next_block += 2
+        key = struct.pack(">HBBII", 10, 0, 0, node.id, 8)
+        record = b"".join(struct.pack(">II", s, c) for s, c in (node.extents[8:] + [(0, 0)] * 8)[:8])
+        overflow.append((key, record))
+
+    for node in walk(nodes):
+        if node.folder or node.kind or not node.data:
+            continue
+        count = -(-len(node.data) // BLOCK)
+        node.extents = [(next_block, count)]
+        image[next_block * BLOCK: next_block * BLOCK + len(node.data)] = node.data
+        used_blocks.update(range(next_block, next_block + count))
+        next_block += count
+    used_blocks.add(TOTAL_BLOCKS - 1)  # the alternate volume header
+    assert next_block < TOTAL_BLOCKS - 1, "volume is too small"
+
+    extents_file = build_tree(overflow, 2, 10, 0, 0x2)
+    catalog_file = build_tree(catalog_records("Example", nodes), 8, 516, 0xCF, 0x6)
+    image[2 * BLOCK: 4 * BLOCK] = extents_file
+    image[4 * BLOCK: 12 * BLOCK] = catalog_file
+
+    bitmap = bytearray(BLOCK)
+    for b in used_blocks:
+        bitmap[b // 8] |= 0x80 >> (b % 8)
+    image[BLOCK: 2 * BLOCK] = bitmap
+
+    files = sum(1 for n in walk(nodes) if not n.folder)
+    folders = sum(1 for n in walk(nodes) if n.folder)
+    header = (b"H+" + struct.pack(">HIIIIIIIIIIIIIIIIIQ", 4, 1 << 8, 0x31302E30, 0, 0, 0, 0, 0, files, folders, BLOCK,
+                                  TOTAL_BLOCKS, TOTAL_BLOCKS - len(used_blocks), next_block, BLOCK, BLOCK,
+                                  Node.counter, 1, 1)
+              + b"\x00" * 32
+              + fork_data(BLOCK, [(1, 1)])
+              + fork_data(len(extents_file), [(2, 2)])
+              + fork_data(len(catalog_file), [(4, 8)])
+              + fork_data(0, [])
+              + fork_data(0, []))
+    assert len(header) == 512
+    image[1024:1536] = header
+    image[len(image) - 1024: len(image) - 512] = header
+    return bytes(image)
+
+
+# disk layouts ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+HFS_GUID = bytes.fromhex("005346480000AA11AA1100306543ECAC")
+DISK_GUID = bytes.fromhex("00112233445566778899AABBCCDDEEFF")
+
+
+def gpt_disk(volume):
+    volume_sectors = len(volume) // SECTOR
+    start = 40
+    total = start + volume_sectors + 40
+    entries = bytearray(128 * 128)
+    name = "disk image".encode("utf-16-le")
+    entries[0:128] = (HFS_GUID + DISK_GUID + struct.pack("<QQQ", start, start + volume_sectors - 1, 0)
+                      + name.ljust(72, b"\x00"))
+    entries_crc = zlib.crc32(entries)
+
+    def gpt_header(my_lba, alternate_lba, entries_lba):
+        header = bytearray(b"EFI PART" + struct.pack("<IIIIQQQQ", 0x00010000, 92, 0, 0, my_lba, alternate_lba, 34,
+                                                          total - 34)
+                           + DISK_GUID + struct.pack("<QIII", entries_lba, 128, 128, entries_crc))
+        header[16:20] = struct.pack("<I", zlib.crc32(header))
+        return bytes(header).ljust(SECTOR, b"\x00")
+
+    mbr = bytearray(SECTOR)
+    mbr[446:462] = struct.pack("<B3sB3sII", 0, b"\x00\x02\x00", 0xEE, b"\xff\xff\xff", 1, total - 1)
+    mbr[510:512] = b"\x55\xaa"
+
+    disk = bytearray(total * SECTOR)
+    disk[0:SECTOR] = mbr
+    disk[SECTOR: 2 * SECTOR] = gpt_header(1, total - 1, 2)
+    disk[2 * SECTOR: 34 * SECTOR] = entries
+    disk[start * SECTOR: (start + volume_sectors) * SECTOR] = volume
+    disk[(total - 33) * SECTOR: (total - 1) * SECTOR] = entries
+    disk[(total - 1) * SECTOR:] = gpt_header(total - 1, 1, total - 33)
+
+    # the partitions as listed by hdiutil, each of which is described by a block table
+    partitions = [
+        ("Protective Master Boot Record (MBR : 0)", 0, 1),
+        ("GPT Header (Primary GPT Header : 1)", 1, 1),
+        ("GPT Partition Data (Primary GPT Table : 2)", 2, 32),
+        (" (Apple_Free : 3)", 34, start - 34),
+        ("disk image (Apple_HFS : 4)", start, volume_sectors),
+        (" (Apple_Free : 5)", start + volume_sectors, total - 33 - start - volume_sectors),
+        ("GPT Partition Data (Backup GPT Table : 6)", total - 33, 32),
+        ("GPT Header (Backup GPT Header : 7)", total - 1, 1),
+    ]
+    return bytes(disk), partitions
+
+
+def apm_disk(volume):
+    volume_sectors = len(volume) // SECTOR
+    start = 64
+    total = start + volume_sectors + 16
+
+    def entry(map_blocks, part_start, part_blocks, name, part_type):
+        return (b"PM" + struct.pack(">HIII", 0, map_blocks, part_start, part_blocks) + name.ljust(32, b"\x00")
+                + part_type.ljust(32, b"\x00") + struct.pack(">IIII", 0, part_blocks, 0x37, 0)).ljust(SECTOR, b"\x00")
+
+    disk = bytearray(total * SECTOR)
+    disk[0:SECTOR] = (b"ER" + struct.pack(">HI", SECTOR, total)).ljust(SECTOR, b"\x00")
+    disk[SECTOR: 2 * SECTOR] = entry(3, 1, 63, b"Apple", b"Apple_partition_map")
+    disk[2 * SECTOR: 3 * SECTOR] = entry(3, start, volume_sectors, b"disk image", b"Apple_HFS")
+    disk[3 * SECTOR: 4 * SECTOR] = entry(3, start + volume_sectors, 16, b"", b"Apple_Free")
+    disk[start * SECTOR: (start + volume_sectors) * SECTOR] = volume
+    return bytes(disk)
+
+
+# UDIF images ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+CHUNK_ZERO = 0x00000000
+CHUNK_RAW = 0x00000001
+CHUNK_IGNORE = 0x00000002
+CHUNK_ZLIB = 0x80000005
+CHUNK_BZIP2 = 0x80000006
+CHUNK_TERMINATOR = 0xFFFFFFFF
+
+CHUNK_SECTORS = 128
+
+
+def block_table(disk, first, count, compression, data, free):
+    chunks = b""
+    number = 0
+    for offset in range(0, count, CHUNK_SECTORS):
+        n = min(CHUNK_SECTORS, count - offset)
+        contents = disk[(first + offset) * SECTOR: (first + offset + n) * SECTOR]
+        if free:
+            entry_type, encoded = CHUNK_IGNORE, b""
+        elif contents.count(0) == len(contents):
+            entry_type, encoded = CHUNK_ZERO, b""
+        elif compression == "bzip2" and number % 3 == 2:
+            entry_type, encoded = CHUNK_RAW, contents
+        elif compression == "bzip2":
+            entry_type, encoded = CHUNK_BZIP2, bz2.compress(contents)
+        else:
+            entry_type, encoded = CHUNK_ZLIB, zlib.compress(contents)
+        chunks += struct.pack(">IIQQQQ", entry_type, 0, offset, n, len(data), len(encoded))
+        data += encoded
+        number += 1
+    chunks += struct.pack(">IIQQQQ", CHUNK_TERMINATOR, 0, count, 0, len(data), 0)
+
+    contents = disk[first * SECTOR: (first + count) * SECTOR]
+    mish = (b"mish" + struct.pack(">IQQQII", 1, first, count, 0, 0x208, 0) + b"\x00" * 24
+            + struct.pack(">II", 2, 32) + struct.pack(">I", zlib.crc32(contents)).ljust(128, b"\x00")
+            + struct.pack(">I", number + 1) + chunks)
+    return mish, data
+
+
+def plist(tables):
+    entries = ""
+    for i, (name, mish) in enumerate(tables):
+        encoded = base64.b64encode(mish).decode()
+        lines = "\n".join("\t\t\t\t" + encoded[j: j + 52] for j in range(0, len(encoded), 52))
+        entries += f"""			<dict>
+				<key>Attributes</key>
+				<string>0x0050</string>
+				<key>CFName</key>
+				<string>{name}</string>
+				<key>Data</key>
+				<data>
+{lines}
+				</data>
+				<key>ID</key>
+				<string>{i - 1}</string>
+				<key>Name</key>
+				<string>{name}</string>
+			</dict>
+"""
+    return f"""<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>resource-fork</key>
+	<dict>
+		<key>blkx</key>
+		<array>
+{entries}		</array>
+	</dict>
+</dict>
+</plist>
+""".encode()
+
+
+def udif(disk, partitions, compression):
+    data = b""
+    tables = []
+    for name, first, count in partitions:
+        mish, data = block_table(disk, first, count, compression, data, "Apple_Free" in name)
+        tables.append((name, mish))
+
+    xml = plist(tables)
+    koly = (b"koly" + struct.pack(">IIIQQQQQII", 4, 512, 1, 0, 0, len(data), 0, 0, 1, 1) + DISK_GUID
+            + struct.pack(">II", 2, 32) + b"\x00" * 128
+            + struct.pack(">QQ", len(data), len(xml)) + b"\x00" * 120
+            + struct.pack(">II", 2, 32) + b"\x00" * 128
+            + struct.pack(">IQ", 1, len(disk) // SECTOR) + b"\x00" * 12)
+    assert len(koly) == 512
+    return data + xml + koly
+
+
+def main():
+    volume = hfsplus_volume()
+
+    disk, partitions = gpt_disk(volume)
+    with open(os.path.join(HERE, "udzo.dmg"), "wb") as f:
+        f.write(udif(disk, partitions, "zlib"))
+
+    with open(os.path.join(HERE, "udbz.dmg"), "wb") as f:
+        f.write(udif(volume, [("whole disk (Apple_HFS : 0)", 0, len(volume) // SECTOR)], "bzip2"))
+
+    with open(os.path.join(HERE, "raw.dmg"), "wb") as f:
+        f.write(apm_disk(volume))
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,72 @@
+#!/usr/bin/env python3
+"""
+Generates a minimal initramfs, which (like the images built by dracut and mkinitcpio) is an uncompressed cpio archive
+in the crc format holding CPU microcode, followed by a gzip compressed cpio archive in the newc format holding the root
+filesystem (with a symlink and hard linked files).
+
+usage: ./generate.py  (writes initrd.img next to this script)
+"""
+import gzip
+import os
+
+HERE = os.path.dirname(os.path.abspath(__file__))
+OUTPUT = os.path.join(HERE, "initrd.img")
+
+DIR = 0o040755
+FILE = 0o100644
+EXEC = 0o100755
+SYMLINK = 0o120777
+MTIME = 1700000000
+
+
+def pad4(b):
+    return b + b"\x00" * (-len(b) % 4)
+
+
+def entry(magic, ino, mode, name, data=b"", nlink=1):
+    name = name.encode() + b"\x00"
+    check = sum(data) & 0xFFFFFFFF if magic == "070702" else 0
+    fields = [ino, mode, 0, 0, nlink, MTIME, len(data), 0, 0, 0, 0, len(name), check]
+    header = magic.encode() + b"".join(b"%08X" % f for f in fields)
+    return pad4(header + name) + pad4(data)
+
+
+def archive(magic, entries):
+    out = b""
+    for ino, e in enumerate(entries, start=1):
+        out += entry(magic, e.get("ino", ino), e["mode"], e["name"], e.get("data", b""), e.get("nlink", 1))
+    out += entry(magic, 0, 0, "TRAILER!!!")
+    # archives are padded to a multiple of 512 bytes
+    return out + b"\x00" * (-len(out) % 512)
+
+
+def main():
+    early = archive("070702", [
+        {"mode": DIR, "name": "kernel"},
+        {"mode": DIR, "name": "kernel/x86"},
+        {"mode": DIR, "name": "kernel/x86/microcode"},
+        {"mode": FILE, "name": "kernel/x86/microcode/GenuineIntel.bin", "data": b"microcode\n"},
+    ])
+
+    busybox = b"BusyBox v1.36.1 (2023-11-07 18:53:09 UTC) multi-call binary.\n"
+    root = archive("070701", [
+        {"mode": DIR, "name": "."},
+        {"mode": DIR, "name": "bin"},
+        # hard links only hold the contents with the last entry of the inode
+        {"mode": EXEC, "name": "bin/busybox", "ino": 100, "nlink": 2},
+        {"mode": EXEC, "name": "bin/sh", "ino": 100, "nlink": 2, "data": busybox},
+        {"mode": SYMLINK, "name": "bin/ash", "data": b"busybox"},
+        {"mode": DIR, "name": "etc"},
+        {"mode": FILE, "name": "etc/os-release", "data": b'NAME="Alpine Linux"\nID=alpine\nVERSION_ID=3.19.0\n'},
+        # entries outside of the archive are never extracted
+        {"mode": FILE, "name": "../escape.txt", "data": b"escape\n"},
+        {"mode": SYMLINK, "name": "etc/passwd", "data": b"../../../etc/passwd"},
+    ])
+
+    with open(OUTPUT, "wb") as f:
+        f.write(early)
+        f.write(gzip.compress(root, mtime=0))
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,106 @@
+package syft
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	stereoscopeFile "github.com/anchore/stereoscope/pkg/file"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/source"
+)
+
+var errFingerprintLimit = errors.New("source is too large to fingerprint")
+
+// sourceFingerprint returns a reproducible identity of the contents of the given source: the manifest digest (or the
+// ID, when there is no manifest digest) for images, otherwise a hash of the digests of all regular files found by the
+// resolver, ordered by path (where each line hashed is "<sha256 digest>  <path>\n").
+func sourceFingerprint(ctx context.Context, cfg cataloging.SourceFingerprintConfig, desc source.Description, resolver file.Resolver) (string, error) {
+	if m, ok := desc.Metadata.(source.ImageMetadata); ok {
+		if m.ManifestDigest != "" {
+			return m.ManifestDigest, nil
+		}
+		if m.ID != "" {
+			return m.ID, nil
+		}
+		return "", fmt.Errorf("image has no manifest digest or ID")
+	}
+
+	locations, err := fingerprintLocations(ctx, cfg, resolver)
+	if err != nil {
+		return "", err
+	}
+
+	tree := sha256.New()
+	for _, location := range locations {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		digest, err := fingerprintFile(resolver, location)
+		if err != nil {
+			return "", err
+		}
+		_, _ = fmt.Fprintf(tree, "%x  %s\n", digest, location.RealPath)
+	}
+
+	return fmt.Sprintf("sha256:%x", tree.Sum(nil)), nil
+}
+
+// fingerprintLocations returns the regular files found by the resolver, ordered by path (without duplicates), or
+// errFingerprintLimit if there are more files (or bytes) than the configuration allows.
+func fingerprintLocations(ctx context.Context, cfg cataloging.SourceFingerprintConfig, resolver file.Resolver) ([]file.Location, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	seen := make(map[string]struct{})
+	var locations []file.Location
+	var totalBytes int64
+	for location := range resolver.AllLocations(ctx) {
+		if _, ok := seen[location.RealPath]; ok {
+			continue
+		}
+		metadata, err := resolver.FileMetadataByLocation(location)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get metadata for %q: %w", location.RealPath, err)
+		}
+		if metadata.Type != stereoscopeFile.TypeRegular {
+			continue
+		}
+		seen[location.RealPath] = struct{}{}
+		locations = append(locations, location)
+		if cfg.MaxFiles > 0 && len(locations) > cfg.MaxFiles {
+			return nil, fmt.Errorf("%w: more than %d files", errFingerprintLimit, cfg.MaxFiles)
+		}
+		totalBytes += metadata.Size()
+		if cfg.MaxBytes > 0 && totalBytes > cfg.MaxBytes {
+			return nil, fmt.Errorf("%w: files are larger than %d bytes", errFingerprintLimit, cfg.MaxBytes)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(locations, func(i, j int) bool {
+		return locations[i].RealPath < locations[j].RealPath
+	})
+	return locations, nil
+}
+
+func fingerprintFile(resolver file.Resolver, location file.Location) ([]byte, error) {
+	reader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %q: %w", location.RealPath, err)
+	}
+	defer internal.CloseAndLogError(reader, location.RealPath)
+
+	h := sha256.New()
+	if _, err := io.Copy(h, reader); err != nil {
+		return nil, fmt.Errorf("unable to read %q: %w", location.RealPath, err)
+	}
+	return h.Sum(nil), nil
+}